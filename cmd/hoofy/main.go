@@ -8,17 +8,32 @@
 //
 //	hoofy serve    # Start MCP server (stdio transport)
 //	hoofy update   # Update to the latest version
+//	hoofy doctor   # Diagnose the SDD project in the current directory
+//	hoofy lint     # Check the project's artifacts against governance rules
+//	hoofy wizard   # Author the spec interactively, without an AI client
+//	hoofy templates # List the artifact templates and the fields they use
+//	hoofy regenerate # Rebuild missing artifacts from their structured sidecars
 package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/doctor"
+	"github.com/HendryAvila/Hoofy/internal/lint"
 	sddserver "github.com/HendryAvila/Hoofy/internal/server"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/HendryAvila/Hoofy/internal/tools"
 	"github.com/HendryAvila/Hoofy/internal/updater"
+	"github.com/HendryAvila/Hoofy/internal/wizard"
+	"github.com/HendryAvila/Hoofy/sdd"
 	"github.com/mark3labs/mcp-go/server"
 )
 
@@ -30,12 +45,55 @@ func main() {
 
 	switch os.Args[1] {
 	case "serve":
-		if err := run(); err != nil {
+		var opts sddserver.Options
+		err := parseFlags("serve", os.Args[2:], func(fs *flag.FlagSet) {
+			fs.BoolVar(&opts.ReadOnly, "read-only", false, "expose only sdd_get_context and the status resources; reject every change")
+			fs.DurationVar(&opts.ToolTimeout, "tool-timeout", tools.DefaultToolTimeout, "stop a read-only tool call that runs longer than this and return a timeout error; tools that write get the deadline but are never abandoned (0 disables)")
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		if err := run(opts); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 	case "update":
 		runUpdate()
+	case "doctor":
+		var fix bool
+		err := parseFlags("doctor", os.Args[2:], func(fs *flag.FlagSet) {
+			fs.BoolVar(&fix, "fix", false, "repair a stage whose artifact was written but never recorded in the config")
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		os.Exit(runDoctor(fix))
+	case "lint":
+		var rulesPath string
+		err := parseFlags("lint", os.Args[2:], func(fs *flag.FlagSet) {
+			fs.StringVar(&rulesPath, "rules", "", "JSON rule file (default: the built-in rule set)")
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		os.Exit(runLint(rulesPath))
+	case "wizard":
+		if err := parseFlags("wizard", os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		os.Exit(runWizard())
+	case "templates":
+		os.Exit(runTemplates())
+	case "regenerate":
+		if err := parseFlags("regenerate", os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		os.Exit(runRegenerate())
 	case "--help", "-h", "help":
 		printUsage()
 		os.Exit(0)
@@ -49,8 +107,35 @@ func main() {
 	}
 }
 
-func run() error {
-	s, cleanup, err := sddserver.New()
+// parseFlags parses the flags shared by commands that work on a project
+// and applies them to the config package before anything resolves paths.
+// extra registers command-specific flags.
+func parseFlags(cmd string, args []string, extra ...func(*flag.FlagSet)) error {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	for _, register := range extra {
+		register(fs)
+	}
+	configName := fs.String("config-name", "", "project config filename inside the docs directory (default: hoofy.json)")
+	configFormat := fs.String("config-format", "", "format for new project configs: json or yaml (default: json)")
+	noColor := fs.Bool("no-color", false, "use ASCII status markers instead of emoji (same as NO_COLOR=1)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *noColor {
+		// The server reads its defaults from the environment, so the
+		// flag is just a spelling of NO_COLOR.
+		if err := os.Setenv(config.EnvNoColor, "1"); err != nil {
+			return err
+		}
+	}
+	if err := config.SetConfigFormat(*configFormat); err != nil {
+		return err
+	}
+	return config.SetConfigName(*configName)
+}
+
+func run(opts sddserver.Options) error {
+	s, cleanup, err := sddserver.New(opts)
 	if err != nil {
 		return fmt.Errorf("creating server: %w", err)
 	}
@@ -115,12 +200,261 @@ func runUpdate() {
 	fmt.Fprintf(os.Stderr, "   Restart hoofy to use the new version.\n")
 }
 
+// runDoctor diagnoses the project containing the working directory and
+// prints a PASS/WARN/FAIL checklist to stdout. With fix it first applies
+// doctor.Fix and prints each repair. Returns the exit code: 1 if any
+// check failed or the repair failed, 0 otherwise.
+func runDoctor(fix bool) int {
+	defaults, err := config.DefaultsFromEnv(os.Getenv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err := config.SetDocsDir(defaults.DocsDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	root, ok := config.FindProjectRoot(cwd)
+	if !ok {
+		root = cwd
+	}
+
+	fmt.Printf("hoofy v%s doctor — %s\n\n", sddserver.Version, root)
+	store := config.NewFileStore()
+	if fix {
+		repairs, err := doctor.Fix(root, store)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		for _, r := range repairs {
+			fmt.Printf("FIXED %s\n", r)
+		}
+		if len(repairs) == 0 {
+			fmt.Println("Nothing to fix.")
+		}
+		fmt.Println()
+	}
+	checks := doctor.Run(root, store)
+	doctor.Print(os.Stdout, checks)
+	if doctor.Failed(checks) {
+		return 1
+	}
+	return 0
+}
+
+// runLint checks the project containing the working directory against
+// the rule file at rulesPath (the built-in rules when empty) and prints
+// the violations to stdout. Returns the exit code: 1 if any error-severity
+// rule failed, 2 if the rules or project couldn't be loaded, 0 otherwise.
+func runLint(rulesPath string) int {
+	defaults, err := config.DefaultsFromEnv(os.Getenv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	if err := config.SetDocsDir(defaults.DocsDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	rules := lint.DefaultRules()
+	if rulesPath != "" {
+		if rules, err = lint.LoadRules(rulesPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 2
+		}
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	root, ok := config.FindProjectRoot(cwd)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: no SDD project found in %s or its parents\n", cwd)
+		return 2
+	}
+
+	violations, err := lint.Run(root, rules)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	lint.Print(os.Stdout, violations)
+	if lint.HasErrors(violations) {
+		return 1
+	}
+	return 0
+}
+
+// runWizard walks the project containing the working directory (or a new
+// project in it) through the pipeline, prompting on stdin. Returns the
+// exit code: 1 if the wizard stopped before the pipeline was complete.
+func runWizard() int {
+	defaults, err := config.DefaultsFromEnv(os.Getenv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err := config.SetDocsDir(defaults.DocsDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	root, ok := config.FindProjectRoot(cwd)
+	if !ok {
+		root = cwd
+	}
+
+	eng, err := sdd.New(root)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Printf("hoofy v%s wizard — %s\n\n", sddserver.Version, root)
+	if err := wizard.New(eng, os.Stdin, os.Stdout).Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "\nError: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runTemplates prints the artifact templates — embedded or overridden
+// by SDD_TEMPLATES_DIR — with the data fields each can use. Returns the
+// exit code: 1 if the overlay directory doesn't load.
+func runTemplates() int {
+	defaults, err := config.DefaultsFromEnv(os.Getenv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	renderer, err := templates.NewRendererWithOverlay(defaults.TemplatesDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if defaults.TemplatesDir != "" {
+		fmt.Printf("Overlay: %s\n\n", defaults.TemplatesDir)
+	}
+	for _, info := range renderer.List() {
+		fmt.Printf("%-28s %-8s %s\n", info.Name, info.Source, strings.Join(info.Fields, ", "))
+	}
+	return 0
+}
+
+// runRegenerate rebuilds the missing artifacts of the project containing
+// the working directory from their structured sidecars and prints what
+// could and couldn't be regenerated. Returns the exit code: 1 if the
+// project couldn't be loaded or an artifact failed to render.
+func runRegenerate() int {
+	defaults, err := config.DefaultsFromEnv(os.Getenv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err := config.SetDocsDir(defaults.DocsDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	renderer, err := templates.NewRendererWithOverlay(defaults.TemplatesDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	root, ok := config.FindProjectRoot(cwd)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: no SDD project found in %s or its parents\n", cwd)
+		return 1
+	}
+
+	fmt.Printf("hoofy v%s regenerate — %s\n\n", sddserver.Version, root)
+	results, err := tools.Regenerate(root, config.NewFileStore(), renderer)
+	for _, r := range results {
+		mark := "skip"
+		if r.Regenerated {
+			mark = "done"
+		}
+		rel, relErr := filepath.Rel(root, r.Path)
+		if relErr != nil {
+			rel = r.Path
+		}
+		fmt.Printf("[%s] %-24s %s\n", mark, rel, r.Reason)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "\nError: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
 func printUsage() {
 	fmt.Fprintf(os.Stderr, `Hoofy v%s — Spec-Driven Development MCP Server
 
 Usage:
   hoofy serve    Start the MCP server (stdio transport)
+                 (--read-only to expose only sdd_get_context and the
+                 status resources, for demos and CI)
+                 (--tool-timeout DURATION to stop a read-only tool call
+                 that runs longer, default 30s; 0 disables)
   hoofy update   Update to the latest version
+  hoofy doctor   Diagnose the SDD project in the current directory
+                 (--fix to complete a stage whose artifact was written
+                 but never recorded in hoofy.json)
+  hoofy lint     Check the project's artifacts against governance rules
+                 (--rules FILE for a custom JSON rule set; exits 1 on errors)
+  hoofy wizard   Author the spec interactively on the terminal, stage by
+                 stage, with the same validation and Clarity Gate
+  hoofy templates
+                 List the artifact templates, whether each is embedded or
+                 overridden by SDD_TEMPLATES_DIR, and the data fields it uses
+  hoofy regenerate
+                 Rebuild deleted artifacts from their structured sidecars
+                 (requirements.md from requirements.json) and report which
+                 artifacts have no structured source
+
+Flags (serve, doctor, lint, wizard, regenerate):
+  --config-name NAME      Project config filename (default: hoofy.json).
+                          Lets several projects share one docs directory,
+                          e.g. --config-name api.json and --config-name web.json.
+                          Each name keeps its artifacts in its own
+                          subdirectory, e.g. docs/api/ beside docs/api.json.
+  --config-format FORMAT  json | yaml (default: json). New projects get
+                          hoofy.yaml instead of hoofy.json; existing configs
+                          are detected and keep their format.
+  --no-color              ASCII status markers ([x], [~], [>], [ ]) instead of
+                          emoji in tool output. Same as setting NO_COLOR.
+
+Environment (optional defaults for sdd_init_project):
+  SDD_DEFAULT_MODE        guided | expert
+  SDD_CLARITY_THRESHOLD   Clarity Gate threshold, 1-100
+  SDD_DIR                 Artifact directory relative to project root (default: docs)
+  SDD_LINE_ENDINGS        lf | crlf newlines in written artifacts (default: lf)
+  SDD_FILE_MODE           Octal permissions for written artifacts (default: 0644)
+  SDD_WRITE_RETRIES       Retries for transiently failing writes, 0-10 (default: 3)
+  SDD_MAX_INPUT_BYTES     Maximum size of any single tool argument (default: 262144)
+  SDD_TEMPLATES_DIR       Directory of *.tmpl files overriding the built-in
+                          artifact templates of the same name
+  NO_COLOR                Any value: ASCII status markers instead of emoji
 
 Configuration:
   Add to your AI tool's MCP config:
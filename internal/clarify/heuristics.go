@@ -0,0 +1,192 @@
+package clarify
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+// vagueWords are terms that read as requirements but can't be tested:
+// "fast", "user-friendly", "some". Matched as whole words.
+var vagueWords = []string{
+	"some", "several", "many", "various", "etc", "fast", "quick", "quickly",
+	"slow", "easy", "easily", "simple", "intuitive", "user-friendly",
+	"seamless", "seamlessly", "robust", "scalable", "efficient", "flexible",
+	"modern", "appropriate", "as needed", "if possible", "and so on",
+}
+
+// vaguePattern matches any of vagueWords, case-insensitively.
+var vaguePattern = regexp.MustCompile(`(?i)\b(` + strings.Join(quoteAll(vagueWords), "|") + `)\b`)
+
+// measurablePattern matches a number, the mark of a testable NFR
+// ("under 200ms", "99.9% uptime", "10k users").
+var measurablePattern = regexp.MustCompile(`\d`)
+
+// dimensionKeywords are the words whose presence suggests a dimension
+// has been thought about. Each distinct keyword found is one signal.
+var dimensionKeywords = map[string][]string{
+	"target_users":      {"user", "users", "admin", "administrator", "customer", "persona", "role", "visitor", "operator", "as a"},
+	"data_model":        {"store", "stores", "record", "entity", "field", "database", "profile", "account", "history", "schema", "attribute"},
+	"integrations":      {"api", "integrate", "integration", "webhook", "oauth", "third-party", "import", "export", "sync", "email", "sms", "payment"},
+	"edge_cases":        {"error", "fail", "fails", "failure", "invalid", "retry", "timeout", "offline", "duplicate", "limit", "empty", "conflict", "rollback"},
+	"security":          {"auth", "authentication", "authorization", "login", "password", "permission", "encrypt", "encrypted", "token", "session", "gdpr", "privacy", "mfa", "2fa", "audit"},
+	"scale_performance": {"latency", "ms", "seconds", "concurrent", "throughput", "load", "uptime", "response time", "requests per", "availability"},
+}
+
+// VagueTerm is one vague word found in a requirement.
+type VagueTerm struct {
+	ID   string // requirement ID, e.g. "FR-003"
+	Word string
+}
+
+// FindVagueTerms returns the vague words used in doc's identified
+// requirements, in document order.
+func FindVagueTerms(doc string) []VagueTerm {
+	var terms []VagueTerm
+	for _, r := range requirements.ParseMarkdown(doc) {
+		for _, w := range vaguePattern.FindAllString(r.Text, -1) {
+			terms = append(terms, VagueTerm{ID: r.ID, Word: strings.ToLower(w)})
+		}
+	}
+	return terms
+}
+
+// Heuristics estimates the Clarity Gate dimensions of a rendered
+// requirements.md without an AI: it counts identified requirements,
+// looks for the keywords each dimension is usually written with,
+// penalizes vague wording and open [NEEDS CLARIFICATION] markers, and
+// rewards measurable NFRs and explicit Won't Haves. Each dimension's
+// Justification cites the evidence.
+//
+// The scores are a deterministic starting point for the AI to refine,
+// never a substitute for the gate: they are capped at 80, since keyword
+// presence shows a topic is mentioned, not that it is unambiguous.
+func Heuristics(doc string) []pipeline.ClarityDimension {
+	data := templates.ParseRequirements(doc)
+	reqs := requirements.ParseMarkdown(doc)
+	lower := strings.ToLower(doc)
+	markers := len(ExtractMarkers(doc))
+
+	var functional, nfrs, measurable, wont int
+	for _, r := range reqs {
+		switch r.Bucket {
+		case requirements.BucketMust, requirements.BucketShould, requirements.BucketCould:
+			functional++
+		case requirements.BucketNFR:
+			nfrs++
+			if measurablePattern.MatchString(r.Text) {
+				measurable++
+			}
+		case requirements.BucketWont:
+			wont++
+		}
+	}
+	unidentified := listItems(data.MustHave) + listItems(data.ShouldHave) + listItems(data.CouldHave) - functional
+	vague := FindVagueTerms(doc)
+
+	dims := pipeline.DefaultDimensions()
+	for i := range dims {
+		d := &dims[i]
+		var score int
+		var evidence []string
+		switch d.Name {
+		case "core_functionality":
+			score = min(functional*15, 90)
+			evidence = append(evidence, fmt.Sprintf("%d functional requirement(s) with IDs", functional))
+			if unidentified > 0 {
+				score -= 10 * unidentified
+				evidence = append(evidence, fmt.Sprintf("%d without an ID", unidentified))
+			}
+			if n := countVague(vague, "FR-"); n > 0 {
+				score -= 5 * n
+				evidence = append(evidence, fmt.Sprintf("%d vague term(s) in FRs", n))
+			}
+			if markers > 0 {
+				score -= 5 * markers
+				evidence = append(evidence, fmt.Sprintf("%d open [NEEDS CLARIFICATION] marker(s)", markers))
+			}
+		case "scale_performance":
+			score, evidence = keywordScore(lower, d.Name)
+			if nfrs > 0 {
+				score += 40 * measurable / nfrs
+				evidence = append(evidence, fmt.Sprintf("%d of %d NFR(s) measurable", measurable, nfrs))
+			} else {
+				evidence = append(evidence, "no NFRs")
+			}
+			if n := countVague(vague, "NFR-"); n > 0 {
+				score -= 10 * n
+				evidence = append(evidence, fmt.Sprintf("%d vague term(s) in NFRs", n))
+			}
+		case "scope_boundaries":
+			score = min(wont*20, 60)
+			evidence = append(evidence, fmt.Sprintf("%d Won't Have item(s)", wont))
+			for _, s := range []struct{ name, body string }{
+				{"constraints", data.Constraints}, {"assumptions", data.Assumptions},
+			} {
+				if listItems(s.body) > 0 {
+					score += 15
+					evidence = append(evidence, s.name+" listed")
+				}
+			}
+		default:
+			score, evidence = keywordScore(lower, d.Name)
+		}
+		d.Score = max(0, min(score, 80))
+		d.Covered = d.Score > 30
+		d.Justification = "heuristic: " + strings.Join(evidence, "; ")
+	}
+	return dims
+}
+
+// keywordScore scores a dimension 20 points per distinct keyword found
+// in lower, and names the keywords as evidence.
+func keywordScore(lower, dimension string) (int, []string) {
+	var found []string
+	for _, kw := range dimensionKeywords[dimension] {
+		if regexp.MustCompile(`\b` + regexp.QuoteMeta(kw) + `\b`).MatchString(lower) {
+			found = append(found, kw)
+		}
+	}
+	if len(found) == 0 {
+		return 0, []string{"no related terms"}
+	}
+	sort.Strings(found)
+	return 20 * len(found), []string{"mentions " + strings.Join(found, "/")}
+}
+
+// countVague counts the vague terms in requirements whose ID starts
+// with prefix.
+func countVague(terms []VagueTerm, prefix string) int {
+	n := 0
+	for _, t := range terms {
+		if strings.HasPrefix(t.ID, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+// listItems counts the top-level list items in a section body.
+func listItems(body string) int {
+	n := 0
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") {
+			n++
+		}
+	}
+	return n
+}
+
+// quoteAll regexp-quotes each word.
+func quoteAll(words []string) []string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return quoted
+}
@@ -0,0 +1,138 @@
+package clarify
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+)
+
+const clearRequirements = `# Shop — Requirements
+
+## Functional Requirements
+
+### Must Have
+
+- **FR-001**: Customers can register an account with email and password
+- **FR-002**: Admin users can export the order history as CSV
+- **FR-003**: Payment failures are retried once, then shown as an error
+- **FR-004**: Login sessions expire after 30 minutes; tokens are encrypted
+
+### Should Have
+
+- **FR-005**: Customers receive an order confirmation email
+
+### Won't Have (this version)
+
+- **FR-006**: Mobile app
+- **FR-007**: Loyalty points
+
+## Non-Functional Requirements
+
+- **NFR-001**: Checkout responds in under 300 ms at p95
+- **NFR-002**: 99.9% monthly uptime
+
+## Constraints
+
+- Hosted in the EU
+
+## Assumptions
+
+- Stripe handles card storage
+`
+
+const vagueRequirements = `# Shop — Requirements
+
+## Functional Requirements
+
+### Must Have
+
+- **FR-001**: The app should be fast and user-friendly
+- **FR-002**: Support some payment options [NEEDS CLARIFICATION: which ones?]
+- Show a nice dashboard
+
+## Non-Functional Requirements
+
+- **NFR-001**: Pages load quickly
+`
+
+func scoresByName(dims []pipeline.ClarityDimension) map[string]int {
+	scores := make(map[string]int, len(dims))
+	for _, d := range dims {
+		scores[d.Name] = d.Score
+	}
+	return scores
+}
+
+func TestHeuristics_ClearRequirementsScoreHigher(t *testing.T) {
+	clear := Heuristics(clearRequirements)
+	vague := Heuristics(vagueRequirements)
+	if len(clear) != len(pipeline.DefaultDimensions()) {
+		t.Fatalf("got %d dimensions, want the %d defaults", len(clear), len(pipeline.DefaultDimensions()))
+	}
+
+	clearScores, vagueScores := scoresByName(clear), scoresByName(vague)
+	for _, name := range []string{"core_functionality", "scale_performance", "scope_boundaries", "security", "edge_cases"} {
+		if clearScores[name] <= vagueScores[name] {
+			t.Errorf("%s: clear %d should beat vague %d", name, clearScores[name], vagueScores[name])
+		}
+	}
+	if got, want := pipeline.CalculateScore(clear), pipeline.CalculateScore(vague); got <= want {
+		t.Errorf("overall: clear %d should beat vague %d", got, want)
+	}
+
+	for _, d := range clear {
+		if d.Score < 0 || d.Score > 80 {
+			t.Errorf("%s score %d outside 0-80", d.Name, d.Score)
+		}
+		if d.Covered != (d.Score > 30) {
+			t.Errorf("%s: Covered = %v with score %d", d.Name, d.Covered, d.Score)
+		}
+		if !strings.HasPrefix(d.Justification, "heuristic: ") {
+			t.Errorf("%s justification should cite the heuristic: %q", d.Name, d.Justification)
+		}
+	}
+}
+
+func TestHeuristics_Evidence(t *testing.T) {
+	dims := Heuristics(vagueRequirements)
+	byName := make(map[string]string)
+	for _, d := range dims {
+		byName[d.Name] = d.Justification
+	}
+	for name, want := range map[string]string{
+		"core_functionality": "2 functional requirement(s) with IDs; 1 without an ID; 3 vague term(s) in FRs; 1 open [NEEDS CLARIFICATION] marker(s)",
+		"scale_performance":  "0 of 1 NFR(s) measurable; 1 vague term(s) in NFRs",
+		"scope_boundaries":   "0 Won't Have item(s)",
+	} {
+		if !strings.Contains(byName[name], want) {
+			t.Errorf("%s justification = %q, want it to contain %q", name, byName[name], want)
+		}
+	}
+	if got := scoresByName(dims)["core_functionality"]; got != 0 {
+		t.Errorf("core_functionality = %d, want 0 after penalties", got)
+	}
+}
+
+func TestHeuristics_Deterministic(t *testing.T) {
+	if a, b := Heuristics(clearRequirements), Heuristics(clearRequirements); !reflect.DeepEqual(a, b) {
+		t.Errorf("Heuristics is not deterministic:\n%+v\n%+v", a, b)
+	}
+}
+
+func TestFindVagueTerms(t *testing.T) {
+	got := FindVagueTerms(vagueRequirements)
+	want := []VagueTerm{
+		{ID: "FR-001", Word: "fast"},
+		{ID: "FR-001", Word: "user-friendly"},
+		{ID: "FR-002", Word: "some"},
+		{ID: "NFR-001", Word: "quickly"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindVagueTerms =\n%+v\nwant\n%+v", got, want)
+	}
+	if got := FindVagueTerms(clearRequirements); got != nil {
+		t.Errorf("clear requirements have no vague terms, got %+v", got)
+	}
+}
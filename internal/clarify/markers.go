@@ -0,0 +1,88 @@
+// Package clarify finds explicit author uncertainty in SDD artifacts:
+// inline [NEEDS CLARIFICATION: ...] markers, a common spec-driven
+// development convention for "I don't know yet, ask me".
+//
+// Markers feed the Clarity Gate: every one left in the requirements
+// becomes a question the gate must ask, so the score reacts to gaps the
+// author already knows about instead of only those the agent infers.
+package clarify
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// markerPattern matches [NEEDS CLARIFICATION] with an optional
+// ": question" body, case-insensitively. The body can't contain ']'.
+var markerPattern = regexp.MustCompile(`(?i)\[NEEDS CLARIFICATION(?:\s*:\s*([^\]]*))?\]`)
+
+// Marker is one unresolved [NEEDS CLARIFICATION] marker.
+type Marker struct {
+	// Stage is the artifact the marker was found in. ExtractMarkers
+	// leaves it empty; callers scanning a stage artifact set it.
+	Stage config.Stage
+	// Path is the file the marker was found in, set alongside Stage:
+	// a stage split across files has more than one.
+	Path string
+	// Line is the 1-based line of the marker. It is approximate in the
+	// sense that a marker wrapped across lines reports its first line.
+	Line int
+	// Text is the marker's question, empty for a bare marker.
+	Text string
+	// Context is the surrounding line with the marker removed, e.g.
+	// the requirement the question is about.
+	Context string
+}
+
+// Question phrases m as a question for the Clarity Gate.
+func (m Marker) Question() string {
+	switch {
+	case m.Text != "" && m.Context != "":
+		return m.Text + " (re: " + m.Context + ")"
+	case m.Text != "":
+		return m.Text
+	case m.Context != "":
+		return "What is unclear about: " + m.Context + "?"
+	default:
+		return "The author flagged this spot as needing clarification."
+	}
+}
+
+// ExtractMarkers returns the [NEEDS CLARIFICATION] markers in content,
+// in document order. A line with several markers yields one per marker.
+func ExtractMarkers(content string) []Marker {
+	var markers []Marker
+	for i, line := range strings.Split(content, "\n") {
+		matches := markerPattern.FindAllStringSubmatch(line, -1)
+		if len(matches) == 0 {
+			continue
+		}
+		context := markerContext(markerPattern.ReplaceAllString(line, ""))
+		for _, m := range matches {
+			markers = append(markers, Marker{
+				Line:    i + 1,
+				Text:    strings.TrimSpace(m[1]),
+				Context: context,
+			})
+		}
+	}
+	return markers
+}
+
+// StripMarkers removes every [NEEDS CLARIFICATION] marker from content,
+// e.g. from a requirement deferred instead of clarified.
+func StripMarkers(content string) string {
+	return markerPattern.ReplaceAllString(content, "")
+}
+
+// markerContext strips list bullets, emphasis and stray punctuation
+// from a line so it reads as a phrase.
+func markerContext(line string) string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimLeft(line, "-*+#> ")
+	line = strings.ReplaceAll(line, "**", "")
+	line = strings.Join(strings.Fields(line), " ")
+	return strings.TrimRight(line, " :—-")
+}
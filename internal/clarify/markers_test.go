@@ -0,0 +1,49 @@
+package clarify
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractMarkers(t *testing.T) {
+	content := `# Requirements
+
+## Must Have
+
+- **FR-001**: Users can log in
+- **FR-002**: Export reports [NEEDS CLARIFICATION: which formats?] nightly
+- **FR-003**: Retain data [needs clarification] [NEEDS CLARIFICATION: how long?]
+
+[NEEDS CLARIFICATION]
+`
+	got := ExtractMarkers(content)
+	want := []Marker{
+		{Line: 6, Text: "which formats?", Context: "FR-002: Export reports nightly"},
+		{Line: 7, Text: "", Context: "FR-003: Retain data"},
+		{Line: 7, Text: "how long?", Context: "FR-003: Retain data"},
+		{Line: 9},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractMarkers =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := ExtractMarkers("- FR-001: no uncertainty [NEEDS REVIEW]"); got != nil {
+		t.Errorf("unrelated brackets should not match, got %+v", got)
+	}
+}
+
+func TestMarker_Question(t *testing.T) {
+	tests := []struct {
+		m    Marker
+		want string
+	}{
+		{Marker{Text: "which formats?", Context: "FR-002: Export reports"}, "which formats? (re: FR-002: Export reports)"},
+		{Marker{Text: "which formats?"}, "which formats?"},
+		{Marker{Context: "FR-003: Retain data"}, "What is unclear about: FR-003: Retain data?"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.Question(); got != tt.want {
+			t.Errorf("Question() = %q, want %q", got, tt.want)
+		}
+	}
+}
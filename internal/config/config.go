@@ -6,11 +6,18 @@
 package config
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/HendryAvila/Hoofy/internal/i18n"
 )
 
 const (
@@ -18,7 +25,8 @@ const (
 	DocsDir = "docs"
 	// DocsDirFallback is the subdirectory used when docs/ already exists with non-Hoofy content.
 	DocsDirFallback = "specs"
-	// ConfigFile is the Hoofy configuration filename.
+	// ConfigFile is the default Hoofy configuration filename.
+	// Use ConfigName() for the active name (see SetConfigName).
 	ConfigFile = "hoofy.json"
 )
 
@@ -34,6 +42,15 @@ const (
 	ModeExpert Mode = "expert"
 )
 
+// ParseMode parses a mode name, case-insensitively.
+func ParseMode(s string) (Mode, error) {
+	mode := Mode(strings.ToLower(strings.TrimSpace(s)))
+	if mode != ModeGuided && mode != ModeExpert {
+		return "", fmt.Errorf("mode must be 'guided' or 'expert', got %q", s)
+	}
+	return mode, nil
+}
+
 // Stage represents a discrete phase in the SDD pipeline.
 type Stage string
 
@@ -47,10 +64,15 @@ const (
 	StageDesign        Stage = "design"
 	StageTasks         Stage = "tasks"
 	StageValidate      Stage = "validate"
+
+	// StageResearch is an optional research/spike stage inserted after
+	// clarify when enabled per project (see EnableOptionalStage).
+	StageResearch Stage = "research"
 )
 
-// StageOrder defines the sequential pipeline. Used by the pipeline package
-// to determine valid transitions.
+// StageOrder defines the default sequential pipeline. Projects that enable
+// optional stages have a longer order — use StageOrderFor(cfg) whenever a
+// project config is at hand; the pipeline package always does.
 var StageOrder = []Stage{
 	StageInit,
 	StagePrinciples,
@@ -68,6 +90,8 @@ type StageMetadata struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 	Order       int    `json:"order"`
+	// Optional stages are not part of StageOrder; projects opt in at init.
+	Optional bool `json:"optional,omitempty"`
 }
 
 // Stages maps each Stage to its metadata.
@@ -81,29 +105,306 @@ var Stages = map[Stage]StageMetadata{
 	StageDesign:        {Name: "Design", Description: "Create technical architecture and design decisions", Order: 6},
 	StageTasks:         {Name: "Tasks", Description: "Break down design into atomic, actionable tasks", Order: 7},
 	StageValidate:      {Name: "Validate", Description: "Verify consistency across all artifacts", Order: 8},
+	// Optional stages share the Order of the stage they follow.
+	StageResearch: {Name: "Research", Description: "Evaluate libraries and run spikes before committing to a design", Order: 5, Optional: true},
+}
+
+// optionalStageAfter maps each optional stage to the stage it is
+// inserted directly after when enabled.
+var optionalStageAfter = map[Stage]Stage{
+	StageResearch: StageClarify,
+}
+
+// IsOptionalStage reports whether stage can be enabled per project.
+func IsOptionalStage(stage Stage) bool {
+	_, ok := optionalStageAfter[stage]
+	return ok
 }
 
+// StageOrderFor returns the pipeline order for a project: StageOrder with
+// any enabled optional stages spliced in. A nil cfg yields StageOrder.
+// The returned slice is always a fresh copy, safe for callers to modify.
+func StageOrderFor(cfg *ProjectConfig) []Stage {
+	order := make([]Stage, 0, len(StageOrder)+len(optionalStageAfter))
+	for _, stage := range StageOrder {
+		order = append(order, stage)
+		if cfg == nil {
+			continue
+		}
+		for _, opt := range cfg.OptionalStages {
+			if optionalStageAfter[opt] == stage {
+				order = append(order, opt)
+			}
+		}
+	}
+	return order
+}
+
+// Clarity score schemes (ProjectConfig.ScoringScheme).
+const (
+	// ScoringMean is the weighted mean of the dimension scores.
+	ScoringMean = "mean"
+	// ScoringPenalized is the weighted mean minus a penalty for each
+	// weak dimension, scaled by its weight, so one unaddressed critical
+	// dimension can hold the gate shut.
+	ScoringPenalized = "penalized"
+)
+
+// ScoringSchemes lists the valid clarity score schemes.
+var ScoringSchemes = []string{ScoringMean, ScoringPenalized}
+
+// Implementation statuses of a task (ProjectConfig.TaskStatus).
+const (
+	TaskTodo       = "todo"
+	TaskInProgress = "in-progress"
+	TaskDone       = "done"
+	TaskBlocked    = "blocked"
+)
+
+// TaskStatuses lists the valid task statuses.
+var TaskStatuses = []string{TaskTodo, TaskInProgress, TaskDone, TaskBlocked}
+
 // StageStatus tracks progress for a single pipeline stage.
 type StageStatus struct {
 	Status      string `json:"status"` // pending | in_progress | completed | skipped
 	StartedAt   string `json:"started_at,omitempty"`
 	CompletedAt string `json:"completed_at,omitempty"`
+	CompletedBy string `json:"completed_by,omitempty"` // client or agent that completed the stage
 	Iterations  int    `json:"iterations"`
+	// References are the external links attached to the stage's
+	// artifact (RFCs, tickets), in the order given.
+	References []Reference `json:"references,omitempty"`
+	// SourceHashes are the sha256 hashes of the artifacts the stage's
+	// artifact was built from, keyed by their stage, as they were when
+	// the stage completed. A later mismatch means the artifact may be
+	// stale (e.g. requirements amended after design).
+	SourceHashes map[Stage]string `json:"source_hashes,omitempty"`
 }
 
+// Reference is an external link attached to a stage's artifact.
+type Reference struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// UnknownActor is recorded as StageStatus.CompletedBy when the caller
+// that completed a stage did not identify itself.
+const UnknownActor = "unknown"
+
 // ProjectConfig is the root configuration persisted in hoofy.json.
 type ProjectConfig struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 	Version     string `json:"version"`
 
+	// Revision counts the saves of this config. FileStore.Save rejects a
+	// config whose Revision is behind the one on disk with ErrConflict,
+	// so two writers can't silently clobber each other's updates.
+	// (Version above is the Hoofy version that created the project.)
+	Revision int `json:"revision,omitempty"`
+
 	Mode         Mode   `json:"mode"`
 	CurrentStage Stage  `json:"current_stage"`
 	CreatedAt    string `json:"created_at"`
 	UpdatedAt    string `json:"updated_at"`
 
+	// Lang is the language of the tools' responses and guidance (not of
+	// the artifacts — see SDD_TEMPLATES_DIR). Empty means English.
+	Lang i18n.Lang `json:"lang,omitempty"`
+
 	StageStatus  map[Stage]StageStatus `json:"stage_status"`
 	ClarityScore int                   `json:"clarity_score"`
+
+	// OptionalStages lists the optional stages enabled for this project.
+	// Empty means the default StageOrder pipeline.
+	OptionalStages []Stage `json:"optional_stages,omitempty"`
+
+	// ClarityThreshold overrides the mode's built-in Clarity Gate
+	// threshold when non-zero (seeded from SDD_CLARITY_THRESHOLD or
+	// the sdd_init_project parameter).
+	ClarityThreshold int `json:"clarity_threshold,omitempty"`
+
+	// ClarityMargin is how many points above the threshold a passing
+	// Clarity Gate score still counts as marginal: sdd_clarify advances
+	// but flags the pass. 0 means the default margin; -1 turns it off.
+	ClarityMargin int `json:"clarity_margin,omitempty"`
+
+	// ScoringScheme selects how dimension scores combine into the
+	// Clarity Gate score: ScoringMean (the default when empty) or
+	// ScoringPenalized.
+	ScoringScheme string `json:"scoring_scheme,omitempty"`
+
+	// DimensionScores holds the latest score (0-100) of each clarity
+	// dimension, so a focused sdd_clarify round can rescore a few
+	// dimensions and keep the rest.
+	DimensionScores map[string]int `json:"dimension_scores,omitempty"`
+
+	// ClarityGatePassed records how the Clarity Gate was passed, set
+	// whenever the pipeline advances out of clarify (sdd_clarify adds
+	// each dimension's evidence). It is nil when the gate was never passed
+	// through the pipeline — e.g. the score was set by hand — which the
+	// validation report calls out.
+	ClarityGatePassed *ClarityGatePass `json:"clarity_gate_passed,omitempty"`
+
+	// Preset names the project-type bundle chosen at init (e.g. "library"),
+	// empty when none was. DimensionWeights holds the weights it set,
+	// overriding the built-in clarity dimension weights (1-10).
+	Preset           string         `json:"preset,omitempty"`
+	DimensionWeights map[string]int `json:"dimension_weights,omitempty"`
+
+	// RecommendedFields replaces, per stage, the built-in list of optional
+	// fields a stage tool questions when left empty; an empty list turns
+	// the note off for that stage. SkipRecommendedFields turns it off for
+	// every stage.
+	RecommendedFields     map[Stage][]string `json:"recommended_fields,omitempty"`
+	SkipRecommendedFields bool               `json:"skip_recommended_fields,omitempty"`
+
+	// JargonCheck turns the plain-language note on the charter on or off.
+	// Unset means on in guided mode and off in expert mode. Jargon adds
+	// terms to the built-in dictionary, each mapped to plainer wording;
+	// an empty wording drops a built-in term.
+	JargonCheck *bool             `json:"jargon_check,omitempty"`
+	Jargon      map[string]string `json:"jargon,omitempty"`
+
+	// WordBudgets caps, per stage, the length in words of the artifact a
+	// stage tool writes; a longer artifact gets a note asking to trim it.
+	// Unset stages use the built-in budgets in expert mode and none in
+	// guided mode; 0 turns the note off for a stage.
+	WordBudgets map[Stage]int `json:"word_budgets,omitempty"`
+
+	// RequiredFields replaces, per stage, the built-in list of fields a
+	// stage tool rejects when empty — to make an optional field mandatory
+	// or a required one optional. An empty list requires nothing.
+	RequiredFields map[Stage][]string `json:"required_fields,omitempty"`
+
+	// StageFiles overrides artifact filenames per stage, relative to the
+	// docs directory (e.g. "specify": "spec.md"). Unset stages use the
+	// default names. See ValidateStageFiles for the rules.
+	StageFiles map[Stage]string `json:"stage_files,omitempty"`
+
+	// StageParts lists, per stage, the part files a split run wrote into
+	// StagePartsDir (e.g. "specify": ["auth.md", "billing.md"]). Only
+	// these are read as part of the artifact or replaced by the next
+	// split; other files in the directory belong to the user.
+	StageParts map[Stage][]string `json:"stage_parts,omitempty"`
+
+	// AttributionFooter replaces the "Generated by Hoofy" attribution in
+	// each artifact's header line. Unset keeps the default; an empty
+	// string removes the attribution — internal docs are free to drop it.
+	AttributionFooter *string `json:"attribution_footer,omitempty"`
+
+	// ModeChanges records every mode switch made after init, so a
+	// lowered Clarity Gate threshold is always traceable.
+	ModeChanges []ModeChange `json:"mode_changes,omitempty"`
+
+	// SubProjects lists the sub-projects sdd_split_project split this
+	// project into. A project with sub-projects is an umbrella: its
+	// requirements are carried forward by the sub-projects.
+	SubProjects []SubProject `json:"sub_projects,omitempty"`
+
+	// TaskStatus tracks implementation after validation: each task's
+	// status (one of TaskStatuses), keyed by task ID. Tasks not listed
+	// are todo.
+	TaskStatus map[string]string `json:"task_status,omitempty"`
+
+	// ValidationRuns records the verdict of every sdd_validate run,
+	// oldest first, so a FAIL→PASS trend survives the report being
+	// rewritten.
+	ValidationRuns []ValidationRun `json:"validation_runs,omitempty"`
+
+	// Checklist is the release checklist sdd_validate asks about on
+	// every run — bespoke gates such as "Security review done". A failed
+	// mandatory item keeps the verdict from being PASS. Copy it into
+	// another project's config to reuse it.
+	Checklist []ChecklistItem `json:"checklist,omitempty"`
+
+	// Tags records the named snapshots of the validated spec taken by
+	// sdd_tag_version, oldest first. Each snapshot lives in
+	// versions/<tag>/ under the docs directory and is never rewritten.
+	Tags []VersionTag `json:"tags,omitempty"`
+}
+
+// VersionTag is one named snapshot of the spec.
+type VersionTag struct {
+	Tag     string `json:"tag"`
+	At      string `json:"at"`
+	Version string `json:"version,omitempty"` // project version when tagged
+	Verdict string `json:"verdict,omitempty"` // validation verdict when tagged
+}
+
+// versionTagPattern limits tags to names that are safe as a directory.
+var versionTagPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// ValidateVersionTag checks that tag can name a spec snapshot: 1-64
+// letters, digits, dots, dashes or underscores, starting with a letter
+// or digit (e.g. "v1.0-approved").
+func ValidateVersionTag(tag string) error {
+	if !versionTagPattern.MatchString(tag) {
+		return fmt.Errorf("tag %q must be 1-64 letters, digits, '.', '-' or '_', starting with a letter or digit", tag)
+	}
+	return nil
+}
+
+// FindTag returns the recorded snapshot named tag.
+func (c *ProjectConfig) FindTag(tag string) (VersionTag, bool) {
+	for _, t := range c.Tags {
+		if t.Tag == tag {
+			return t, true
+		}
+	}
+	return VersionTag{}, false
+}
+
+// ChecklistItem is one entry of a project's validate checklist.
+type ChecklistItem struct {
+	Item      string `json:"item"`
+	Mandatory bool   `json:"mandatory,omitempty"`
+}
+
+// ModeChange is one recorded switch of a project's mode.
+type ModeChange struct {
+	From            Mode   `json:"from"`
+	To              Mode   `json:"to"`
+	At              string `json:"at"`
+	Stage           Stage  `json:"stage"`
+	ThresholdBefore int    `json:"threshold_before"`
+	ThresholdAfter  int    `json:"threshold_after"`
+	ClarityScore    int    `json:"clarity_score"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// ClarityGatePass is the audit record of the round that passed the
+// Clarity Gate: when, under which threshold, and the dimension scores
+// and evidence it was passed with.
+type ClarityGatePass struct {
+	At         string           `json:"at"`
+	Mode       Mode             `json:"mode"`
+	Threshold  int              `json:"threshold"`
+	Score      int              `json:"score"`
+	Round      int              `json:"round"`
+	Dimensions []DimensionScore `json:"dimensions"`
+}
+
+// DimensionScore is one clarity dimension's score in a ClarityGatePass.
+type DimensionScore struct {
+	Name     string `json:"name"`
+	Score    int    `json:"score"`
+	Evidence string `json:"evidence,omitempty"`
+}
+
+// SubProject is one sub-project an umbrella project was split into.
+type SubProject struct {
+	Name         string   `json:"name"`
+	Path         string   `json:"path"` // project root, slash-separated and relative to the umbrella's root
+	Requirements []string `json:"requirements"`
+}
+
+// ValidationRun is one recorded sdd_validate run.
+type ValidationRun struct {
+	Verdict string `json:"verdict"` // PASS | PASS_WITH_WARNINGS | FAIL
+	At      string `json:"at"`
+	Blocked bool   `json:"blocked,omitempty"` // strict mode kept the stage in progress
+	Report  string `json:"report,omitempty"`  // archived report, relative to the docs directory
 }
 
 // NewProjectConfig creates a config with sensible defaults.
@@ -119,6 +420,7 @@ func NewProjectConfig(name, description string, mode Mode) *ProjectConfig {
 		Status:      "completed",
 		StartedAt:   now,
 		CompletedAt: now,
+		CompletedBy: UnknownActor,
 		Iterations:  1,
 	}
 
@@ -135,56 +437,371 @@ func NewProjectConfig(name, description string, mode Mode) *ProjectConfig {
 	}
 }
 
+// HasStage reports whether stage is part of this project's pipeline.
+func (c *ProjectConfig) HasStage(stage Stage) bool {
+	for _, s := range StageOrderFor(c) {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// EnableOptionalStage adds an optional stage to the project's pipeline.
+// It must be called before the pipeline reaches the insertion point;
+// enabling an already-enabled stage is a no-op.
+func (c *ProjectConfig) EnableOptionalStage(stage Stage) error {
+	if !IsOptionalStage(stage) {
+		return fmt.Errorf("stage %q is not optional", stage)
+	}
+	if c.HasStage(stage) {
+		return nil
+	}
+	c.OptionalStages = append(c.OptionalStages, stage)
+	if c.StageStatus == nil {
+		c.StageStatus = make(map[Stage]StageStatus)
+	}
+	c.StageStatus[stage] = StageStatus{Status: "pending"}
+	return nil
+}
+
+// validStageStatuses are the values StageStatus.Status may hold.
+var validStageStatuses = map[string]bool{
+	"pending":     true,
+	"in_progress": true,
+	"completed":   true,
+	"skipped":     true,
+}
+
+// Validate checks the config for internal consistency: a name, a known
+// mode, a current stage that belongs to the project's pipeline, known
+// stage statuses, and scores within range. All problems are reported
+// together, joined with errors.Join.
+func (c *ProjectConfig) Validate() error {
+	var errs []error
+	if strings.TrimSpace(c.Name) == "" {
+		errs = append(errs, errors.New("name is empty"))
+	}
+	if c.Mode != ModeGuided && c.Mode != ModeExpert {
+		errs = append(errs, fmt.Errorf("mode %q must be 'guided' or 'expert'", c.Mode))
+	}
+	if c.Lang != "" {
+		if lang, err := i18n.ParseLang(string(c.Lang)); err != nil || lang != c.Lang {
+			errs = append(errs, fmt.Errorf("lang %q must be one of the supported language codes", c.Lang))
+		}
+	}
+	for _, opt := range c.OptionalStages {
+		if !IsOptionalStage(opt) {
+			errs = append(errs, fmt.Errorf("optional_stages contains non-optional stage %q", opt))
+		}
+	}
+	if !c.HasStage(c.CurrentStage) {
+		errs = append(errs, fmt.Errorf("current_stage %q is not part of this project's pipeline", c.CurrentStage))
+	}
+	for stage, st := range c.StageStatus {
+		if _, ok := Stages[stage]; !ok {
+			errs = append(errs, fmt.Errorf("stage_status has unknown stage %q", stage))
+			continue
+		}
+		if !validStageStatuses[st.Status] {
+			errs = append(errs, fmt.Errorf("stage %q has unknown status %q", stage, st.Status))
+		}
+	}
+	if c.ClarityScore < 0 || c.ClarityScore > 100 {
+		errs = append(errs, fmt.Errorf("clarity_score %d is outside 0-100", c.ClarityScore))
+	}
+	if c.ClarityThreshold != 0 {
+		if err := ValidateClarityThreshold(c.ClarityThreshold); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if c.ClarityMargin < -1 || c.ClarityMargin > 100 {
+		errs = append(errs, fmt.Errorf("clarity_margin %d must be -1 (off) or 0-100", c.ClarityMargin))
+	}
+	if c.ScoringScheme != "" && !slices.Contains(ScoringSchemes, c.ScoringScheme) {
+		errs = append(errs, fmt.Errorf("scoring_scheme %q must be one of: %s", c.ScoringScheme, strings.Join(ScoringSchemes, ", ")))
+	}
+	for _, id := range slices.Sorted(maps.Keys(c.TaskStatus)) {
+		if status := c.TaskStatus[id]; !slices.Contains(TaskStatuses, status) {
+			errs = append(errs, fmt.Errorf("task_status: %s has unknown status %q", id, status))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.DimensionWeights)) {
+		if w := c.DimensionWeights[name]; w < 1 || w > 10 {
+			errs = append(errs, fmt.Errorf("dimension_weights: %s weight %d is outside 1-10", name, w))
+		}
+	}
+	for stage := range c.RecommendedFields {
+		if _, ok := Stages[stage]; !ok {
+			errs = append(errs, fmt.Errorf("recommended_fields has unknown stage %q", stage))
+		}
+	}
+	for _, stage := range slices.Sorted(maps.Keys(c.WordBudgets)) {
+		if _, ok := Stages[stage]; !ok {
+			errs = append(errs, fmt.Errorf("word_budgets has unknown stage %q", stage))
+		} else if n := c.WordBudgets[stage]; n < 0 {
+			errs = append(errs, fmt.Errorf("word_budgets: %s budget %d must be 0 (off) or more", stage, n))
+		}
+	}
+	for stage := range c.RequiredFields {
+		if _, ok := Stages[stage]; !ok {
+			errs = append(errs, fmt.Errorf("required_fields has unknown stage %q", stage))
+		}
+	}
+	if err := ValidateStageFiles(c.StageFiles); err != nil {
+		errs = append(errs, err)
+	}
+	for _, stage := range slices.Sorted(maps.Keys(c.StageParts)) {
+		if _, ok := Stages[stage]; !ok {
+			errs = append(errs, fmt.Errorf("stage_parts has unknown stage %q", stage))
+			continue
+		}
+		for _, name := range c.StageParts[stage] {
+			if name != filepath.Base(name) || filepath.Ext(name) != ".md" || strings.HasPrefix(name, ".") {
+				errs = append(errs, fmt.Errorf("stage_parts: %s part %q must be a .md file name without directories", stage, name))
+			}
+		}
+	}
+	seen := map[string]bool{}
+	for i, item := range c.Checklist {
+		key := strings.ToLower(strings.TrimSpace(item.Item))
+		switch {
+		case key == "":
+			errs = append(errs, fmt.Errorf("checklist[%d] has no item text", i))
+		case seen[key]:
+			errs = append(errs, fmt.Errorf("checklist item %q is listed twice", strings.TrimSpace(item.Item)))
+		}
+		seen[key] = true
+	}
+	tags := map[string]bool{}
+	for _, t := range c.Tags {
+		if err := ValidateVersionTag(t.Tag); err != nil {
+			errs = append(errs, fmt.Errorf("tags: %w", err))
+		} else if tags[t.Tag] {
+			errs = append(errs, fmt.Errorf("tags: %q is recorded twice", t.Tag))
+		}
+		tags[t.Tag] = true
+	}
+	return errors.Join(errs...)
+}
+
 // --- Path helpers ---
 
 // ResolveDocsDir determines the docs directory relative to projectRoot.
 // Resolution algorithm:
-//  1. If docs/hoofy.json exists → "docs"
-//  2. If docs/specs/hoofy.json exists → "docs/specs"
-//  3. Neither exists → default to "docs" (for new projects)
+//  1. If an SDD_DIR override is set and <override>/hoofy.json exists → override
+//  2. If docs/hoofy.json exists → "docs"
+//  3. If docs/specs/hoofy.json exists → "docs/specs"
+//  4. None exists → the override if set, else "docs" (for new projects)
 func ResolveDocsDir(projectRoot string) string {
-	primary := filepath.Join(projectRoot, DocsDir, ConfigFile)
-	if _, err := os.Stat(primary); err == nil {
-		return DocsDir
-	}
-
-	fallback := filepath.Join(projectRoot, DocsDir, DocsDirFallback, ConfigFile)
-	if _, err := os.Stat(fallback); err == nil {
-		return filepath.Join(DocsDir, DocsDirFallback)
+	for _, dir := range DocsDirCandidates() {
+		if HasConfig(filepath.Join(projectRoot, dir)) {
+			return dir
+		}
 	}
-
-	return DocsDir
+	return defaultDocsDir()
 }
 
-// DocsPath returns the absolute path to the resolved docs directory.
+// DocsPath returns the absolute path to the directory holding the
+// project's artifacts: the resolved docs directory, or under a config
+// name override its subdirectory named after the config (docs/api/ for
+// docs/api.json), so projects sharing a docs directory keep separate
+// artifacts.
 func DocsPath(projectRoot string) string {
-	return filepath.Join(projectRoot, ResolveDocsDir(projectRoot))
+	return filepath.Join(projectRoot, ResolveDocsDir(projectRoot), artifactsSubdir())
 }
 
-// ConfigPath returns the absolute path to hoofy.json.
+// ConfigPath returns the absolute path to the project config: the
+// existing hoofy.json or hoofy.yaml, else the name new projects get
+// (see SetConfigName and SetConfigFormat).
 func ConfigPath(projectRoot string) string {
-	return filepath.Join(DocsPath(projectRoot), ConfigFile)
+	return configPathIn(filepath.Join(projectRoot, ResolveDocsDir(projectRoot)))
 }
 
-// StagePath returns the absolute path to a stage's markdown artifact.
+// ConfigPathFor returns the path of the config governing the artifacts
+// in docsDir: the one inside docsDir — the default layout, and archived
+// or tagged snapshots, which copy the config beside the artifacts — or
+// under a config name override, the one beside docsDir (docs/api.json
+// for docs/api/).
+func ConfigPathFor(docsDir string) string {
+	if sub := artifactsSubdir(); sub != "" && filepath.Base(docsDir) == sub && !HasConfig(docsDir) {
+		return configPathIn(filepath.Dir(docsDir))
+	}
+	return configPathIn(docsDir)
+}
+
+// StagePath returns the absolute path to a stage's markdown artifact,
+// honoring the project's StageFiles overrides. Returns "" for a stage
+// without an artifact (see HasArtifact) and for an unknown stage.
 func StagePath(projectRoot string, stage Stage) string {
-	filename := stageFilenames[stage]
+	filename := StageFilenameIn(projectRoot, stage)
 	if filename == "" {
 		return ""
 	}
 	return filepath.Join(DocsPath(projectRoot), filename)
 }
 
+// StagePartsDir returns the directory that holds a stage's split
+// artifact files: the stage file's path without its extension, e.g.
+// docs/requirements/ for docs/requirements.md. Returns "" for stages
+// without an artifact.
+func StagePartsDir(projectRoot string, stage Stage) string {
+	path := StagePath(projectRoot, stage)
+	if path == "" {
+		return ""
+	}
+	return strings.TrimSuffix(path, filepath.Ext(path))
+}
+
+// StagePaths returns every markdown file of a stage's artifact: its
+// StagePath first, then the part files cfg records for it in StageParts
+// (one per domain when requirements are split by domain), in StagePartsDir
+// and sorted by name. Files in StagePartsDir that cfg doesn't record are
+// not part of the artifact. A single-file stage, or a nil cfg, returns
+// just its StagePath; a stage without an artifact, nil.
+func StagePaths(projectRoot string, cfg *ProjectConfig, stage Stage) []string {
+	path := StagePath(projectRoot, stage)
+	if path == "" {
+		return nil
+	}
+	paths := []string{path}
+	if cfg == nil {
+		return paths
+	}
+	dir := StagePartsDir(projectRoot, stage)
+	for _, name := range slices.Sorted(slices.Values(cfg.StageParts[stage])) {
+		paths = append(paths, filepath.Join(dir, name))
+	}
+	return paths
+}
+
+// StagePathsIn is StagePaths for callers that hold no loaded config: it
+// reads the project config at projectRoot for the recorded part files.
+// Without a readable config it returns just the StagePath.
+func StagePathsIn(projectRoot string, stage Stage) []string {
+	cfg, _ := NewFileStore().Load(projectRoot)
+	return StagePaths(projectRoot, cfg, stage)
+}
+
 // ADRsPath returns the absolute path to the central ADRs directory.
 func ADRsPath(projectRoot string) string {
 	return filepath.Join(DocsPath(projectRoot), "adrs")
 }
 
-// StageFilename returns the output filename for a stage, or empty if none.
+// AcceptanceFile is the filename of the acceptance scenarios artifact.
+// It is not a pipeline stage — scenarios are attached to requirements
+// at any point after specify.
+const AcceptanceFile = "acceptance.md"
+
+// AcceptancePath returns the absolute path to the acceptance scenarios artifact.
+func AcceptancePath(projectRoot string) string {
+	return filepath.Join(DocsPath(projectRoot), AcceptanceFile)
+}
+
+// SummaryFile is the filename of the executive summary artifact,
+// generated by sdd_summarize once validation has passed.
+const SummaryFile = "summary.md"
+
+// SummaryPath returns the absolute path to the executive summary artifact.
+func SummaryPath(projectRoot string) string {
+	return filepath.Join(DocsPath(projectRoot), SummaryFile)
+}
+
+// OpenAPIFile is the filename of the OpenAPI skeleton generated by
+// sdd_export_openapi from the design's API contracts.
+const OpenAPIFile = "openapi.yaml"
+
+// OpenAPIPath returns the absolute path to the OpenAPI skeleton.
+func OpenAPIPath(projectRoot string) string {
+	return filepath.Join(DocsPath(projectRoot), OpenAPIFile)
+}
+
+// TraceabilityFile is the filename of the requirements traceability
+// matrix generated by sdd_traceability_matrix.
+const TraceabilityFile = "traceability.md"
+
+// TraceabilityPath returns the absolute path to the traceability matrix.
+func TraceabilityPath(projectRoot string) string {
+	return filepath.Join(DocsPath(projectRoot), TraceabilityFile)
+}
+
+// TaskGraphFile and TaskGraphDOTFile are the filenames of the task
+// dependency graph exported by sdd_export_task_graph, as Mermaid and as
+// Graphviz DOT.
+const (
+	TaskGraphFile    = "tasks.mmd"
+	TaskGraphDOTFile = "tasks.dot"
+)
+
+// TaskGraphPath returns the absolute path to the Mermaid task graph.
+func TaskGraphPath(projectRoot string) string {
+	return filepath.Join(DocsPath(projectRoot), TaskGraphFile)
+}
+
+// TaskGraphDOTPath returns the absolute path to the DOT task graph.
+func TaskGraphDOTPath(projectRoot string) string {
+	return filepath.Join(DocsPath(projectRoot), TaskGraphDOTFile)
+}
+
+// RequirementsJSONFile is the filename of the structured requirements
+// sidecar written next to requirements.md. The markdown stays primary;
+// the sidecar saves tooling from re-parsing it.
+const RequirementsJSONFile = "requirements.json"
+
+// RequirementsJSONPath returns the absolute path to the structured
+// requirements sidecar.
+func RequirementsJSONPath(projectRoot string) string {
+	return filepath.Join(DocsPath(projectRoot), RequirementsJSONFile)
+}
+
+// SideArtifact is an artifact that lives next to the stage
+// artifacts but is not produced by a pipeline stage.
+type SideArtifact struct {
+	Key      string // name accepted by sdd_get_context's stage parameter
+	Name     string
+	Filename string
+}
+
+// SideArtifacts lists the non-stage artifacts in display order.
+var SideArtifacts = []SideArtifact{
+	{Key: "acceptance", Name: "Acceptance Tests", Filename: AcceptanceFile},
+	{Key: "summary", Name: "Executive Summary", Filename: SummaryFile},
+	{Key: "openapi", Name: "OpenAPI Skeleton", Filename: OpenAPIFile},
+	{Key: "traceability", Name: "Traceability Matrix", Filename: TraceabilityFile},
+	{Key: "task-graph", Name: "Task Graph", Filename: TaskGraphFile},
+	{Key: "task-graph-dot", Name: "Task Graph (DOT)", Filename: TaskGraphDOTFile},
+	{Key: "requirements-json", Name: "Structured Requirements", Filename: RequirementsJSONFile},
+}
+
+// Path returns the absolute path to the side artifact.
+func (a SideArtifact) Path(projectRoot string) string {
+	return filepath.Join(DocsPath(projectRoot), a.Filename)
+}
+
+// FindSideArtifact returns the side artifact registered under key.
+func FindSideArtifact(key string) (SideArtifact, bool) {
+	for _, a := range SideArtifacts {
+		if a.Key == key {
+			return a, true
+		}
+	}
+	return SideArtifact{}, false
+}
+
+// StageFilename returns the default output filename for a stage, or
+// empty if none. Use StageFilenameIn or ProjectConfig.StageFilename to
+// honor a project's StageFiles overrides.
 func StageFilename(stage Stage) string {
 	return stageFilenames[stage]
 }
 
+// HasArtifact reports whether a stage produces a markdown artifact.
+// Every stage does except init, whose output is the project config
+// (hoofy.json) itself; unknown stages have none.
+func HasArtifact(stage Stage) bool {
+	return stageFilenames[stage] != ""
+}
+
 // stageFilenames maps stages to their output filenames.
 var stageFilenames = map[Stage]string{
 	StagePrinciples:    "principles.md",
@@ -195,6 +812,7 @@ var stageFilenames = map[Stage]string{
 	StageDesign:        "design.md",
 	StageTasks:         "tasks.md",
 	StageValidate:      "validation.md",
+	StageResearch:      "research.md",
 }
 
 // --- Persistence (Open/Closed: extend via interfaces, not modification) ---
@@ -217,47 +835,139 @@ type Store interface {
 	Saver
 }
 
-// FileStore implements Store using the local filesystem.
-type FileStore struct{}
+// ErrConflict is returned by FileStore.Save when the config on disk was
+// saved by someone else after cfg was loaded. Reload and retry.
+var ErrConflict = errors.New("project config was modified concurrently")
+
+// ErrNotInitialized is returned by FileStore.Load when the project has
+// no config yet.
+var ErrNotInitialized = errors.New("hoofy project not initialized")
+
+// FileStore implements Store and Transactor using the local filesystem.
+type FileStore struct {
+	mu  sync.Mutex
+	txs map[string]*fileTx // open transactions, by project root
+}
+
+// saveMu makes Save's revision check and write atomic within this
+// process, where concurrent tool calls are the common source of
+// conflicting writes.
+var saveMu sync.Mutex
 
 // NewFileStore creates a filesystem-backed config store.
 func NewFileStore() *FileStore {
 	return &FileStore{}
 }
 
-// Load reads and parses hoofy.json from disk.
+// Load reads and parses the project config from disk, in whichever
+// format (JSON or YAML) it was saved.
 func (fs *FileStore) Load(projectRoot string) (*ProjectConfig, error) {
+	if cfg, ok, err := fs.txLoad(projectRoot); ok || err != nil {
+		return cfg, err
+	}
+
 	path := ConfigPath(projectRoot)
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("hoofy project not initialized — run sdd_init_project first")
+			if _, found := InferStageFromArtifacts(projectRoot); len(found) > 0 {
+				return nil, fmt.Errorf("%w: hoofy.json is missing but %d stage artifact(s) exist — "+
+					"run sdd_init_project with reconstruct=true to rebuild it", ErrNotInitialized, len(found))
+			}
+			return nil, fmt.Errorf("%w — run sdd_init_project first", ErrNotInitialized)
 		}
 		return nil, fmt.Errorf("reading config: %w", err)
 	}
 
 	var cfg ProjectConfig
-	if err := json.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("parsing hoofy.json: %w", err)
+	if err := unmarshalConfig(path, data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filepath.Base(path), err)
 	}
 	return &cfg, nil
 }
 
-// Save writes the config to hoofy.json, creating directories as needed.
+// Save writes the config, creating directories as needed. An existing
+// config keeps its format; a new one uses the active ConfigName.
+//
+// Save is optimistic-locked: it fails with ErrConflict, writing
+// nothing, when the on-disk config's Revision differs from cfg's, and
+// otherwise increments cfg.Revision before writing. Inside a
+// transaction (see Transactor) the config is buffered until Commit.
 func (fs *FileStore) Save(projectRoot string, cfg *ProjectConfig) error {
+	if buffered, err := fs.txSave(projectRoot, cfg); buffered || err != nil {
+		return err
+	}
+
+	saveMu.Lock()
+	defer saveMu.Unlock()
+
+	path := ConfigPath(projectRoot)
+	if onDisk, err := diskRevision(path); err != nil {
+		return err
+	} else if onDisk != cfg.Revision {
+		return fmt.Errorf("%w: %s is at revision %d, but this update was based on revision %d",
+			ErrConflict, filepath.Base(path), onDisk, cfg.Revision)
+	}
+
+	cfg.Revision++
 	cfg.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := writeConfig(path, cfg); err != nil {
+		cfg.Revision--
+		return err
+	}
+	return nil
+}
 
-	data, err := json.MarshalIndent(cfg, "", "  ")
+// writeConfig writes cfg to path as is, creating directories as needed.
+func writeConfig(path string, cfg *ProjectConfig) error {
+	data, err := marshalConfig(path, cfg)
 	if err != nil {
 		return fmt.Errorf("marshaling config: %w", err)
 	}
 
-	dir := filepath.Dir(ConfigPath(projectRoot))
-	if err := os.MkdirAll(dir, 0o755); err != nil {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
 		return fmt.Errorf("creating docs directory: %w", err)
 	}
 
-	return os.WriteFile(ConfigPath(projectRoot), data, 0o644)
+	forgetStageFiles(path)
+	return WriteFile(path, data, 0o644)
+}
+
+// diskRevision returns the Revision of the config at path, or 0 when
+// there is none yet or it can't be parsed.
+func diskRevision(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("reading config: %w", err)
+	}
+	var rev struct {
+		Revision int `json:"revision"`
+	}
+	if err := unmarshalConfig(path, data, &rev); err != nil {
+		return 0, nil
+	}
+	return rev.Revision, nil
+}
+
+// FindProjectRoot walks up from start looking for hoofy.json in any of
+// DocsDirCandidates. Returns the directory and true when found.
+func FindProjectRoot(start string) (string, bool) {
+	current := start
+	for {
+		for _, candidate := range DocsDirCandidates() {
+			if HasConfig(filepath.Join(current, candidate)) {
+				return current, true
+			}
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return "", false
+		}
+		current = parent
+	}
 }
 
 // Exists checks whether a Hoofy project is initialized at the given root.
@@ -2,8 +2,11 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -221,6 +224,21 @@ func TestStagePath_Init_HasNoFile(t *testing.T) {
 	}
 }
 
+func TestHasArtifact(t *testing.T) {
+	for stage := range Stages {
+		want := stage != StageInit
+		if got := HasArtifact(stage); got != want {
+			t.Errorf("HasArtifact(%s) = %v, want %v", stage, got, want)
+		}
+		if got := StagePath(t.TempDir(), stage) != ""; got != want {
+			t.Errorf("StagePath(%s) non-empty = %v, should agree with HasArtifact", stage, got)
+		}
+	}
+	if HasArtifact(Stage("unknown")) {
+		t.Error("HasArtifact(unknown) = true, want false")
+	}
+}
+
 // --- FileStore ---
 
 func TestFileStore_SaveAndLoad(t *testing.T) {
@@ -300,6 +318,55 @@ func TestFileStore_SaveUpdatesTimestamp(t *testing.T) {
 	_ = originalUpdatedAt
 }
 
+func TestFileStore_SaveRejectsStaleConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	store := NewFileStore()
+	if err := store.Save(tmpDir, NewProjectConfig("x", "y", ModeGuided)); err != nil {
+		t.Fatalf("initial Save: %v", err)
+	}
+
+	// Two writers load the same revision.
+	first, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first.ClarityScore = 40
+	if err := store.Save(tmpDir, first); err != nil {
+		t.Fatalf("first Save: %v", err)
+	}
+	if first.Revision != 2 {
+		t.Errorf("Revision after two saves = %d, want 2", first.Revision)
+	}
+
+	second.ClarityScore = 90
+	err = store.Save(tmpDir, second)
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("stale Save error = %v, want ErrConflict", err)
+	}
+	if second.Revision != 1 {
+		t.Errorf("a rejected Save must not bump Revision, got %d", second.Revision)
+	}
+
+	onDisk, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if onDisk.ClarityScore != 40 {
+		t.Errorf("stale write clobbered the score: got %d, want 40", onDisk.ClarityScore)
+	}
+
+	// Reloading picks up the current revision and saves cleanly.
+	onDisk.ClarityScore = 90
+	if err := store.Save(tmpDir, onDisk); err != nil {
+		t.Errorf("Save after reload: %v", err)
+	}
+}
+
 func TestFileStore_SaveWritesValidJSON(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -409,6 +476,58 @@ func TestStageOrder_Has9Stages(t *testing.T) {
 	}
 }
 
+func TestStageOrderFor_DefaultMatchesStageOrder(t *testing.T) {
+	cfg := NewProjectConfig("x", "y", ModeGuided)
+	got := StageOrderFor(cfg)
+	if len(got) != len(StageOrder) {
+		t.Fatalf("StageOrderFor() = %v, want %v", got, StageOrder)
+	}
+	for i := range got {
+		if got[i] != StageOrder[i] {
+			t.Errorf("StageOrderFor()[%d] = %s, want %s", i, got[i], StageOrder[i])
+		}
+	}
+	if cfg.HasStage(StageResearch) {
+		t.Error("research should not be part of the default pipeline")
+	}
+}
+
+func TestEnableOptionalStage_InsertsResearchAfterClarify(t *testing.T) {
+	cfg := NewProjectConfig("x", "y", ModeGuided)
+	if err := cfg.EnableOptionalStage(StageResearch); err != nil {
+		t.Fatalf("EnableOptionalStage: %v", err)
+	}
+	// Idempotent.
+	if err := cfg.EnableOptionalStage(StageResearch); err != nil {
+		t.Fatalf("EnableOptionalStage (again): %v", err)
+	}
+
+	order := StageOrderFor(cfg)
+	if len(order) != len(StageOrder)+1 {
+		t.Fatalf("len(order) = %d, want %d", len(order), len(StageOrder)+1)
+	}
+	for i, s := range order {
+		if s == StageResearch {
+			if order[i-1] != StageClarify || order[i+1] != StageDesign {
+				t.Errorf("research at wrong position: %v", order)
+			}
+		}
+	}
+	if cfg.StageStatus[StageResearch].Status != "pending" {
+		t.Error("enabled stage should start pending")
+	}
+	if StageFilename(StageResearch) != "research.md" {
+		t.Errorf("StageFilename(research) = %q", StageFilename(StageResearch))
+	}
+}
+
+func TestEnableOptionalStage_RejectsCoreStage(t *testing.T) {
+	cfg := NewProjectConfig("x", "y", ModeGuided)
+	if err := cfg.EnableOptionalStage(StageDesign); err == nil {
+		t.Error("expected error enabling a non-optional stage")
+	}
+}
+
 func TestStageFilenames_PrinciplesAndCharter(t *testing.T) {
 	if got := StageFilename(StagePrinciples); got != "principles.md" {
 		t.Errorf("StageFilename(principles) = %s, want principles.md", got)
@@ -418,6 +537,42 @@ func TestStageFilenames_PrinciplesAndCharter(t *testing.T) {
 	}
 }
 
+func TestInferStageFromArtifacts(t *testing.T) {
+	root := t.TempDir()
+
+	if stage, found := InferStageFromArtifacts(root); stage != "" || found != nil {
+		t.Fatalf("empty dir: got %q, %v", stage, found)
+	}
+
+	docs := filepath.Join(root, DocsDir)
+	if err := os.MkdirAll(docs, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"principles.md":   "# P",
+		"charter.md":      "# C",
+		"research.md":     "# R",
+		"requirements.md": "   \n", // blank artifacts don't count
+	} {
+		if err := os.WriteFile(filepath.Join(docs, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stage, found := InferStageFromArtifacts(root)
+	if stage != StageResearch {
+		t.Errorf("furthest = %s, want research", stage)
+	}
+	if len(found) != 3 {
+		t.Errorf("found = %v, want principles, charter, research", found)
+	}
+
+	_, err := NewFileStore().Load(root)
+	if err == nil || !stringContains(err.Error(), "reconstruct=true") {
+		t.Errorf("Load should point at reconstruct for orphaned artifacts, got %v", err)
+	}
+}
+
 // --- helpers ---
 
 func stringContains(s, substr string) bool {
@@ -428,3 +583,94 @@ func stringContains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestProjectConfig_Validate(t *testing.T) {
+	cfg := NewProjectConfig("x", "y", ModeGuided)
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("new config should be valid: %v", err)
+	}
+
+	cfg.Name = ""
+	cfg.Mode = "turbo"
+	cfg.CurrentStage = "bogus"
+	cfg.ClarityScore = 150
+	cfg.Lang = "fr"
+	cfg.Checklist = []ChecklistItem{{Item: "Security review"}, {Item: " security review "}, {Item: ""}}
+	cfg.ClarityMargin = -5
+	cfg.ScoringScheme = "median"
+	cfg.TaskStatus = map[string]string{"TASK-001": TaskDone, "TASK-002": "finished"}
+	cfg.WordBudgets = map[Stage]int{StageDesign: -1, "appendix": 100}
+	cfg.Tags = []VersionTag{{Tag: "v1.0"}, {Tag: "v1.0"}, {Tag: "../up"}}
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, want := range []string{"name is empty", "turbo", "bogus", "150", `lang "fr"`, `"security review" is listed twice`, "checklist[2] has no item text", "clarity_margin -5", `scoring_scheme "median"`, `TASK-002 has unknown status "finished"`, "design budget -1", `word_budgets has unknown stage "appendix"`, `tags: "v1.0" is recorded twice`, `tags: tag "../up" must be`} {
+		if !stringContains(err.Error(), want) {
+			t.Errorf("error should mention %q: %v", want, err)
+		}
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	for in, want := range map[string]Mode{"guided": ModeGuided, " Expert ": ModeExpert, "GUIDED": ModeGuided} {
+		got, err := ParseMode(in)
+		if err != nil || got != want {
+			t.Errorf("ParseMode(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, bad := range []string{"", "turbo"} {
+		if _, err := ParseMode(bad); err == nil {
+			t.Errorf("ParseMode(%q) should fail", bad)
+		}
+	}
+}
+
+func TestStagePaths_SplitParts(t *testing.T) {
+	tmpDir := t.TempDir()
+	if got := StagePaths(tmpDir, nil, StageSpecify); len(got) != 1 || got[0] != StagePath(tmpDir, StageSpecify) {
+		t.Fatalf("single-file stage: StagePaths = %v", got)
+	}
+
+	partsDir := StagePartsDir(tmpDir, StageSpecify)
+	if want := filepath.Join(DocsPath(tmpDir), "requirements"); partsDir != want {
+		t.Fatalf("StagePartsDir = %s, want %s", partsDir, want)
+	}
+	if err := os.MkdirAll(partsDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"billing.md", "auth.md", "notes.md"} {
+		if err := os.WriteFile(filepath.Join(partsDir, name), []byte("x"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg := &ProjectConfig{StageParts: map[Stage][]string{StageSpecify: {"billing.md", "auth.md"}}}
+	want := []string{
+		StagePath(tmpDir, StageSpecify),
+		filepath.Join(partsDir, "auth.md"),
+		filepath.Join(partsDir, "billing.md"),
+	}
+	if got := StagePaths(tmpDir, cfg, StageSpecify); !slices.Equal(got, want) {
+		t.Errorf("StagePaths = %v, want %v (recorded parts only, sorted)", got, want)
+	}
+	if got := StagePaths(tmpDir, cfg, StageInit); got != nil {
+		t.Errorf("init has no artifact, StagePaths = %v", got)
+	}
+}
+
+func TestValidate_StageParts(t *testing.T) {
+	cfg := NewProjectConfig("p", "d", ModeGuided)
+	cfg.StageParts = map[Stage][]string{StageSpecify: {"auth.md", "../escape.md", "notes.txt"}, "nope": {"a.md"}}
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("invalid stage_parts should fail validation")
+	}
+	for _, want := range []string{`unknown stage "nope"`, `"../escape.md"`, `"notes.txt"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %s: %v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), `"auth.md"`) {
+		t.Errorf("auth.md is a valid part: %v", err)
+	}
+}
@@ -0,0 +1,284 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Environment variables that seed project defaults. They are read once
+// at server startup; explicit tool parameters always take precedence.
+const (
+	// EnvDefaultMode sets the mode used by sdd_init_project when omitted.
+	EnvDefaultMode = "SDD_DEFAULT_MODE"
+	// EnvClarityThreshold sets the Clarity Gate threshold for new projects.
+	EnvClarityThreshold = "SDD_CLARITY_THRESHOLD"
+	// EnvDocsDir overrides the directory (relative to the project root)
+	// where Hoofy artifacts live.
+	EnvDocsDir = "SDD_DIR"
+	// EnvLineEndings sets the newline style of written artifacts: lf or crlf.
+	EnvLineEndings = "SDD_LINE_ENDINGS"
+	// EnvFileMode sets the permissions of written artifacts, in octal (e.g. 0664).
+	EnvFileMode = "SDD_FILE_MODE"
+	// EnvWriteRetries sets how many times a transiently failing write is
+	// retried (0-10, default 3; 0 disables retrying).
+	EnvWriteRetries = "SDD_WRITE_RETRIES"
+	// EnvMaxInputBytes caps the size of any single tool argument, in
+	// bytes (default DefaultMaxInputBytes).
+	EnvMaxInputBytes = "SDD_MAX_INPUT_BYTES"
+	// EnvTemplatesDir names a directory of *.tmpl files that override the
+	// embedded artifact templates of the same name.
+	EnvTemplatesDir = "SDD_TEMPLATES_DIR"
+	// EnvNoColor, set to any non-empty value, switches status markers in
+	// tool output from emoji to ASCII (see https://no-color.org).
+	EnvNoColor = "NO_COLOR"
+)
+
+// DefaultMaxInputBytes is the per-argument input limit when
+// SDD_MAX_INPUT_BYTES is unset: generous for any real spec section, but
+// small enough to stop a runaway multi-megabyte argument.
+const DefaultMaxInputBytes = 256 << 10
+
+// MinMaxInputBytes is the smallest limit SDD_MAX_INPUT_BYTES accepts, so
+// a typo can't make every tool call fail.
+const MinMaxInputBytes = 1 << 10
+
+// Newline styles accepted by SDD_LINE_ENDINGS.
+const (
+	LineEndingsLF   = "lf"
+	LineEndingsCRLF = "crlf"
+)
+
+// Defaults holds house standards applied when a tool caller omits them.
+// The zero value means "no overrides" — built-in defaults apply.
+type Defaults struct {
+	Mode             Mode
+	ClarityThreshold int // 0 = use the mode's built-in threshold
+	DocsDir          string
+	LineEndings      string      // "" = LF
+	FileMode         os.FileMode // 0 = 0644
+	WriteRetries     int         // 0 = DefaultWriteRetries, NoWriteRetries = disabled
+	MaxInputBytes    int         // 0 = DefaultMaxInputBytes
+	TemplatesDir     string      // "" = embedded templates only
+	NoColor          bool        // ASCII status markers instead of emoji
+}
+
+// DefaultsFromEnv parses and validates the SDD_* environment variables
+// using the given lookup function (typically os.Getenv).
+// Invalid values are reported as errors so the server can fail fast.
+func DefaultsFromEnv(getenv func(string) string) (Defaults, error) {
+	var d Defaults
+
+	if v := strings.TrimSpace(getenv(EnvDefaultMode)); v != "" {
+		mode, err := ParseMode(v)
+		if err != nil {
+			return Defaults{}, fmt.Errorf("%s must be 'guided' or 'expert', got %q", EnvDefaultMode, v)
+		}
+		d.Mode = mode
+	}
+
+	if v := strings.TrimSpace(getenv(EnvClarityThreshold)); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return Defaults{}, fmt.Errorf("%s must be an integer, got %q", EnvClarityThreshold, v)
+		}
+		if err := ValidateClarityThreshold(n); err != nil {
+			return Defaults{}, fmt.Errorf("%s: %w", EnvClarityThreshold, err)
+		}
+		d.ClarityThreshold = n
+	}
+
+	if v := strings.TrimSpace(getenv(EnvDocsDir)); v != "" {
+		dir, err := cleanDocsDir(v)
+		if err != nil {
+			return Defaults{}, fmt.Errorf("%s: %w", EnvDocsDir, err)
+		}
+		d.DocsDir = dir
+	}
+
+	if v := strings.TrimSpace(getenv(EnvLineEndings)); v != "" {
+		le := strings.ToLower(v)
+		if le != LineEndingsLF && le != LineEndingsCRLF {
+			return Defaults{}, fmt.Errorf("%s must be 'lf' or 'crlf', got %q", EnvLineEndings, v)
+		}
+		d.LineEndings = le
+	}
+
+	if v := strings.TrimSpace(getenv(EnvFileMode)); v != "" {
+		n, err := strconv.ParseUint(v, 8, 32)
+		if err != nil {
+			return Defaults{}, fmt.Errorf("%s must be an octal permission like 0644, got %q", EnvFileMode, v)
+		}
+		if err := ValidateFileMode(os.FileMode(n)); err != nil {
+			return Defaults{}, fmt.Errorf("%s: %w", EnvFileMode, err)
+		}
+		d.FileMode = os.FileMode(n)
+	}
+
+	if v := strings.TrimSpace(getenv(EnvWriteRetries)); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > MaxWriteRetries {
+			return Defaults{}, fmt.Errorf("%s must be an integer between 0 and %d, got %q", EnvWriteRetries, MaxWriteRetries, v)
+		}
+		d.WriteRetries = n
+		if n == 0 {
+			d.WriteRetries = NoWriteRetries
+		}
+	}
+
+	if v := strings.TrimSpace(getenv(EnvMaxInputBytes)); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < MinMaxInputBytes {
+			return Defaults{}, fmt.Errorf("%s must be an integer of at least %d bytes, got %q", EnvMaxInputBytes, MinMaxInputBytes, v)
+		}
+		d.MaxInputBytes = n
+	}
+
+	d.TemplatesDir = strings.TrimSpace(getenv(EnvTemplatesDir))
+	d.NoColor = getenv(EnvNoColor) != ""
+
+	return d, nil
+}
+
+// ValidateClarityThreshold checks that a threshold is within 1-100.
+func ValidateClarityThreshold(n int) error {
+	if n < 1 || n > 100 {
+		return fmt.Errorf("clarity threshold must be between 1 and 100, got %d", n)
+	}
+	return nil
+}
+
+// ValidateFileMode checks that an artifact file mode contains only
+// permission bits and keeps the file readable and writable by its owner.
+func ValidateFileMode(mode os.FileMode) error {
+	if mode&^os.ModePerm != 0 {
+		return fmt.Errorf("file mode must only contain permission bits, got %#o", uint32(mode))
+	}
+	if mode&0o600 != 0o600 {
+		return fmt.Errorf("file mode must keep owner read/write, got %#o", uint32(mode))
+	}
+	return nil
+}
+
+// cleanDocsDir validates a docs directory override. It must be a
+// relative path that stays inside the project root.
+func cleanDocsDir(dir string) (string, error) {
+	if filepath.IsAbs(dir) {
+		return "", fmt.Errorf("docs directory must be relative to the project root, got %q", dir)
+	}
+	cleaned := filepath.Clean(dir)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("docs directory must be inside the project root, got %q", dir)
+	}
+	return cleaned, nil
+}
+
+// docsDirOverride is the SDD_DIR override installed by SetDocsDir.
+// Empty means the built-in docs/ and docs/specs/ layout is used.
+var docsDirOverride string
+
+// SetDocsDir installs a docs directory override for path resolution.
+// Intended to be called once at startup, before any tool runs.
+// An empty dir clears the override.
+func SetDocsDir(dir string) error {
+	if dir == "" {
+		docsDirOverride = ""
+		return nil
+	}
+	cleaned, err := cleanDocsDir(dir)
+	if err != nil {
+		return err
+	}
+	docsDirOverride = cleaned
+	return nil
+}
+
+// DocsDirCandidates returns the docs directories (relative to a project
+// root) that may hold hoofy.json, in lookup order. The SDD_DIR override,
+// when set, is checked first so existing default-layout projects keep
+// working.
+func DocsDirCandidates() []string {
+	builtin := []string{DocsDir, filepath.Join(DocsDir, DocsDirFallback)}
+	if docsDirOverride == "" {
+		return builtin
+	}
+	candidates := []string{docsDirOverride}
+	for _, dir := range builtin {
+		if dir != docsDirOverride {
+			candidates = append(candidates, dir)
+		}
+	}
+	return candidates
+}
+
+// defaultDocsDir is the directory used for projects that don't exist yet.
+func defaultDocsDir() string {
+	if docsDirOverride != "" {
+		return docsDirOverride
+	}
+	return DocsDir
+}
+
+// configNameOverride is the config filename installed by SetConfigName.
+// Empty means the default ConfigFile (hoofy.json).
+var configNameOverride string
+
+// reservedArtifactDirs are docs subdirectories Hoofy already uses, so no
+// config name may claim them for its artifacts (see artifactsSubdir).
+var reservedArtifactDirs = []string{"adrs", "changes", "history", "versions", DocsDirFallback}
+
+// SetConfigName overrides the project config filename (default
+// hoofy.json) so several projects can share one docs directory, e.g.
+// api.json and web.json. Each keeps its artifacts in a subdirectory
+// named after its config (see DocsPath). Intended to be called once at
+// startup. The name must be a plain .json filename (SetConfigFormat
+// switches it to .yaml); empty restores the default.
+func SetConfigName(name string) error {
+	if name == "" {
+		configNameOverride = ""
+		return nil
+	}
+	if name != filepath.Base(name) || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("config name must be a filename without directories, got %q", name)
+	}
+	if filepath.Ext(name) != ".json" || name == ".json" {
+		return fmt.Errorf("config name must end in .json, got %q", name)
+	}
+	stem := strings.ToLower(strings.TrimSuffix(name, ".json"))
+	reserved := slices.Contains(reservedArtifactDirs, stem)
+	for _, filename := range stageFilenames {
+		reserved = reserved || stem == strings.TrimSuffix(filename, filepath.Ext(filename))
+	}
+	if reserved {
+		return fmt.Errorf("config name %q would keep its artifacts in docs/%s/, which Hoofy already uses — pick another name", name, stem)
+	}
+	configNameOverride = name
+	return nil
+}
+
+// artifactsSubdir returns the docs subdirectory holding the artifacts of
+// a project whose config name is overridden: the name without its
+// extension (api for api.json). Empty for the default name, whose
+// artifacts sit in the docs directory itself.
+func artifactsSubdir() string {
+	if configNameOverride == "" || configNameOverride == ConfigFile {
+		return ""
+	}
+	return strings.TrimSuffix(configNameOverride, filepath.Ext(configNameOverride))
+}
+
+// ConfigName returns the active project config filename. With the YAML
+// format selected (SetConfigFormat), a .json name becomes .yaml.
+func ConfigName() string {
+	name := ConfigFile
+	if configNameOverride != "" {
+		name = configNameOverride
+	}
+	if configFormat == ConfigFormatYAML && formatOf(name) == ConfigFormatJSON {
+		name = strings.TrimSuffix(name, filepath.Ext(name)) + ".yaml"
+	}
+	return name
+}
@@ -0,0 +1,220 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func envMap(vals map[string]string) func(string) string {
+	return func(key string) string { return vals[key] }
+}
+
+func TestDefaultsFromEnv_Empty(t *testing.T) {
+	d, err := DefaultsFromEnv(envMap(nil))
+	if err != nil {
+		t.Fatalf("DefaultsFromEnv: %v", err)
+	}
+	if d != (Defaults{}) {
+		t.Errorf("expected zero Defaults, got %+v", d)
+	}
+}
+
+func TestDefaultsFromEnv_Valid(t *testing.T) {
+	d, err := DefaultsFromEnv(envMap(map[string]string{
+		EnvDefaultMode:      "Expert",
+		EnvClarityThreshold: "85",
+		EnvDocsDir:          "specs/sdd/",
+		EnvTemplatesDir:     " /etc/hoofy/templates ",
+	}))
+	if err != nil {
+		t.Fatalf("DefaultsFromEnv: %v", err)
+	}
+	if d.Mode != ModeExpert {
+		t.Errorf("Mode = %s, want expert", d.Mode)
+	}
+	if d.ClarityThreshold != 85 {
+		t.Errorf("ClarityThreshold = %d, want 85", d.ClarityThreshold)
+	}
+	if d.DocsDir != filepath.Join("specs", "sdd") {
+		t.Errorf("DocsDir = %q, want specs/sdd", d.DocsDir)
+	}
+	if d.TemplatesDir != "/etc/hoofy/templates" {
+		t.Errorf("TemplatesDir = %q", d.TemplatesDir)
+	}
+}
+
+func TestDefaultsFromEnv_Invalid(t *testing.T) {
+	tests := map[string]map[string]string{
+		"bad mode":            {EnvDefaultMode: "wizard"},
+		"non-numeric":         {EnvClarityThreshold: "high"},
+		"threshold too high":  {EnvClarityThreshold: "101"},
+		"threshold zero":      {EnvClarityThreshold: "0"},
+		"absolute docs dir":   {EnvDocsDir: "/etc/sdd"},
+		"escaping docs dir":   {EnvDocsDir: "../elsewhere"},
+		"project root itself": {EnvDocsDir: "."},
+		"input limit too low": {EnvMaxInputBytes: "100"},
+		"input limit text":    {EnvMaxInputBytes: "256KB"},
+	}
+	for name, env := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := DefaultsFromEnv(envMap(env)); err == nil {
+				t.Errorf("expected error for %v", env)
+			}
+		})
+	}
+}
+
+func TestSetDocsDir_ResolvesOverride(t *testing.T) {
+	t.Cleanup(func() { _ = SetDocsDir("") })
+	if err := SetDocsDir(".sdd"); err != nil {
+		t.Fatalf("SetDocsDir: %v", err)
+	}
+
+	tmpDir := t.TempDir()
+
+	// New projects land in the override directory.
+	if got := ResolveDocsDir(tmpDir); got != ".sdd" {
+		t.Errorf("ResolveDocsDir() = %q, want .sdd for a new project", got)
+	}
+
+	// Existing default-layout projects keep resolving to docs/.
+	if err := os.MkdirAll(filepath.Join(tmpDir, DocsDir), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, DocsDir, ConfigFile), []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got := ResolveDocsDir(tmpDir); got != DocsDir {
+		t.Errorf("ResolveDocsDir() = %q, want docs for an existing project", got)
+	}
+}
+
+func TestDocsDirCandidates_NoDuplicates(t *testing.T) {
+	t.Cleanup(func() { _ = SetDocsDir("") })
+	if err := SetDocsDir(DocsDir); err != nil {
+		t.Fatal(err)
+	}
+	got := DocsDirCandidates()
+	if len(got) != 2 {
+		t.Errorf("DocsDirCandidates() = %v, want 2 unique entries", got)
+	}
+}
+
+func TestSetConfigName(t *testing.T) {
+	t.Cleanup(func() { _ = SetConfigName("") })
+
+	for _, bad := range []string{"../api.json", "sub/api.json", "api.yaml", ".json", "adrs.json", "requirements.json"} {
+		if err := SetConfigName(bad); err == nil {
+			t.Errorf("SetConfigName(%q) should fail", bad)
+		}
+	}
+	if ConfigName() != ConfigFile {
+		t.Errorf("failed overrides must not change the name, got %q", ConfigName())
+	}
+
+	if err := SetConfigName("api.json"); err != nil {
+		t.Fatalf("SetConfigName: %v", err)
+	}
+	if ConfigName() != "api.json" {
+		t.Errorf("ConfigName() = %q, want api.json", ConfigName())
+	}
+}
+
+func TestSetConfigName_ProjectsCoexist(t *testing.T) {
+	t.Cleanup(func() { _ = SetConfigName("") })
+	root := t.TempDir()
+	store := NewFileStore()
+
+	for _, name := range []string{"api.json", "web.json"} {
+		if err := SetConfigName(name); err != nil {
+			t.Fatal(err)
+		}
+		if Exists(root) {
+			t.Fatalf("%s should not exist yet", name)
+		}
+		if err := store.Save(root, NewProjectConfig(name, "test", ModeExpert)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"api.json", "web.json"} {
+		_ = SetConfigName(name)
+		cfg, err := store.Load(root)
+		if err != nil {
+			t.Fatalf("Load with %s: %v", name, err)
+		}
+		if cfg.Name != name {
+			t.Errorf("loaded %q with config name %s", cfg.Name, name)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, DocsDir, ConfigFile)); !os.IsNotExist(err) {
+		t.Error("default hoofy.json should not have been written")
+	}
+
+	paths := map[string]string{}
+	for _, name := range []string{"api.json", "web.json"} {
+		_ = SetConfigName(name)
+		path := StagePath(root, StageSpecify)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		paths[name] = path
+	}
+	if paths["api.json"] == paths["web.json"] {
+		t.Fatalf("both configs resolve requirements to %s", paths["api.json"])
+	}
+	if want := filepath.Join(root, DocsDir, "api", "requirements.md"); paths["api.json"] != want {
+		t.Errorf("api requirements at %s, want %s", paths["api.json"], want)
+	}
+	for name, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil || string(data) != name {
+			t.Errorf("%s requirements = %q, %v; want its own content", name, data, err)
+		}
+	}
+}
+
+func TestDefaultsFromEnv_FileOptions(t *testing.T) {
+	d, err := DefaultsFromEnv(envMap(map[string]string{
+		EnvLineEndings: "CRLF",
+		EnvFileMode:    "0664",
+	}))
+	if err != nil {
+		t.Fatalf("DefaultsFromEnv: %v", err)
+	}
+	if d.LineEndings != LineEndingsCRLF {
+		t.Errorf("LineEndings = %q, want crlf", d.LineEndings)
+	}
+	if d.FileMode != 0o664 {
+		t.Errorf("FileMode = %#o, want 0664", d.FileMode)
+	}
+
+	for name, env := range map[string]map[string]string{
+		"bad line endings": {EnvLineEndings: "cr"},
+		"non-octal mode":   {EnvFileMode: "rw-r--r--"},
+		"mode with 9":      {EnvFileMode: "0699"},
+		"no owner write":   {EnvFileMode: "0444"},
+		"setuid bit":       {EnvFileMode: "4755"},
+	} {
+		if _, err := DefaultsFromEnv(envMap(env)); err == nil {
+			t.Errorf("%s: expected error for %v", name, env)
+		}
+	}
+}
+
+func TestDefaultsFromEnv_NoColor(t *testing.T) {
+	d, err := DefaultsFromEnv(envMap(map[string]string{EnvNoColor: "1"}))
+	if err != nil {
+		t.Fatalf("DefaultsFromEnv: %v", err)
+	}
+	if !d.NoColor {
+		t.Errorf("NoColor = false, want true when %s is set", EnvNoColor)
+	}
+	if d, _ := DefaultsFromEnv(envMap(nil)); d.NoColor {
+		t.Error("NoColor should default to false")
+	}
+}
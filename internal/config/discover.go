@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Discovery bounds for DiscoverProjects. Dashboards and listings point
+// the walk at a workspace directory, so it must stay cheap on large trees.
+const (
+	DefaultDiscoveryDepth = 3
+	MaxDiscoveryDepth     = 6
+)
+
+// skipDiscoveryDirs are never descended into while discovering projects.
+var skipDiscoveryDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"dist":         true,
+	"build":        true,
+	"target":       true,
+	"__pycache__":  true,
+}
+
+// Lister is implemented by stores that know every project root they
+// manage, such as a store backed by a database, so listings need not
+// walk the filesystem to find them. It is optional and separate from
+// Store: use ListProjects, which checks for it with an interface
+// assertion and falls back to DiscoverProjects for stores without it,
+// FileStore among them.
+type Lister interface {
+	List() ([]string, error)
+}
+
+// ListProjects returns the project roots under root, at most maxDepth
+// levels down, sorted. A store that implements Lister is asked for its
+// roots, which are then held to the same bounds and .sddignore rules as
+// a walk; any other store has root walked with DiscoverProjects.
+func ListProjects(store Store, root string, maxDepth int) ([]string, error) {
+	lister, ok := store.(Lister)
+	if !ok {
+		return DiscoverProjects(root, maxDepth), nil
+	}
+	roots, err := lister.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing projects: %w", err)
+	}
+
+	ignore := LoadIgnoreRules(root)
+	var found []string
+	for _, dir := range roots {
+		dir = filepath.Clean(dir)
+		rel, err := filepath.Rel(root, dir)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if pathDepth(root, dir) > maxDepth || discoverySkips(rel, ignore) {
+			continue
+		}
+		found = append(found, dir)
+	}
+	sort.Strings(found)
+	return slices.Compact(found), nil
+}
+
+// DiscoverProjects walks root up to maxDepth directory levels and returns
+// every directory that holds a hoofy.json in one of the docs candidates.
+// Hidden directories and common dependency/build folders are skipped,
+// as are the paths listed in root's .sddignore.
+func DiscoverProjects(root string, maxDepth int) []string {
+	var found []string
+	ignore := LoadIgnoreRules(root)
+	_ = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if d != nil && d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		if path != root {
+			name := d.Name()
+			if strings.HasPrefix(name, ".") || skipDiscoveryDirs[name] {
+				return fs.SkipDir
+			}
+			if rel, err := filepath.Rel(root, path); err == nil && ignore.Ignored(rel, true) {
+				return fs.SkipDir
+			}
+		}
+
+		for _, candidate := range DocsDirCandidates() {
+			if HasConfig(filepath.Join(path, candidate)) {
+				found = append(found, path)
+				break
+			}
+		}
+
+		if pathDepth(root, path) >= maxDepth {
+			return fs.SkipDir
+		}
+		return nil
+	})
+	sort.Strings(found)
+	return found
+}
+
+// discoverySkips reports whether DiscoverProjects would skip rel, a
+// path relative to the root, or any directory above it.
+func discoverySkips(rel string, ignore IgnoreRules) bool {
+	if rel == "." {
+		return false
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	for i, name := range parts {
+		if strings.HasPrefix(name, ".") || skipDiscoveryDirs[name] ||
+			ignore.Ignored(strings.Join(parts[:i+1], "/"), true) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathDepth returns how many directory levels path is below root.
+func pathDepth(root, path string) int {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == "." {
+		return 0
+	}
+	return strings.Count(filepath.ToSlash(rel), "/") + 1
+}
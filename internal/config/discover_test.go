@@ -0,0 +1,108 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiscoverProjects_BoundedDepth(t *testing.T) {
+	root := t.TempDir()
+	for _, rel := range []string{"a", "group/b", "group/deep/nested/c", "node_modules/pkg"} {
+		cfg := NewProjectConfig(filepath.Base(rel), "test", ModeGuided)
+		if err := NewFileStore().Save(filepath.Join(root, rel), cfg); err != nil {
+			t.Fatalf("save %s: %v", rel, err)
+		}
+	}
+
+	got := DiscoverProjects(root, 2)
+	if len(got) != 2 {
+		t.Fatalf("depth 2: got %v, want a and group/b", got)
+	}
+
+	got = DiscoverProjects(root, MaxDiscoveryDepth)
+	if len(got) != 3 {
+		t.Fatalf("max depth: got %v, want 3 projects (node_modules skipped)", got)
+	}
+}
+
+func TestDiscoverProjects_SDDIgnore(t *testing.T) {
+	root := t.TempDir()
+	for _, rel := range []string{"app", "examples/demo", "pkg/testdata/fixture", "pkg/core", "generated/keep", "node_modules/pkg"} {
+		cfg := NewProjectConfig(filepath.Base(rel), "test", ModeGuided)
+		if err := NewFileStore().Save(filepath.Join(root, rel), cfg); err != nil {
+			t.Fatalf("save %s: %v", rel, err)
+		}
+	}
+	ignore := "# vendored and generated trees\nexamples/\ntestdata\n/generated/*\n!/generated/keep\n"
+	if err := os.WriteFile(filepath.Join(root, IgnoreFile), []byte(ignore), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, dir := range DiscoverProjects(root, MaxDiscoveryDepth) {
+		rel, _ := filepath.Rel(root, dir)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	want := []string{"app", "generated/keep", "pkg/core"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiscoverProjects = %v, want %v (ignored paths and node_modules skipped)", got, want)
+	}
+}
+
+// listingStore is a FileStore that also knows its project roots.
+type listingStore struct {
+	*FileStore
+	roots []string
+	err   error
+}
+
+func (s *listingStore) List() ([]string, error) { return s.roots, s.err }
+
+func TestListProjects_UsesLister(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, IgnoreFile), []byte("examples/\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Nothing is on disk: the roots come from the store alone.
+	store := &listingStore{FileStore: NewFileStore(), roots: []string{
+		filepath.Join(root, "b"),
+		filepath.Join(root, "a"),
+		filepath.Join(root, "a") + string(filepath.Separator),
+		filepath.Join(root, "group", "deep", "nested", "c"),
+		filepath.Join(root, "examples", "demo"),
+		filepath.Join(root, ".cache", "d"),
+		filepath.Join(filepath.Dir(root), "outside"),
+	}}
+
+	got, err := ListProjects(store, root, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{filepath.Join(root, "a"), filepath.Join(root, "b")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListProjects = %v, want %v (deduplicated, sorted, bounded to root, depth and ignore rules)", got, want)
+	}
+
+	store.err = errors.New("backend down")
+	if _, err := ListProjects(store, root, 2); err == nil || !strings.Contains(err.Error(), "backend down") {
+		t.Errorf("a List failure should be returned, got %v", err)
+	}
+}
+
+func TestListProjects_FileStoreWalks(t *testing.T) {
+	root := t.TempDir()
+	if err := NewFileStore().Save(filepath.Join(root, "app"), NewProjectConfig("app", "test", ModeGuided)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ListProjects(NewFileStore(), root, DefaultDiscoveryDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := DiscoverProjects(root, DefaultDiscoveryDepth); !reflect.DeepEqual(got, want) || len(got) != 1 {
+		t.Errorf("ListProjects = %v, want the walk's %v", got, want)
+	}
+}
@@ -0,0 +1,156 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFormat selects how the project config is serialized on disk.
+type ConfigFormat string
+
+const (
+	// ConfigFormatJSON stores the config as hoofy.json (the default).
+	ConfigFormatJSON ConfigFormat = "json"
+	// ConfigFormatYAML stores the config as hoofy.yaml, which allows
+	// comments and is more forgiving to hand-edit.
+	ConfigFormatYAML ConfigFormat = "yaml"
+)
+
+// configFormat is the format installed by SetConfigFormat. It decides
+// the filename for new projects; existing configs keep their format.
+var configFormat = ConfigFormatJSON
+
+// SetConfigFormat sets the config format used when a project has no
+// config yet. Load detects whichever of hoofy.json and hoofy.yaml
+// exists, so projects in either format keep working. Intended to be
+// called once at startup; empty restores the default (json).
+func SetConfigFormat(format string) error {
+	switch ConfigFormat(strings.ToLower(strings.TrimSpace(format))) {
+	case "", ConfigFormatJSON:
+		configFormat = ConfigFormatJSON
+	case ConfigFormatYAML, "yml":
+		configFormat = ConfigFormatYAML
+	default:
+		return fmt.Errorf("config format must be 'json' or 'yaml', got %q", format)
+	}
+	return nil
+}
+
+// formatOf returns the format implied by a config filename's extension.
+func formatOf(name string) ConfigFormat {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		return ConfigFormatYAML
+	default:
+		return ConfigFormatJSON
+	}
+}
+
+// ConfigNames returns the config filenames recognized in a docs
+// directory, the active ConfigName first, then its counterpart in the
+// other format (hoofy.json ↔ hoofy.yaml).
+func ConfigNames() []string {
+	name := ConfigName()
+	stem := strings.TrimSuffix(name, filepath.Ext(name))
+	if formatOf(name) == ConfigFormatYAML {
+		return []string{name, stem + ".yml", stem + ".json"}
+	}
+	return []string{name, stem + ".yaml", stem + ".yml"}
+}
+
+// configPathIn returns the path of the config inside docsDir: the first
+// of ConfigNames that exists, or the active ConfigName when none does.
+func configPathIn(docsDir string) string {
+	for _, name := range ConfigNames() {
+		path := filepath.Join(docsDir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return filepath.Join(docsDir, ConfigName())
+}
+
+// HasConfig reports whether docsDir holds a project config in any
+// supported format.
+func HasConfig(docsDir string) bool {
+	for _, name := range ConfigNames() {
+		if _, err := os.Stat(filepath.Join(docsDir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// unmarshalConfig decodes config data in the format implied by path.
+// YAML is converted through JSON so the json struct tags remain the
+// single source of field names for both formats.
+func unmarshalConfig(path string, data []byte, v any) error {
+	data = StripBOM(data)
+	if formatOf(path) == ConfigFormatJSON {
+		return json.Unmarshal(data, v)
+	}
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+	if generic == nil {
+		generic = map[string]any{}
+	}
+	asJSON, err := json.Marshal(generic)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(asJSON, v)
+}
+
+// utf8BOM is the byte order mark some Windows editors prepend to
+// UTF-8 files.
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// StripBOM removes a leading UTF-8 byte order mark from data, which
+// the JSON decoder rejects and markdown renders as a stray character.
+func StripBOM(data []byte) []byte {
+	return bytes.TrimPrefix(data, utf8BOM)
+}
+
+// marshalConfig encodes v in the format implied by path. YAML output
+// keeps the field order of the JSON encoding.
+func marshalConfig(path string, v any) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil || formatOf(path) == ConfigFormatJSON {
+		return data, err
+	}
+	// JSON is valid YAML: parse it into a node tree, drop the flow and
+	// quoting styles inherited from JSON, and re-encode as block YAML.
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	clearYAMLStyle(&doc)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// clearYAMLStyle resets node styles so the encoder picks block
+// collections and plain scalars (quoting only where required).
+func clearYAMLStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		clearYAMLStyle(c)
+	}
+}
@@ -0,0 +1,202 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// roundTripConfig returns a config exercising nested and optional fields.
+func roundTripConfig() *ProjectConfig {
+	cfg := NewProjectConfig("round-trip", "Config: with \"quotes\" and # hashes", ModeExpert)
+	cfg.ClarityThreshold = 65
+	cfg.ClarityScore = 72
+	cfg.StageFiles = map[Stage]string{StageSpecify: "spec.md"}
+	cfg.CurrentStage = StageSpecify
+	return cfg
+}
+
+func TestFileStore_RoundTrip_BothFormats(t *testing.T) {
+	tests := []struct {
+		format   string
+		wantFile string
+	}{
+		{"json", "hoofy.json"},
+		{"yaml", "hoofy.yaml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			t.Cleanup(func() { _ = SetConfigFormat("") })
+			if err := SetConfigFormat(tt.format); err != nil {
+				t.Fatal(err)
+			}
+
+			root := t.TempDir()
+			store := NewFileStore()
+			original := roundTripConfig()
+			if err := store.Save(root, original); err != nil {
+				t.Fatalf("Save: %v", err)
+			}
+			if got := filepath.Base(ConfigPath(root)); got != tt.wantFile {
+				t.Fatalf("config file = %s, want %s", got, tt.wantFile)
+			}
+
+			loaded, err := store.Load(root)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if !reflect.DeepEqual(loaded, original) {
+				t.Errorf("round trip mismatch:\n got %+v\nwant %+v", loaded, original)
+			}
+		})
+	}
+}
+
+func TestFileStore_YAMLIsBlockStyle(t *testing.T) {
+	t.Cleanup(func() { _ = SetConfigFormat("") })
+	if err := SetConfigFormat("yaml"); err != nil {
+		t.Fatal(err)
+	}
+	root := t.TempDir()
+	if err := NewFileStore().Save(root, roundTripConfig()); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	data, err := os.ReadFile(ConfigPath(root))
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := string(data)
+	for _, want := range []string{"name: round-trip\n", "mode: expert\n", "stage_files:\n  specify: spec.md\n"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("YAML should contain %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "{") {
+		t.Errorf("YAML should use block style, got:\n%s", text)
+	}
+}
+
+func TestFileStore_LoadDetectsFormat(t *testing.T) {
+	// A hand-written YAML config with comments is found and loaded even
+	// though the active format is JSON, and saving keeps it YAML.
+	root := t.TempDir()
+	docs := filepath.Join(root, DocsDir)
+	if err := os.MkdirAll(docs, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	yamlConfig := `# Hand-edited project config
+name: hand-edited
+description: Written by a human
+mode: guided   # or expert
+current_stage: specify
+clarity_score: 0
+stage_status:
+  init:
+    status: completed
+`
+	if err := os.WriteFile(filepath.Join(docs, "hoofy.yaml"), []byte(yamlConfig), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if !Exists(root) {
+		t.Fatal("Exists should detect hoofy.yaml")
+	}
+	if got, ok := FindProjectRoot(filepath.Join(docs)); !ok || got != root {
+		t.Errorf("FindProjectRoot = %q, %v; want %q", got, ok, root)
+	}
+
+	store := NewFileStore()
+	cfg, err := store.Load(root)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Name != "hand-edited" || cfg.Mode != ModeGuided || cfg.CurrentStage != StageSpecify {
+		t.Errorf("loaded %+v", cfg)
+	}
+	if cfg.StageStatus[StageInit].Status != "completed" {
+		t.Errorf("init status = %q, want completed", cfg.StageStatus[StageInit].Status)
+	}
+
+	if err := store.Save(root, cfg); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(docs, ConfigFile)); !os.IsNotExist(err) {
+		t.Error("saving a YAML project must not create hoofy.json")
+	}
+}
+
+func TestFileStore_Load_BOM(t *testing.T) {
+	// Notepad and some PowerShell versions prefix UTF-8 files with a BOM.
+	configs := map[string]string{
+		"hoofy.json": `{"name": "bom", "mode": "guided", "current_stage": "charter"}`,
+		"hoofy.yaml": "name: bom\nmode: guided\ncurrent_stage: charter\n",
+	}
+	for name, content := range configs {
+		t.Run(name, func(t *testing.T) {
+			root := t.TempDir()
+			docs := filepath.Join(root, DocsDir)
+			if err := os.MkdirAll(docs, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(docs, name), []byte("\xef\xbb\xbf"+content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			store := NewFileStore()
+			cfg, err := store.Load(root)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if cfg.Name != "bom" || cfg.CurrentStage != StageCharter {
+				t.Errorf("loaded %+v", cfg)
+			}
+			if err := store.Save(root, cfg); err != nil {
+				t.Fatalf("Save after loading a BOM config: %v", err)
+			}
+		})
+	}
+}
+
+func TestFileStore_Load_CorruptYAML(t *testing.T) {
+	root := t.TempDir()
+	docs := filepath.Join(root, DocsDir)
+	if err := os.MkdirAll(docs, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(docs, "hoofy.yaml"), []byte("name: [unclosed\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := NewFileStore().Load(root)
+	if err == nil || !strings.Contains(err.Error(), "parsing hoofy.yaml") {
+		t.Fatalf("Load error = %v, want parsing hoofy.yaml", err)
+	}
+}
+
+func TestSetConfigFormat(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetConfigFormat("")
+		_ = SetConfigName("")
+	})
+
+	if err := SetConfigFormat("toml"); err == nil {
+		t.Error("SetConfigFormat(toml) should fail")
+	}
+	if ConfigName() != ConfigFile {
+		t.Errorf("failed format must not change the name, got %q", ConfigName())
+	}
+
+	if err := SetConfigFormat("YAML"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetConfigName("api.json"); err != nil {
+		t.Fatal(err)
+	}
+	if ConfigName() != "api.yaml" {
+		t.Errorf("ConfigName() = %q, want api.yaml", ConfigName())
+	}
+	if got := ConfigNames(); !reflect.DeepEqual(got, []string{"api.yaml", "api.yml", "api.json"}) {
+		t.Errorf("ConfigNames() = %v", got)
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// HumanizeSince renders an RFC 3339 timestamp relative to now, e.g.
+// "just now", "5 minutes ago", "3 days ago". Timestamps in the future
+// render as "in 2 hours". Empty or unparseable input returns "".
+func HumanizeSince(rfc3339 string, now time.Time) string {
+	if rfc3339 == "" {
+		return ""
+	}
+	t, err := time.Parse(time.RFC3339, rfc3339)
+	if err != nil {
+		return ""
+	}
+
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var span string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		span = plural(int(d/time.Minute), "minute")
+	case d < 24*time.Hour:
+		span = plural(int(d/time.Hour), "hour")
+	case d < 30*24*time.Hour:
+		span = plural(int(d/(24*time.Hour)), "day")
+	case d < 365*24*time.Hour:
+		span = plural(int(d/(30*24*time.Hour)), "month")
+	default:
+		span = plural(int(d/(365*24*time.Hour)), "year")
+	}
+
+	if future {
+		return "in " + span
+	}
+	return span + " ago"
+}
+
+// plural formats n with unit, adding an "s" when n != 1.
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
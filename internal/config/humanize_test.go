@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizeSince(t *testing.T) {
+	now := time.Date(2024, 1, 13, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		ts   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"unparseable", "yesterday", ""},
+		{"just now", "2024-01-13T11:59:30Z", "just now"},
+		{"one minute", "2024-01-13T11:59:00Z", "1 minute ago"},
+		{"minutes", "2024-01-13T11:15:00Z", "45 minutes ago"},
+		{"hours", "2024-01-13T07:00:00Z", "5 hours ago"},
+		{"days", "2024-01-10T12:00:00Z", "3 days ago"},
+		{"months", "2023-11-13T12:00:00Z", "2 months ago"},
+		{"years", "2022-01-01T00:00:00Z", "2 years ago"},
+		{"future", "2024-01-13T14:00:00Z", "in 2 hours"},
+		{"offset timezone", "2024-01-13T09:00:00-03:00", "just now"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HumanizeSince(tt.ts, now); got != tt.want {
+				t.Errorf("HumanizeSince(%q) = %q, want %q", tt.ts, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreFile lists paths, as gitignore-style globs, that project
+// discovery skips below the directory holding it.
+const IgnoreFile = ".sddignore"
+
+// ignorePattern is one parsed .sddignore line.
+type ignorePattern struct {
+	glob     string // slash-separated, without the leading "/", trailing "/" or "!"
+	negate   bool   // "!pattern" re-includes a path an earlier pattern ignored
+	anchored bool   // contains a "/" — matched against the path from the root
+	dirOnly  bool   // trailing "/" — matches directories only
+}
+
+// IgnoreRules are the parsed patterns of a .sddignore file.
+// The zero value ignores nothing.
+type IgnoreRules struct {
+	patterns []ignorePattern
+}
+
+// LoadIgnoreRules reads root/.sddignore. A missing or unreadable file
+// yields empty rules: ignoring is a convenience, never a reason to fail
+// a walk.
+func LoadIgnoreRules(root string) IgnoreRules {
+	data, err := os.ReadFile(filepath.Join(root, IgnoreFile))
+	if err != nil {
+		return IgnoreRules{}
+	}
+	return ParseIgnoreRules(string(StripBOM(data)))
+}
+
+// ParseIgnoreRules parses gitignore-style content: one glob per line,
+// "#" comments, "!" negation, a leading "/" or an inner "/" anchoring
+// the glob to the root, a trailing "/" matching directories only, and
+// "**" matching any number of directories. The last matching pattern
+// wins, as in git.
+func ParseIgnoreRules(content string) IgnoreRules {
+	var rules IgnoreRules
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var p ignorePattern
+		if rest, ok := strings.CutPrefix(line, "!"); ok {
+			p.negate, line = true, rest
+		}
+		if rest, ok := strings.CutSuffix(line, "/"); ok {
+			p.dirOnly, line = true, rest
+		}
+		if rest, ok := strings.CutPrefix(line, "/"); ok {
+			p.anchored, line = true, rest
+		}
+		if strings.Contains(line, "/") {
+			p.anchored = true
+		}
+		if rest, ok := strings.CutPrefix(line, "**/"); ok && !strings.Contains(rest, "/") {
+			// "**/name" matches name at any depth, like a bare "name".
+			p.anchored, line = false, rest
+		}
+		if line == "" {
+			continue
+		}
+		p.glob = line
+		rules.patterns = append(rules.patterns, p)
+	}
+	return rules
+}
+
+// Ignored reports whether rel, a slash-separated path relative to the
+// root, is excluded. Callers walking a tree skip an ignored directory
+// entirely, so a path under an ignored directory need not be checked.
+func (r IgnoreRules) Ignored(rel string, isDir bool) bool {
+	rel = strings.Trim(filepath.ToSlash(rel), "/")
+	if rel == "" || rel == "." {
+		return false
+	}
+	ignored := false
+	for _, p := range r.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.matches(rel) {
+			ignored = !p.negate
+		}
+	}
+	return ignored
+}
+
+// matches reports whether the pattern matches rel.
+func (p ignorePattern) matches(rel string) bool {
+	if !p.anchored {
+		ok, _ := path.Match(p.glob, path.Base(rel))
+		return ok
+	}
+	return globMatch(strings.Split(p.glob, "/"), strings.Split(rel, "/"))
+}
+
+// globMatch matches path segments against glob segments, where a "**"
+// segment matches zero or more path segments.
+func globMatch(glob, segs []string) bool {
+	if len(glob) == 0 {
+		return len(segs) == 0
+	}
+	if glob[0] == "**" {
+		for i := 0; i <= len(segs); i++ {
+			if globMatch(glob[1:], segs[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segs) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(glob[0], segs[0]); !ok {
+		return false
+	}
+	return globMatch(glob[1:], segs[1:])
+}
@@ -0,0 +1,41 @@
+package config
+
+import "testing"
+
+func TestIgnoreRules_Ignored(t *testing.T) {
+	rules := ParseIgnoreRules("# comment\n\nexamples/\n*.tmp\n/build-out\ndocs/**/drafts\n**/fixtures\nthird_party/*\n!third_party/ours\n")
+
+	tests := []struct {
+		rel   string
+		isDir bool
+		want  bool
+	}{
+		{"examples", true, true},
+		{"pkg/examples", true, true},
+		{"examples", false, false}, // trailing "/" matches directories only
+		{"notes.tmp", false, true},
+		{"a/b/c.tmp", false, true},
+		{"build-out", true, true},
+		{"sub/build-out", true, false}, // leading "/" anchors to the root
+		{"docs/drafts", true, true},
+		{"docs/a/b/drafts", true, true},
+		{"other/drafts", true, false},
+		{"deep/in/fixtures", true, true},
+		{"third_party/lib", true, true},
+		{"third_party/ours", true, false}, // re-included by "!"
+		{"app", true, false},
+		{".", true, false},
+	}
+	for _, tt := range tests {
+		if got := rules.Ignored(tt.rel, tt.isDir); got != tt.want {
+			t.Errorf("Ignored(%q, dir=%v) = %v, want %v", tt.rel, tt.isDir, got, tt.want)
+		}
+	}
+
+	if (IgnoreRules{}).Ignored("anything", true) {
+		t.Error("empty rules must ignore nothing")
+	}
+	if got := LoadIgnoreRules(t.TempDir()); len(got.patterns) != 0 {
+		t.Errorf("missing .sddignore should give empty rules, got %+v", got)
+	}
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// InferStageFromArtifacts detects stage artifacts left in the docs
+// directory without a hoofy.json ("orphaned artifacts", e.g. after the
+// config was deleted). It returns the furthest stage, in pipeline order,
+// whose artifact exists and is non-empty, plus every stage found.
+// Returns ("", nil) when no artifacts exist.
+//
+// The optional research stage is considered part of the order, so a
+// present research.md is detected too.
+func InferStageFromArtifacts(projectRoot string) (Stage, []Stage) {
+	full := StageOrderFor(&ProjectConfig{OptionalStages: optionalStageList()})
+
+	var furthest Stage
+	var found []Stage
+	for _, stage := range full {
+		path := StagePath(projectRoot, stage)
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil || strings.TrimSpace(string(data)) == "" {
+			continue
+		}
+		furthest = stage
+		found = append(found, stage)
+	}
+	return furthest, found
+}
+
+// optionalStageList returns every optional stage.
+func optionalStageList() []Stage {
+	var stages []Stage
+	for stage := range optionalStageAfter {
+		stages = append(stages, stage)
+	}
+	return stages
+}
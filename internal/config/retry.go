@@ -0,0 +1,127 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// Write retry settings. Networked and virtual filesystems occasionally
+// reject a write transiently (EAGAIN, or a file briefly locked by an
+// antivirus scanner on Windows); a short bounded retry rides those out.
+const (
+	// DefaultWriteRetries is the number of retries after a failed first
+	// attempt when SDD_WRITE_RETRIES is not set.
+	DefaultWriteRetries = 3
+	// MaxWriteRetries caps SDD_WRITE_RETRIES.
+	MaxWriteRetries = 10
+	// NoWriteRetries is the Defaults.WriteRetries value for
+	// SDD_WRITE_RETRIES=0, distinguishing "disabled" from the zero
+	// value's "use the built-in default".
+	NoWriteRetries = -1
+)
+
+// writeRetryBackoff is the delay before the first retry; it doubles on
+// each subsequent retry (50ms, 100ms, 200ms, ...).
+const writeRetryBackoff = 50 * time.Millisecond
+
+// FileSystem is the set of filesystem writes that are retried.
+// Abstracted so tests can inject transient failures.
+type FileSystem interface {
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	Rename(oldpath, newpath string) error
+}
+
+// osFileSystem implements FileSystem with the os package.
+type osFileSystem struct{}
+
+func (osFileSystem) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+
+func (osFileSystem) Rename(oldpath, newpath string) error { return os.Rename(oldpath, newpath) }
+
+var (
+	fileSystem   FileSystem = osFileSystem{}
+	writeRetries            = DefaultWriteRetries
+	retrySleep              = time.Sleep
+)
+
+// SetFileSystem installs the filesystem used by WriteFile and Rename and
+// returns a function restoring the previous one. Intended for tests.
+func SetFileSystem(fsys FileSystem) (restore func()) {
+	prev := fileSystem
+	fileSystem = fsys
+	return func() { fileSystem = prev }
+}
+
+// SetWriteRetries installs the retry count from a Defaults.WriteRetries
+// value: 0 keeps DefaultWriteRetries, NoWriteRetries disables retrying.
+// Intended to be called once at startup, before any tool runs.
+func SetWriteRetries(n int) {
+	switch {
+	case n == 0:
+		writeRetries = DefaultWriteRetries
+	case n < 0:
+		writeRetries = 0
+	default:
+		writeRetries = n
+	}
+}
+
+// WriteFile writes data to name, retrying transient failures with
+// backoff. Permanent errors (no space, permission denied) fail at once.
+func WriteFile(name string, data []byte, perm os.FileMode) error {
+	return withRetry("write "+name, func() error {
+		return fileSystem.WriteFile(name, data, perm)
+	})
+}
+
+// Rename renames oldpath to newpath with the same retry policy as WriteFile.
+func Rename(oldpath, newpath string) error {
+	return withRetry(fmt.Sprintf("rename %s to %s", oldpath, newpath), func() error {
+		return fileSystem.Rename(oldpath, newpath)
+	})
+}
+
+// withRetry runs op, retrying up to writeRetries times while it fails
+// with a retryable error. Each retry is logged.
+func withRetry(desc string, op func() error) error {
+	backoff := writeRetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := op()
+		if err == nil || attempt >= writeRetries || !IsRetryable(err) {
+			return err
+		}
+		log.Printf("WARNING: %s failed (retry %d/%d in %s): %v", desc, attempt+1, writeRetries, backoff, err)
+		retrySleep(backoff)
+		backoff *= 2
+	}
+}
+
+// Windows errors for files held open by another process (commonly an
+// antivirus scanner or indexer).
+const (
+	errWindowsSharingViolation = syscall.Errno(32) // ERROR_SHARING_VIOLATION
+	errWindowsLockViolation    = syscall.Errno(33) // ERROR_LOCK_VIOLATION
+)
+
+// IsRetryable reports whether err is a transient filesystem error worth
+// retrying. Anything not recognized as transient is treated as permanent.
+func IsRetryable(err error) bool {
+	var errno syscall.Errno
+	if !errors.As(err, &errno) {
+		return false
+	}
+	switch errno {
+	case syscall.EAGAIN, syscall.EBUSY, syscall.EINTR:
+		return true
+	case errWindowsSharingViolation, errWindowsLockViolation:
+		return runtime.GOOS == "windows"
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// flakyFS fails the first `failures` calls with err, then writes through
+// to the real filesystem.
+type flakyFS struct {
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *flakyFS) WriteFile(name string, data []byte, perm os.FileMode) error {
+	f.calls++
+	if f.calls <= f.failures {
+		return &fs.PathError{Op: "open", Path: name, Err: f.err}
+	}
+	return os.WriteFile(name, data, perm)
+}
+
+func (f *flakyFS) Rename(oldpath, newpath string) error {
+	f.calls++
+	if f.calls <= f.failures {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: f.err}
+	}
+	return os.Rename(oldpath, newpath)
+}
+
+// withFlakyFS installs fsys with instant backoff and the given retry count.
+func withFlakyFS(t *testing.T, fsys FileSystem, retries int) *[]time.Duration {
+	t.Helper()
+	var slept []time.Duration
+	restore := SetFileSystem(fsys)
+	prevSleep, prevRetries := retrySleep, writeRetries
+	retrySleep = func(d time.Duration) { slept = append(slept, d) }
+	SetWriteRetries(retries)
+	t.Cleanup(func() {
+		restore()
+		retrySleep, writeRetries = prevSleep, prevRetries
+	})
+	return &slept
+}
+
+func TestFileStoreSave_RetriesTransientFailures(t *testing.T) {
+	fsys := &flakyFS{failures: 2, err: syscall.EAGAIN}
+	slept := withFlakyFS(t, fsys, 0)
+
+	tmpDir := t.TempDir()
+	store := NewFileStore()
+	cfg := NewProjectConfig("retry", "", ModeGuided)
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatalf("Save should succeed after two transient failures: %v", err)
+	}
+	if fsys.calls != 3 {
+		t.Errorf("calls = %d, want 3", fsys.calls)
+	}
+	if want := []time.Duration{50 * time.Millisecond, 100 * time.Millisecond}; fmt.Sprint(*slept) != fmt.Sprint(want) {
+		t.Errorf("backoff = %v, want %v", *slept, want)
+	}
+	if loaded, err := store.Load(tmpDir); err != nil || loaded.Name != "retry" {
+		t.Errorf("Load after retried Save = %+v, %v", loaded, err)
+	}
+}
+
+func TestWriteFile_PermanentErrorFailsImmediately(t *testing.T) {
+	for _, errno := range []syscall.Errno{syscall.ENOSPC, syscall.EACCES} {
+		t.Run(errno.Error(), func(t *testing.T) {
+			fsys := &flakyFS{failures: 5, err: errno}
+			slept := withFlakyFS(t, fsys, 0)
+
+			err := WriteFile(filepath.Join(t.TempDir(), "x.md"), []byte("x"), 0o644)
+			if !errors.Is(err, errno) {
+				t.Fatalf("err = %v, want %v", err, errno)
+			}
+			if fsys.calls != 1 || len(*slept) != 0 {
+				t.Errorf("permanent errors must not be retried: calls=%d sleeps=%v", fsys.calls, *slept)
+			}
+		})
+	}
+}
+
+func TestWriteFile_GivesUpAfterRetries(t *testing.T) {
+	fsys := &flakyFS{failures: 10, err: syscall.EBUSY}
+	withFlakyFS(t, fsys, 2)
+
+	err := WriteFile(filepath.Join(t.TempDir(), "x.md"), []byte("x"), 0o644)
+	if !errors.Is(err, syscall.EBUSY) {
+		t.Fatalf("err = %v, want EBUSY", err)
+	}
+	if fsys.calls != 3 {
+		t.Errorf("calls = %d, want 1 attempt + 2 retries", fsys.calls)
+	}
+}
+
+func TestWriteFile_RetriesDisabled(t *testing.T) {
+	fsys := &flakyFS{failures: 1, err: syscall.EAGAIN}
+	withFlakyFS(t, fsys, NoWriteRetries)
+
+	if err := WriteFile(filepath.Join(t.TempDir(), "x.md"), []byte("x"), 0o644); err == nil {
+		t.Fatal("with retries disabled the first failure should be returned")
+	}
+	if fsys.calls != 1 {
+		t.Errorf("calls = %d, want 1", fsys.calls)
+	}
+}
+
+func TestRename_Retries(t *testing.T) {
+	fsys := &flakyFS{failures: 1, err: syscall.EAGAIN}
+	withFlakyFS(t, fsys, 0)
+
+	dir := t.TempDir()
+	src, dst := filepath.Join(dir, "a"), filepath.Join(dir, "b")
+	if err := os.WriteFile(src, []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Rename(src, dst); err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	if _, err := os.Stat(dst); err != nil {
+		t.Errorf("destination missing: %v", err)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&fs.PathError{Op: "open", Path: "x", Err: syscall.EAGAIN}, true},
+		{syscall.EBUSY, true},
+		{syscall.EINTR, true},
+		{syscall.ENOSPC, false},
+		{syscall.EACCES, false},
+		{os.ErrNotExist, false},
+		{errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		if got := IsRetryable(tt.err); got != tt.want {
+			t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultsFromEnv_WriteRetries(t *testing.T) {
+	for v, want := range map[string]int{"5": 5, "0": NoWriteRetries, "10": 10} {
+		d, err := DefaultsFromEnv(envMap(map[string]string{EnvWriteRetries: v}))
+		if err != nil {
+			t.Fatalf("%s=%s: %v", EnvWriteRetries, v, err)
+		}
+		if d.WriteRetries != want {
+			t.Errorf("%s=%s: WriteRetries = %d, want %d", EnvWriteRetries, v, d.WriteRetries, want)
+		}
+	}
+	for _, v := range []string{"-1", "11", "many"} {
+		if _, err := DefaultsFromEnv(envMap(map[string]string{EnvWriteRetries: v})); err == nil {
+			t.Errorf("%s=%s should be rejected", EnvWriteRetries, v)
+		}
+	}
+}
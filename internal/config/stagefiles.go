@@ -0,0 +1,190 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ValidateStageFiles checks per-project artifact filename overrides:
+// each key must be a stage that produces an artifact, each value a
+// relative path that stays inside the docs directory, and no two
+// stages (overridden or default) may resolve to the same file.
+// Overrides may not shadow the project config or side artifacts.
+func ValidateStageFiles(files map[Stage]string) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	stages := make([]Stage, 0, len(files))
+	for stage := range files {
+		stages = append(stages, stage)
+	}
+	sort.Slice(stages, func(i, j int) bool { return stages[i] < stages[j] })
+
+	for _, stage := range stages {
+		if !HasArtifact(stage) {
+			return fmt.Errorf("stage_files: stage %q has no artifact to rename", stage)
+		}
+		if _, err := cleanStageFile(files[stage]); err != nil {
+			return fmt.Errorf("stage_files[%s]: %w", stage, err)
+		}
+	}
+
+	reserved := make(map[string]string)
+	for _, name := range ConfigNames() {
+		reserved[strings.ToLower(name)] = "the project config"
+	}
+	for _, side := range SideArtifacts {
+		reserved[strings.ToLower(side.Filename)] = "the " + side.Name + " artifact"
+	}
+
+	owner := make(map[string]Stage)
+	for stage := range stageFilenames {
+		name := stageFilenames[stage]
+		if override, ok := files[stage]; ok {
+			name, _ = cleanStageFile(override)
+		}
+		key := strings.ToLower(filepath.ToSlash(name))
+		if what, ok := reserved[key]; ok {
+			return fmt.Errorf("stage_files[%s]: %q would overwrite %s", stage, name, what)
+		}
+		if other, ok := owner[key]; ok {
+			a, b := other, stage
+			if b < a {
+				a, b = b, a
+			}
+			return fmt.Errorf("stage_files: stages %q and %q both map to %q", a, b, name)
+		}
+		owner[key] = stage
+	}
+	return nil
+}
+
+// cleanStageFile validates one override path, relative to the docs
+// directory, and returns it cleaned.
+func cleanStageFile(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("filename is empty")
+	}
+	if filepath.IsAbs(name) || strings.HasPrefix(name, "/") || strings.HasPrefix(name, `\`) {
+		return "", fmt.Errorf("%q must be relative to the docs directory", name)
+	}
+	cleaned := filepath.Clean(name)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%q must stay inside the docs directory", name)
+	}
+	return cleaned, nil
+}
+
+// ParseStageFiles parses "stage=filename" pairs separated by commas,
+// e.g. "specify=spec.md,design=arch/design.md", and validates them.
+func ParseStageFiles(spec string) (map[Stage]string, error) {
+	files := make(map[Stage]string)
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		stage, name, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("stage_files entry %q must look like stage=filename", pair)
+		}
+		files[Stage(strings.TrimSpace(stage))] = strings.TrimSpace(name)
+	}
+	if err := ValidateStageFiles(files); err != nil {
+		return nil, err
+	}
+	for stage, name := range files {
+		files[stage], _ = cleanStageFile(name)
+	}
+	return files, nil
+}
+
+// StageFilename returns the artifact filename for a stage in this
+// project, relative to the docs directory: the StageFiles override when
+// set, else the default name.
+func (c *ProjectConfig) StageFilename(stage Stage) string {
+	if name, ok := c.StageFiles[stage]; ok && stageFilenames[stage] != "" {
+		if cleaned, err := cleanStageFile(name); err == nil {
+			return cleaned
+		}
+	}
+	return stageFilenames[stage]
+}
+
+// StageFilenameIn returns the artifact filename for a stage in the
+// project at projectRoot, honoring its StageFiles overrides.
+func StageFilenameIn(projectRoot string, stage Stage) string {
+	return StageFilenameInDocs(DocsPath(projectRoot), stage)
+}
+
+// StageFilenameInDocs is StageFilenameIn for callers that hold the
+// resolved docs directory rather than the project root.
+func StageFilenameInDocs(docsDir string, stage Stage) string {
+	if files := docsStageFiles(docsDir); files != nil {
+		if name, ok := files[stage]; ok {
+			return name
+		}
+	}
+	return stageFilenames[stage]
+}
+
+// stageFilesEntry caches a project's overrides, keyed by config path
+// and invalidated when the file's size or mtime changes.
+type stageFilesEntry struct {
+	modTime time.Time
+	size    int64
+	files   map[Stage]string
+}
+
+var (
+	stageFilesMu    sync.Mutex
+	stageFilesCache = make(map[string]stageFilesEntry)
+)
+
+// docsStageFiles returns the validated StageFiles overrides stored in
+// the project config inside docsDir, or nil when there are none. Invalid
+// overrides are ignored here (default names apply) and reported by
+// Validate.
+func docsStageFiles(docsDir string) map[Stage]string {
+	path := ConfigPathFor(docsDir)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil
+	}
+
+	stageFilesMu.Lock()
+	defer stageFilesMu.Unlock()
+
+	if e, ok := stageFilesCache[path]; ok && e.modTime.Equal(info.ModTime()) && e.size == info.Size() {
+		return e.files
+	}
+
+	var partial struct {
+		StageFiles map[Stage]string `json:"stage_files"`
+	}
+	var files map[Stage]string
+	if data, err := os.ReadFile(path); err == nil && unmarshalConfig(path, data, &partial) == nil {
+		if ValidateStageFiles(partial.StageFiles) == nil && len(partial.StageFiles) > 0 {
+			files = make(map[Stage]string, len(partial.StageFiles))
+			for stage, name := range partial.StageFiles {
+				files[stage], _ = cleanStageFile(name)
+			}
+		}
+	}
+	stageFilesCache[path] = stageFilesEntry{modTime: info.ModTime(), size: info.Size(), files: files}
+	return files
+}
+
+// forgetStageFiles drops the cached overrides for a config path.
+func forgetStageFiles(configPath string) {
+	stageFilesMu.Lock()
+	delete(stageFilesCache, configPath)
+	stageFilesMu.Unlock()
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateStageFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[Stage]string
+		wantErr string
+	}{
+		{"empty", nil, ""},
+		{"rename", map[Stage]string{StageSpecify: "spec.md"}, ""},
+		{"subdirectory", map[Stage]string{StageDesign: "architecture/design.md"}, ""},
+		{"swap", map[Stage]string{StageDesign: "tasks.md", StageTasks: "design.md"}, ""},
+		{"init has no artifact", map[Stage]string{StageInit: "init.md"}, "no artifact"},
+		{"unknown stage", map[Stage]string{"bogus": "bogus.md"}, "no artifact"},
+		{"empty filename", map[Stage]string{StageSpecify: "  "}, "empty"},
+		{"absolute", map[Stage]string{StageSpecify: "/etc/spec.md"}, "relative"},
+		{"escapes docs", map[Stage]string{StageSpecify: "../spec.md"}, "inside the docs"},
+		{"collides with default", map[Stage]string{StageSpecify: "design.md"}, "both map to"},
+		{"collides case-insensitively", map[Stage]string{StageSpecify: "Spec.md", StageDesign: "spec.MD"}, "both map to"},
+		{"shadows config", map[Stage]string{StageSpecify: ConfigName()}, "project config"},
+		{"shadows side artifact", map[Stage]string{StageSpecify: SummaryFile}, "Summary"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStageFiles(tt.files)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseStageFiles(t *testing.T) {
+	files, err := ParseStageFiles(" specify = spec.md , design=arch//design.md,")
+	if err != nil {
+		t.Fatalf("ParseStageFiles: %v", err)
+	}
+	if files[StageSpecify] != "spec.md" {
+		t.Errorf("specify = %q, want spec.md", files[StageSpecify])
+	}
+	if want := filepath.Join("arch", "design.md"); files[StageDesign] != want {
+		t.Errorf("design = %q, want %q", files[StageDesign], want)
+	}
+
+	if files, err := ParseStageFiles(""); err != nil || len(files) != 0 {
+		t.Errorf("empty spec = %v, %v; want no overrides", files, err)
+	}
+	if _, err := ParseStageFiles("specify"); err == nil {
+		t.Error("expected error for entry without '='")
+	}
+}
+
+func TestStagePath_HonorsStageFiles(t *testing.T) {
+	root := t.TempDir()
+	store := NewFileStore()
+
+	if got, want := StagePath(root, StageSpecify), filepath.Join(root, DocsDir, "requirements.md"); got != want {
+		t.Fatalf("before init StagePath = %s, want %s", got, want)
+	}
+
+	cfg := NewProjectConfig("x", "y", ModeGuided)
+	cfg.StageFiles = map[Stage]string{StageSpecify: "spec.md"}
+	if err := store.Save(root, cfg); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if got, want := StagePath(root, StageSpecify), filepath.Join(root, DocsDir, "spec.md"); got != want {
+		t.Errorf("StagePath(specify) = %s, want %s", got, want)
+	}
+	if got, want := StagePath(root, StageDesign), filepath.Join(root, DocsDir, "design.md"); got != want {
+		t.Errorf("StagePath(design) = %s, want %s", got, want)
+	}
+	if got := cfg.StageFilename(StageSpecify); got != "spec.md" {
+		t.Errorf("cfg.StageFilename(specify) = %s, want spec.md", got)
+	}
+
+	// A later save replaces the cached overrides.
+	cfg.StageFiles = map[Stage]string{StageSpecify: "reqs/spec.md"}
+	if err := store.Save(root, cfg); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if got, want := StagePath(root, StageSpecify), filepath.Join(root, DocsDir, "reqs", "spec.md"); got != want {
+		t.Errorf("after update StagePath = %s, want %s", got, want)
+	}
+}
+
+func TestProjectConfig_ValidateStageFiles(t *testing.T) {
+	cfg := NewProjectConfig("x", "y", ModeGuided)
+	cfg.StageFiles = map[Stage]string{StageSpecify: "design.md"}
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "both map to") {
+		t.Fatalf("Validate = %v, want stage_files collision", err)
+	}
+	if got := cfg.StageFilename(StageSpecify); got != "design.md" {
+		t.Errorf("cfg.StageFilename(specify) = %s, want design.md", got)
+	}
+
+	// Invalid overrides on disk fall back to the default names.
+	root := t.TempDir()
+	if err := NewFileStore().Save(root, cfg); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if got, want := StagePath(root, StageSpecify), filepath.Join(root, DocsDir, "requirements.md"); got != want {
+		t.Errorf("StagePath with invalid overrides = %s, want %s", got, want)
+	}
+}
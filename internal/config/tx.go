@@ -0,0 +1,199 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Transactor is implemented by stores that can batch the saves of one
+// logical operation into a single write. Between Begin and Commit,
+// Save buffers the config and Load returns the buffered copy; Commit
+// writes it once, Rollback discards it. Transactions nest: only the
+// outermost Commit writes.
+//
+// It is separate from Store so test fakes and other stores need not
+// implement it; use InTransaction rather than calling it directly.
+type Transactor interface {
+	Begin(projectRoot string) error
+	Commit(projectRoot string) error
+	Rollback(projectRoot string)
+}
+
+// ErrNoTransaction is returned by Commit when no transaction is open
+// for the project.
+var ErrNoTransaction = errors.New("no config transaction is open")
+
+// InTransaction runs fn with store's saves for projectRoot batched into
+// one write, committed when fn succeeds and rolled back when it fails.
+// Stores that don't implement Transactor save immediately, as usual.
+func InTransaction(store Store, projectRoot string, fn func() error) error {
+	tx, ok := store.(Transactor)
+	if !ok {
+		return fn()
+	}
+	if err := tx.Begin(projectRoot); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		tx.Rollback(projectRoot)
+		return err
+	}
+	return tx.Commit(projectRoot)
+}
+
+// fileTx is an open FileStore transaction for one project.
+type fileTx struct {
+	depth int
+	// base is the on-disk Revision when the first buffered save was
+	// made; Commit fails with ErrConflict if the disk moved on since.
+	base int
+	// cfg is the buffered config, nil until the first save.
+	cfg *ProjectConfig
+}
+
+// txKey identifies a project's transaction.
+func txKey(projectRoot string) string {
+	return filepath.Clean(projectRoot)
+}
+
+// openTx returns the open transaction for projectRoot, or nil.
+// fs.mu must be held.
+func (fs *FileStore) openTx(projectRoot string) *fileTx {
+	return fs.txs[txKey(projectRoot)]
+}
+
+// Begin opens a transaction for projectRoot, or nests inside the one
+// already open.
+func (fs *FileStore) Begin(projectRoot string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if tx := fs.openTx(projectRoot); tx != nil {
+		tx.depth++
+		return nil
+	}
+	if fs.txs == nil {
+		fs.txs = map[string]*fileTx{}
+	}
+	fs.txs[txKey(projectRoot)] = &fileTx{depth: 1}
+	return nil
+}
+
+// Commit closes a transaction level. Closing the outermost one writes
+// the buffered config, if any save was made; it fails with ErrConflict,
+// writing nothing, when the config on disk changed since the
+// transaction's first save.
+func (fs *FileStore) Commit(projectRoot string) error {
+	fs.mu.Lock()
+	tx := fs.openTx(projectRoot)
+	if tx == nil {
+		fs.mu.Unlock()
+		return ErrNoTransaction
+	}
+	if tx.depth--; tx.depth > 0 {
+		fs.mu.Unlock()
+		return nil
+	}
+	delete(fs.txs, txKey(projectRoot))
+	fs.mu.Unlock()
+
+	if tx.cfg == nil {
+		return nil
+	}
+
+	saveMu.Lock()
+	defer saveMu.Unlock()
+
+	path := ConfigPath(projectRoot)
+	if onDisk, err := diskRevision(path); err != nil {
+		return err
+	} else if onDisk != tx.base {
+		return fmt.Errorf("%w: %s is at revision %d, but this transaction started from revision %d",
+			ErrConflict, filepath.Base(path), onDisk, tx.base)
+	}
+	return writeConfig(path, tx.cfg)
+}
+
+// Rollback discards the whole transaction for projectRoot, however
+// deeply nested. Nothing buffered is written.
+func (fs *FileStore) Rollback(projectRoot string) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	delete(fs.txs, txKey(projectRoot))
+}
+
+// txSave buffers cfg when projectRoot has an open transaction, with
+// the same revision check and bump as Save, and reports whether it did.
+// A save that creates the config is not buffered: other readers locate
+// the project, and its stage file overrides, by the file on disk.
+func (fs *FileStore) txSave(projectRoot string, cfg *ProjectConfig) (bool, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	tx := fs.openTx(projectRoot)
+	if tx == nil {
+		return false, nil
+	}
+
+	path := ConfigPath(projectRoot)
+	current := 0
+	if tx.cfg != nil {
+		current = tx.cfg.Revision
+	} else {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return false, nil
+		}
+		onDisk, err := diskRevision(path)
+		if err != nil {
+			return true, err
+		}
+		current = onDisk
+	}
+	if cfg.Revision != current {
+		return true, fmt.Errorf("%w: %s is at revision %d, but this update was based on revision %d",
+			ErrConflict, filepath.Base(path), current, cfg.Revision)
+	}
+
+	cfg.Revision++
+	cfg.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	buffered, err := cloneConfig(cfg)
+	if err != nil {
+		cfg.Revision--
+		return true, err
+	}
+	if tx.cfg == nil {
+		tx.base = current
+	}
+	tx.cfg = buffered
+	return true, nil
+}
+
+// txLoad returns a copy of the buffered config, if projectRoot has an
+// open transaction with a save in it.
+func (fs *FileStore) txLoad(projectRoot string) (*ProjectConfig, bool, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	tx := fs.openTx(projectRoot)
+	if tx == nil || tx.cfg == nil {
+		return nil, false, nil
+	}
+	cfg, err := cloneConfig(tx.cfg)
+	return cfg, true, err
+}
+
+// cloneConfig deep-copies cfg through its JSON form, so a buffered
+// config isn't shared with the callers that loaded or saved it.
+func cloneConfig(cfg *ProjectConfig) (*ProjectConfig, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("copying config: %w", err)
+	}
+	var out ProjectConfig
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("copying config: %w", err)
+	}
+	return &out, nil
+}
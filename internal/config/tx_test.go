@@ -0,0 +1,163 @@
+package config
+
+import (
+	"errors"
+	"testing"
+)
+
+// savedProject creates a project config on disk and returns its root.
+func savedProject(t *testing.T, fs *FileStore) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := fs.Save(root, &ProjectConfig{Name: "demo", CurrentStage: StageCharter}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	return root
+}
+
+// diskConfig reads the config on disk, bypassing any transaction.
+func diskConfig(t *testing.T, root string) *ProjectConfig {
+	t.Helper()
+	cfg, err := NewFileStore().Load(root)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return cfg
+}
+
+func TestFileStore_TransactionBuffersSaves(t *testing.T) {
+	fs := NewFileStore()
+	root := savedProject(t, fs)
+
+	err := InTransaction(fs, root, func() error {
+		for _, stage := range []Stage{StageSpecify, StageClarify, StageDesign} {
+			cfg, err := fs.Load(root)
+			if err != nil {
+				return err
+			}
+			cfg.CurrentStage = stage
+			if err := fs.Save(root, cfg); err != nil {
+				return err
+			}
+		}
+		if got := diskConfig(t, root); got.CurrentStage != StageCharter || got.Revision != 1 {
+			t.Errorf("disk before commit: stage %s rev %d, want charter rev 1", got.CurrentStage, got.Revision)
+		}
+		if cfg, _ := fs.Load(root); cfg.CurrentStage != StageDesign {
+			t.Errorf("Load inside transaction: stage %s, want the buffered design", cfg.CurrentStage)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("InTransaction: %v", err)
+	}
+
+	got := diskConfig(t, root)
+	if got.CurrentStage != StageDesign || got.Revision != 4 {
+		t.Errorf("disk after commit: stage %s rev %d, want design rev 4", got.CurrentStage, got.Revision)
+	}
+	// Saves go straight to disk again once the transaction is closed.
+	got.Name = "renamed"
+	if err := fs.Save(root, got); err != nil {
+		t.Fatalf("Save after commit: %v", err)
+	}
+	if diskConfig(t, root).Name != "renamed" {
+		t.Error("save after commit was not written")
+	}
+}
+
+func TestFileStore_TransactionNests(t *testing.T) {
+	fs := NewFileStore()
+	root := savedProject(t, fs)
+
+	_ = fs.Begin(root)
+	_ = fs.Begin(root)
+	cfg, _ := fs.Load(root)
+	cfg.CurrentStage = StageSpecify
+	if err := fs.Save(root, cfg); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := fs.Commit(root); err != nil {
+		t.Fatalf("inner Commit: %v", err)
+	}
+	if diskConfig(t, root).CurrentStage != StageCharter {
+		t.Error("inner Commit wrote the config; only the outermost should")
+	}
+	if err := fs.Commit(root); err != nil {
+		t.Fatalf("outer Commit: %v", err)
+	}
+	if diskConfig(t, root).CurrentStage != StageSpecify {
+		t.Error("outer Commit did not write the config")
+	}
+	if err := fs.Commit(root); !errors.Is(err, ErrNoTransaction) {
+		t.Errorf("Commit without a transaction: err = %v, want ErrNoTransaction", err)
+	}
+}
+
+func TestFileStore_TransactionRollsBackOnError(t *testing.T) {
+	fs := NewFileStore()
+	root := savedProject(t, fs)
+	boom := errors.New("boom")
+
+	err := InTransaction(fs, root, func() error {
+		cfg, _ := fs.Load(root)
+		cfg.CurrentStage = StageSpecify
+		if err := fs.Save(root, cfg); err != nil {
+			return err
+		}
+		return boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("InTransaction: err = %v, want boom", err)
+	}
+	if got := diskConfig(t, root); got.CurrentStage != StageCharter || got.Revision != 1 {
+		t.Errorf("after rollback: stage %s rev %d, want charter rev 1", got.CurrentStage, got.Revision)
+	}
+	if cfg, _ := fs.Load(root); cfg.CurrentStage != StageCharter {
+		t.Error("Load after rollback still returns the discarded buffer")
+	}
+}
+
+func TestFileStore_TransactionCommitConflict(t *testing.T) {
+	fs := NewFileStore()
+	root := savedProject(t, fs)
+
+	_ = fs.Begin(root)
+	cfg, _ := fs.Load(root)
+	cfg.CurrentStage = StageSpecify
+	if err := fs.Save(root, cfg); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// Another store writes the config while the transaction is open.
+	other := diskConfig(t, root)
+	other.Name = "theirs"
+	if err := NewFileStore().Save(root, other); err != nil {
+		t.Fatalf("concurrent Save: %v", err)
+	}
+
+	if err := fs.Commit(root); !errors.Is(err, ErrConflict) {
+		t.Fatalf("Commit: err = %v, want ErrConflict", err)
+	}
+	if got := diskConfig(t, root); got.Name != "theirs" || got.CurrentStage != StageCharter {
+		t.Errorf("conflicting Commit overwrote the config: %+v", got)
+	}
+}
+
+func TestFileStore_TransactionWritesThroughCreation(t *testing.T) {
+	fs := NewFileStore()
+	root := t.TempDir()
+
+	err := InTransaction(fs, root, func() error {
+		if err := fs.Save(root, &ProjectConfig{Name: "demo", CurrentStage: StageCharter}); err != nil {
+			return err
+		}
+		if _, err := NewFileStore().Load(root); err != nil {
+			t.Errorf("creating save was buffered: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("InTransaction: %v", err)
+	}
+}
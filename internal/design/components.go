@@ -0,0 +1,90 @@
+// Package design reads the structure of a rendered design.md.
+//
+// Like package requirements, it works on raw markdown, so callers can
+// check other artifacts against the design without a parsed model.
+package design
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ComponentsHeading is the design.md section listing the components.
+const ComponentsHeading = "Components"
+
+// Component is one ### sub-section of the design's Components section.
+type Component struct {
+	Name string // the heading as written, e.g. "AuthModule (`internal/auth`)"
+	Body string
+}
+
+// Components returns the ### sub-sections under "## Components", in
+// document order.
+func Components(content string) []Component {
+	var comps []Component
+	inComponents := false
+	var current *Component
+
+	flush := func() {
+		if current != nil {
+			comps = append(comps, *current)
+			current = nil
+		}
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		switch {
+		case strings.HasPrefix(line, "## "):
+			flush()
+			inComponents = strings.TrimSpace(strings.TrimPrefix(line, "## ")) == ComponentsHeading
+		case inComponents && strings.HasPrefix(line, "### "):
+			flush()
+			current = &Component{Name: strings.TrimSpace(strings.TrimPrefix(line, "### "))}
+		case current != nil:
+			current.Body += line + "\n"
+		}
+	}
+	flush()
+
+	return comps
+}
+
+// headingNumber matches a leading "1." or "2)" on a component heading.
+var headingNumber = regexp.MustCompile(`^\d+[.)]\s*`)
+
+// nameSeparators end a component's name inside its heading, e.g.
+// "AuthModule — login and sessions" or "AuthModule (internal/auth)".
+var nameSeparators = []string{" (", " — ", " – ", " - ", ":"}
+
+// ExtractComponents returns the names of the design's components: each
+// Components heading stripped of numbering, markdown emphasis and any
+// description after the name. "### 2. `AuthModule` (internal/auth)"
+// yields "AuthModule".
+func ExtractComponents(content string) []string {
+	var names []string
+	for _, c := range Components(content) {
+		if name := ComponentName(c.Name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// ComponentName strips a component heading or reference down to the
+// component's name.
+func ComponentName(s string) string {
+	s = headingNumber.ReplaceAllString(strings.TrimSpace(s), "")
+	for _, sep := range nameSeparators {
+		if i := strings.Index(s, sep); i > 0 {
+			s = s[:i]
+		}
+	}
+	return strings.TrimSpace(strings.Trim(s, "`*_ "))
+}
+
+// ComponentKey normalizes a component name for comparison: case,
+// spaces, hyphens and underscores are ignored, so "Auth Module",
+// "auth-module" and "AuthModule" are the same component.
+func ComponentKey(name string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(ComponentName(name)))
+}
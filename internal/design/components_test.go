@@ -0,0 +1,44 @@
+package design
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExtractComponents(t *testing.T) {
+	doc := "# Design\n\n## Architecture Overview\n\n### Not a component\n\n" +
+		"## Components\n\n" +
+		"### AuthModule\n- **Covers**: FR-001\n\n" +
+		"### 2. `Billing Service` (internal/billing)\n- **Covers**: FR-002\n\n" +
+		"### **Mailer** — outbound email\n\n" +
+		"## Data Model\n\n### User\n"
+
+	got := ExtractComponents(doc)
+	want := []string{"AuthModule", "Billing Service", "Mailer"}
+	if !slices.Equal(got, want) {
+		t.Errorf("ExtractComponents = %v, want %v", got, want)
+	}
+	if comps := Components(doc); len(comps) != 3 || comps[0].Body != "- **Covers**: FR-001\n\n" {
+		t.Errorf("Components = %+v", comps)
+	}
+	if got := ExtractComponents("# Design\n\nNo sections."); got != nil {
+		t.Errorf("want no components, got %v", got)
+	}
+}
+
+func TestComponentKey(t *testing.T) {
+	tests := []struct {
+		a, b string
+		same bool
+	}{
+		{"AuthModule", "auth-module", true},
+		{"Billing Service", "billing_service", true},
+		{"`Mailer` (smtp)", "mailer", true},
+		{"AuthModule", "AuthModul", false},
+	}
+	for _, tt := range tests {
+		if got := ComponentKey(tt.a) == ComponentKey(tt.b); got != tt.same {
+			t.Errorf("ComponentKey(%q) == ComponentKey(%q): %v, want %v", tt.a, tt.b, got, tt.same)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package design
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// mermaidDiagramTypes are the diagram declarations Mermaid accepts as
+// the first statement of a diagram.
+var mermaidDiagramTypes = []string{
+	"graph", "flowchart", "sequenceDiagram", "classDiagram", "classDiagram-v2",
+	"stateDiagram", "stateDiagram-v2", "erDiagram", "journey", "gantt", "pie",
+	"quadrantChart", "requirementDiagram", "gitGraph", "mindmap", "timeline",
+	"C4Context", "C4Container", "C4Component", "C4Dynamic", "C4Deployment",
+	"sankey-beta", "xychart-beta", "block-beta", "packet-beta", "architecture-beta",
+	"zenuml", "kanban",
+}
+
+// flowchartDirections are the directions graph/flowchart accept.
+var flowchartDirections = []string{"TB", "TD", "BT", "RL", "LR"}
+
+// erCardinality matches the relationship markers of an ER diagram
+// ("||--o{", "}|..|{"), whose braces are not brackets.
+var erCardinality = regexp.MustCompile(`[|}o]{1,2}(?:--|\.\.)[|{o]{1,2}`)
+
+// asymmetricNode matches the opening of a flowchart node drawn as
+// id>text], whose ">" pairs with the closing "]".
+var asymmetricNode = regexp.MustCompile(`(\w)>`)
+
+// mermaidBlock is one ```mermaid fenced block.
+type mermaidBlock struct {
+	line   int // 1-based line of the opening fence
+	lines  []string
+	closed bool
+}
+
+// ValidateMermaid checks the ```mermaid fenced blocks of content for the
+// mistakes that make GitHub show a diagram as an error instead of
+// rendering it: an unclosed fence, an empty block, an unknown diagram
+// type or flowchart direction, unbalanced brackets, and unterminated
+// quotes. It is a basic syntax check, not a Mermaid parser; each error
+// names the block's line in content. Content without diagrams is valid.
+func ValidateMermaid(content string) []error {
+	var errs []error
+	for _, b := range mermaidBlocks(content) {
+		if !b.closed {
+			errs = append(errs, fmt.Errorf("mermaid block at line %d: the ``` fence is never closed", b.line))
+		}
+		errs = append(errs, b.validate()...)
+	}
+	return errs
+}
+
+// mermaidBlocks returns the ```mermaid blocks of content in order.
+func mermaidBlocks(content string) []mermaidBlock {
+	var blocks []mermaidBlock
+	var current *mermaidBlock
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current == nil {
+			if strings.HasPrefix(trimmed, "```") && strings.TrimSpace(strings.TrimLeft(trimmed, "`")) == "mermaid" {
+				current = &mermaidBlock{line: i + 1}
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") {
+			current.closed = true
+			blocks = append(blocks, *current)
+			current = nil
+			continue
+		}
+		current.lines = append(current.lines, line)
+	}
+	if current != nil {
+		blocks = append(blocks, *current)
+	}
+	return blocks
+}
+
+// validate checks the diagram declaration and the block's syntax.
+func (b mermaidBlock) validate() []error {
+	at := func(offset int) int { return b.line + 1 + offset }
+
+	// The declaration is the first statement after any front matter,
+	// directives and comments.
+	decl := -1
+	inFrontMatter := false
+	for i, line := range b.lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "---":
+			inFrontMatter = !inFrontMatter
+		case inFrontMatter, trimmed == "", strings.HasPrefix(trimmed, "%%"):
+		default:
+			decl = i
+		}
+		if decl >= 0 {
+			break
+		}
+	}
+	if decl < 0 {
+		return []error{fmt.Errorf("mermaid block at line %d is empty", b.line)}
+	}
+
+	var errs []error
+	fields := strings.Fields(b.lines[decl])
+	kind := strings.TrimSuffix(fields[0], ":")
+	switch {
+	case !slices.Contains(mermaidDiagramTypes, kind):
+		errs = append(errs, fmt.Errorf("mermaid block at line %d: unknown diagram type %q on line %d",
+			b.line, kind, at(decl)))
+	case (kind == "graph" || kind == "flowchart") && len(fields) > 1 &&
+		!slices.Contains(flowchartDirections, strings.TrimSuffix(fields[1], ";")):
+		errs = append(errs, fmt.Errorf("mermaid block at line %d: unknown %s direction %q on line %d — use one of %s",
+			b.line, kind, fields[1], at(decl), strings.Join(flowchartDirections, ", ")))
+	}
+
+	var stack []rune
+	var opened []int
+	for i, line := range b.lines[decl:] {
+		if strings.HasPrefix(strings.TrimSpace(line), "%%") {
+			continue
+		}
+		switch kind {
+		case "erDiagram":
+			line = erCardinality.ReplaceAllString(line, "--")
+		case "graph", "flowchart":
+			line = asymmetricNode.ReplaceAllString(line, "$1[")
+		}
+		inQuote := false
+		for _, r := range line {
+			switch {
+			case r == '"':
+				inQuote = !inQuote
+			case inQuote:
+			case r == '(' || r == '[' || r == '{':
+				stack = append(stack, r)
+				opened = append(opened, decl+i)
+			case r == ')' || r == ']' || r == '}':
+				want := map[rune]rune{')': '(', ']': '[', '}': '{'}[r]
+				if len(stack) == 0 || stack[len(stack)-1] != want {
+					return append(errs, fmt.Errorf("mermaid block at line %d: unexpected %q on line %d",
+						b.line, r, at(decl+i)))
+				}
+				stack, opened = stack[:len(stack)-1], opened[:len(opened)-1]
+			}
+		}
+		if inQuote {
+			return append(errs, fmt.Errorf("mermaid block at line %d: unterminated quote on line %d",
+				b.line, at(decl+i)))
+		}
+	}
+	if len(stack) > 0 {
+		errs = append(errs, fmt.Errorf("mermaid block at line %d: %q opened on line %d is never closed",
+			b.line, stack[len(stack)-1], at(opened[len(opened)-1])))
+	}
+	return errs
+}
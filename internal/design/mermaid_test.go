@@ -0,0 +1,50 @@
+package design
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateMermaid_Valid(t *testing.T) {
+	doc := "Overview\n\n```mermaid\n%% the request path\ngraph LR\n" +
+		"    A[\"Client (web)\"] --> B{Gateway}\n    B -->|auth| C(Auth)\n```\n\n" +
+		"```mermaid\n---\ntitle: Login\n---\nsequenceDiagram\n    Alice->>Bob: Hello [there]\n```\n\n" +
+		"```mermaid\nerDiagram\n    CUSTOMER ||--o{ ORDER : places\n    ORDER }|..|{ LINE : contains\n```\n\n" +
+		"```mermaid\nflowchart TD\n    A>Flag] --> B\n```\n\n" +
+		"```go\nfunc f() {\n```\n"
+	if errs := ValidateMermaid(doc); len(errs) != 0 {
+		t.Errorf("ValidateMermaid = %v, want no errors", errs)
+	}
+	if errs := ValidateMermaid("No diagrams here."); len(errs) != 0 {
+		t.Errorf("content without diagrams = %v, want no errors", errs)
+	}
+}
+
+func TestValidateMermaid_Problems(t *testing.T) {
+	tests := []struct {
+		name, doc, want string
+	}{
+		{"unknown type", "```mermaid\ngrpah TD\n  A --> B\n```", `mermaid block at line 1: unknown diagram type "grpah" on line 2`},
+		{"bad direction", "```mermaid\nflowchart DOWN\n```", `unknown flowchart direction "DOWN"`},
+		{"unclosed bracket", "text\n```mermaid\ngraph TD\n  A[Start --> B\n```", `'[' opened on line 4 is never closed`},
+		{"mismatched bracket", "```mermaid\ngraph TD\n  A(Start] --> B\n```", `unexpected ']' on line 3`},
+		{"unterminated quote", "```mermaid\ngraph TD\n  A[\"Start] --> B\n```", "unterminated quote on line 3"},
+		{"empty", "```mermaid\n%% todo\n```", "mermaid block at line 1 is empty"},
+		{"unclosed fence", "```mermaid\ngraph TD\n  A --> B\n", "fence is never closed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateMermaid(tt.doc)
+			if len(errs) == 0 {
+				t.Fatalf("expected an error containing %q", tt.want)
+			}
+			var msgs []string
+			for _, err := range errs {
+				msgs = append(msgs, err.Error())
+			}
+			if joined := strings.Join(msgs, "\n"); !strings.Contains(joined, tt.want) {
+				t.Errorf("errors = %q, want one containing %q", joined, tt.want)
+			}
+		})
+	}
+}
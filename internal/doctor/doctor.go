@@ -0,0 +1,281 @@
+// Package doctor implements the `hoofy doctor` self-diagnostic.
+//
+// It inspects a project's hoofy.json and artifacts without modifying
+// anything and reports a checklist of PASS/WARN/FAIL results. Bug
+// reporters run it first; a FAIL means the project state is broken.
+// Fix repairs the inconsistencies that have a safe repair, once the
+// user asks for it.
+package doctor
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+)
+
+// Status is the outcome of a single check.
+type Status string
+
+// Check statuses, from best to worst.
+const (
+	Pass Status = "PASS"
+	Warn Status = "WARN"
+	Fail Status = "FAIL"
+)
+
+// Check is one line of the doctor checklist.
+type Check struct {
+	Status Status
+	Name   string
+	Detail string
+}
+
+// mojibakeMarkers are byte sequences typical of UTF-8 text that was
+// decoded as Latin-1/Windows-1252 and re-encoded ("Ã©" for "é").
+var mojibakeMarkers = []string{"Ã", "Â", "â€"}
+
+// Run diagnoses the project at projectRoot. It never writes to disk.
+func Run(projectRoot string, store config.Loader) []Check {
+	var checks []Check
+	add := func(status Status, name, format string, args ...any) {
+		checks = append(checks, Check{Status: status, Name: name, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	raw, err := os.ReadFile(config.ConfigPath(projectRoot))
+	if err != nil {
+		if _, found := config.InferStageFromArtifacts(projectRoot); len(found) > 0 {
+			add(Fail, "config", "hoofy.json is missing but %d artifact(s) exist — run sdd_init_project with reconstruct=true", len(found))
+		} else {
+			add(Fail, "config", "no hoofy.json found under %s — run sdd_init_project", projectRoot)
+		}
+		return checks
+	}
+	add(Pass, "config", "found %s", config.ConfigPath(projectRoot))
+
+	switch {
+	case !utf8.Valid(raw):
+		add(Fail, "encoding", "hoofy.json is not valid UTF-8")
+	case containsMojibake(raw):
+		add(Warn, "encoding", "hoofy.json contains mojibake (e.g. \"Ã©\" instead of \"é\") — it was likely re-encoded by an editor")
+	default:
+		add(Pass, "encoding", "hoofy.json is clean UTF-8")
+	}
+
+	cfg, err := store.Load(projectRoot)
+	if err != nil {
+		add(Fail, "parse", "%v", err)
+		return checks
+	}
+	add(Pass, "parse", "project %q at stage %s", cfg.Name, cfg.CurrentStage)
+
+	if err := cfg.Validate(); err != nil {
+		add(Fail, "validate", "%s", strings.ReplaceAll(err.Error(), "\n", "; "))
+		// Stage-based checks are meaningless with an unknown current stage.
+		if !cfg.HasStage(cfg.CurrentStage) {
+			return checks
+		}
+	} else {
+		add(Pass, "validate", "config is internally consistent")
+	}
+
+	checks = append(checks, artifactChecks(projectRoot, cfg)...)
+	checks = append(checks, clarityChecks(projectRoot, cfg)...)
+	checks = append(checks, sourceHashChecks(projectRoot, cfg)...)
+	checks = append(checks, timestampChecks(cfg)...)
+	return checks
+}
+
+// artifactChecks verifies each stage's artifact against CurrentStage:
+// completed stages must have one; stages ahead of the pipeline shouldn't.
+func artifactChecks(projectRoot string, cfg *config.ProjectConfig) []Check {
+	var checks []Check
+	current := pipeline.StageIndexFor(cfg, cfg.CurrentStage)
+
+	for i, stage := range config.StageOrderFor(cfg) {
+		path := config.StagePath(projectRoot, stage)
+		if path == "" {
+			continue
+		}
+		name := "artifact " + cfg.StageFilename(stage)
+		present := nonEmptyFile(path)
+		completed := pipeline.IsCompleted(cfg, stage)
+
+		switch {
+		case stage == cfg.CurrentStage && writtenAfterSave(path, cfg):
+			checks = append(checks, Check{Warn, name, fmt.Sprintf(
+				"was written after hoofy.json was last saved, but stage %s isn't completed — "+
+					"the run likely stopped before recording it; `hoofy doctor --fix` completes the stage", stage)})
+		case completed && !present:
+			checks = append(checks, Check{Fail, name, fmt.Sprintf("stage %s is completed but its artifact is missing or empty", stage)})
+		case !completed && i > current && present:
+			checks = append(checks, Check{Warn, name, fmt.Sprintf("exists although the pipeline hasn't reached %s yet", stage)})
+		case present:
+			checks = append(checks, Check{Pass, name, "present"})
+		}
+	}
+	return checks
+}
+
+// clarityChecks detects a stale clarity score: a passed gate whose stored
+// score is below the threshold, or a score that disagrees with the one
+// recorded in clarifications.md.
+func clarityChecks(projectRoot string, cfg *config.ProjectConfig) []Check {
+	threshold := pipeline.ProjectClarityThreshold(cfg)
+	if pipeline.IsCompleted(cfg, config.StageClarify) && cfg.ClarityScore < threshold {
+		return []Check{{Warn, "clarity", fmt.Sprintf(
+			"clarify is completed but clarity_score %d is below the threshold %d", cfg.ClarityScore, threshold)}}
+	}
+
+	data, err := os.ReadFile(config.StagePath(projectRoot, config.StageClarify))
+	if err != nil {
+		return nil
+	}
+	recorded, ok := pipeline.ParseClarityScore(string(data))
+	if ok && recorded != cfg.ClarityScore {
+		return []Check{{Warn, "clarity", fmt.Sprintf(
+			"hoofy.json clarity_score %d differs from clarifications.md (%d) — the score is stale", cfg.ClarityScore, recorded)}}
+	}
+	return []Check{{Pass, "clarity", fmt.Sprintf("score %d/100 (threshold %d)", cfg.ClarityScore, threshold)}}
+}
+
+// sourceHashChecks compares the source hashes a stage recorded (design
+// records the requirements it was built from) with the source artifacts
+// as they read now. A mismatch means the source was amended afterwards
+// and the stage may be stale.
+func sourceHashChecks(projectRoot string, cfg *config.ProjectConfig) []Check {
+	var checks []Check
+	compared := 0
+	for _, stage := range config.StageOrderFor(cfg) {
+		hashes := cfg.StageStatus[stage].SourceHashes
+		for _, source := range slices.Sorted(maps.Keys(hashes)) {
+			content, err := pipeline.ReadArtifact(projectRoot, cfg, source)
+			if err != nil {
+				checks = append(checks, Check{Warn, "source hashes", err.Error()})
+				continue
+			}
+			compared++
+			if pipeline.ContentHash(content) != hashes[source] {
+				checks = append(checks, Check{Warn, "source hashes", fmt.Sprintf(
+					"%s changed after %s was built from it — %s may be stale; review it against the amended %s",
+					cfg.StageFilename(source), cfg.StageFilename(stage), stage, source)})
+			}
+		}
+	}
+	if len(checks) == 0 && compared > 0 {
+		checks = append(checks, Check{Pass, "source hashes", fmt.Sprintf("%d recorded source artifact(s) unchanged", compared)})
+	}
+	return checks
+}
+
+// timestampChecks flags stage timestamps that aren't RFC 3339.
+func timestampChecks(cfg *config.ProjectConfig) []Check {
+	var bad []string
+	for _, stage := range config.StageOrderFor(cfg) {
+		st := cfg.StageStatus[stage]
+		for _, ts := range []string{st.StartedAt, st.CompletedAt} {
+			if ts == "" {
+				continue
+			}
+			if _, err := time.Parse(time.RFC3339, ts); err != nil {
+				bad = append(bad, string(stage))
+				break
+			}
+		}
+	}
+	if len(bad) > 0 {
+		return []Check{{Warn, "timestamps", "unparseable timestamps for: " + strings.Join(bad, ", ")}}
+	}
+	return []Check{{Pass, "timestamps", "all stage timestamps are RFC 3339"}}
+}
+
+// writtenAfterSave reports whether the artifact at path was modified in
+// a later second than cfg's last save while its stage is incomplete:
+// the stage tool wrote it and stopped before saving the config. A tool
+// that saves the config after writing its artifact — a clarify round
+// that doesn't pass the gate — leaves the artifact older than UpdatedAt.
+func writtenAfterSave(path string, cfg *config.ProjectConfig) bool {
+	if pipeline.IsCompleted(cfg, cfg.CurrentStage) || !nonEmptyFile(path) {
+		return false
+	}
+	saved, err := time.Parse(time.RFC3339, cfg.UpdatedAt)
+	if err != nil {
+		return false
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	// UpdatedAt has second precision; an artifact from the same second
+	// may predate the save.
+	return !info.ModTime().Before(saved.Add(time.Second))
+}
+
+// Fix repairs a stage whose artifact was written after the last config
+// save (see writtenAfterSave) by completing it, as the interrupted stage
+// tool would have. The Clarity Gate still applies: an interrupted
+// clarify whose score never reached hoofy.json is left for sdd_clarify.
+// Fix returns a description of each repair; none means nothing needed
+// fixing.
+func Fix(projectRoot string, store config.Store) ([]string, error) {
+	cfg, err := store.Load(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+	stage := cfg.CurrentStage
+	path := config.StagePath(projectRoot, stage)
+	if path == "" || !writtenAfterSave(path, cfg) {
+		return nil, nil
+	}
+	if err := pipeline.CompleteThrough(cfg, stage); err != nil {
+		return nil, fmt.Errorf("completing %s: %w", stage, err)
+	}
+	if err := store.Save(projectRoot, cfg); err != nil {
+		return nil, fmt.Errorf("saving config: %w", err)
+	}
+	return []string{fmt.Sprintf("completed stage %s from its artifact %s; the pipeline is now at %s",
+		stage, cfg.StageFilename(stage), cfg.CurrentStage)}, nil
+}
+
+// Failed reports whether any check failed.
+func Failed(checks []Check) bool {
+	for _, c := range checks {
+		if c.Status == Fail {
+			return true
+		}
+	}
+	return false
+}
+
+// Print writes the checklist, one "STATUS  name: detail" line per check,
+// followed by a summary line.
+func Print(w io.Writer, checks []Check) {
+	counts := map[Status]int{}
+	for _, c := range checks {
+		counts[c.Status]++
+		fmt.Fprintf(w, "%-4s  %s: %s\n", c.Status, c.Name, c.Detail)
+	}
+	fmt.Fprintf(w, "\n%d passed, %d warnings, %d failed\n", counts[Pass], counts[Warn], counts[Fail])
+}
+
+func containsMojibake(data []byte) bool {
+	for _, marker := range mojibakeMarkers {
+		if bytes.Contains(data, []byte(marker)) {
+			return true
+		}
+	}
+	return false
+}
+
+func nonEmptyFile(path string) bool {
+	data, err := os.ReadFile(path)
+	return err == nil && strings.TrimSpace(string(data)) != ""
+}
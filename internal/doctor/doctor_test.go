@@ -0,0 +1,262 @@
+package doctor
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+)
+
+// newProject saves a project advanced to stage with the given artifacts.
+func newProject(t *testing.T, stage config.Stage, artifacts map[config.Stage]string) string {
+	t.Helper()
+	root := t.TempDir()
+	cfg := config.NewProjectConfig("doc-test", "test", config.ModeGuided)
+	cfg.ClarityScore = 80
+	for cfg.CurrentStage != stage {
+		if err := pipeline.Advance(cfg); err != nil {
+			t.Fatalf("advance: %v", err)
+		}
+	}
+	if err := config.NewFileStore().Save(root, cfg); err != nil {
+		t.Fatal(err)
+	}
+	for s, content := range artifacts {
+		if err := os.WriteFile(config.StagePath(root, s), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func findCheck(checks []Check, name string) (Check, bool) {
+	for _, c := range checks {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Check{}, false
+}
+
+func TestRun_HealthyProject(t *testing.T) {
+	root := newProject(t, config.StageSpecify, map[config.Stage]string{
+		config.StagePrinciples: "# P",
+		config.StageCharter:    "# C",
+	})
+
+	checks := Run(root, config.NewFileStore())
+	if Failed(checks) {
+		var buf bytes.Buffer
+		Print(&buf, checks)
+		t.Fatalf("healthy project should not fail:\n%s", buf.String())
+	}
+	for _, c := range checks {
+		if c.Status == Warn {
+			t.Errorf("unexpected warning: %+v", c)
+		}
+	}
+}
+
+func TestRun_MissingArtifactForCompletedStage(t *testing.T) {
+	root := newProject(t, config.StageSpecify, map[config.Stage]string{
+		config.StagePrinciples: "# P",
+	})
+
+	checks := Run(root, config.NewFileStore())
+	c, ok := findCheck(checks, "artifact charter.md")
+	if !ok || c.Status != Fail {
+		t.Errorf("charter.md check = %+v, want FAIL", c)
+	}
+	if !Failed(checks) {
+		t.Error("Failed should report true")
+	}
+}
+
+func TestRun_DetectsStaleClarityScoreAndAheadArtifact(t *testing.T) {
+	root := newProject(t, config.StageDesign, map[config.Stage]string{
+		config.StagePrinciples:    "# P",
+		config.StageCharter:       "# C",
+		config.StageSpecify:       "# R",
+		config.StageBusinessRules: "# BR",
+		config.StageClarify:       "# Clarifications\n\n## Clarity Score: 72/100\n",
+		config.StageTasks:         "# Tasks written too early",
+	})
+
+	checks := Run(root, config.NewFileStore())
+	if c, _ := findCheck(checks, "clarity"); c.Status != Warn || !strings.Contains(c.Detail, "stale") {
+		t.Errorf("clarity check = %+v, want stale WARN", c)
+	}
+	if c, _ := findCheck(checks, "artifact tasks.md"); c.Status != Warn {
+		t.Errorf("tasks.md check = %+v, want WARN", c)
+	}
+}
+
+func TestRun_DetectsRequirementsChangedSinceDesign(t *testing.T) {
+	requirements := "# Requirements\r\n\n- FR-001: Sign up\n"
+	root := newProject(t, config.StageTasks, map[config.Stage]string{
+		config.StagePrinciples:    "# P",
+		config.StageCharter:       "# C",
+		config.StageSpecify:       requirements,
+		config.StageBusinessRules: "# BR",
+		config.StageClarify:       "# Clarifications\n\n## Clarity Score: 80/100\n",
+		config.StageDesign:        "# D",
+	})
+	store := config.NewFileStore()
+	cfg, _ := store.Load(root)
+	st := cfg.StageStatus[config.StageDesign]
+	st.SourceHashes = map[config.Stage]string{config.StageSpecify: pipeline.ContentHash(pipeline.NormalizeNewlines(requirements))}
+	cfg.StageStatus[config.StageDesign] = st
+	if err := store.Save(root, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	if c, _ := findCheck(Run(root, store), "source hashes"); c.Status != Pass {
+		t.Errorf("source hashes check = %+v, want PASS while requirements.md is unchanged", c)
+	}
+
+	amended := requirements + "- FR-002: Log in\n"
+	if err := os.WriteFile(config.StagePath(root, config.StageSpecify), []byte(amended), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, _ := findCheck(Run(root, store), "source hashes")
+	if c.Status != Warn || !strings.Contains(c.Detail, "requirements.md changed after design.md") {
+		t.Errorf("source hashes check = %+v, want a WARN naming requirements.md and design.md", c)
+	}
+}
+
+func TestRun_OrphanedArtifactsAndMojibake(t *testing.T) {
+	root := t.TempDir()
+	docs := filepath.Join(root, config.DocsDir)
+	if err := os.MkdirAll(docs, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(docs, "charter.md"), []byte("# C"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	checks := Run(root, config.NewFileStore())
+	if c, _ := findCheck(checks, "config"); c.Status != Fail || !strings.Contains(c.Detail, "reconstruct=true") {
+		t.Errorf("config check = %+v, want orphaned FAIL", c)
+	}
+
+	root = newProject(t, config.StagePrinciples, nil)
+	raw, _ := os.ReadFile(config.ConfigPath(root))
+	raw = bytes.Replace(raw, []byte(`"test"`), []byte(`"cafÃ©"`), 1)
+	if err := os.WriteFile(config.ConfigPath(root), raw, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if c, _ := findCheck(Run(root, config.NewFileStore()), "encoding"); c.Status != Warn {
+		t.Errorf("encoding check = %+v, want WARN", c)
+	}
+}
+
+func TestRun_InvalidConfig(t *testing.T) {
+	root := newProject(t, config.StagePrinciples, nil)
+	raw, _ := os.ReadFile(config.ConfigPath(root))
+	raw = bytes.Replace(raw, []byte(`"current_stage": "principles"`), []byte(`"current_stage": "bogus"`), 1)
+	if err := os.WriteFile(config.ConfigPath(root), raw, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	checks := Run(root, config.NewFileStore())
+	if c, _ := findCheck(checks, "validate"); c.Status != Fail || !strings.Contains(c.Detail, "bogus") {
+		t.Errorf("validate check = %+v, want FAIL naming the stage", c)
+	}
+}
+
+func TestPrint(t *testing.T) {
+	var buf bytes.Buffer
+	Print(&buf, []Check{
+		{Status: Pass, Name: "config", Detail: "ok"},
+		{Status: Fail, Name: "parse", Detail: "bad"},
+	})
+	out := buf.String()
+	if !strings.Contains(out, "PASS  config: ok") || !strings.Contains(out, "1 passed, 0 warnings, 1 failed") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+// touchCurrentArtifact sets the current stage's artifact's mtime to
+// offset after the config's last save.
+func touchCurrentArtifact(t *testing.T, root string, offset time.Duration) {
+	t.Helper()
+	cfg, err := config.NewFileStore().Load(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved, err := time.Parse(time.RFC3339, cfg.UpdatedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := saved.Add(offset)
+	if err := os.Chtimes(config.StagePath(root, cfg.CurrentStage), at, at); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// interruptStage makes the current stage's artifact look written after
+// the last config save, as when a stage tool dies before saving.
+func interruptStage(t *testing.T, root string) {
+	t.Helper()
+	touchCurrentArtifact(t, root, 5*time.Second)
+}
+
+func TestFix_CompletesInterruptedStage(t *testing.T) {
+	root := newProject(t, config.StageSpecify, map[config.Stage]string{
+		config.StagePrinciples: "# P",
+		config.StageCharter:    "# C",
+		config.StageSpecify:    "# Requirements\n\n- **FR-001**: Users can sign up\n",
+	})
+	store := config.NewFileStore()
+
+	// Written in the same second as the save: not flagged.
+	touchCurrentArtifact(t, root, 500*time.Millisecond)
+	if c, _ := findCheck(Run(root, store), "artifact requirements.md"); c.Status != Pass {
+		t.Errorf("an artifact from the save's second should pass, got %+v", c)
+	}
+
+	interruptStage(t, root)
+	c, _ := findCheck(Run(root, store), "artifact requirements.md")
+	if c.Status != Warn || !strings.Contains(c.Detail, "hoofy doctor --fix") {
+		t.Fatalf("interrupted stage should be flagged, got %+v", c)
+	}
+
+	repairs, err := Fix(root, store)
+	if err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if len(repairs) != 1 || !strings.Contains(repairs[0], "completed stage specify") {
+		t.Errorf("repairs = %v", repairs)
+	}
+	cfg, _ := store.Load(root)
+	if !pipeline.IsCompleted(cfg, config.StageSpecify) || cfg.CurrentStage != config.StageBusinessRules {
+		t.Errorf("specify should be completed and business rules current, got current %s", cfg.CurrentStage)
+	}
+
+	// Nothing left to fix.
+	if repairs, err := Fix(root, store); err != nil || len(repairs) != 0 {
+		t.Errorf("second Fix = %v, %v; want no repairs", repairs, err)
+	}
+}
+
+func TestFix_RespectsClarityGate(t *testing.T) {
+	root := newProject(t, config.StageClarify, map[config.Stage]string{
+		config.StageClarify: "# Clarifications\n",
+	})
+	store := config.NewFileStore()
+	cfg, _ := store.Load(root)
+	cfg.ClarityScore = 10
+	if err := store.Save(root, cfg); err != nil {
+		t.Fatal(err)
+	}
+	interruptStage(t, root)
+
+	if _, err := Fix(root, store); err == nil || !strings.Contains(err.Error(), "completing clarify") {
+		t.Errorf("Fix should refuse to pass the Clarity Gate, got %v", err)
+	}
+}
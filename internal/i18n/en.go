@@ -0,0 +1,92 @@
+package i18n
+
+// english is the reference catalog: every key is defined here, and the
+// other catalogs translate a subset of it.
+var english = map[string]string{
+	// Next-step guidance, by current stage.
+	"next.init":       "Use `sdd_init_project` to start a new SDD project.",
+	"next.principles": "Use `sdd_create_principles` to define your project's golden invariants, coding standards, and domain truths.",
+	"next.charter":    "Use `sdd_create_charter` with your project idea to create a structured charter.",
+	"next.specify":    "Use `sdd_generate_requirements` to extract formal requirements from the charter.",
+	"next.clarify":    "Use `sdd_clarify` to run the Clarity Gate. Current score: %d/%d needed.",
+	"next.research": "Use `sdd_record_research` to capture the options evaluated, spike findings, " +
+		"and a recommendation before committing to a design.",
+	"next.design": "Use `sdd_create_design` to create the technical architecture document. " +
+		"Read all previous artifacts first (use `sdd_get_context`), then design the system " +
+		"addressing ALL requirements. Include tech stack, components, data model, and key design decisions.",
+	"next.tasks": "Use `sdd_create_tasks` to break the design into atomic implementation tasks. " +
+		"Read the design document first (use `sdd_get_context stage=design`). " +
+		"Each task should have a unique ID, clear scope, requirements covered, and acceptance criteria.",
+	"next.validate": "Use `sdd_validate` to run a cross-artifact consistency check. " +
+		"Read ALL artifacts and verify: requirement coverage, component coverage, " +
+		"consistency between documents, and identify any gaps or risks.",
+
+	// sdd_create_charter. Args: note, docs dir, charter content.
+	"charter.created": "# Charter Created\n\n" +
+		"%s" +
+		"Saved to `%s/charter.md`\n\n" +
+		"## Content\n\n%s\n\n" +
+		"---\n\n" +
+		"## Next Step\n\n" +
+		"Pipeline advanced to **Stage 3: Specify**.\n\n" +
+		"Now analyze this charter and extract formal requirements using MoSCoW prioritization " +
+		"(Must Have, Should Have, Could Have, Won't Have). Each requirement needs a unique ID " +
+		"(FR-001 for functional, NFR-001 for non-functional).\n\n" +
+		"Call `sdd_generate_requirements` with the extracted requirements.",
+
+	// sdd_clarify. Passed args: score, threshold, marginal note, next
+	// stage, guidance. Marginal args: score, threshold.
+	// Needed args: score, threshold, weak dimensions.
+	"clarify.passed": "# Clarity Gate PASSED\n\n" +
+		"**Score:** %d/100 (threshold: %d)\n\n" +
+		"%s" +
+		"Your requirements are now clear enough to proceed.\n\n" +
+		"## Next Step\n\n" +
+		"Pipeline advanced to **%s**.\n\n" +
+		"%s",
+	"clarify.marginal": "⚠️ **Marginal pass:** Score %d/%d is the bare minimum — consider one more round " +
+		"on the weakest dimensions. Advancing anyway.\n\n",
+	"clarify.needed": "# Clarity Gate: More Clarification Needed\n\n" +
+		"**Score:** %d/100 (need %d to pass)\n\n" +
+		"## Weak Areas\n\n" +
+		"These dimensions still need attention: %s\n\n" +
+		"## What to Do\n\n" +
+		"Call `sdd_clarify` again (without answers) to get the next round of questions " +
+		"targeting these weak areas.",
+
+	// sdd_validate. Report args: verdict, trend, warnings, report, next step.
+	"validate.report": "# Validation Report\n\n" +
+		"**Verdict:** %s\n\n" +
+		"%s" +
+		"%s" +
+		"Saved to `docs/validation.md`\n\n" +
+		"## Summary\n\n%s\n\n" +
+		"---\n\n" +
+		"%s",
+	"validate.pass": "## 🎉 SDD Pipeline Complete!\n\n" +
+		"All specifications are consistent and ready for implementation.\n\n" +
+		"**Your SDD artifacts:**\n" +
+		"- `docs/principles.md` — Golden invariants and coding standards\n" +
+		"- `docs/charter.md` — What we're building and why\n" +
+		"- `docs/requirements.md` — Formal requirements (MoSCoW)\n" +
+		"- `docs/clarifications.md` — Resolved ambiguities\n" +
+		"- `docs/design.md` — Technical architecture\n" +
+		"- `docs/tasks.md` — Implementation task breakdown\n" +
+		"- `docs/validation.md` — This consistency report\n\n" +
+		"**Next:** Use these specs with your AI coding tool's `/plan mode` to start implementation. " +
+		"The specs will dramatically reduce hallucinations because every requirement is clear, " +
+		"traced to a task, and architecturally grounded.",
+	// Args: recommendations.
+	"validate.pass_with_warnings": "## ⚠️ SDD Pipeline Complete (with warnings)\n\n" +
+		"Specifications are usable but have minor gaps. " +
+		"Track the warnings during implementation.\n\n" +
+		"**Recommendations:**\n\n%s\n\n" +
+		"**Next:** You can proceed to implementation, but keep an eye on the flagged issues.",
+	// Args: recommendations.
+	"validate.fail": "## ❌ Validation Failed\n\n" +
+		"Critical gaps or inconsistencies were found. " +
+		"Implementation would likely produce incorrect results.\n\n" +
+		"**Required actions:**\n\n%s\n\n" +
+		"**Next:** Revisit the stages mentioned above to fix the issues, " +
+		"then re-run validation.",
+}
@@ -0,0 +1,84 @@
+package i18n
+
+// spanish translates the English catalog. Tool names, parameters and
+// file paths stay as they are — the assistant still calls them verbatim.
+var spanish = map[string]string{
+	"next.init":       "Usa `sdd_init_project` para empezar un nuevo proyecto SDD.",
+	"next.principles": "Usa `sdd_create_principles` para definir las reglas de oro del proyecto, sus estándares de código y las verdades del dominio.",
+	"next.charter":    "Usa `sdd_create_charter` con la idea del proyecto para crear un acta estructurada.",
+	"next.specify":    "Usa `sdd_generate_requirements` para extraer los requisitos formales del acta.",
+	"next.clarify":    "Usa `sdd_clarify` para pasar el Clarity Gate. Puntuación actual: %d/%d necesarios.",
+	"next.research": "Usa `sdd_record_research` para registrar las opciones evaluadas, los resultados de las pruebas " +
+		"y una recomendación antes de comprometerte con un diseño.",
+	"next.design": "Usa `sdd_create_design` para crear el documento de arquitectura técnica. " +
+		"Lee primero todos los artefactos anteriores (con `sdd_get_context`) y diseña el sistema " +
+		"cubriendo TODOS los requisitos. Incluye el stack tecnológico, los componentes, el modelo de datos y las decisiones clave.",
+	"next.tasks": "Usa `sdd_create_tasks` para dividir el diseño en tareas de implementación atómicas. " +
+		"Lee primero el documento de diseño (con `sdd_get_context stage=design`). " +
+		"Cada tarea necesita un ID único, un alcance claro, los requisitos que cubre y sus criterios de aceptación.",
+	"next.validate": "Usa `sdd_validate` para comprobar la coherencia entre artefactos. " +
+		"Lee TODOS los artefactos y verifica: cobertura de requisitos, cobertura de componentes, " +
+		"coherencia entre documentos, e identifica huecos o riesgos.",
+
+	"charter.created": "# Acta creada\n\n" +
+		"%s" +
+		"Guardada en `%s/charter.md`\n\n" +
+		"## Contenido\n\n%s\n\n" +
+		"---\n\n" +
+		"## Siguiente paso\n\n" +
+		"El pipeline avanzó a la **Etapa 3: Specify**.\n\n" +
+		"Ahora analiza esta acta y extrae los requisitos formales con priorización MoSCoW " +
+		"(Must Have, Should Have, Could Have, Won't Have). Cada requisito necesita un ID único " +
+		"(FR-001 para funcionales, NFR-001 para no funcionales).\n\n" +
+		"Llama a `sdd_generate_requirements` con los requisitos extraídos.",
+
+	"clarify.passed": "# Clarity Gate SUPERADO\n\n" +
+		"**Puntuación:** %d/100 (umbral: %d)\n\n" +
+		"%s" +
+		"Tus requisitos ya son lo bastante claros para continuar.\n\n" +
+		"## Siguiente paso\n\n" +
+		"El pipeline avanzó a **%s**.\n\n" +
+		"%s",
+	"clarify.marginal": "⚠️ **Aprobado por poco:** una puntuación de %d/%d es el mínimo — considera una ronda más " +
+		"sobre las dimensiones más débiles. Se avanza de todos modos.\n\n",
+	"clarify.needed": "# Clarity Gate: hace falta más claridad\n\n" +
+		"**Puntuación:** %d/100 (se necesitan %d para pasar)\n\n" +
+		"## Áreas débiles\n\n" +
+		"Estas dimensiones todavía necesitan atención: %s\n\n" +
+		"## Qué hacer\n\n" +
+		"Vuelve a llamar a `sdd_clarify` (sin respuestas) para obtener la siguiente ronda de preguntas " +
+		"centrada en estas áreas débiles.",
+
+	"validate.report": "# Informe de validación\n\n" +
+		"**Veredicto:** %s\n\n" +
+		"%s" +
+		"%s" +
+		"Guardado en `docs/validation.md`\n\n" +
+		"## Resumen\n\n%s\n\n" +
+		"---\n\n" +
+		"%s",
+	"validate.pass": "## 🎉 ¡Pipeline SDD completo!\n\n" +
+		"Todas las especificaciones son coherentes y están listas para implementarse.\n\n" +
+		"**Tus artefactos SDD:**\n" +
+		"- `docs/principles.md` — Reglas de oro y estándares de código\n" +
+		"- `docs/charter.md` — Qué construimos y por qué\n" +
+		"- `docs/requirements.md` — Requisitos formales (MoSCoW)\n" +
+		"- `docs/clarifications.md` — Ambigüedades resueltas\n" +
+		"- `docs/design.md` — Arquitectura técnica\n" +
+		"- `docs/tasks.md` — Desglose de tareas de implementación\n" +
+		"- `docs/validation.md` — Este informe de coherencia\n\n" +
+		"**Siguiente:** Usa estas especificaciones con el `/plan mode` de tu herramienta de IA para empezar a implementar. " +
+		"Reducen mucho las alucinaciones porque cada requisito es claro, " +
+		"está ligado a una tarea y tiene base en la arquitectura.",
+	"validate.pass_with_warnings": "## ⚠️ Pipeline SDD completo (con avisos)\n\n" +
+		"Las especificaciones se pueden usar, pero tienen huecos menores. " +
+		"Sigue los avisos durante la implementación.\n\n" +
+		"**Recomendaciones:**\n\n%s\n\n" +
+		"**Siguiente:** Puedes pasar a la implementación, pero vigila los problemas señalados.",
+	"validate.fail": "## ❌ La validación falló\n\n" +
+		"Se encontraron huecos o incoherencias críticas. " +
+		"La implementación probablemente daría resultados incorrectos.\n\n" +
+		"**Acciones necesarias:**\n\n%s\n\n" +
+		"**Siguiente:** Vuelve a las etapas mencionadas arriba para corregir los problemas " +
+		"y después repite la validación.",
+}
@@ -0,0 +1,69 @@
+// Package i18n holds the translated tool responses and guidance strings.
+//
+// Artifacts are localized by template overlays (SDD_TEMPLATES_DIR); this
+// catalog covers what the tools say back — the text a guided-mode user
+// reads between stages. Messages are fmt format strings looked up by
+// key; a key missing from a language falls back to English.
+package i18n
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Lang is a response language, as an ISO 639-1 code.
+type Lang string
+
+// Supported languages.
+const (
+	English Lang = "en"
+	Spanish Lang = "es"
+)
+
+// Langs lists the supported languages, English (the fallback) first.
+var Langs = []Lang{English, Spanish}
+
+// catalogs maps each language to its messages by key.
+var catalogs = map[Lang]map[string]string{
+	English: english,
+	Spanish: spanish,
+}
+
+// ParseLang parses a language code, case-insensitively. Regional
+// variants resolve to their language ("es-MX" is Spanish).
+func ParseLang(s string) (Lang, error) {
+	code := strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexAny(code, "-_"); i > 0 {
+		code = code[:i]
+	}
+	if !slices.Contains(Langs, Lang(code)) {
+		return "", fmt.Errorf("language must be one of %s, got %q", joinLangs(), s)
+	}
+	return Lang(code), nil
+}
+
+// T formats the message key in lang with args. Unknown or empty
+// languages, and keys lang has no translation for, use English.
+func T(lang Lang, key string, args ...any) string {
+	format, ok := catalogs[lang][key]
+	if !ok {
+		format, ok = english[key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// joinLangs renders Langs for error messages.
+func joinLangs() string {
+	names := make([]string, len(Langs))
+	for i, l := range Langs {
+		names[i] = string(l)
+	}
+	return strings.Join(names, ", ")
+}
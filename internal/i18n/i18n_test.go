@@ -0,0 +1,71 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// verbPattern matches fmt verbs, skipping escaped percent signs.
+var verbPattern = regexp.MustCompile(`%[-+# 0]*\d*(?:\.\d+)?[a-zA-Z%]`)
+
+func verbs(format string) []string {
+	var out []string
+	for _, v := range verbPattern.FindAllString(format, -1) {
+		if v != "%%" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func TestCatalogs_MatchEnglish(t *testing.T) {
+	for lang, catalog := range catalogs {
+		for key, format := range catalog {
+			want, ok := english[key]
+			if !ok {
+				t.Errorf("%s: key %q is not in the English catalog", lang, key)
+				continue
+			}
+			if got, want := verbs(format), verbs(want); !slices.Equal(got, want) {
+				t.Errorf("%s: %q has verbs %v, English has %v", lang, key, got, want)
+			}
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	if got := T(Spanish, "next.clarify", 40, 70); !strings.Contains(got, "40/70") || !strings.Contains(got, "Puntuación") {
+		t.Errorf("Spanish next.clarify = %q", got)
+	}
+	if got := T("", "next.clarify", 40, 70); !strings.HasPrefix(got, "Use `sdd_clarify`") {
+		t.Errorf("default language should be English, got %q", got)
+	}
+	if got := T("fr", "next.init"); got != english["next.init"] {
+		t.Errorf("unknown language should fall back to English, got %q", got)
+	}
+
+	// A key Spanish lacks falls back to the English message.
+	english["test.only"] = "English only %d"
+	defer delete(english, "test.only")
+	if got := T(Spanish, "test.only", 3); got != "English only 3" {
+		t.Errorf("missing Spanish key = %q, want the English fallback", got)
+	}
+	if got := T(English, "no.such.key"); got != "no.such.key" {
+		t.Errorf("unknown key = %q, want the key itself", got)
+	}
+}
+
+func TestParseLang(t *testing.T) {
+	for in, want := range map[string]Lang{"es": Spanish, " EN ": English, "es-MX": Spanish, "es_AR": Spanish} {
+		if got, err := ParseLang(in); err != nil || got != want {
+			t.Errorf("ParseLang(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "fr", "spanish"} {
+		if _, err := ParseLang(in); err == nil {
+			t.Errorf("ParseLang(%q) should fail", in)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+)
+
+// Built-in checks. Every check skips artifacts that don't exist yet: a
+// project at the design stage has no tasks.md to lint.
+func init() {
+	Register("task_references_requirement", checkTaskReferencesRequirement)
+	Register("requirement_covered_by_task", checkRequirementCoveredByTask)
+	Register("requirement_has_acceptance", checkRequirementHasAcceptance)
+	Register("required_section", checkRequiredSection)
+	Register("forbidden_pattern", checkForbiddenPattern)
+}
+
+// taskHeading matches a task heading such as "### TASK-003: Build API".
+var taskHeading = regexp.MustCompile(`^(#{2,4})\s+(TASK-\d{3,4})\b`)
+
+// requirementID matches FR-NNN / NFR-NNN identifiers.
+var requirementID = regexp.MustCompile(`\b(?:FR|NFR)-\d{3,4}\b`)
+
+// checkTaskReferencesRequirement flags tasks whose section mentions no
+// FR/NFR ID.
+func checkTaskReferencesRequirement(p *Project, _ Rule) ([]Violation, error) {
+	rel, tasks, ok, err := p.Artifact("tasks")
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	var out []Violation
+	lines := strings.Split(tasks, "\n")
+	for i := 0; i < len(lines); i++ {
+		m := taskHeading.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		level := len(m[1])
+		referenced := requirementID.MatchString(lines[i])
+		for j := i + 1; j < len(lines) && !endsSection(lines[j], level); j++ {
+			referenced = referenced || requirementID.MatchString(lines[j])
+		}
+		if !referenced {
+			out = append(out, Violation{File: rel, Line: i + 1,
+				Message: m[2] + " references no requirement (FR-XXX/NFR-XXX)"})
+		}
+	}
+	return out, nil
+}
+
+// endsSection reports whether line is a heading at or above level.
+func endsSection(line string, level int) bool {
+	n := len(line) - len(strings.TrimLeft(line, "#"))
+	return n > 0 && n <= level && strings.HasPrefix(line[n:], " ")
+}
+
+// checkRequirementCoveredByTask flags requirements in the configured
+// buckets (param "buckets", default "Must") that tasks.md never
+// mentions.
+func checkRequirementCoveredByTask(p *Project, r Rule) ([]Violation, error) {
+	return requirementsMentionedIn(p, r, "tasks", "Must", "is not covered by any task")
+}
+
+// checkRequirementHasAcceptance flags requirements in the configured
+// buckets (param "buckets", default "Must,Should") that the acceptance
+// scenarios never mention.
+func checkRequirementHasAcceptance(p *Project, r Rule) ([]Violation, error) {
+	return requirementsMentionedIn(p, r, "acceptance", "Must,Should", "has no acceptance scenario")
+}
+
+// requirementsMentionedIn flags each requirement in the rule's buckets
+// whose ID doesn't appear in the target artifact.
+func requirementsMentionedIn(p *Project, r Rule, target, defaultBuckets, problem string) ([]Violation, error) {
+	buckets, err := parseBuckets(param(r, "buckets", defaultBuckets))
+	if err != nil {
+		return nil, err
+	}
+	reqFiles, err := p.Files("specify")
+	if err != nil || len(reqFiles) == 0 {
+		return nil, err
+	}
+	targetFiles, err := p.Files(target)
+	if err != nil || len(targetFiles) == 0 {
+		return nil, err
+	}
+
+	mentioned := make(map[string]bool)
+	for _, f := range targetFiles {
+		for _, id := range requirementID.FindAllString(f.Content, -1) {
+			mentioned[id] = true
+		}
+	}
+
+	var out []Violation
+	for _, f := range reqFiles {
+		for _, req := range requirements.ParseMarkdown(f.Content) {
+			if slices.Contains(buckets, req.Bucket) && !mentioned[req.ID] {
+				out = append(out, Violation{File: f.Rel, Line: req.Line,
+					Message: fmt.Sprintf("%s (%s) %s", req.ID, req.Bucket, problem)})
+			}
+		}
+	}
+	return out, nil
+}
+
+// parseBuckets parses a comma-separated bucket list such as "Must,Should".
+func parseBuckets(s string) ([]string, error) {
+	var buckets []string
+	for _, b := range strings.Split(s, ",") {
+		b = strings.TrimSpace(b)
+		if b == "" {
+			continue
+		}
+		if !slices.Contains(requirements.Buckets, b) {
+			return nil, fmt.Errorf("unknown bucket %q — valid buckets: %s", b, strings.Join(requirements.Buckets, ", "))
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+// checkRequiredSection flags an artifact (param "artifact") that lacks a
+// heading (param "heading", matched case-insensitively at any level).
+func checkRequiredSection(p *Project, r Rule) ([]Violation, error) {
+	name, heading := param(r, "artifact", ""), param(r, "heading", "")
+	if name == "" || heading == "" {
+		return nil, fmt.Errorf("required_section needs 'artifact' and 'heading' params")
+	}
+	rel, _, ok, err := p.Artifact(name)
+	if err != nil || !ok {
+		return nil, err
+	}
+	files, err := p.Files(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		for _, line := range strings.Split(f.Content, "\n") {
+			if strings.HasPrefix(line, "#") && strings.EqualFold(strings.TrimSpace(strings.TrimLeft(line, "#")), heading) {
+				return nil, nil
+			}
+		}
+	}
+	return []Violation{{File: rel, Message: fmt.Sprintf("missing section %q", heading)}}, nil
+}
+
+// checkForbiddenPattern flags every line of the artifacts (param
+// "artifacts", comma-separated) matching the regular expression in
+// param "pattern". Param "message" describes the problem.
+func checkForbiddenPattern(p *Project, r Rule) ([]Violation, error) {
+	pattern := param(r, "pattern", "")
+	if pattern == "" {
+		return nil, fmt.Errorf("forbidden_pattern needs a 'pattern' param")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	message := param(r, "message", "matches forbidden pattern "+pattern)
+
+	var out []Violation
+	for _, name := range strings.Split(param(r, "artifacts", ""), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		files, err := p.Files(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			for i, line := range strings.Split(f.Content, "\n") {
+				if re.MatchString(line) {
+					out = append(out, Violation{File: f.Rel, Line: i + 1, Message: message})
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// param returns a rule parameter, or def when unset.
+func param(r Rule, key, def string) string {
+	if v, ok := r.Params[key]; ok && v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,90 @@
+package lint
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Finding is one jargon term found in a text, with plainer wording to
+// suggest instead.
+type Finding struct {
+	Term       string // as written in the text
+	Suggestion string
+	Line       int // 1-based line of the first occurrence
+}
+
+// DefaultJargon maps technical terms (lower-case) that a non-technical
+// reader may not know to plainer wording. Guided mode coaches against
+// them in the charter; hoofy.json's jargon setting adds to or trims it.
+var DefaultJargon = map[string]string{
+	"api":            "a way for other programs to talk to it",
+	"backend":        "the part that runs on the server",
+	"frontend":       "the screens people use",
+	"microservice":   "a separate small program",
+	"microservices":  "separate small programs",
+	"database":       "where the information is stored",
+	"schema":         "how the information is organized",
+	"crud":           "create, view, change and delete",
+	"latency":        "delay",
+	"scalable":       "able to handle more people",
+	"scalability":    "handling more people",
+	"deploy":         "make it available",
+	"deployment":     "making it available",
+	"kubernetes":     "the hosting setup",
+	"oauth":          "signing in with another account",
+	"sso":            "one sign-in for several tools",
+	"webhook":        "an automatic notification to another system",
+	"endpoint":       "a web address the app answers on",
+	"middleware":     "a layer between parts of the system",
+	"authentication": "signing in",
+	"authorization":  "who is allowed to do what",
+	"mvp":            "first usable version",
+	"saas":           "an online service people subscribe to",
+	"ui":             "the screens",
+	"ux":             "how easy it is to use",
+	"leverage":       "use",
+	"synergy":        "working together",
+}
+
+// Jargon returns the terms of dict that appear in text as whole words,
+// matched case-insensitively, once per term in order of first
+// occurrence. dict maps lower-case terms, which may span several words,
+// to their suggested replacement.
+func Jargon(text string, dict map[string]string) []Finding {
+	if len(dict) == 0 || strings.TrimSpace(text) == "" {
+		return nil
+	}
+
+	// Longest terms first, so "microservices" wins over "microservice".
+	terms := make([]string, 0, len(dict))
+	for term := range dict {
+		if term = strings.TrimSpace(term); term != "" {
+			terms = append(terms, regexp.QuoteMeta(term))
+		}
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if len(terms[i]) != len(terms[j]) {
+			return len(terms[i]) > len(terms[j])
+		}
+		return terms[i] < terms[j]
+	})
+	pattern := regexp.MustCompile(`(?i)\b(?:` + strings.Join(terms, "|") + `)\b`)
+
+	var findings []Finding
+	seen := make(map[string]bool)
+	for i, line := range strings.Split(text, "\n") {
+		for _, match := range pattern.FindAllString(line, -1) {
+			key := strings.ToLower(match)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			findings = append(findings, Finding{Term: match, Suggestion: dict[key], Line: i + 1})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,30 @@
+package lint
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJargon(t *testing.T) {
+	dict := map[string]string{
+		"api":            "a way for other programs to talk to it",
+		"microservice":   "a separate small program",
+		"microservices":  "separate small programs",
+		"single sign-on": "one sign-in for several tools",
+	}
+	text := "A dashboard built on Microservices.\nPartners use the API, and the api again.\nApiary and rapid are not jargon; Single Sign-On is."
+
+	got := Jargon(text, dict)
+	want := []Finding{
+		{Term: "Microservices", Suggestion: "separate small programs", Line: 1},
+		{Term: "API", Suggestion: "a way for other programs to talk to it", Line: 2},
+		{Term: "Single Sign-On", Suggestion: "one sign-in for several tools", Line: 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Jargon =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := Jargon("Plain words only.", DefaultJargon); got != nil {
+		t.Errorf("plain text should have no findings, got %+v", got)
+	}
+}
@@ -0,0 +1,337 @@
+// Package lint implements `hoofy lint`: governance rules asserting
+// structural properties of a project's artifacts, such as "every task
+// references a requirement" or "every Must Have requirement has an
+// acceptance scenario".
+//
+// A rule set is JSON. Each rule names a registered check, a severity,
+// and the check's parameters. A built-in default rule set ships with
+// the binary; a rule file can replace it or extend it ("extends":
+// "default"), overriding default rules by ID. New checks plug in with
+// Register.
+package lint
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// Severities a rule can have. SeverityOff disables a rule, typically a
+// default one overridden by an extending rule file.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityOff     = "off"
+)
+
+// Rule is one configured check.
+type Rule struct {
+	ID          string            `json:"id"`
+	Check       string            `json:"check"`
+	Severity    string            `json:"severity"`
+	Description string            `json:"description,omitempty"`
+	Params      map[string]string `json:"params,omitempty"`
+}
+
+// RuleSet is the rule file format.
+type RuleSet struct {
+	// Extends is "default" to start from the built-in rules, or empty
+	// to use only Rules.
+	Extends string `json:"extends,omitempty"`
+	Rules   []Rule `json:"rules"`
+}
+
+// Violation is one rule failure, located in a file. Line is 1-based; 0
+// means the file as a whole.
+type Violation struct {
+	Rule     string
+	Severity string
+	File     string // slash-separated, relative to the project root
+	Line     int
+	Message  string
+}
+
+// String renders the violation as "file:line: severity [rule] message".
+func (v Violation) String() string {
+	loc := v.File
+	if v.Line > 0 {
+		loc = fmt.Sprintf("%s:%d", v.File, v.Line)
+	}
+	return fmt.Sprintf("%s: %s [%s] %s", loc, v.Severity, v.Rule, v.Message)
+}
+
+// CheckFunc runs one check against a project. It returns violations
+// with File, Line and Message set; Run fills in the rule and severity.
+// An error means the rule itself is misconfigured (bad params).
+type CheckFunc func(p *Project, r Rule) ([]Violation, error)
+
+// checks is the registry of check implementations by name.
+var checks = map[string]CheckFunc{}
+
+// Register adds a check under name. It panics if the name is taken, so
+// a clash surfaces at startup rather than as a silently shadowed check.
+func Register(name string, fn CheckFunc) {
+	if _, dup := checks[name]; dup {
+		panic("lint: check " + name + " registered twice")
+	}
+	checks[name] = fn
+}
+
+// CheckNames returns the registered check names, sorted.
+func CheckNames() []string {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//go:embed default-rules.json
+var defaultRulesJSON []byte
+
+// DefaultRules returns the built-in rule set.
+func DefaultRules() []Rule {
+	var rs RuleSet
+	if err := json.Unmarshal(defaultRulesJSON, &rs); err != nil {
+		panic("lint: invalid default-rules.json: " + err.Error())
+	}
+	return rs.Rules
+}
+
+// LoadRules reads a rule file. With "extends": "default" its rules are
+// merged over the default rule set: a rule whose ID matches a default
+// rule overrides that rule's non-empty fields, any other is appended.
+func LoadRules(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading rules: %w", err)
+	}
+	var rs RuleSet
+	if err := json.Unmarshal(data, &rs); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filepath.Base(path), err)
+	}
+
+	switch rs.Extends {
+	case "":
+		return rs.Rules, nil
+	case "default":
+		return mergeRules(DefaultRules(), rs.Rules), nil
+	default:
+		return nil, fmt.Errorf("%s: unknown extends %q — only \"default\" is supported", filepath.Base(path), rs.Extends)
+	}
+}
+
+// mergeRules applies overrides to base by rule ID.
+func mergeRules(base, overrides []Rule) []Rule {
+	merged := append([]Rule(nil), base...)
+	index := make(map[string]int, len(merged))
+	for i, r := range merged {
+		index[r.ID] = i
+	}
+	for _, o := range overrides {
+		i, ok := index[o.ID]
+		if !ok {
+			index[o.ID] = len(merged)
+			merged = append(merged, o)
+			continue
+		}
+		r := &merged[i]
+		if o.Check != "" {
+			r.Check = o.Check
+		}
+		if o.Severity != "" {
+			r.Severity = o.Severity
+		}
+		if o.Description != "" {
+			r.Description = o.Description
+		}
+		if len(o.Params) > 0 {
+			params := make(map[string]string, len(r.Params)+len(o.Params))
+			for k, v := range r.Params {
+				params[k] = v
+			}
+			for k, v := range o.Params {
+				params[k] = v
+			}
+			r.Params = params
+		}
+	}
+	return merged
+}
+
+// validateRule checks a rule's ID, check name and severity.
+func validateRule(r Rule) error {
+	if r.ID == "" {
+		return fmt.Errorf("a rule using check %q has no id", r.Check)
+	}
+	if _, ok := checks[r.Check]; !ok {
+		return fmt.Errorf("rule %s: unknown check %q — available checks: %s",
+			r.ID, r.Check, strings.Join(CheckNames(), ", "))
+	}
+	switch r.Severity {
+	case SeverityError, SeverityWarning, SeverityOff:
+		return nil
+	default:
+		return fmt.Errorf("rule %s: severity must be error, warning or off, got %q", r.ID, r.Severity)
+	}
+}
+
+// Run checks the project at projectRoot against rules and returns the
+// violations sorted by file and line. Rules are validated before any
+// runs, so a misconfigured rule set fails fast.
+func Run(projectRoot string, rules []Rule) ([]Violation, error) {
+	for _, r := range rules {
+		if err := validateRule(r); err != nil {
+			return nil, err
+		}
+	}
+
+	p := newProject(projectRoot)
+	var all []Violation
+	for _, r := range rules {
+		if r.Severity == SeverityOff {
+			continue
+		}
+		found, err := checks[r.Check](p, r)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", r.ID, err)
+		}
+		for _, v := range found {
+			v.Rule = r.ID
+			v.Severity = r.Severity
+			all = append(all, v)
+		}
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].File != all[j].File {
+			return all[i].File < all[j].File
+		}
+		return all[i].Line < all[j].Line
+	})
+	return all, nil
+}
+
+// HasErrors reports whether any violation has error severity.
+func HasErrors(violations []Violation) bool {
+	for _, v := range violations {
+		if v.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Print writes one line per violation and a summary.
+func Print(w io.Writer, violations []Violation) {
+	errs := 0
+	for _, v := range violations {
+		fmt.Fprintln(w, v)
+		if v.Severity == SeverityError {
+			errs++
+		}
+	}
+	if len(violations) > 0 {
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "%d errors, %d warnings\n", errs, len(violations)-errs)
+}
+
+// Project gives checks cached, read-only access to a project's
+// artifacts.
+type Project struct {
+	Root  string
+	files map[string][]*artifactFile
+}
+
+// artifactFile is a read artifact file; a missing file has ok false.
+type artifactFile struct {
+	rel     string
+	content string
+	ok      bool
+}
+
+// File is one file of an artifact: its path relative to the project
+// root and its content.
+type File struct {
+	Rel     string
+	Content string
+}
+
+func newProject(root string) *Project {
+	return &Project{Root: root, files: make(map[string][]*artifactFile)}
+}
+
+// Artifact returns the path (relative to the project root) and content
+// of an artifact, named by stage ("specify", "tasks", ...) or side
+// artifact key ("acceptance", ...). ok is false when the artifact
+// doesn't exist; an unknown name is an error. For a stage split across
+// files, it is the stage file alone; see Files.
+func (p *Project) Artifact(name string) (rel, content string, ok bool, err error) {
+	files, err := p.read(name)
+	if err != nil {
+		return "", "", false, err
+	}
+	f := files[0]
+	return f.rel, f.content, f.ok, nil
+}
+
+// Files returns every existing file of an artifact, named as for
+// Artifact: the artifact file, then for a stage split across files
+// (requirements split by domain) the part files its project config
+// records.
+func (p *Project) Files(name string) ([]File, error) {
+	files, err := p.read(name)
+	if err != nil {
+		return nil, err
+	}
+	var out []File
+	for _, f := range files {
+		if f.ok {
+			out = append(out, File{Rel: f.rel, Content: f.content})
+		}
+	}
+	return out, nil
+}
+
+// read returns an artifact's files, reading them on first use.
+func (p *Project) read(name string) ([]*artifactFile, error) {
+	if files, cached := p.files[name]; cached {
+		return files, nil
+	}
+
+	var paths []string
+	if _, isStage := config.Stages[config.Stage(name)]; isStage {
+		paths = config.StagePathsIn(p.Root, config.Stage(name))
+	}
+	for _, side := range config.SideArtifacts {
+		if side.Key == name {
+			paths = []string{side.Path(p.Root)}
+		}
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("unknown artifact %q — use a stage name (e.g. specify, tasks) or a side artifact key (e.g. acceptance)", name)
+	}
+
+	files := make([]*artifactFile, 0, len(paths))
+	for _, path := range paths {
+		f := &artifactFile{rel: filepath.ToSlash(path)}
+		if r, err := filepath.Rel(p.Root, path); err == nil {
+			f.rel = filepath.ToSlash(r)
+		}
+		if data, err := os.ReadFile(path); err == nil {
+			f.content, f.ok = string(data), true
+		}
+		files = append(files, f)
+	}
+	p.files[name] = files
+	return files, nil
+}
@@ -0,0 +1,207 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+const testRequirements = `# app — Requirements
+
+## Functional Requirements
+
+### Must Have
+
+- **FR-001**: Users can register
+- **FR-002**: Users can log in
+
+### Should Have
+
+- **FR-003**: Export to CSV
+
+### Won't Have (this version)
+
+- **FR-004**: Mobile app
+
+## Non-Functional Requirements
+
+- **NFR-001**: p95 < 200ms
+`
+
+const testTasks = `# app — Tasks
+
+### TASK-001: Registration endpoint
+Implements FR-001.
+
+### TASK-002: Set up CI
+No requirement here. TBD which provider.
+
+### TASK-003: Performance budget
+**Requirements**: NFR-001
+`
+
+// writeLintProject creates a project with the given artifacts.
+func writeLintProject(t *testing.T, artifacts map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := config.NewFileStore().Save(root, config.NewProjectConfig("app", "lint test", config.ModeExpert)); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range artifacts {
+		if err := os.WriteFile(filepath.Join(config.DocsPath(root), name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestRun_DefaultRules(t *testing.T) {
+	root := writeLintProject(t, map[string]string{
+		"requirements.md": testRequirements,
+		"tasks.md":        testTasks,
+		"acceptance.md":   "Scenario: register (FR-001)\nScenario: export (FR-003)\n",
+	})
+
+	violations, err := Run(root, DefaultRules())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	var got []string
+	for _, v := range violations {
+		got = append(got, v.String())
+	}
+	want := []string{
+		"docs/requirements.md:8: error [must-have-covered] FR-002 (Must) is not covered by any task",
+		"docs/requirements.md:8: warning [requirement-has-acceptance] FR-002 (Must) has no acceptance scenario",
+		"docs/tasks.md:6: error [task-references-requirement] TASK-002 references no requirement (FR-XXX/NFR-XXX)",
+		"docs/tasks.md:7: warning [no-placeholders] unresolved placeholder",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("violations =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if !HasErrors(violations) {
+		t.Error("HasErrors = false, want true")
+	}
+}
+
+func TestRun_MissingArtifactsAreSkipped(t *testing.T) {
+	root := writeLintProject(t, map[string]string{"requirements.md": testRequirements})
+	violations, err := Run(root, DefaultRules())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("want no violations without tasks.md or acceptance.md, got %v", violations)
+	}
+}
+
+func TestRun_SplitRequirements(t *testing.T) {
+	root := writeLintProject(t, map[string]string{
+		"requirements.md": testRequirements,
+		"tasks.md":        "### TASK-001: All\nCovers FR-001, FR-002, NFR-001.\n",
+	})
+	auth := "# app: auth — Requirements\n\n## Functional Requirements\n\n### Must Have\n\n- **FR-005**: Users can log out\n"
+	if err := os.MkdirAll(filepath.Join(config.DocsPath(root), "requirements"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(config.DocsPath(root), "requirements", "auth.md"), []byte(auth), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	store := config.NewFileStore()
+	cfg, _ := store.Load(root)
+	cfg.StageParts = map[config.Stage][]string{config.StageSpecify: {"auth.md"}}
+	if err := store.Save(root, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	rules := []Rule{{ID: "must-have-covered", Check: "requirement_covered_by_task", Severity: SeverityError, Params: map[string]string{"buckets": "Must"}}}
+	violations, err := Run(root, rules)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	var got []string
+	for _, v := range violations {
+		got = append(got, v.String())
+	}
+	want := []string{"docs/requirements/auth.md:7: error [must-have-covered] FR-005 (Must) is not covered by any task"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("violations = %v, want %v (the domain file is read and located)", got, want)
+	}
+}
+
+func TestLoadRules_ExtendsDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	rules := `{
+  "extends": "default",
+  "rules": [
+    {"id": "no-placeholders", "severity": "off"},
+    {"id": "must-have-covered", "params": {"buckets": "Must,Should"}},
+    {"id": "design-has-security", "check": "required_section", "severity": "error",
+     "params": {"artifact": "design", "heading": "Security"}}
+  ]
+}`
+	if err := os.WriteFile(path, []byte(rules), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := LoadRules(path)
+	if err != nil {
+		t.Fatalf("LoadRules: %v", err)
+	}
+	if len(got) != len(DefaultRules())+1 {
+		t.Fatalf("got %d rules, want defaults plus one", len(got))
+	}
+
+	root := writeLintProject(t, map[string]string{
+		"requirements.md": testRequirements,
+		"tasks.md":        testTasks,
+		"design.md":       "# Design\n\n## Components\n",
+	})
+	violations, err := Run(root, got)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	var lines []string
+	for _, v := range violations {
+		lines = append(lines, v.Rule+": "+v.Message)
+	}
+	joined := strings.Join(lines, "\n")
+	for _, want := range []string{
+		`design-has-security: missing section "Security"`,
+		"must-have-covered: FR-003 (Should) is not covered by any task",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("missing %q in:\n%s", want, joined)
+		}
+	}
+	if strings.Contains(joined, "no-placeholders") {
+		t.Errorf("disabled rule still ran:\n%s", joined)
+	}
+}
+
+func TestRun_InvalidRules(t *testing.T) {
+	root := writeLintProject(t, nil)
+	tests := []struct {
+		name string
+		rule Rule
+		want string
+	}{
+		{"unknown check", Rule{ID: "x", Check: "nope", Severity: SeverityError}, `unknown check "nope"`},
+		{"bad severity", Rule{ID: "x", Check: "required_section", Severity: "fatal"}, "severity must be"},
+		{"missing params", Rule{ID: "x", Check: "required_section", Severity: SeverityError}, "needs 'artifact' and 'heading'"},
+		{"unknown artifact", Rule{ID: "x", Check: "forbidden_pattern", Severity: SeverityError,
+			Params: map[string]string{"artifacts": "readme", "pattern": "x"}}, `unknown artifact "readme"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Run(root, []Rule{tt.rule})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
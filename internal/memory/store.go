@@ -9,6 +9,7 @@ import (
 	"crypto/sha256"
 	"database/sql"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -23,6 +24,11 @@ import (
 // openDB is a package-level var to allow test injection.
 var openDB = sql.Open
 
+// ErrNotFound is wrapped by the errors of lookups whose observation or
+// relation doesn't exist (or was soft-deleted), so callers can tell a
+// bad ID from a storage failure.
+var ErrNotFound = errors.New("not found")
+
 // ─── Types ───────────────────────────────────────────────────────────────────
 
 // Session represents a coding session with start/end timestamps.
@@ -853,6 +859,9 @@ func (s *Store) GetObservation(id int64) (*Observation, error) {
 		&o.ToolName, &o.Project, &o.Scope, &o.TopicKey, &o.Namespace, &o.RevisionCount, &o.DuplicateCount, &o.LastSeenAt,
 		&o.CreatedAt, &o.UpdatedAt, &o.DeletedAt,
 	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("observation %d %w", id, ErrNotFound)
+		}
 		return nil, err
 	}
 	return &o, nil
@@ -1147,7 +1156,7 @@ func (s *Store) AddRelation(p AddRelationParams) ([]int64, error) {
 		found := row.Next()
 		_ = row.Close()
 		if !found {
-			return nil, fmt.Errorf("observation %d not found or is deleted", id)
+			return nil, fmt.Errorf("observation %d %w or is deleted", id, ErrNotFound)
 		}
 	}
 
@@ -1215,7 +1224,7 @@ func (s *Store) RemoveRelation(id int64) error {
 	}
 	n, _ := res.RowsAffected()
 	if n == 0 {
-		return fmt.Errorf("relation %d not found", id)
+		return fmt.Errorf("relation %d %w", id, ErrNotFound)
 	}
 	return nil
 }
@@ -1260,7 +1269,7 @@ func (s *Store) BuildContext(observationID int64, maxDepth int) (*ContextResult,
 	// Get root observation
 	root, err := s.GetObservation(observationID)
 	if err != nil {
-		return nil, fmt.Errorf("root observation %d not found: %w", observationID, err)
+		return nil, fmt.Errorf("root %w", err)
 	}
 
 	// BFS traversal
@@ -1456,7 +1465,7 @@ func (s *Store) Timeline(observationID int64, before, after int) (*TimelineResul
 	// Get the focus observation
 	focus, err := s.GetObservation(observationID)
 	if err != nil {
-		return nil, fmt.Errorf("timeline: observation #%d not found: %w", observationID, err)
+		return nil, fmt.Errorf("timeline: %w", err)
 	}
 
 	// Get session info (may be nil for manual-save observations)
@@ -1,6 +1,7 @@
 package memory_test
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -1978,8 +1979,8 @@ func TestAddRelation_NonExistentObservation(t *testing.T) {
 	if err == nil {
 		t.Error("expected error for non-existent target observation")
 	}
-	if !strings.Contains(err.Error(), "not found") {
-		t.Errorf("error = %q, expected to contain 'not found'", err.Error())
+	if !errors.Is(err, memory.ErrNotFound) || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("error = %q, expected to wrap ErrNotFound", err.Error())
 	}
 }
 
@@ -2031,8 +2032,8 @@ func TestRemoveRelation_NotFound(t *testing.T) {
 	if err == nil {
 		t.Error("expected error for non-existent relation")
 	}
-	if !strings.Contains(err.Error(), "not found") {
-		t.Errorf("error = %q, expected to contain 'not found'", err.Error())
+	if !errors.Is(err, memory.ErrNotFound) || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("error = %q, expected to wrap ErrNotFound", err.Error())
 	}
 }
 
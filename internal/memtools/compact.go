@@ -67,33 +67,54 @@ func (t *CompactTool) Definition() mcp.Tool {
 
 // Handle processes the mem_compact tool call.
 func (t *CompactTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	olderThanDays := intArg(req, "older_than_days", 0)
-	if olderThanDays <= 0 {
-		return mcp.NewToolResultError("'older_than_days' is required and must be > 0"), nil
-	}
+	return toolResult(t.process(compactParams{
+		OlderThanDays:  intArg(req, "older_than_days", 0),
+		Project:        req.GetString("project", ""),
+		Scope:          req.GetString("scope", ""),
+		CompactIDs:     req.GetString("compact_ids", ""),
+		Namespace:      req.GetString("namespace", ""),
+		SummaryTitle:   req.GetString("summary_title", ""),
+		SummaryContent: req.GetString("summary_content", ""),
+		SessionID:      req.GetString("session_id", ""),
+	}))
+}
 
-	project := req.GetString("project", "")
-	scope := req.GetString("scope", "")
-	compactIDsRaw := req.GetString("compact_ids", "")
-	namespace := req.GetString("namespace", "")
+// compactParams are the parsed mem_compact arguments.
+type compactParams struct {
+	OlderThanDays  int
+	Project        string
+	Scope          string
+	CompactIDs     string // JSON array; empty means identify candidates only
+	Namespace      string
+	SummaryTitle   string
+	SummaryContent string
+	SessionID      string
+}
 
-	if compactIDsRaw == "" {
-		return t.handleIdentify(project, scope, namespace, olderThanDays)
+// process identifies stale observations, or compacts the given IDs.
+func (t *CompactTool) process(params compactParams) (string, error) {
+	if params.OlderThanDays == 0 {
+		return "", missingFieldError("'older_than_days' is required and must be > 0")
+	}
+	if params.OlderThanDays < 0 {
+		return "", newUserError("'older_than_days' must be > 0")
 	}
-	return t.handleExecute(req, project, scope, compactIDsRaw)
+
+	if params.CompactIDs == "" {
+		return t.handleIdentify(params.Project, params.Scope, params.Namespace, params.OlderThanDays)
+	}
+	return t.handleExecute(params)
 }
 
 // handleIdentify lists stale observation candidates without deleting.
-func (t *CompactTool) handleIdentify(project, scope, namespace string, olderThanDays int) (*mcp.CallToolResult, error) {
+func (t *CompactTool) handleIdentify(project, scope, namespace string, olderThanDays int) (string, error) {
 	stale, err := t.store.FindStaleObservations(project, scope, namespace, olderThanDays, 200)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to find stale observations: %v", err)), nil
+		return "", storeError("failed to find stale observations", err)
 	}
 
 	if len(stale) == 0 {
-		return mcp.NewToolResultText(
-			fmt.Sprintf("No stale observations found older than %d days.", olderThanDays),
-		), nil
+		return fmt.Sprintf("No stale observations found older than %d days.", olderThanDays), nil
 	}
 
 	var sb strings.Builder
@@ -124,39 +145,39 @@ func (t *CompactTool) handleIdentify(project, scope, namespace string, olderThan
 	totalCount, _ := t.store.CountObservations(project, scope, namespace)
 	fmt.Fprintf(&sb, "\n%s", memory.NavigationHint(len(stale), totalCount, ""))
 
-	return mcp.NewToolResultText(sb.String()), nil
+	return sb.String(), nil
 }
 
 // handleExecute batch soft-deletes observations and optionally creates a summary.
-func (t *CompactTool) handleExecute(req mcp.CallToolRequest, project, scope, compactIDsRaw string) (*mcp.CallToolResult, error) {
+func (t *CompactTool) handleExecute(params compactParams) (string, error) {
 	// Parse compact_ids JSON array
 	var ids []int64
-	if err := json.Unmarshal([]byte(compactIDsRaw), &ids); err != nil {
-		return mcp.NewToolResultError(
+	if err := json.Unmarshal([]byte(params.CompactIDs), &ids); err != nil {
+		return "", newUserError(
 			fmt.Sprintf("'compact_ids' must be a valid JSON array of integers, e.g. \"[1, 2, 3]\". Parse error: %v", err),
-		), nil
+		)
 	}
 	if len(ids) == 0 {
-		return mcp.NewToolResultError("'compact_ids' array is empty"), nil
+		return "", newUserError("'compact_ids' array is empty")
 	}
 
-	summaryTitle := req.GetString("summary_title", "")
-	summaryContent := req.GetString("summary_content", "")
+	summaryTitle := params.SummaryTitle
+	summaryContent := params.SummaryContent
 
 	if summaryContent != "" && summaryTitle == "" {
-		return mcp.NewToolResultError("'summary_content' requires 'summary_title'"), nil
+		return "", missingFieldError("'summary_content' requires 'summary_title'")
 	}
 
 	result, err := t.store.CompactObservations(memory.CompactParams{
 		IDs:            ids,
 		SummaryTitle:   summaryTitle,
 		SummaryContent: summaryContent,
-		Project:        project,
-		Scope:          scope,
-		SessionID:      req.GetString("session_id", ""),
+		Project:        params.Project,
+		Scope:          params.Scope,
+		SessionID:      params.SessionID,
 	})
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("compaction failed: %v", err)), nil
+		return "", storeError("compaction failed", err)
 	}
 
 	var sb strings.Builder
@@ -174,5 +195,5 @@ func (t *CompactTool) handleExecute(req mcp.CallToolRequest, project, scope, com
 		fmt.Fprintf(&sb, "\n⚠️ %d ID(s) were skipped (already deleted or not found).\n", skipped)
 	}
 
-	return mcp.NewToolResultText(sb.String()), nil
+	return sb.String(), nil
 }
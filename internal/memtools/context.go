@@ -52,12 +52,35 @@ func (t *ContextTool) Definition() mcp.Tool {
 
 // Handle processes the mem_context tool call.
 func (t *ContextTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	project := req.GetString("project", "")
-	scope := req.GetString("scope", "")
-	limit := intArg(req, "limit", 0)
-	detailLevel := memory.ParseDetailLevel(req.GetString("detail_level", ""))
-	namespace := req.GetString("namespace", "")
-	maxTokens := intArg(req, "max_tokens", 0)
+	params := contextParams{
+		Project:     req.GetString("project", ""),
+		Scope:       req.GetString("scope", ""),
+		Limit:       intArg(req, "limit", 0),
+		DetailLevel: memory.ParseDetailLevel(req.GetString("detail_level", "")),
+		Namespace:   req.GetString("namespace", ""),
+		MaxTokens:   intArg(req, "max_tokens", 0),
+	}
+	return toolResult(t.process(params))
+}
+
+// contextParams are the parsed mem_context arguments.
+type contextParams struct {
+	Project     string
+	Scope       string
+	Limit       int
+	DetailLevel string
+	Namespace   string
+	MaxTokens   int
+}
+
+// process formats recent memory context within the requested budget.
+func (t *ContextTool) process(params contextParams) (string, error) {
+	project := params.Project
+	scope := params.Scope
+	limit := params.Limit
+	detailLevel := params.DetailLevel
+	namespace := params.Namespace
+	maxTokens := params.MaxTokens
 
 	formatted, err := t.store.FormatContextDetailed(project, scope, memory.ContextFormatOptions{
 		DetailLevel: detailLevel,
@@ -66,11 +89,11 @@ func (t *ContextTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp
 		MaxTokens:   maxTokens,
 	})
 	if err != nil {
-		return mcp.NewToolResultText("No memory context available."), nil
+		return "No memory context available.", nil
 	}
 
 	if formatted == "" {
-		return mcp.NewToolResultText("No memory context available yet. Start saving observations with mem_save."), nil
+		return "No memory context available yet. Start saving observations with mem_save.", nil
 	}
 
 	// Append footer hint for summary mode.
@@ -97,5 +120,5 @@ func (t *ContextTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp
 	// Always append token footer for context budget visibility.
 	formatted += memory.TokenFooter(memory.EstimateTokens(formatted))
 
-	return mcp.NewToolResultText(formatted), nil
+	return formatted, nil
 }
@@ -3,15 +3,46 @@
 // Each tool handler follows the same pattern as internal/tools:
 // - A struct with dependencies (memory.Store) injected via constructor
 // - Definition() returns the mcp.Tool schema
-// - Handle() processes the request and returns a result
+// - Handle() parses the request into a params struct for process()
+// - process() holds the tool's logic and returns (string, error)
 //
 // Tools are storage tools: they receive AI-generated content and persist it.
 package memtools
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/HendryAvila/Hoofy/internal/memory"
+	"github.com/HendryAvila/Hoofy/internal/tools"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
+// newUserError returns a caller-fixable error for a bad argument value,
+// coded like the SDD tools' errors.
+func newUserError(msg string) error { return tools.NewToolError(tools.CodeInvalidInput, msg) }
+
+// missingFieldError returns a caller-fixable error for a required
+// argument left empty, coded CodeMissingField as in the SDD tools.
+func missingFieldError(msg string) error { return tools.NewToolError(tools.CodeMissingField, msg) }
+
+// storeError wraps a memory store failure. An unknown observation or
+// relation is the caller's to fix and becomes a CodeNotFound error
+// result; anything else is a storage failure and propagates as an error.
+func storeError(what string, err error) error {
+	if errors.Is(err, memory.ErrNotFound) {
+		return tools.NewToolError(tools.CodeNotFound, fmt.Sprintf("%s: %v", what, err))
+	}
+	return fmt.Errorf("%s: %w", what, err)
+}
+
+// toolResult converts the outcome of a tool's process method into an MCP
+// result the way the SDD tools do (see tools.ToolResult): caller errors
+// become error results carrying their error code, other errors propagate.
+func toolResult(text string, err error) (*mcp.CallToolResult, error) {
+	return tools.ToolResult(text, err)
+}
+
 // intArg extracts an integer argument from a tool request, returning
 // defaultVal if the key is missing or not a number (JSON numbers are float64).
 func intArg(req mcp.CallToolRequest, key string, defaultVal int) int {
@@ -38,23 +38,38 @@ func (t *DeleteTool) Definition() mcp.Tool {
 
 // Handle processes the mem_delete tool call.
 func (t *DeleteTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id := intArg(req, "id", 0)
+	params := deleteParams{
+		ID:         intArg(req, "id", 0),
+		HardDelete: boolArg(req, "hard_delete", false),
+	}
+	return toolResult(t.process(params))
+}
+
+// deleteParams are the parsed mem_delete arguments.
+type deleteParams struct {
+	ID         int
+	HardDelete bool
+}
+
+// process soft- or hard-deletes the observation.
+func (t *DeleteTool) process(params deleteParams) (string, error) {
+	id := params.ID
 	if id == 0 {
-		return mcp.NewToolResultError("'id' is required"), nil
+		return "", missingFieldError("'id' is required")
 	}
 
-	hardDelete := boolArg(req, "hard_delete", false)
+	hardDelete := params.HardDelete
 
 	err := t.store.DeleteObservation(int64(id), hardDelete)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to delete observation: %v", err)), nil
+		return "", storeError("failed to delete observation", err)
 	}
 
 	action := "soft-deleted"
 	if hardDelete {
 		action = "permanently deleted"
 	}
-	return mcp.NewToolResultText(fmt.Sprintf("Observation %d %s", id, action)), nil
+	return fmt.Sprintf("Observation %d %s", id, action), nil
 }
 
 // ─── UpdateTool ─────────────────────────────────────────────────────────────
@@ -102,49 +117,74 @@ func (t *UpdateTool) Definition() mcp.Tool {
 
 // Handle processes the mem_update tool call.
 func (t *UpdateTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id := intArg(req, "id", 0)
+	return toolResult(t.process(updateParams{
+		ID:       intArg(req, "id", 0),
+		Title:    req.GetString("title", ""),
+		Content:  req.GetString("content", ""),
+		Type:     req.GetString("type", ""),
+		Project:  req.GetString("project", ""),
+		Scope:    req.GetString("scope", ""),
+		TopicKey: req.GetString("topic_key", ""),
+	}))
+}
+
+// updateParams are the parsed mem_update arguments. Empty fields are left
+// unchanged.
+type updateParams struct {
+	ID       int
+	Title    string
+	Content  string
+	Type     string
+	Project  string
+	Scope    string
+	TopicKey string
+}
+
+// process applies the non-empty fields to the observation.
+func (t *UpdateTool) process(params updateParams) (string, error) {
+	id := params.ID
 	if id == 0 {
-		return mcp.NewToolResultError("'id' is required"), nil
+		return "", missingFieldError("'id' is required")
 	}
 
-	params := memory.UpdateObservationParams{}
+	update := memory.UpdateObservationParams{}
 	hasUpdates := false
 
-	if v := req.GetString("title", ""); v != "" {
-		params.Title = &v
+	if v := params.Title; v != "" {
+		update.Title = &v
 		hasUpdates = true
 	}
-	if v := req.GetString("content", ""); v != "" {
-		params.Content = &v
+	if v := params.Content; v != "" {
+		update.Content = &v
 		hasUpdates = true
 	}
-	if v := req.GetString("type", ""); v != "" {
-		params.Type = &v
+	if v := params.Type; v != "" {
+		update.Type = &v
 		hasUpdates = true
 	}
-	if v := req.GetString("project", ""); v != "" {
-		params.Project = &v
+	if v := params.Project; v != "" {
+		update.Project = &v
 		hasUpdates = true
 	}
-	if v := req.GetString("scope", ""); v != "" {
-		params.Scope = &v
+	if v := params.Scope; v != "" {
+		update.Scope = &v
 		hasUpdates = true
 	}
-	if v := req.GetString("topic_key", ""); v != "" {
-		params.TopicKey = &v
+	if v := params.TopicKey; v != "" {
+		update.TopicKey = &v
 		hasUpdates = true
 	}
 
 	if !hasUpdates {
-		return mcp.NewToolResultError("at least one field to update is required"), nil
+		return "", missingFieldError("at least one field to update is required")
 	}
 
-	obs, err := t.store.UpdateObservation(int64(id), params)
+	obs, err := t.store.UpdateObservation(int64(id), update)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to update observation: %v", err)), nil
+		return "", storeError("failed to update observation", err)
 	}
 
-	return mcp.NewToolResultText(fmt.Sprintf("Observation %d updated: %q (rev %d)", obs.ID, obs.Title, obs.RevisionCount)), nil
+	return fmt.Sprintf("Observation %d updated: %q (rev %d)", obs.ID, obs.Title, obs.RevisionCount), nil
 }
 
 // ─── SuggestTopicKeyTool ────────────────────────────────────────────────────
@@ -179,14 +219,31 @@ func (t *SuggestTopicKeyTool) Definition() mcp.Tool {
 
 // Handle processes the mem_suggest_topic_key tool call.
 func (t *SuggestTopicKeyTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	title := req.GetString("title", "")
-	content := req.GetString("content", "")
-	typ := req.GetString("type", "")
+	params := suggestTopicKeyParams{
+		Title:   req.GetString("title", ""),
+		Content: req.GetString("content", ""),
+		Type:    req.GetString("type", ""),
+	}
+	return toolResult(t.process(params))
+}
+
+// suggestTopicKeyParams are the parsed mem_suggest_topic_key arguments.
+type suggestTopicKeyParams struct {
+	Title   string
+	Content string
+	Type    string
+}
+
+// process derives a stable topic key from the title or content.
+func (t *SuggestTopicKeyTool) process(params suggestTopicKeyParams) (string, error) {
+	title := params.Title
+	content := params.Content
+	typ := params.Type
 
 	if title == "" && content == "" {
-		return mcp.NewToolResultError("at least 'title' or 'content' is required"), nil
+		return "", missingFieldError("at least 'title' or 'content' is required")
 	}
 
 	key := memory.SuggestTopicKey(typ, title, content)
-	return mcp.NewToolResultText(fmt.Sprintf("Suggested topic_key: %s", key)), nil
+	return fmt.Sprintf("Suggested topic_key: %s", key), nil
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/HendryAvila/Hoofy/internal/memory"
+	"github.com/HendryAvila/Hoofy/internal/tools"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
@@ -306,6 +307,57 @@ func TestSaveTool_Success(t *testing.T) {
 	}
 }
 
+func TestSaveTool_ProcessErrors(t *testing.T) {
+	tool := NewSaveTool(newTestStore(t))
+
+	_, err := tool.process(saveParams{SaveType: "observation", Content: "body"})
+	if !tools.IsUserError(err) || !strings.Contains(err.Error(), "title") {
+		t.Errorf("missing title: err = %v, want a user error mentioning title", err)
+	}
+
+	_, err = tool.process(saveParams{SaveType: "bogus", Content: "body"})
+	if !tools.IsUserError(err) {
+		t.Errorf("unknown save_type: err = %v, want a user error", err)
+	}
+}
+
+func TestToolResult_ErrorCodes(t *testing.T) {
+	store := newTestStore(t)
+
+	r, err := NewGetObservationTool(store).Handle(ctx, makeReq(map[string]interface{}{"id": float64(99999)}))
+	mustBeToolError(t, r, err, "not found")
+	if got := r.Meta.AdditionalFields[tools.ErrorCodeKey]; got != string(tools.CodeNotFound) {
+		t.Errorf("unknown ID: error code = %v, want %s", got, tools.CodeNotFound)
+	}
+
+	r, err = NewSaveTool(store).Handle(ctx, makeReq(map[string]interface{}{"content": "body"}))
+	mustBeToolError(t, r, err, "title")
+	if got := r.Meta.AdditionalFields[tools.ErrorCodeKey]; got != string(tools.CodeMissingField) {
+		t.Errorf("missing title: error code = %v, want %s", got, tools.CodeMissingField)
+	}
+
+	r, err = NewSearchTool(store).Handle(ctx, makeReq(map[string]interface{}{}))
+	mustBeToolError(t, r, err, "query")
+	if got := r.Meta.AdditionalFields[tools.ErrorCodeKey]; got != string(tools.CodeMissingField) {
+		t.Errorf("missing query: error code = %v, want %s", got, tools.CodeMissingField)
+	}
+
+	r, err = NewSaveTool(store).Handle(ctx, makeReq(map[string]interface{}{"content": "body", "save_type": "bogus"}))
+	mustBeToolError(t, r, err, "save_type")
+	if got := r.Meta.AdditionalFields[tools.ErrorCodeKey]; got != string(tools.CodeInvalidInput) {
+		t.Errorf("bad save_type: error code = %v, want %s", got, tools.CodeInvalidInput)
+	}
+
+	// A storage failure is not the caller's to fix: it propagates.
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewSaveTool(store).process(saveParams{SaveType: "observation", Title: "t", Content: "body"})
+	if err == nil || tools.IsUserError(err) || !strings.Contains(err.Error(), "failed to save observation") {
+		t.Errorf("closed store: err = %v, want an internal error", err)
+	}
+}
+
 // ─── SessionTool (unified) ───────────────────────────────────────────────────
 
 func TestSessionTool_StartSuccess(t *testing.T) {
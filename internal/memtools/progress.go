@@ -64,49 +64,61 @@ func (t *ProgressTool) Definition() mcp.Tool {
 
 // Handle processes the mem_progress tool call.
 func (t *ProgressTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	project := req.GetString("project", "")
-	if project == "" {
-		return mcp.NewToolResultError("'project' is required"), nil
-	}
+	return toolResult(t.process(progressParams{
+		Project:   req.GetString("project", ""),
+		Content:   req.GetString("content", ""),
+		SessionID: req.GetString("session_id", "manual-save"),
+		Namespace: req.GetString("namespace", ""),
+	}))
+}
 
-	content := req.GetString("content", "")
-	namespace := req.GetString("namespace", "")
+// progressParams are the parsed mem_progress arguments.
+type progressParams struct {
+	Project   string
+	Content   string // empty reads the document instead of writing it
+	SessionID string
+	Namespace string
+}
+
+// process reads or replaces the project's progress document.
+func (t *ProgressTool) process(params progressParams) (string, error) {
+	if params.Project == "" {
+		return "", missingFieldError("'project' is required")
+	}
 
-	if content == "" {
-		return t.handleRead(project, namespace)
+	if params.Content == "" {
+		return t.handleRead(params.Project, params.Namespace)
 	}
-	return t.handleWrite(project, content, req.GetString("session_id", "manual-save"), namespace)
+	return t.handleWrite(params.Project, params.Content, params.SessionID, params.Namespace)
 }
 
 // handleRead retrieves the current progress document for a project.
-func (t *ProgressTool) handleRead(project, namespace string) (*mcp.CallToolResult, error) {
+func (t *ProgressTool) handleRead(project, namespace string) (string, error) {
 	topicKey := progressTopicKey(project, namespace)
 	obs, err := t.store.FindByTopicKey(topicKey, project, "project")
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to read progress: %v", err)), nil
+		return "", storeError("failed to read progress", err)
 	}
 	if obs == nil {
-		return mcp.NewToolResultText(
-			fmt.Sprintf("No progress document found for project %q. "+
-				"Call mem_progress with content to create one.", project),
-		), nil
+		return fmt.Sprintf("No progress document found for project %q. "+
+			"Call mem_progress with content to create one.", project), nil
 	}
 
 	response := fmt.Sprintf("# Progress: %s\n\n", project)
 	response += obs.Content
 	response += fmt.Sprintf("\n\n---\n_Last updated: %s | ID: %d | Revisions: %d_", obs.UpdatedAt, obs.ID, obs.RevisionCount)
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
 
 // handleWrite validates and saves a JSON progress document.
-func (t *ProgressTool) handleWrite(project, content, sessionID, namespace string) (*mcp.CallToolResult, error) {
+func (t *ProgressTool) handleWrite(project, content, sessionID, namespace string) (string, error) {
 	// Validate JSON
 	if !json.Valid([]byte(content)) {
-		return mcp.NewToolResultError(
+		return "", newUserError(
 			"'content' must be valid JSON. " +
 				"Recommended: {\"goal\": \"...\", \"completed\": [...], \"next_steps\": [...], \"blockers\": [...]}",
-		), nil
+		)
 	}
 
 	topicKey := progressTopicKey(project, namespace)
@@ -121,8 +133,8 @@ func (t *ProgressTool) handleWrite(project, content, sessionID, namespace string
 		Namespace: namespace,
 	})
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to save progress: %v", err)), nil
+		return "", storeError("failed to save progress", err)
 	}
 
-	return mcp.NewToolResultText(fmt.Sprintf("Progress updated for %q (ID: %d)", project, id)), nil
+	return fmt.Sprintf("Progress updated for %q (ID: %d)", project, id), nil
 }
@@ -54,44 +54,72 @@ func (t *RelateTool) Definition() mcp.Tool {
 
 // Handle processes the mem_relate tool call.
 func (t *RelateTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	action := strings.ToLower(strings.TrimSpace(req.GetString("action", "add")))
+	params := relateParams{
+		Action:        strings.ToLower(strings.TrimSpace(req.GetString("action", "add"))),
+		ID:            intArg(req, "id", 0),
+		FromID:        intArg(req, "from_id", 0),
+		ToID:          intArg(req, "to_id", 0),
+		RelationType:  req.GetString("relation_type", ""),
+		Note:          req.GetString("note", ""),
+		Bidirectional: boolArg(req, "bidirectional", false),
+	}
+	return toolResult(t.process(params))
+}
+
+// relateParams are the parsed mem_relate arguments.
+type relateParams struct {
+	Action        string
+	ID            int
+	FromID        int
+	ToID          int
+	RelationType  string
+	Note          string
+	Bidirectional bool
+}
+
+// process adds or removes the relation.
+func (t *RelateTool) process(params relateParams) (string, error) {
+	action := params.Action
 	if action == "" {
 		action = "add"
 	}
 
 	if action == "remove" {
-		id := intArg(req, "id", 0)
+		id := params.ID
 		if id == 0 {
-			return mcp.NewToolResultError("'id' is required for action=remove"), nil
+			return "", missingFieldError("'id' is required for action=remove")
 		}
 		err := t.store.RemoveRelation(int64(id))
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to remove relation: %v", err)), nil
+			return "", storeError("failed to remove relation", err)
 		}
-		return mcp.NewToolResultText(fmt.Sprintf("Relation %d removed", id)), nil
+		return fmt.Sprintf("Relation %d removed", id), nil
 	}
 
 	if action != "add" {
-		return mcp.NewToolResultError("'action' must be one of: add, remove"), nil
+		return "", newUserError("'action' must be one of: add, remove")
 	}
 
-	fromID := intArg(req, "from_id", 0)
-	toID := intArg(req, "to_id", 0)
+	fromID := params.FromID
+	toID := params.ToID
 
 	if fromID == 0 {
-		return mcp.NewToolResultError("'from_id' is required"), nil
+		return "", missingFieldError("'from_id' is required")
 	}
 	if toID == 0 {
-		return mcp.NewToolResultError("'to_id' is required"), nil
+		return "", missingFieldError("'to_id' is required")
+	}
+	if fromID == toID {
+		return "", newUserError(fmt.Sprintf("'from_id' and 'to_id' are both %d — a self-relation isn't allowed", fromID))
 	}
 
-	relType := req.GetString("relation_type", "")
+	relType := params.RelationType
 	if relType == "" {
-		return mcp.NewToolResultError("'relation_type' is required"), nil
+		return "", missingFieldError("'relation_type' is required")
 	}
 
-	note := req.GetString("note", "")
-	bidir := boolArg(req, "bidirectional", false)
+	note := params.Note
+	bidir := params.Bidirectional
 
 	ids, err := t.store.AddRelation(memory.AddRelationParams{
 		FromID:        int64(fromID),
@@ -101,18 +129,14 @@ func (t *RelateTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 		Bidirectional: bidir,
 	})
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to create relation: %v", err)), nil
+		return "", storeError("failed to create relation", err)
 	}
 
 	if bidir {
-		return mcp.NewToolResultText(
-			fmt.Sprintf("Bidirectional relation created: #%d ↔ #%d (%s)\nRelation IDs: %d, %d",
-				fromID, toID, relType, ids[0], ids[1]),
-		), nil
+		return fmt.Sprintf("Bidirectional relation created: #%d ↔ #%d (%s)\nRelation IDs: %d, %d",
+			fromID, toID, relType, ids[0], ids[1]), nil
 	}
 
-	return mcp.NewToolResultText(
-		fmt.Sprintf("Relation created: #%d → #%d (%s)\nRelation ID: %d",
-			fromID, toID, relType, ids[0]),
-	), nil
+	return fmt.Sprintf("Relation created: #%d → #%d (%s)\nRelation ID: %d",
+		fromID, toID, relType, ids[0]), nil
 }
@@ -69,23 +69,57 @@ func (t *SaveTool) Definition() mcp.Tool {
 
 // Handle processes the mem_save tool call.
 func (t *SaveTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	saveType := strings.ToLower(strings.TrimSpace(req.GetString("save_type", "observation")))
+	return toolResult(t.process(saveParams{
+		SaveType:  strings.ToLower(strings.TrimSpace(req.GetString("save_type", "observation"))),
+		Content:   req.GetString("content", ""),
+		SessionID: req.GetString("session_id", "manual-save"),
+		Project:   req.GetString("project", ""),
+		Namespace: req.GetString("namespace", ""),
+		Source:    req.GetString("source", ""),
+		Title:     req.GetString("title", ""),
+		Type:      req.GetString("type", "manual"),
+		Scope:     req.GetString("scope", "project"),
+		TopicKey:  req.GetString("topic_key", ""),
+		Upsert:    boolArg(req, "upsert", false),
+		RelateTo:  req.GetArguments()["relate_to"],
+	}))
+}
+
+// saveParams are the parsed mem_save arguments.
+type saveParams struct {
+	SaveType  string
+	Content   string
+	SessionID string
+	Project   string
+	Namespace string
+	Source    string
+	Title     string
+	Type      string
+	Scope     string
+	TopicKey  string
+	Upsert    bool
+	RelateTo  any // raw relate_to value; see parseRelatedIDs
+}
+
+// process saves an observation, a prompt, or a passive capture.
+func (t *SaveTool) process(params saveParams) (string, error) {
+	saveType := params.SaveType
 	if saveType == "" {
 		saveType = "observation"
 	}
 
-	content := req.GetString("content", "")
+	content := params.Content
 	if content == "" {
-		return mcp.NewToolResultError("'content' is required"), nil
+		return "", missingFieldError("'content' is required")
 	}
 
-	sessionID := req.GetString("session_id", "manual-save")
-	project := req.GetString("project", "")
-	namespace := req.GetString("namespace", "")
+	sessionID := params.SessionID
+	project := params.Project
+	namespace := params.Namespace
 
 	switch saveType {
 	case "observation":
-		return t.handleSaveObservation(req, sessionID, project, namespace)
+		return t.handleSaveObservation(params)
 	case "prompt":
 		id, err := t.store.AddPrompt(memory.AddPromptParams{
 			SessionID: sessionID,
@@ -94,56 +128,53 @@ func (t *SaveTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.Ca
 			Namespace: namespace,
 		})
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to save prompt: %v", err)), nil
+			return "", storeError("failed to save prompt", err)
 		}
-		return mcp.NewToolResultText(fmt.Sprintf("Prompt saved (ID: %d)", id)), nil
+		return fmt.Sprintf("Prompt saved (ID: %d)", id), nil
 	case "passive":
-		source := req.GetString("source", "")
 		result, err := t.store.PassiveCapture(memory.PassiveCaptureParams{
 			SessionID: sessionID,
 			Content:   content,
 			Project:   project,
-			Source:    source,
+			Source:    params.Source,
 		})
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("passive capture failed: %v", err)), nil
+			return "", storeError("passive capture failed", err)
 		}
-		return mcp.NewToolResultText(
-			fmt.Sprintf("Passive capture complete: %d extracted, %d saved, %d duplicates", result.Extracted, result.Saved, result.Duplicates),
-		), nil
+		return fmt.Sprintf("Passive capture complete: %d extracted, %d saved, %d duplicates", result.Extracted, result.Saved, result.Duplicates), nil
 	default:
-		return mcp.NewToolResultError("'save_type' must be one of: observation, prompt, passive"), nil
+		return "", newUserError("'save_type' must be one of: observation, prompt, passive")
 	}
 }
 
-func (t *SaveTool) handleSaveObservation(req mcp.CallToolRequest, sessionID, project, namespace string) (*mcp.CallToolResult, error) {
-	title := req.GetString("title", "")
+func (t *SaveTool) handleSaveObservation(params saveParams) (string, error) {
+	title := params.Title
 	if title == "" {
-		return mcp.NewToolResultError("'title' is required for save_type=observation"), nil
+		return "", missingFieldError("'title' is required for save_type=observation")
 	}
 
-	content := req.GetString("content", "")
-	typ := req.GetString("type", "manual")
-	scope := req.GetString("scope", "project")
-	topicKey := req.GetString("topic_key", "")
-	upsert := boolArg(req, "upsert", false)
+	content := params.Content
+	typ := params.Type
+	scope := params.Scope
+	topicKey := params.TopicKey
+	upsert := params.Upsert
 
 	if upsert && topicKey == "" {
 		topicKey = memory.SuggestTopicKey(typ, title, content)
 	}
 
 	id, err := t.store.AddObservation(memory.AddObservationParams{
-		SessionID: sessionID,
+		SessionID: params.SessionID,
 		Type:      typ,
 		Title:     title,
 		Content:   content,
-		Project:   project,
+		Project:   params.Project,
 		Scope:     scope,
 		TopicKey:  topicKey,
-		Namespace: namespace,
+		Namespace: params.Namespace,
 	})
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to save observation: %v", err)), nil
+		return "", storeError("failed to save observation", err)
 	}
 
 	response := fmt.Sprintf("Memory saved: %q (%s)", title, typ)
@@ -155,10 +186,10 @@ func (t *SaveTool) handleSaveObservation(req mcp.CallToolRequest, sessionID, pro
 	}
 	response += fmt.Sprintf("\nID: %d", id)
 
-	relatedIDs, parseErr := parseRelatedIDs(req.GetArguments()["relate_to"])
+	relatedIDs, parseErr := parseRelatedIDs(params.RelateTo)
 	if parseErr != nil {
 		response += fmt.Sprintf("\nWarning: relate_to ignored (%v)", parseErr)
-		return mcp.NewToolResultText(response), nil
+		return response, nil
 	}
 
 	if len(relatedIDs) > 0 {
@@ -182,7 +213,7 @@ func (t *SaveTool) handleSaveObservation(req mcp.CallToolRequest, sessionID, pro
 		}
 	}
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
 
 func parseRelatedIDs(raw any) ([]int64, error) {
@@ -61,18 +61,45 @@ func (t *SearchTool) Definition() mcp.Tool {
 
 // Handle processes the mem_search tool call.
 func (t *SearchTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	query := req.GetString("query", "")
+	params := searchParams{
+		Query:       req.GetString("query", ""),
+		Type:        req.GetString("type", ""),
+		Project:     req.GetString("project", ""),
+		Scope:       req.GetString("scope", ""),
+		Limit:       intArg(req, "limit", 10),
+		DetailLevel: memory.ParseDetailLevel(req.GetString("detail_level", "")),
+		Namespace:   req.GetString("namespace", ""),
+		MaxTokens:   intArg(req, "max_tokens", 0),
+	}
+	return toolResult(t.process(params))
+}
+
+// searchParams are the parsed mem_search arguments.
+type searchParams struct {
+	Query       string
+	Type        string
+	Project     string
+	Scope       string
+	Limit       int
+	DetailLevel string
+	Namespace   string
+	MaxTokens   int
+}
+
+// process runs the full-text search and formats the matches.
+func (t *SearchTool) process(params searchParams) (string, error) {
+	query := params.Query
 	if query == "" {
-		return mcp.NewToolResultError("'query' is required"), nil
+		return "", missingFieldError("'query' is required")
 	}
 
-	typ := req.GetString("type", "")
-	project := req.GetString("project", "")
-	scope := req.GetString("scope", "")
-	limit := intArg(req, "limit", 10)
-	detailLevel := memory.ParseDetailLevel(req.GetString("detail_level", ""))
-	namespace := req.GetString("namespace", "")
-	maxTokens := intArg(req, "max_tokens", 0)
+	typ := params.Type
+	project := params.Project
+	scope := params.Scope
+	limit := params.Limit
+	detailLevel := params.DetailLevel
+	namespace := params.Namespace
+	maxTokens := params.MaxTokens
 
 	results, err := t.store.Search(query, memory.SearchOptions{
 		Type:      typ,
@@ -82,11 +109,11 @@ func (t *SearchTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 		Namespace: namespace,
 	})
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("search failed: %v", err)), nil
+		return "", storeError("search failed", err)
 	}
 
 	if len(results) == 0 {
-		return mcp.NewToolResultText("No memories found matching your query."), nil
+		return "No memories found matching your query.", nil
 	}
 
 	var b strings.Builder
@@ -128,7 +155,7 @@ func (t *SearchTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 		if maxTokens > 0 && memory.EstimateTokens(b.String()+entry) > maxTokens {
 			b.WriteString(memory.BudgetFooter(memory.EstimateTokens(b.String()), maxTokens, shown, len(results)))
 			b.WriteString(memory.TokenFooter(memory.EstimateTokens(b.String())))
-			return mcp.NewToolResultText(b.String()), nil
+			return b.String(), nil
 		}
 
 		b.WriteString(entry)
@@ -155,5 +182,5 @@ func (t *SearchTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 	// Always append token footer for context budget visibility.
 	b.WriteString(memory.TokenFooter(memory.EstimateTokens(b.String())))
 
-	return mcp.NewToolResultText(b.String()), nil
+	return b.String(), nil
 }
@@ -47,30 +47,47 @@ func (t *SessionTool) Definition() mcp.Tool {
 
 // Handle processes the mem_session tool call.
 func (t *SessionTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	action := strings.ToLower(strings.TrimSpace(req.GetString("action", "")))
-	id := req.GetString("id", "")
+	return toolResult(t.process(sessionParams{
+		Action:    strings.ToLower(strings.TrimSpace(req.GetString("action", ""))),
+		ID:        req.GetString("id", ""),
+		Project:   req.GetString("project", ""),
+		Directory: req.GetString("directory", ""),
+		Summary:   req.GetString("summary", ""),
+	}))
+}
+
+// sessionParams are the parsed mem_session arguments.
+type sessionParams struct {
+	Action    string
+	ID        string
+	Project   string
+	Directory string
+	Summary   string
+}
+
+// process starts or ends the session.
+func (t *SessionTool) process(params sessionParams) (string, error) {
+	id := params.ID
 	if id == "" {
-		return mcp.NewToolResultError("'id' is required"), nil
+		return "", missingFieldError("'id' is required")
 	}
 
-	switch action {
+	switch params.Action {
 	case "start":
-		project := req.GetString("project", "")
+		project := params.Project
 		if project == "" {
-			return mcp.NewToolResultError("'project' is required for action=start"), nil
+			return "", missingFieldError("'project' is required for action=start")
 		}
-		directory := req.GetString("directory", "")
-		if err := t.store.CreateSession(id, project, directory); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to start session: %v", err)), nil
+		if err := t.store.CreateSession(id, project, params.Directory); err != nil {
+			return "", storeError("failed to start session", err)
 		}
-		return mcp.NewToolResultText(fmt.Sprintf("Session %q started for project %q", id, project)), nil
+		return fmt.Sprintf("Session %q started for project %q", id, project), nil
 	case "end":
-		summary := req.GetString("summary", "")
-		if err := t.store.EndSession(id, summary); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to end session: %v", err)), nil
+		if err := t.store.EndSession(id, params.Summary); err != nil {
+			return "", storeError("failed to end session", err)
 		}
-		return mcp.NewToolResultText(fmt.Sprintf("Session %q completed", id)), nil
+		return fmt.Sprintf("Session %q completed", id), nil
 	default:
-		return mcp.NewToolResultError("'action' must be one of: start, end"), nil
+		return "", newUserError("'action' must be one of: start, end")
 	}
 }
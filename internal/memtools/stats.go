@@ -30,9 +30,14 @@ func (t *StatsTool) Definition() mcp.Tool {
 
 // Handle processes the mem_stats tool call.
 func (t *StatsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return toolResult(t.process())
+}
+
+// process summarizes the memory store's contents.
+func (t *StatsTool) process() (string, error) {
 	stats, err := t.store.Stats()
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to get stats: %v", err)), nil
+		return "", storeError("failed to get stats", err)
 	}
 
 	var sb strings.Builder
@@ -47,5 +52,5 @@ func (t *StatsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.C
 		sb.WriteString("- **Projects**: none\n")
 	}
 
-	return mcp.NewToolResultText(sb.String()), nil
+	return sb.String(), nil
 }
@@ -53,19 +53,40 @@ func (t *TimelineTool) Definition() mcp.Tool {
 
 // Handle processes the mem_timeline tool call.
 func (t *TimelineTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	obsID := intArg(req, "observation_id", 0)
+	params := timelineParams{
+		ObservationID: intArg(req, "observation_id", 0),
+		Before:        intArg(req, "before", 5),
+		After:         intArg(req, "after", 5),
+		DetailLevel:   memory.ParseDetailLevel(req.GetString("detail_level", "")),
+		MaxTokens:     intArg(req, "max_tokens", 0),
+	}
+	return toolResult(t.process(params))
+}
+
+// timelineParams are the parsed mem_timeline arguments.
+type timelineParams struct {
+	ObservationID int
+	Before        int
+	After         int
+	DetailLevel   string
+	MaxTokens     int
+}
+
+// process renders the observations around the requested one.
+func (t *TimelineTool) process(params timelineParams) (string, error) {
+	obsID := params.ObservationID
 	if obsID == 0 {
-		return mcp.NewToolResultError("'observation_id' is required"), nil
+		return "", missingFieldError("'observation_id' is required")
 	}
 
-	before := intArg(req, "before", 5)
-	after := intArg(req, "after", 5)
-	detailLevel := memory.ParseDetailLevel(req.GetString("detail_level", ""))
-	maxTokens := intArg(req, "max_tokens", 0)
+	before := params.Before
+	after := params.After
+	detailLevel := params.DetailLevel
+	maxTokens := params.MaxTokens
 
 	result, err := t.store.Timeline(int64(obsID), before, after)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("timeline failed: %v", err)), nil
+		return "", storeError("timeline failed", err)
 	}
 
 	var b strings.Builder
@@ -113,7 +134,7 @@ func (t *TimelineTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mc
 	// Always append token footer for context budget visibility.
 	response += memory.TokenFooter(memory.EstimateTokens(response))
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
 
 // formatTimelineStandard is the original behavior: 200-char snippets for
@@ -211,13 +232,28 @@ func (t *GetObservationTool) Definition() mcp.Tool {
 
 // Handle processes the mem_get tool call.
 func (t *GetObservationTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id := intArg(req, "id", 0)
+	params := getObservationParams{
+		ID:    intArg(req, "id", 0),
+		Depth: intArg(req, "depth", 0),
+	}
+	return toolResult(t.process(params))
+}
+
+// getObservationParams are the parsed mem_get arguments.
+type getObservationParams struct {
+	ID    int
+	Depth int
+}
+
+// process renders the observation and, up to depth, its relations.
+func (t *GetObservationTool) process(params getObservationParams) (string, error) {
+	id := params.ID
 	if id == 0 {
-		return mcp.NewToolResultError("'id' is required"), nil
+		return "", missingFieldError("'id' is required")
 	}
-	depth := intArg(req, "depth", 0)
+	depth := params.Depth
 	if depth < 0 {
-		return mcp.NewToolResultError("'depth' must be >= 0"), nil
+		return "", newUserError("'depth' must be >= 0")
 	}
 	if depth > 5 {
 		depth = 5
@@ -225,7 +261,7 @@ func (t *GetObservationTool) Handle(ctx context.Context, req mcp.CallToolRequest
 
 	obs, err := t.store.GetObservation(int64(id))
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("observation #%d not found", id)), nil
+		return "", storeError("failed to get observation", err)
 	}
 
 	var b strings.Builder
@@ -303,5 +339,5 @@ func (t *GetObservationTool) Handle(ctx context.Context, req mcp.CallToolRequest
 		}
 	}
 
-	return mcp.NewToolResultText(b.String()), nil
+	return b.String(), nil
 }
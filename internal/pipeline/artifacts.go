@@ -0,0 +1,191 @@
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// ErrMissingArtifact is wrapped by RequireArtifacts' error when a
+// prerequisite artifact is missing or empty.
+var ErrMissingArtifact = errors.New("required artifact is missing or empty")
+
+// missingArtifactsError reports several missing artifacts at once.
+type missingArtifactsError struct{ missing []string }
+
+func (e *missingArtifactsError) Error() string {
+	return "required artifacts are missing or empty: " + strings.Join(e.missing, ", ")
+}
+
+func (e *missingArtifactsError) Is(target error) bool { return target == ErrMissingArtifact }
+
+// stageProducers names the tool that writes each stage's artifact, so
+// precondition errors can tell the AI exactly what to run.
+var stageProducers = map[config.Stage]string{
+	config.StagePrinciples:    "sdd_create_principles",
+	config.StageCharter:       "sdd_create_charter",
+	config.StageSpecify:       "sdd_generate_requirements",
+	config.StageBusinessRules: "sdd_create_business_rules",
+	config.StageClarify:       "sdd_clarify",
+	config.StageResearch:      "sdd_record_research",
+	config.StageDesign:        "sdd_create_design",
+	config.StageTasks:         "sdd_create_tasks",
+}
+
+// prerequisites lists the artifacts each stage tool reads or builds on.
+// Optional stages are added by PrerequisiteArtifacts when enabled.
+var prerequisites = map[config.Stage][]config.Stage{
+	config.StageSpecify:       {config.StageCharter},
+	config.StageBusinessRules: {config.StageSpecify},
+	config.StageClarify:       {config.StageSpecify},
+	config.StageResearch:      {config.StageSpecify, config.StageClarify},
+	config.StageDesign:        {config.StageSpecify, config.StageClarify},
+	config.StageTasks:         {config.StageDesign},
+	config.StageValidate: {
+		config.StagePrinciples,
+		config.StageCharter,
+		config.StageSpecify,
+		config.StageClarify,
+		config.StageDesign,
+		config.StageTasks,
+	},
+}
+
+// PrerequisiteArtifacts returns the stages whose artifacts must exist
+// before the given stage's tool runs. Enabled optional stages are
+// required by every stage that comes after them (design and validate
+// need research.md when research is enabled). Stages marked skipped in
+// StageStatus are left out: a library with no API may skip design, and
+// its missing design.md was decided, not forgotten.
+func PrerequisiteArtifacts(cfg *config.ProjectConfig, stage config.Stage) []config.Stage {
+	required := append([]config.Stage(nil), prerequisites[stage]...)
+	idx := StageIndexFor(cfg, stage)
+	for _, opt := range cfg.OptionalStages {
+		if optIdx := StageIndexFor(cfg, opt); optIdx >= 0 && optIdx < idx {
+			required = append(required, opt)
+		}
+	}
+	return slices.DeleteFunc(required, func(s config.Stage) bool { return IsSkipped(cfg, s) })
+}
+
+// RequireArtifacts checks that every given stage's artifact exists and is
+// non-empty under projectRoot. The error names ALL missing artifacts, and
+// the tool that produces each, so one round-trip is enough to fix them.
+func RequireArtifacts(projectRoot string, stages ...config.Stage) error {
+	var missing []string
+	for _, stage := range stages {
+		path := config.StagePath(projectRoot, stage)
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("reading %s: %w", config.StageFilenameIn(projectRoot, stage), err)
+		}
+		if strings.TrimSpace(string(data)) != "" {
+			continue
+		}
+
+		entry := config.StageFilenameIn(projectRoot, stage)
+		if tool := stageProducers[stage]; tool != "" {
+			entry += " (run " + tool + ")"
+		}
+		missing = append(missing, entry)
+	}
+
+	switch len(missing) {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("%w: %s", ErrMissingArtifact, missing[0])
+	default:
+		return &missingArtifactsError{missing: missing}
+	}
+}
+
+// ReadArtifactFile reads one artifact file the way the stage tools do.
+// A missing file reads as empty (the stage just hasn't run yet). CRLF
+// line endings are normalized to LF and a leading UTF-8 BOM is dropped,
+// so content parses, compares and hashes the same however it was written.
+func ReadArtifactFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("reading %s: %w", path, err)
+	}
+	return NormalizeNewlines(string(config.StripBOM(data))), nil
+}
+
+// ReadArtifact reads every file of a stage's artifact — the stage file,
+// then the split parts cfg records (see config.StagePaths) — joined by a
+// blank line, so requirements split by domain parse as one document.
+// Missing files read as empty.
+func ReadArtifact(projectRoot string, cfg *config.ProjectConfig, stage config.Stage) (string, error) {
+	var parts []string
+	for _, path := range config.StagePaths(projectRoot, cfg, stage) {
+		content, err := ReadArtifactFile(path)
+		if err != nil {
+			return "", err
+		}
+		if content != "" {
+			parts = append(parts, content)
+		}
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
+
+// NormalizeNewlines converts CRLF and lone CR line endings to LF.
+func NormalizeNewlines(s string) string {
+	if !strings.Contains(s, "\r") {
+		return s
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\r", "\n")
+}
+
+// ContentHash is the hex sha256 of an artifact's content as ReadArtifact
+// returns it, the form recorded in StageStatus.SourceHashes.
+func ContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// clarityScorePattern matches the score heading in clarifications.md.
+var clarityScorePattern = regexp.MustCompile(`(?m)^## Clarity Score: (\d{1,3})/100`)
+
+// ParseClarityScore extracts the clarity score recorded in the
+// "## Clarity Score: N/100" heading of clarifications.md.
+func ParseClarityScore(content string) (int, bool) {
+	m := clarityScorePattern.FindStringSubmatch(content)
+	if m == nil {
+		return 0, false
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// verdictPattern matches the verdict heading written by sdd_validate.
+var verdictPattern = regexp.MustCompile(`(?m)^## Verdict: ([A-Z_]+)`)
+
+// ParseVerdict extracts the verdict (PASS, PASS_WITH_WARNINGS or FAIL)
+// recorded in the "## Verdict: X" heading of validation.md.
+func ParseVerdict(content string) (string, bool) {
+	m := verdictPattern.FindStringSubmatch(content)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
@@ -0,0 +1,96 @@
+package pipeline
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+func writeArtifact(t *testing.T, root string, stage config.Stage, content string) {
+	t.Helper()
+	path := config.StagePath(root, stage)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRequireArtifacts_AllPresent(t *testing.T) {
+	root := t.TempDir()
+	writeArtifact(t, root, config.StageSpecify, "# Requirements")
+	writeArtifact(t, root, config.StageClarify, "# Clarifications")
+
+	if err := RequireArtifacts(root, config.StageSpecify, config.StageClarify); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
+
+func TestRequireArtifacts_SingleMissing(t *testing.T) {
+	root := t.TempDir()
+	writeArtifact(t, root, config.StageSpecify, "# Requirements")
+
+	err := RequireArtifacts(root, config.StageSpecify, config.StageClarify)
+	if err == nil {
+		t.Fatal("expected error for missing clarifications.md")
+	}
+	if !strings.Contains(err.Error(), "artifact is missing or empty: clarifications.md (run sdd_clarify)") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRequireArtifacts_MultipleMissingAndEmpty(t *testing.T) {
+	root := t.TempDir()
+	writeArtifact(t, root, config.StageCharter, "# Charter")
+	writeArtifact(t, root, config.StageDesign, "  \n\n") // whitespace-only counts as empty
+
+	err := RequireArtifacts(root,
+		config.StagePrinciples, config.StageCharter, config.StageDesign, config.StageTasks)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	msg := err.Error()
+	for _, want := range []string{"principles.md", "design.md", "tasks.md"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error should name %s: %v", want, msg)
+		}
+	}
+	if strings.Contains(msg, "charter.md") {
+		t.Errorf("present artifact should not be listed: %v", msg)
+	}
+}
+
+func TestPrerequisiteArtifacts(t *testing.T) {
+	cfg := newTestConfig(config.StageDesign, config.ModeGuided, 0)
+
+	got := PrerequisiteArtifacts(cfg, config.StageDesign)
+	if len(got) != 2 || got[0] != config.StageSpecify || got[1] != config.StageClarify {
+		t.Errorf("design prerequisites = %v, want [specify clarify]", got)
+	}
+
+	cfg.OptionalStages = []config.Stage{config.StageResearch}
+	got = PrerequisiteArtifacts(cfg, config.StageDesign)
+	if got[len(got)-1] != config.StageResearch {
+		t.Errorf("design should require research.md when enabled, got %v", got)
+	}
+	for _, s := range PrerequisiteArtifacts(cfg, config.StageResearch) {
+		if s == config.StageResearch {
+			t.Error("research must not require its own artifact")
+		}
+	}
+	if got := PrerequisiteArtifacts(cfg, config.StageSpecify); len(got) != 1 || got[0] != config.StageCharter {
+		t.Errorf("specify prerequisites = %v, want [charter]", got)
+	}
+
+	st := cfg.StageStatus[config.StageDesign]
+	st.Status = "skipped"
+	cfg.StageStatus[config.StageDesign] = st
+	if got := PrerequisiteArtifacts(cfg, config.StageValidate); slices.Contains(got, config.StageDesign) {
+		t.Errorf("a skipped design should not be required, got %v", got)
+	}
+}
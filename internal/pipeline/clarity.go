@@ -6,6 +6,8 @@
 // meets the threshold for the active mode.
 package pipeline
 
+import "github.com/HendryAvila/Hoofy/internal/config"
+
 // ClarityDimension represents one axis of clarity evaluation.
 // Each dimension contributes to the overall clarity score.
 type ClarityDimension struct {
@@ -14,6 +16,9 @@ type ClarityDimension struct {
 	Weight      int    `json:"weight"`   // relative importance (1-10)
 	Covered     bool   `json:"covered"`  // whether this dimension has been addressed
 	Score       int    `json:"score"`    // 0-100 for this dimension
+	// Justification is the optional evidence cited for the score,
+	// e.g. "JWT+bcrypt specified in FR-009".
+	Justification string `json:"justification,omitempty"`
 }
 
 // DefaultDimensions returns the standard clarity dimensions.
@@ -85,7 +90,8 @@ type ClarityAnswer struct {
 	Answer    string `json:"answer"`
 }
 
-// CalculateScore computes the weighted overall score from dimensions.
+// CalculateScore computes the overall score from dimensions as their
+// weighted mean, rounded down: sum(score*weight) / sum(weight).
 func CalculateScore(dimensions []ClarityDimension) int {
 	totalWeight := 0
 	weightedSum := 0
@@ -102,6 +108,37 @@ func CalculateScore(dimensions []ClarityDimension) int {
 	return weightedSum / totalWeight
 }
 
+// PenaltyFloor is the dimension score below which the penalized scheme
+// deducts points; PenaltyPerWeight is how many points a dimension
+// scoring 0 costs per unit of weight. A weight-10 dimension left at 0
+// costs 40 points, a weight-5 one 20.
+const (
+	PenaltyFloor     = 40
+	PenaltyPerWeight = 4
+)
+
+// CalculateScoreWith computes the overall score under a scoring scheme
+// (config.ScoringMean or config.ScoringPenalized; "" is mean).
+//
+// Penalized starts from the weighted mean and subtracts, for every
+// dimension under PenaltyFloor, weight*PenaltyPerWeight scaled by how
+// far below the floor it is. The result is rounded down and never
+// negative. Dimensions at or above the floor score the same under both.
+func CalculateScoreWith(scheme string, dimensions []ClarityDimension) int {
+	score := CalculateScore(dimensions)
+	if scheme != config.ScoringPenalized {
+		return score
+	}
+
+	penalty := 0
+	for _, d := range dimensions {
+		if d.Score < PenaltyFloor {
+			penalty += d.Weight * PenaltyPerWeight * (PenaltyFloor - d.Score)
+		}
+	}
+	return max(score-penalty/PenaltyFloor, 0)
+}
+
 // UncoveredDimensions returns dimensions that haven't been addressed yet.
 func UncoveredDimensions(dimensions []ClarityDimension) []ClarityDimension {
 	var uncovered []ClarityDimension
@@ -0,0 +1,104 @@
+package pipeline
+
+import "sort"
+
+// Question budget for one clarify round.
+const (
+	MinRoundQuestions = 3
+	MaxRoundQuestions = 5
+	// maxQuestionsPerDimension keeps a round from fixating on one gap.
+	maxQuestionsPerDimension = 2
+	// clearDimensionScore is the score at which a dimension needs no
+	// further questions.
+	clearDimensionScore = 90
+)
+
+// QuestionAllocation is how many questions a clarify round should ask
+// about one dimension.
+type QuestionAllocation struct {
+	Dimension string `json:"dimension"`
+	Questions int    `json:"questions"`
+	Score     int    `json:"score"`
+	Weight    int    `json:"weight"`
+}
+
+// PlanQuestions turns the latest per-dimension scores into a directive
+// question plan for the next clarify round.
+//
+// The round size grows with the distance to the threshold: 3 questions
+// when within 10 points, 4 within 20, 5 beyond that. Questions go to the
+// dimensions with the largest weighted gap (weight × (100 − score)),
+// at most two per dimension, so the weakest heavy dimensions get two
+// questions and the next ones get one. Dimensions scoring 90 or more are
+// skipped. The result is deterministic and ordered by questions, then
+// gap. Returns nil when every dimension is already clear.
+func PlanQuestions(dimensions []ClarityDimension, threshold int) []QuestionAllocation {
+	type candidate struct {
+		dim      ClarityDimension
+		gap      int
+		assigned int
+	}
+	var candidates []*candidate
+	for _, d := range dimensions {
+		if d.Score >= clearDimensionScore || d.Weight <= 0 {
+			continue
+		}
+		candidates = append(candidates, &candidate{dim: d, gap: d.Weight * (100 - d.Score)})
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].gap != candidates[j].gap {
+			return candidates[i].gap > candidates[j].gap
+		}
+		return candidates[i].dim.Name < candidates[j].dim.Name
+	})
+
+	total := RoundQuestionCount(CalculateScore(dimensions), threshold)
+	if limit := len(candidates) * maxQuestionsPerDimension; total > limit {
+		total = limit
+	}
+
+	// Greedy allocation: each question goes to the candidate whose gap,
+	// shared among the questions it already has, is still the largest.
+	for n := 0; n < total; n++ {
+		var best *candidate
+		for _, c := range candidates {
+			if c.assigned >= maxQuestionsPerDimension {
+				continue
+			}
+			if best == nil || c.gap*(best.assigned+1) > best.gap*(c.assigned+1) {
+				best = c
+			}
+		}
+		best.assigned++
+	}
+
+	var plan []QuestionAllocation
+	for _, c := range candidates {
+		if c.assigned > 0 {
+			plan = append(plan, QuestionAllocation{
+				Dimension: c.dim.Name,
+				Questions: c.assigned,
+				Score:     c.dim.Score,
+				Weight:    c.dim.Weight,
+			})
+		}
+	}
+	sort.SliceStable(plan, func(i, j int) bool { return plan[i].Questions > plan[j].Questions })
+	return plan
+}
+
+// RoundQuestionCount returns how many questions the next clarify round
+// should ask given the current overall score and the gate threshold.
+func RoundQuestionCount(score, threshold int) int {
+	switch gap := threshold - score; {
+	case gap > 20:
+		return MaxRoundQuestions
+	case gap > 10:
+		return MinRoundQuestions + 1
+	default:
+		return MinRoundQuestions
+	}
+}
@@ -0,0 +1,105 @@
+package pipeline
+
+import (
+	"reflect"
+	"testing"
+)
+
+func scoredDimensions(scores map[string]int) []ClarityDimension {
+	dims := DefaultDimensions()
+	for i := range dims {
+		dims[i].Score = scores[dims[i].Name]
+	}
+	return dims
+}
+
+func TestRoundQuestionCount(t *testing.T) {
+	tests := []struct {
+		score, threshold, want int
+	}{
+		{65, 70, 3},
+		{60, 70, 3},
+		{55, 70, 4},
+		{50, 70, 4},
+		{49, 70, 5},
+		{0, 70, 5},
+		{80, 70, 3},
+	}
+	for _, tt := range tests {
+		if got := RoundQuestionCount(tt.score, tt.threshold); got != tt.want {
+			t.Errorf("RoundQuestionCount(%d, %d) = %d, want %d", tt.score, tt.threshold, got, tt.want)
+		}
+	}
+}
+
+func TestPlanQuestions_WeakestHeavyDimensionsFirst(t *testing.T) {
+	dims := scoredDimensions(map[string]int{
+		"target_users":       95,
+		"core_functionality": 90,
+		"data_model":         80,
+		"integrations":       70,
+		"edge_cases":         40,
+		"security":           85,
+		"scale_performance":  90,
+		"scope_boundaries":   20,
+	})
+	// Overall 69 vs threshold 90 → 5 questions.
+	got := PlanQuestions(dims, 90)
+	want := []QuestionAllocation{
+		{Dimension: "scope_boundaries", Questions: 2, Score: 20, Weight: 9},
+		{Dimension: "edge_cases", Questions: 2, Score: 40, Weight: 8},
+		{Dimension: "integrations", Questions: 1, Score: 70, Weight: 6},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PlanQuestions =\n%+v\nwant\n%+v", got, want)
+	}
+
+	// Closer to the threshold → 3 questions, still weakest first.
+	got = PlanQuestions(dims, 75)
+	want = []QuestionAllocation{
+		{Dimension: "scope_boundaries", Questions: 2, Score: 20, Weight: 9},
+		{Dimension: "edge_cases", Questions: 1, Score: 40, Weight: 8},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PlanQuestions near threshold =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestPlanQuestions_CapsPerDimension(t *testing.T) {
+	// Only one weak dimension: it gets at most two questions.
+	dims := scoredDimensions(map[string]int{
+		"target_users": 95, "core_functionality": 95, "data_model": 95, "integrations": 95,
+		"edge_cases": 95, "security": 95, "scale_performance": 95, "scope_boundaries": 0,
+	})
+	got := PlanQuestions(dims, 100)
+	if len(got) != 1 || got[0].Dimension != "scope_boundaries" || got[0].Questions != 2 {
+		t.Errorf("PlanQuestions = %+v, want 2 questions about scope_boundaries", got)
+	}
+}
+
+func TestPlanQuestions_AllClear(t *testing.T) {
+	dims := scoredDimensions(map[string]int{
+		"target_users": 90, "core_functionality": 95, "data_model": 90, "integrations": 100,
+		"edge_cases": 90, "security": 92, "scale_performance": 90, "scope_boundaries": 90,
+	})
+	if got := PlanQuestions(dims, 100); got != nil {
+		t.Errorf("PlanQuestions = %+v, want nil when every dimension is clear", got)
+	}
+}
+
+func TestPlanQuestions_Deterministic(t *testing.T) {
+	dims := scoredDimensions(nil)
+	first := PlanQuestions(dims, 70)
+	for i := 0; i < 10; i++ {
+		if got := PlanQuestions(dims, 70); !reflect.DeepEqual(got, first) {
+			t.Fatalf("run %d differs: %+v vs %+v", i, got, first)
+		}
+	}
+	total := 0
+	for _, a := range first {
+		total += a.Questions
+	}
+	if total != MaxRoundQuestions {
+		t.Errorf("all-zero scores should ask %d questions, got %d (%+v)", MaxRoundQuestions, total, first)
+	}
+}
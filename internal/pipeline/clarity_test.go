@@ -2,6 +2,8 @@ package pipeline
 
 import (
 	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
 )
 
 // --- DefaultDimensions ---
@@ -180,3 +182,59 @@ func TestUncoveredDimensions_EmptySlice(t *testing.T) {
 		t.Errorf("UncoveredDimensions(nil) = %d, want 0", len(uncovered))
 	}
 }
+
+func TestCalculateScoreWith_MeanVsPenalized(t *testing.T) {
+	// withScores returns the default dimensions at 100, except the
+	// named ones.
+	withScores := func(scores map[string]int) []ClarityDimension {
+		dims := DefaultDimensions()
+		for i := range dims {
+			dims[i].Score = 100
+			if s, ok := scores[dims[i].Name]; ok {
+				dims[i].Score = s
+			}
+		}
+		return dims
+	}
+
+	tests := []struct {
+		name          string
+		dims          []ClarityDimension
+		mean, penalty int
+	}{
+		// Critical dimension (weight 10) unaddressed: the mean still
+		// clears the guided threshold (70), the penalized score doesn't.
+		{"critical dimension at 0", withScores(map[string]int{"core_functionality": 0}), 83, 43},
+		// A lighter dimension (weight 5) costs proportionally less.
+		{"light dimension at 0", withScores(map[string]int{"scale_performance": 0}), 91, 71},
+		// Halfway to the floor costs half the penalty.
+		{"critical dimension at 20", withScores(map[string]int{"core_functionality": 20}), 86, 66},
+		// Nothing under the floor: both schemes agree.
+		{"all at 60", func() []ClarityDimension {
+			dims := DefaultDimensions()
+			for i := range dims {
+				dims[i].Score = 60
+			}
+			return dims
+		}(), 60, 60},
+		// The penalty never takes the score below 0.
+		{"all at 0", DefaultDimensions(), 0, 0},
+		{"no dimensions", nil, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateScoreWith(config.ScoringMean, tt.dims); got != tt.mean {
+				t.Errorf("mean = %d, want %d", got, tt.mean)
+			}
+			if got := CalculateScoreWith("", tt.dims); got != tt.mean {
+				t.Errorf("default scheme = %d, want the mean %d", got, tt.mean)
+			}
+			if got := CalculateScore(tt.dims); got != tt.mean {
+				t.Errorf("CalculateScore = %d, want the mean %d", got, tt.mean)
+			}
+			if got := CalculateScoreWith(config.ScoringPenalized, tt.dims); got != tt.penalty {
+				t.Errorf("penalized = %d, want %d", got, tt.penalty)
+			}
+		})
+	}
+}
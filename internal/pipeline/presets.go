@@ -0,0 +1,123 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Preset is a named bundle of project-type defaults applied by
+// sdd_init_project: clarity dimension weights that fit the project type
+// and guidance for writing its specs.
+type Preset struct {
+	Name    string
+	Summary string
+	// Weights overrides the DefaultDimensions weights by dimension name.
+	Weights map[string]int
+	// OutOfScope lists things this kind of project usually leaves out —
+	// hints for the charter's out-of-scope section.
+	OutOfScope []string
+	// Guidance is a short paragraph on what its specs must pin down.
+	Guidance string
+}
+
+// presets lists the built-in presets, in the order they are presented.
+var presets = []Preset{
+	{
+		Name:    "library",
+		Summary: "reusable package consumed by other developers",
+		Weights: map[string]int{
+			"target_users":      6,
+			"integrations":      5,
+			"scale_performance": 3,
+			"security":          5,
+		},
+		OutOfScope: []string{
+			"hosted service, deployment, or infrastructure",
+			"end-user UI",
+			"user accounts and authentication",
+		},
+		Guidance: "Specify the public API surface, supported language/runtime versions, and " +
+			"compatibility guarantees. Users are developers — describe what they call, not screens.",
+	},
+	{
+		Name:    "cli",
+		Summary: "command-line tool run by a person or a script",
+		Weights: map[string]int{
+			"integrations":      5,
+			"security":          5,
+			"scale_performance": 4,
+			"edge_cases":        9,
+		},
+		OutOfScope: []string{
+			"graphical UI",
+			"long-running server process",
+			"multi-user accounts",
+		},
+		Guidance: "Specify commands, flags, exit codes, and output formats (human and machine-readable). " +
+			"Cover bad input, missing files, and interrupted runs.",
+	},
+	{
+		Name:    "web-api",
+		Summary: "HTTP API serving clients over the network",
+		Weights: map[string]int{
+			"security":          10,
+			"integrations":      8,
+			"scale_performance": 7,
+			"data_model":        8,
+		},
+		OutOfScope: []string{
+			"frontend UI",
+			"client SDKs",
+			"admin dashboard",
+		},
+		Guidance: "Specify endpoints, authentication and authorization, error responses, and rate limits. " +
+			"State versioning and backward-compatibility expectations.",
+	},
+	{
+		Name:    "service",
+		Summary: "background service, worker, or daemon",
+		Weights: map[string]int{
+			"integrations":      8,
+			"scale_performance": 8,
+			"edge_cases":        9,
+			"target_users":      5,
+		},
+		OutOfScope: []string{
+			"end-user UI",
+			"public API for third parties",
+		},
+		Guidance: "Specify inputs and outputs (queues, schedules, events), failure and retry behavior, " +
+			"idempotency, and what operators monitor.",
+	},
+}
+
+// PresetNames returns the built-in preset names, in presentation order.
+func PresetNames() []string {
+	names := make([]string, len(presets))
+	for i, p := range presets {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// LookupPreset returns the named preset. Unknown names error with the
+// list of valid ones.
+func LookupPreset(name string) (Preset, error) {
+	for _, p := range presets {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return Preset{}, fmt.Errorf("unknown preset %q — valid presets: %s", name, strings.Join(PresetNames(), ", "))
+}
+
+// ApplyWeights overrides dimension weights by name and returns the
+// dimensions. Names that match no dimension are ignored.
+func ApplyWeights(dimensions []ClarityDimension, weights map[string]int) []ClarityDimension {
+	for i := range dimensions {
+		if w, ok := weights[dimensions[i].Name]; ok {
+			dimensions[i].Weight = w
+		}
+	}
+	return dimensions
+}
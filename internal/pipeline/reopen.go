@@ -0,0 +1,97 @@
+package pipeline
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// DefaultReopenStage is where a new iteration starts when the caller
+// doesn't choose: the charter, so the problem and scope are revisited
+// before the requirements change.
+const DefaultReopenStage = config.StageCharter
+
+// Reopen starts a new iteration of a completed project: it bumps
+// cfg.Version's minor number, resets stage and every later stage to
+// pending, and makes stage current. An empty stage means
+// DefaultReopenStage. Earlier stages stay completed.
+//
+// Reopening at or before clarify also clears the clarity score, so the
+// Clarity Gate is passed again for the changed requirements.
+//
+// Reopen only changes state; archiving the previous iteration's
+// artifacts is the caller's job, done before the config is saved.
+func Reopen(cfg *config.ProjectConfig, stage config.Stage) error {
+	if stage == "" {
+		stage = DefaultReopenStage
+	}
+	order := config.StageOrderFor(cfg)
+	final := order[len(order)-1]
+	if !IsCompleted(cfg, final) {
+		return fmt.Errorf("the project isn't complete yet (currently at '%s') — "+
+			"a new iteration starts after the %s stage is completed", cfg.CurrentStage, final)
+	}
+	idx := indexIn(order, stage)
+	if idx < 0 {
+		return fmt.Errorf("stage %s is not part of this project's pipeline", stage)
+	}
+	if idx == 0 {
+		return fmt.Errorf("cannot reopen at %s — choose a stage after it", stage)
+	}
+
+	version, err := BumpMinor(cfg.Version)
+	if err != nil {
+		return err
+	}
+	cfg.Version = version
+
+	for _, s := range order[idx:] {
+		cfg.StageStatus[s] = config.StageStatus{Status: "pending"}
+	}
+	if indexIn(order[idx:], config.StageClarify) >= 0 {
+		cfg.ClarityScore = 0
+		cfg.DimensionScores = nil
+		cfg.ClarityGatePassed = nil
+	}
+	cfg.CurrentStage = stage
+	markInProgress(cfg, stage)
+	return nil
+}
+
+// BumpMinor returns the semantic version after version with its minor
+// number incremented and patch reset: "1.0.3" → "1.1.0". A leading "v"
+// is kept. An empty version is treated as "0.1.0", the initial version.
+func BumpMinor(version string) (string, error) {
+	if version == "" {
+		version = "0.1.0"
+	}
+	parts, err := ParseVersion(version)
+	if err != nil {
+		return "", err
+	}
+	prefix := ""
+	if strings.HasPrefix(version, "v") {
+		prefix = "v"
+	}
+	return fmt.Sprintf("%s%d.%d.0", prefix, parts[0], parts[1]+1), nil
+}
+
+// ParseVersion parses a MAJOR.MINOR.PATCH version, with an optional
+// leading "v".
+func ParseVersion(version string) ([3]int, error) {
+	var parts [3]int
+	fields := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(fields) != 3 {
+		return parts, fmt.Errorf("version %q is not MAJOR.MINOR.PATCH", version)
+	}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("version %q is not MAJOR.MINOR.PATCH", version)
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
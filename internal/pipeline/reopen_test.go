@@ -0,0 +1,90 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// completedProject returns a config whose whole pipeline is completed.
+func completedProject(t *testing.T) *config.ProjectConfig {
+	t.Helper()
+	cfg := config.NewProjectConfig("x", "y", config.ModeGuided)
+	cfg.ClarityScore = 85
+	cfg.DimensionScores = map[string]int{"target_users": 85}
+	cfg.ClarityGatePassed = &config.ClarityGatePass{Score: 85}
+	if err := CompleteThrough(cfg, config.StageValidate); err != nil {
+		t.Fatal(err)
+	}
+	markCompleted(cfg, config.StageValidate, "")
+	return cfg
+}
+
+func TestReopen_DefaultStage(t *testing.T) {
+	cfg := completedProject(t)
+	if err := Reopen(cfg, ""); err != nil {
+		t.Fatalf("Reopen: %v", err)
+	}
+
+	if cfg.Version != "0.2.0" {
+		t.Errorf("Version = %q, want 0.2.0", cfg.Version)
+	}
+	if cfg.CurrentStage != config.StageCharter {
+		t.Errorf("CurrentStage = %s, want charter", cfg.CurrentStage)
+	}
+	if !IsCompleted(cfg, config.StagePrinciples) {
+		t.Error("stages before the reopened one should stay completed")
+	}
+	if got := cfg.StageStatus[config.StageCharter]; got.Status != "in_progress" || got.Iterations != 1 {
+		t.Errorf("charter status = %+v, want fresh in_progress", got)
+	}
+	for _, s := range []config.Stage{config.StageSpecify, config.StageClarify, config.StageValidate} {
+		if got := cfg.StageStatus[s].Status; got != "pending" {
+			t.Errorf("%s status = %s, want pending", s, got)
+		}
+	}
+	if cfg.ClarityScore != 0 || cfg.DimensionScores != nil || cfg.ClarityGatePassed != nil {
+		t.Errorf("clarity should reset when clarify is reopened: score %d, dims %v, gate %+v",
+			cfg.ClarityScore, cfg.DimensionScores, cfg.ClarityGatePassed)
+	}
+}
+
+func TestReopen_AfterClarifyKeepsScore(t *testing.T) {
+	cfg := completedProject(t)
+	if err := Reopen(cfg, config.StageDesign); err != nil {
+		t.Fatalf("Reopen: %v", err)
+	}
+	if cfg.ClarityScore != 85 || !IsCompleted(cfg, config.StageClarify) {
+		t.Errorf("reopening after clarify should keep the gate passed (score %d)", cfg.ClarityScore)
+	}
+}
+
+func TestReopen_Errors(t *testing.T) {
+	inProgress := config.NewProjectConfig("x", "y", config.ModeGuided)
+	if err := Reopen(inProgress, ""); err == nil {
+		t.Error("reopening an incomplete project should fail")
+	}
+	if err := Reopen(completedProject(t), config.StageInit); err == nil {
+		t.Error("reopening at init should fail")
+	}
+	if err := Reopen(completedProject(t), config.StageResearch); err == nil {
+		t.Error("reopening at a stage outside the pipeline should fail")
+	}
+	bad := completedProject(t)
+	bad.Version = "one"
+	if err := Reopen(bad, ""); err == nil {
+		t.Error("a non-semver version should fail")
+	}
+}
+
+func TestBumpMinor(t *testing.T) {
+	tests := map[string]string{"": "0.2.0", "1.0.3": "1.1.0", "v2.4.0": "v2.5.0"}
+	for in, want := range tests {
+		if got, err := BumpMinor(in); err != nil || got != want {
+			t.Errorf("BumpMinor(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := BumpMinor("1.0"); err == nil {
+		t.Error("BumpMinor(1.0) should fail")
+	}
+}
@@ -6,11 +6,23 @@
 package pipeline
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
 )
 
+// Sentinel errors for pipeline rule violations. Errors returned by
+// RequireStage and CanAdvance wrap them, so callers can tell the
+// violations apart with errors.Is.
+var (
+	// ErrWrongStage means a tool ran at the wrong pipeline stage.
+	ErrWrongStage = errors.New("wrong pipeline stage")
+	// ErrGateNotPassed means the clarity score is below the threshold.
+	ErrGateNotPassed = errors.New("clarity gate not passed")
+)
+
 // --- Clarity Gate thresholds (Liskov-safe: both modes use the same interface) ---
 
 const (
@@ -28,11 +40,60 @@ func ClarityThreshold(mode config.Mode) int {
 	return ClarityThresholdGuided
 }
 
+// ProjectClarityThreshold returns the clarity threshold in effect for a
+// project: its explicit override when set, otherwise the mode default.
+func ProjectClarityThreshold(cfg *config.ProjectConfig) int {
+	if cfg.ClarityThreshold > 0 {
+		return cfg.ClarityThreshold
+	}
+	return ClarityThreshold(cfg.Mode)
+}
+
+// ProjectScore computes the Clarity Gate score of dimensions under
+// cfg's ScoringScheme.
+func ProjectScore(cfg *config.ProjectConfig, dimensions []ClarityDimension) int {
+	return CalculateScoreWith(cfg.ScoringScheme, dimensions)
+}
+
+// DefaultClarityMargin is how many points above the threshold a passing
+// score counts as marginal when the project sets no clarity_margin.
+const DefaultClarityMargin = 5
+
+// ProjectClarityMargin returns the marginal-pass margin for cfg: its
+// ClarityMargin, DefaultClarityMargin when unset, or 0 when turned off.
+func ProjectClarityMargin(cfg *config.ProjectConfig) int {
+	switch {
+	case cfg.ClarityMargin < 0:
+		return 0
+	case cfg.ClarityMargin == 0:
+		return DefaultClarityMargin
+	}
+	return cfg.ClarityMargin
+}
+
+// MarginalPass reports whether score passes the Clarity Gate at
+// threshold by less than cfg's margin — enough to advance, but likely
+// still a weak spec.
+func MarginalPass(cfg *config.ProjectConfig, score, threshold int) bool {
+	return score >= threshold && score-threshold < ProjectClarityMargin(cfg)
+}
+
 // --- State machine ---
 
-// StageIndex returns the ordinal position of a stage, or -1 if unknown.
+// StageIndex returns the ordinal position of a stage in the default
+// pipeline, or -1 if unknown.
 func StageIndex(stage config.Stage) int {
-	for i, s := range config.StageOrder {
+	return indexIn(config.StageOrder, stage)
+}
+
+// StageIndexFor returns the ordinal position of a stage in the project's
+// pipeline (including enabled optional stages), or -1 if not present.
+func StageIndexFor(cfg *config.ProjectConfig, stage config.Stage) int {
+	return indexIn(config.StageOrderFor(cfg), stage)
+}
+
+func indexIn(order []config.Stage, stage config.Stage) int {
+	for i, s := range order {
 		if s == stage {
 			return i
 		}
@@ -45,21 +106,22 @@ func StageIndex(stage config.Stage) int {
 // until the clarity score meets the threshold for the active mode.
 func CanAdvance(cfg *config.ProjectConfig) error {
 	if cfg.CurrentStage == config.StageClarify {
-		threshold := ClarityThreshold(cfg.Mode)
+		threshold := ProjectClarityThreshold(cfg)
 		if cfg.ClarityScore < threshold {
 			return fmt.Errorf(
-				"clarity gate not passed: score %d/%d (need %d for %s mode) — "+
+				"%w: score %d/%d (need %d for %s mode) — "+
 					"run sdd_clarify to resolve ambiguities",
-				cfg.ClarityScore, 100, threshold, cfg.Mode,
+				ErrGateNotPassed, cfg.ClarityScore, 100, threshold, cfg.Mode,
 			)
 		}
 	}
 
-	idx := StageIndex(cfg.CurrentStage)
+	order := config.StageOrderFor(cfg)
+	idx := indexIn(order, cfg.CurrentStage)
 	if idx < 0 {
 		return fmt.Errorf("unknown stage: %s", cfg.CurrentStage)
 	}
-	if idx >= len(config.StageOrder)-1 {
+	if idx >= len(order)-1 {
 		return fmt.Errorf("already at the final stage: %s", cfg.CurrentStage)
 	}
 
@@ -67,17 +129,31 @@ func CanAdvance(cfg *config.ProjectConfig) error {
 }
 
 // Advance moves the pipeline to the next stage. It validates the
-// transition first and updates stage statuses atomically.
+// transition first and updates stage statuses atomically. The completed
+// stage is attributed to config.UnknownActor; use AdvanceBy when the
+// caller is known.
 func Advance(cfg *config.ProjectConfig) error {
+	return AdvanceBy(cfg, "")
+}
+
+// AdvanceBy is Advance that records actor — the client or agent that
+// produced the stage's artifact — as the completed stage's CompletedBy.
+// An empty actor is recorded as config.UnknownActor. Leaving clarify
+// records the Clarity Gate pass (see recordGatePass), whichever tool
+// advanced.
+func AdvanceBy(cfg *config.ProjectConfig, actor string) error {
 	if err := CanAdvance(cfg); err != nil {
 		return err
 	}
+	if cfg.CurrentStage == config.StageClarify {
+		recordGatePass(cfg)
+	}
 
-	idx := StageIndex(cfg.CurrentStage)
-	nextStage := config.StageOrder[idx+1]
+	order := config.StageOrderFor(cfg)
+	nextStage := order[indexIn(order, cfg.CurrentStage)+1]
 
 	// Mark current as completed.
-	markCompleted(cfg, cfg.CurrentStage)
+	markCompleted(cfg, cfg.CurrentStage, actor)
 
 	// Move forward.
 	cfg.CurrentStage = nextStage
@@ -86,6 +162,57 @@ func Advance(cfg *config.ProjectConfig) error {
 	return nil
 }
 
+// recordGatePass sets cfg.ClarityGatePassed from the project's current
+// clarity state: the score, the threshold and mode it passed under, the
+// clarify round, and the latest score of each dimension. Evidence is
+// left for sdd_clarify to fill in — only it has the justifications.
+func recordGatePass(cfg *config.ProjectConfig) {
+	var dimensions []config.DimensionScore
+	for _, d := range DefaultDimensions() {
+		if score, ok := cfg.DimensionScores[d.Name]; ok {
+			dimensions = append(dimensions, config.DimensionScore{Name: d.Name, Score: score})
+		}
+	}
+	cfg.ClarityGatePassed = &config.ClarityGatePass{
+		At:         Now(),
+		Mode:       cfg.Mode,
+		Threshold:  ProjectClarityThreshold(cfg),
+		Score:      cfg.ClarityScore,
+		Round:      cfg.StageStatus[config.StageClarify].Iterations,
+		Dimensions: dimensions,
+	}
+}
+
+// CompleteThrough marks every stage up to and including stage as
+// completed and moves the pipeline to the stage after it. It is used to
+// rebuild state for an existing set of artifacts, so it enforces the
+// Clarity Gate exactly like Advance: completing clarify requires the
+// project's clarity score to meet the threshold. If stage is the final
+// stage, it stays current.
+func CompleteThrough(cfg *config.ProjectConfig, stage config.Stage) error {
+	order := config.StageOrderFor(cfg)
+	idx := indexIn(order, stage)
+	if idx < 0 {
+		return fmt.Errorf("stage %s is not part of this project's pipeline", stage)
+	}
+	if indexIn(order[:idx+1], config.StageClarify) >= 0 {
+		if threshold := ProjectClarityThreshold(cfg); cfg.ClarityScore < threshold {
+			return fmt.Errorf("cannot complete clarify: clarity score %d is below %d", cfg.ClarityScore, threshold)
+		}
+	}
+
+	for _, s := range order[:idx+1] {
+		markCompleted(cfg, s, "")
+	}
+	if idx == len(order)-1 {
+		cfg.CurrentStage = stage
+		return nil
+	}
+	cfg.CurrentStage = order[idx+1]
+	markInProgress(cfg, cfg.CurrentStage)
+	return nil
+}
+
 // MarkInProgress marks the current stage as actively being worked on.
 func MarkInProgress(cfg *config.ProjectConfig) {
 	markInProgress(cfg, cfg.CurrentStage)
@@ -97,6 +224,12 @@ func IsCompleted(cfg *config.ProjectConfig, stage config.Stage) bool {
 	return ok && st.Status == "completed"
 }
 
+// IsSkipped checks whether a specific stage was marked skipped.
+func IsSkipped(cfg *config.ProjectConfig, stage config.Stage) bool {
+	st, ok := cfg.StageStatus[stage]
+	return ok && st.Status == "skipped"
+}
+
 // RequireStage returns an error if the current stage doesn't match expected.
 // Tools use this to ensure they're called at the right pipeline moment.
 func RequireStage(cfg *config.ProjectConfig, expected config.Stage) error {
@@ -104,8 +237,8 @@ func RequireStage(cfg *config.ProjectConfig, expected config.Stage) error {
 		current := config.Stages[cfg.CurrentStage]
 		exp := config.Stages[expected]
 		return fmt.Errorf(
-			"wrong pipeline stage: currently at '%s' (%s), but this tool requires '%s' (%s)",
-			cfg.CurrentStage, current.Name, expected, exp.Name,
+			"%w: currently at '%s' (%s), but this tool requires '%s' (%s)",
+			ErrWrongStage, cfg.CurrentStage, current.Name, expected, exp.Name,
 		)
 	}
 	return nil
@@ -113,10 +246,11 @@ func RequireStage(cfg *config.ProjectConfig, expected config.Stage) error {
 
 // --- internal helpers ---
 
-func markCompleted(cfg *config.ProjectConfig, stage config.Stage) {
+func markCompleted(cfg *config.ProjectConfig, stage config.Stage, actor string) {
 	st := cfg.StageStatus[stage]
 	st.Status = "completed"
 	st.CompletedAt = now()
+	st.CompletedBy = ActorOrUnknown(actor)
 	cfg.StageStatus[stage] = st
 }
 
@@ -130,6 +264,14 @@ func markInProgress(cfg *config.ProjectConfig, stage config.Stage) {
 	cfg.StageStatus[stage] = st
 }
 
+// ActorOrUnknown returns actor, or config.UnknownActor when it is blank.
+func ActorOrUnknown(actor string) string {
+	if actor = strings.TrimSpace(actor); actor == "" {
+		return config.UnknownActor
+	}
+	return actor
+}
+
 func now() string {
 	return Now()
 }
@@ -128,6 +128,51 @@ func TestCanAdvance_ClarifyGate_ExpertMode_AtThreshold(t *testing.T) {
 	}
 }
 
+func TestCanAdvance_ClarifyGate_ProjectThresholdOverride(t *testing.T) {
+	cfg := newTestConfig(config.StageClarify, config.ModeExpert, 60)
+	cfg.ClarityThreshold = 80
+	if err := CanAdvance(cfg); err == nil {
+		t.Fatal("CanAdvance(clarify, score=60, threshold=80) should fail")
+	}
+	cfg.ClarityScore = 80
+	if err := CanAdvance(cfg); err != nil {
+		t.Errorf("CanAdvance(clarify, score=80, threshold=80) should pass, got: %v", err)
+	}
+}
+
+func TestProjectClarityThreshold(t *testing.T) {
+	cfg := newTestConfig(config.StageClarify, config.ModeGuided, 0)
+	if got := ProjectClarityThreshold(cfg); got != ClarityThresholdGuided {
+		t.Errorf("ProjectClarityThreshold() = %d, want mode default %d", got, ClarityThresholdGuided)
+	}
+	cfg.ClarityThreshold = 85
+	if got := ProjectClarityThreshold(cfg); got != 85 {
+		t.Errorf("ProjectClarityThreshold() = %d, want override 85", got)
+	}
+}
+
+func TestMarginalPass(t *testing.T) {
+	cfg := newTestConfig(config.StageClarify, config.ModeGuided, 0)
+	tests := []struct {
+		margin, score int
+		want          bool
+	}{
+		{0, 69, false}, // below the threshold is a fail, not a marginal pass
+		{0, 70, true},
+		{0, 74, true},
+		{0, 75, false},
+		{0, 80, false},
+		{10, 79, true},
+		{-1, 70, false},
+	}
+	for _, tt := range tests {
+		cfg.ClarityMargin = tt.margin
+		if got := MarginalPass(cfg, tt.score, 70); got != tt.want {
+			t.Errorf("MarginalPass(margin %d, score %d) = %v, want %v", tt.margin, tt.score, got, tt.want)
+		}
+	}
+}
+
 func TestCanAdvance_FinalStage(t *testing.T) {
 	cfg := newTestConfig(config.StageValidate, config.ModeGuided, 100)
 	err := CanAdvance(cfg)
@@ -175,6 +220,46 @@ func TestAdvance_MarksPreviousCompleted(t *testing.T) {
 	}
 }
 
+func TestAdvanceBy_RecordsGatePass(t *testing.T) {
+	cfg := newTestConfig(config.StageCharter, config.ModeGuided, 0)
+	if err := Advance(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ClarityGatePassed != nil {
+		t.Errorf("leaving charter must not record a gate pass: %+v", cfg.ClarityGatePassed)
+	}
+
+	cfg = newTestConfig(config.StageClarify, config.ModeExpert, 62)
+	cfg.DimensionScores = map[string]int{"target_users": 80, "security": 40}
+	if err := AdvanceBy(cfg, "cli"); err != nil {
+		t.Fatalf("AdvanceBy(clarify) failed: %v", err)
+	}
+	pass := cfg.ClarityGatePassed
+	if pass == nil || pass.Score != 62 || pass.Mode != config.ModeExpert || pass.Threshold != ProjectClarityThreshold(cfg) || pass.At == "" {
+		t.Fatalf("ClarityGatePassed = %+v, want the score, mode and threshold it passed with", pass)
+	}
+	if len(pass.Dimensions) != 2 {
+		t.Errorf("Dimensions = %+v, want the two scored dimensions", pass.Dimensions)
+	}
+}
+
+func TestAdvanceBy_RecordsActor(t *testing.T) {
+	cfg := newTestConfig(config.StageCharter, config.ModeGuided, 0)
+	if err := AdvanceBy(cfg, " claude-code "); err != nil {
+		t.Fatalf("AdvanceBy failed: %v", err)
+	}
+	if got := cfg.StageStatus[config.StageCharter].CompletedBy; got != "claude-code" {
+		t.Errorf("charter CompletedBy = %q, want claude-code", got)
+	}
+
+	if err := Advance(cfg); err != nil {
+		t.Fatalf("Advance failed: %v", err)
+	}
+	if got := cfg.StageStatus[config.StageSpecify].CompletedBy; got != config.UnknownActor {
+		t.Errorf("specify CompletedBy = %q, want %q", got, config.UnknownActor)
+	}
+}
+
 func TestAdvance_MarksNextInProgress(t *testing.T) {
 	cfg := newTestConfig(config.StageCharter, config.ModeGuided, 0)
 	_ = Advance(cfg)
@@ -244,6 +329,31 @@ func TestAdvance_FullPipeline(t *testing.T) {
 
 // --- MarkInProgress ---
 
+func TestAdvance_WithResearchEnabled(t *testing.T) {
+	cfg := config.NewProjectConfig("x", "y", config.ModeGuided)
+	if err := cfg.EnableOptionalStage(config.StageResearch); err != nil {
+		t.Fatal(err)
+	}
+	cfg.CurrentStage = config.StageClarify
+	cfg.ClarityScore = 100
+
+	if err := Advance(cfg); err != nil {
+		t.Fatalf("Advance from clarify: %v", err)
+	}
+	if cfg.CurrentStage != config.StageResearch {
+		t.Fatalf("CurrentStage = %s, want research", cfg.CurrentStage)
+	}
+	if err := Advance(cfg); err != nil {
+		t.Fatalf("Advance from research: %v", err)
+	}
+	if cfg.CurrentStage != config.StageDesign {
+		t.Errorf("CurrentStage = %s, want design", cfg.CurrentStage)
+	}
+	if StageIndexFor(cfg, config.StageDesign) != StageIndex(config.StageDesign)+1 {
+		t.Error("design should shift by one position when research is enabled")
+	}
+}
+
 func TestMarkInProgress_IncrementsIterations(t *testing.T) {
 	cfg := newTestConfig(config.StageCharter, config.ModeGuided, 0)
 
@@ -329,3 +439,61 @@ func containsSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestCompleteThrough(t *testing.T) {
+	cfg := newTestConfig(config.StageInit, config.ModeGuided, 80)
+
+	if err := CompleteThrough(cfg, config.StageDesign); err != nil {
+		t.Fatalf("CompleteThrough: %v", err)
+	}
+	if cfg.CurrentStage != config.StageTasks {
+		t.Errorf("CurrentStage = %s, want tasks", cfg.CurrentStage)
+	}
+	for _, stage := range []config.Stage{config.StageCharter, config.StageClarify, config.StageDesign} {
+		if !IsCompleted(cfg, stage) {
+			t.Errorf("%s should be completed", stage)
+		}
+	}
+	if cfg.StageStatus[config.StageTasks].Status != "in_progress" {
+		t.Errorf("tasks status = %s, want in_progress", cfg.StageStatus[config.StageTasks].Status)
+	}
+}
+
+func TestCompleteThrough_EnforcesClarityGate(t *testing.T) {
+	cfg := newTestConfig(config.StageInit, config.ModeGuided, 40)
+
+	if err := CompleteThrough(cfg, config.StageDesign); err == nil {
+		t.Fatal("expected clarity gate error")
+	}
+	if err := CompleteThrough(cfg, config.StageSpecify); err != nil {
+		t.Errorf("stages before clarify should not need a score: %v", err)
+	}
+}
+
+func TestCompleteThrough_FinalStage(t *testing.T) {
+	cfg := newTestConfig(config.StageInit, config.ModeExpert, 60)
+
+	if err := CompleteThrough(cfg, config.StageValidate); err != nil {
+		t.Fatalf("CompleteThrough: %v", err)
+	}
+	if cfg.CurrentStage != config.StageValidate || !IsCompleted(cfg, config.StageValidate) {
+		t.Errorf("final stage should stay current and completed, got %s", cfg.CurrentStage)
+	}
+}
+
+func TestProjectScore_UsesConfiguredScheme(t *testing.T) {
+	dims := DefaultDimensions()
+	for i := range dims {
+		dims[i].Score = 100
+	}
+	dims[1].Score = 0 // core_functionality
+
+	cfg := config.NewProjectConfig("demo", "", config.ModeGuided)
+	if got := ProjectScore(cfg, dims); got != 83 {
+		t.Errorf("ProjectScore(default) = %d, want 83", got)
+	}
+	cfg.ScoringScheme = config.ScoringPenalized
+	if got := ProjectScore(cfg, dims); got != 43 {
+		t.Errorf("ProjectScore(penalized) = %d, want 43", got)
+	}
+}
@@ -0,0 +1,75 @@
+package pipeline
+
+import (
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// Transition is an operation a client may offer on a project, e.g. as a
+// button. ValidTransitions lists the ones the project allows right now.
+type Transition string
+
+const (
+	// TransitionSubmit runs the current stage's tool. Every stage tool
+	// advances on success except sdd_clarify, whose rounds advance only
+	// once the Clarity Gate is met, and sdd_validate, which completes the
+	// pipeline.
+	TransitionSubmit Transition = "submit"
+	// TransitionAdvance leaves the current stage without another
+	// submission — the Clarity Gate was met, e.g. by a mode switch
+	// lowering the threshold (sdd_set_mode advance=true).
+	TransitionAdvance Transition = "advance"
+	// TransitionSetMode switches between guided and expert mode
+	// (sdd_set_mode), which moves the Clarity Gate threshold.
+	TransitionSetMode Transition = "set_mode"
+	// TransitionReopen starts a new iteration of a completed project
+	// (sdd_new_iteration).
+	TransitionReopen Transition = "reopen"
+	// TransitionReset archives the project and starts over
+	// (sdd_init_project force=true).
+	TransitionReset Transition = "reset"
+)
+
+// ValidTransitions returns the operations allowed on cfg, in the order
+// above. It gathers the rules of CanAdvance, the Clarity Gate and
+// Reopen in one place for clients deciding what to offer; each tool
+// still enforces its own rule.
+//
+// There is no skip: every stage's artifact feeds the next, so stages
+// are left only by completing them.
+func ValidTransitions(cfg *config.ProjectConfig) []Transition {
+	order := config.StageOrderFor(cfg)
+	complete := IsCompleted(cfg, order[len(order)-1])
+
+	var out []Transition
+	if !complete && cfg.CurrentStage != config.StageInit {
+		out = append(out, TransitionSubmit)
+	}
+	if cfg.CurrentStage == config.StageClarify && CanAdvance(cfg) == nil {
+		out = append(out, TransitionAdvance)
+	}
+	out = append(out, TransitionSetMode)
+	if complete {
+		out = append(out, TransitionReopen)
+	}
+	return append(out, TransitionReset)
+}
+
+// TransitionTool returns the tool call that performs tr on cfg.
+func TransitionTool(cfg *config.ProjectConfig, tr Transition) string {
+	switch tr {
+	case TransitionSubmit:
+		if cfg.CurrentStage == config.StageValidate {
+			return "sdd_validate"
+		}
+		return stageProducers[cfg.CurrentStage]
+	case TransitionAdvance:
+		return "sdd_set_mode advance=true"
+	case TransitionSetMode:
+		return "sdd_set_mode"
+	case TransitionReopen:
+		return "sdd_new_iteration"
+	case TransitionReset:
+		return "sdd_init_project force=true"
+	}
+	return ""
+}
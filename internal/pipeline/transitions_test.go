@@ -0,0 +1,73 @@
+package pipeline
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+func TestValidTransitions_EachStage(t *testing.T) {
+	for _, stage := range config.StageOrder[1:] {
+		cfg := config.NewProjectConfig("x", "y", config.ModeGuided)
+		if StageIndex(stage) > StageIndex(config.StageClarify) {
+			cfg.ClarityScore = 100 // past the gate
+		}
+		if stage != config.StagePrinciples {
+			if err := CompleteThrough(cfg, config.StageOrder[StageIndex(stage)-1]); err != nil {
+				t.Fatalf("%s: %v", stage, err)
+			}
+		}
+		if cfg.CurrentStage != stage {
+			t.Fatalf("setup: at %s, want %s", cfg.CurrentStage, stage)
+		}
+
+		want := []Transition{TransitionSubmit, TransitionSetMode, TransitionReset}
+		if got := ValidTransitions(cfg); !slices.Equal(got, want) {
+			t.Errorf("%s: ValidTransitions = %v, want %v", stage, got, want)
+		}
+		if tool := TransitionTool(cfg, TransitionSubmit); tool == "" {
+			t.Errorf("%s: no tool for submit", stage)
+		}
+	}
+}
+
+func TestValidTransitions_ClarityGate(t *testing.T) {
+	cfg := config.NewProjectConfig("x", "y", config.ModeGuided)
+	if err := CompleteThrough(cfg, config.StageBusinessRules); err != nil {
+		t.Fatal(err)
+	}
+	cfg.ClarityScore = ClarityThresholdGuided - 1
+	if slices.Contains(ValidTransitions(cfg), TransitionAdvance) {
+		t.Error("advance offered below the Clarity Gate threshold")
+	}
+
+	cfg.ClarityScore = ClarityThresholdGuided
+	want := []Transition{TransitionSubmit, TransitionAdvance, TransitionSetMode, TransitionReset}
+	if got := ValidTransitions(cfg); !slices.Equal(got, want) {
+		t.Errorf("gate met: ValidTransitions = %v, want %v", got, want)
+	}
+	if got := TransitionTool(cfg, TransitionSubmit); got != "sdd_clarify" {
+		t.Errorf("submit tool at clarify = %q", got)
+	}
+}
+
+func TestValidTransitions_Complete(t *testing.T) {
+	cfg := config.NewProjectConfig("x", "y", config.ModeGuided)
+	cfg.ClarityScore = 100
+	if err := CompleteThrough(cfg, config.StageValidate); err != nil {
+		t.Fatal(err)
+	}
+	want := []Transition{TransitionSetMode, TransitionReopen, TransitionReset}
+	if got := ValidTransitions(cfg); !slices.Equal(got, want) {
+		t.Errorf("complete: ValidTransitions = %v, want %v", got, want)
+	}
+
+	// Reopening brings back submit at the reopened stage.
+	if err := Reopen(cfg, config.StageDesign); err != nil {
+		t.Fatal(err)
+	}
+	if got := ValidTransitions(cfg); !slices.Contains(got, TransitionSubmit) || slices.Contains(got, TransitionReopen) {
+		t.Errorf("after reopen: ValidTransitions = %v", got)
+	}
+}
@@ -0,0 +1,117 @@
+// Package plaintext turns the markdown Hoofy renders into plain text
+// for clients that show tool output raw, such as a terminal. It covers
+// the markdown the templates and tools produce — headings, lists,
+// emphasis, links, code, quotes, rules and tables — not all of
+// CommonMark.
+package plaintext
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	headingLine   = regexp.MustCompile(`^#{1,6}\s+(.*?)\s*#*\s*$`)
+	bulletLine    = regexp.MustCompile(`^(\s*)[*+-]\s+`)
+	quoteLine     = regexp.MustCompile(`^\s*>\s?`)
+	ruleLine      = regexp.MustCompile(`^\s*(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	tableDivider  = regexp.MustCompile(`^\s*\|?\s*:?-{3,}:?\s*(?:\|\s*:?-{3,}:?\s*)*\|?\s*$`)
+	imagePattern  = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	linkPattern   = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	strongPattern = regexp.MustCompile(`\*\*([^\s*](?:.*?[^\s*])?)\*\*|__([^\s_](?:.*?[^\s_])?)__`)
+	strikePattern = regexp.MustCompile(`~~([^~]+)~~`)
+	// Single * and _ only count as emphasis at word boundaries, so
+	// snake_case names and "2 * 3" are left alone.
+	emPattern = regexp.MustCompile(`(^|[^\w*])\*([^\s*](?:[^*]*[^\s*])?)\*($|[^\w*])`)
+	uPattern  = regexp.MustCompile(`(^|[^\w])_([^\s_](?:[^_]*[^\s_])?)_($|[^\w])`)
+)
+
+// FromMarkdown returns md as plain text: headings become upper-case
+// lines, bullets become dashes, and emphasis, link syntax, backticks,
+// quote markers and table dividers are removed. Fenced code keeps its
+// content, indented, and is otherwise left as written.
+func FromMarkdown(md string) string {
+	lines := strings.Split(md, "\n")
+	out := make([]string, 0, len(lines))
+	inFence := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			out = append(out, "    "+line)
+			continue
+		}
+		out = append(out, convertLine(line))
+	}
+	return strings.Join(out, "\n")
+}
+
+// convertLine converts one line outside a code fence.
+func convertLine(line string) string {
+	switch {
+	case ruleLine.MatchString(line):
+		return strings.Repeat("-", 40)
+	case tableDivider.MatchString(line) && strings.Contains(line, "|"):
+		return ""
+	}
+
+	if m := headingLine.FindStringSubmatch(line); m != nil {
+		return strings.ToUpper(inline(m[1]))
+	}
+
+	prefix := ""
+	if loc := quoteLine.FindStringIndex(line); loc != nil {
+		prefix, line = "  ", line[loc[1]:]
+	}
+	if m := bulletLine.FindStringSubmatch(line); m != nil {
+		prefix += m[1] + "- "
+		line = line[len(m[0]):]
+	}
+	if t := strings.TrimSpace(line); strings.HasPrefix(t, "|") && strings.HasSuffix(t, "|") && len(t) > 1 {
+		cells := strings.Split(t[1:len(t)-1], "|")
+		for i, c := range cells {
+			cells[i] = strings.TrimSpace(c)
+		}
+		line = strings.Join(cells, " | ")
+	}
+	return prefix + inline(line)
+}
+
+// inline removes inline markup, leaving the text of code spans as
+// written.
+func inline(s string) string {
+	parts := strings.Split(s, "`")
+	if len(parts)%2 == 0 {
+		// An unmatched backtick is text, not a code span.
+		parts[len(parts)-2] += "`" + parts[len(parts)-1]
+		parts = parts[:len(parts)-1]
+	}
+	for i := 0; i < len(parts); i += 2 {
+		parts[i] = emphasis(parts[i])
+	}
+	return strings.Join(parts, "")
+}
+
+// emphasis removes links, images and emphasis markers from text.
+func emphasis(s string) string {
+	s = imagePattern.ReplaceAllString(s, "$1")
+	s = linkPattern.ReplaceAllStringFunc(s, func(m string) string {
+		sub := linkPattern.FindStringSubmatch(m)
+		if sub[1] == sub[2] {
+			return sub[1]
+		}
+		return sub[1] + " (" + sub[2] + ")"
+	})
+	s = strongPattern.ReplaceAllString(s, "$1$2")
+	s = strikePattern.ReplaceAllString(s, "$1")
+	// The patterns consume the boundary characters, so adjacent matches
+	// need a second pass.
+	for range 2 {
+		s = emPattern.ReplaceAllString(s, "$1$2$3")
+		s = uPattern.ReplaceAllString(s, "$1$2$3")
+	}
+	return s
+}
@@ -0,0 +1,47 @@
+package plaintext
+
+import "testing"
+
+func TestFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{"h1", "# SDD Project: demo", "SDD PROJECT: DEMO"},
+		{"h3 with closing hashes", "### Current Stage ###", "CURRENT STAGE"},
+		{"heading with emphasis", "## **Next** Step", "NEXT STEP"},
+		{"bold", "**Mode:** guided", "Mode: guided"},
+		{"bold underscores", "__Mode__: guided", "Mode: guided"},
+		{"italic", "_Set up the project_ and *then* go", "Set up the project and then go"},
+		{"snake_case kept", "set word_budgets and skip_recommended_fields", "set word_budgets and skip_recommended_fields"},
+		{"multiplication kept", "2 * 3 * 4", "2 * 3 * 4"},
+		{"strikethrough", "~~old~~ new", "old new"},
+		{"code span", "call `sdd_validate` with **care**", "call sdd_validate with care"},
+		{"emphasis inside code kept", "`**raw**`", "**raw**"},
+		{"link", "see [the guide](https://example.com/guide)", "see the guide (https://example.com/guide)"},
+		{"bare link", "[https://example.com](https://example.com)", "https://example.com"},
+		{"image", "![diagram](d.png)", "diagram"},
+		{"star bullet", "* one", "- one"},
+		{"nested plus bullet", "  + **two**", "  - two"},
+		{"checkbox", "- [x] **Status:** done", "- [x] Status: done"},
+		{"quote", "> **Note:** careful", "  Note: careful"},
+		{"rule", "---", "----------------------------------------"},
+		{"table row", "| Stage | **Status** |", "Stage | Status"},
+		{"table divider", "|-------|:------:|", ""},
+		{"unmatched backtick", "a ` b", "a ` b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromMarkdown(tt.in); got != tt.want {
+				t.Errorf("FromMarkdown(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromMarkdown_CodeFence(t *testing.T) {
+	in := "# Title\n\n```go\n# not a heading\nx := **y**\n```\nafter **bold**"
+	want := "TITLE\n\n    # not a heading\n    x := **y**\nafter bold"
+	if got := FromMarkdown(in); got != want {
+		t.Errorf("FromMarkdown = %q, want %q", got, want)
+	}
+}
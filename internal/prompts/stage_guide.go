@@ -3,6 +3,7 @@ package prompts
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 )
@@ -53,6 +54,40 @@ func (p *StageGuidePrompt) Handle(_ context.Context, req mcp.GetPromptRequest) (
 	}, nil
 }
 
+// stageGuideHeadings maps each pipeline stage to the heading of its
+// section in stageGuideContent.
+var stageGuideHeadings = map[string]string{
+	"principles":     "## Stage 1: Principles",
+	"charter":        "## Stage 2: Charter",
+	"specify":        "## Stage 3: Specify",
+	"business-rules": "## Stage 4: Business Rules",
+	"clarify":        "## Stage 5: Clarify",
+	"research":       "## Optional: Research",
+	"design":         "## Stage 6: Design",
+	"tasks":          "## Stage 7: Tasks",
+	"validate":       "## Stage 8: Validate",
+}
+
+// StageGuideSection returns the stage guide's section for one pipeline
+// stage (e.g. "clarify"), heading included, or "" for a stage without
+// one. It lets the current-stage instructions carry just the guidance
+// that applies now instead of the whole guide.
+func StageGuideSection(stage string) string {
+	heading, ok := stageGuideHeadings[stage]
+	if !ok {
+		return ""
+	}
+	start := strings.Index(stageGuideContent, "\n"+heading)
+	if start < 0 {
+		return ""
+	}
+	section := stageGuideContent[start+1:]
+	if end := strings.Index(section, "\n## "); end >= 0 {
+		section = section[:end]
+	}
+	return strings.TrimSpace(section)
+}
+
 const stageGuideContent = `# SDD Stage-by-Stage Workflow Guide
 
 ## PRE-PIPELINE EXPLORATION
@@ -102,7 +137,8 @@ stage is informed by structured pre-work rather than ad-hoc conversation.
 
 ## Stage 2: Charter (Research: IREB Elicitation Techniques)
 
-1. Ask the user about their project idea
+1. Ask the user about their project idea — sdd_propose_questions returns
+   the interview script for the project's mode
 2. Use IREB elicitation techniques — ask about CONTEXT, not just features:
    - Instead of "What features do you want?" ask "What are the top 3 tasks
      your primary user performs daily that this tool should improve?"
@@ -162,6 +198,17 @@ stage is informed by structured pre-work rather than ad-hoc conversation.
 6. Call sdd_clarify WITH answers and your dimension_scores assessment
 7. If score < threshold, repeat from step 1
 
+## Optional: Research / Spike
+
+Only for projects initialized with enable_research=true — the stage sits
+between Clarify and Design and is skipped entirely otherwise.
+
+1. Identify the open technical questions the clarified requirements raise
+2. Evaluate candidate libraries/approaches against the requirements (FR/NFR IDs)
+3. Run small spikes where documentation alone can't answer the question
+4. Call sdd_record_research with options_evaluated, findings, recommendation
+   Optional params: questions, spikes, open_risks
+
 ## Stage 6: Design (Research: ADR format — Michael Nygard, SOLID — Robert C. Martin, Refactoring — Martin Fowler)
 
 1. Read ALL previous artifacts (use sdd_get_context for charter, requirements,
@@ -0,0 +1,85 @@
+// Package requirements provides lightweight analysis of the MoSCoW
+// requirement fields submitted to sdd_generate_requirements.
+//
+// It works on the raw markdown strings (templates.RequirementsData), so
+// callers can summarize requirements before or after they're rendered.
+package requirements
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+// Bucket names used as CountByBucket keys.
+const (
+	BucketMust   = "Must"
+	BucketShould = "Should"
+	BucketCould  = "Could"
+	BucketWont   = "Won't"
+	BucketNFR    = "NFR"
+)
+
+// Buckets lists the bucket names in display order.
+var Buckets = []string{BucketMust, BucketShould, BucketCould, BucketWont, BucketNFR}
+
+// idPattern matches FR-NNN / NFR-NNN requirement identifiers.
+var idPattern = regexp.MustCompile(`\b(?:FR|NFR)-\d{3,4}\b`)
+
+// CountByBucket counts the requirements in each MoSCoW bucket plus the
+// non-functional section. Distinct FR/NFR IDs are counted when a field
+// has any; otherwise each markdown list item counts as one requirement.
+// Empty and placeholder fields ("_None defined._") count as zero.
+func CountByBucket(data templates.RequirementsData) map[string]int {
+	return map[string]int{
+		BucketMust:   countField(data.MustHave),
+		BucketShould: countField(data.ShouldHave),
+		BucketCould:  countField(data.CouldHave),
+		BucketWont:   countField(data.WontHave),
+		BucketNFR:    countField(data.NonFunctional),
+	}
+}
+
+// FormatCounts renders counts in Buckets order,
+// e.g. "Must: 6, Should: 3, Could: 2, Won't: 4, NFR: 5".
+func FormatCounts(counts map[string]int) string {
+	parts := make([]string, 0, len(Buckets))
+	for _, b := range Buckets {
+		parts = append(parts, fmt.Sprintf("%s: %d", b, counts[b]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// countField counts requirements in one markdown field.
+func countField(field string) int {
+	if ids := idPattern.FindAllString(field, -1); len(ids) > 0 {
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			seen[id] = true
+		}
+		return len(seen)
+	}
+
+	n := 0
+	for _, line := range strings.Split(field, "\n") {
+		if isListItem(strings.TrimSpace(line)) {
+			n++
+		}
+	}
+	return n
+}
+
+// isListItem reports whether a trimmed line starts a bullet or
+// numbered markdown list item.
+func isListItem(line string) bool {
+	if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") || strings.HasPrefix(line, "+ ") {
+		return true
+	}
+	digits := 0
+	for digits < len(line) && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+	return digits > 0 && strings.HasPrefix(line[digits:], ". ")
+}
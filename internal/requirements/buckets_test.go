@@ -0,0 +1,35 @@
+package requirements
+
+import (
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+func TestCountByBucket(t *testing.T) {
+	data := templates.RequirementsData{
+		MustHave:      "- **FR-001**: Register\n- **FR-002**: Login\n  - sub-bullet mentioning FR-001 again",
+		ShouldHave:    "- Export CSV\n* Dark mode\n1. Webhooks",
+		CouldHave:     "_None defined for this version._",
+		WontHave:      "",
+		NonFunctional: "- **NFR-001**: p95 < 200ms",
+	}
+
+	got := CountByBucket(data)
+	want := map[string]int{
+		BucketMust:   2, // distinct IDs, duplicates ignored
+		BucketShould: 3, // no IDs: bullet and numbered lines
+		BucketCould:  0,
+		BucketWont:   0,
+		BucketNFR:    1,
+	}
+	for bucket, n := range want {
+		if got[bucket] != n {
+			t.Errorf("%s = %d, want %d", bucket, got[bucket], n)
+		}
+	}
+
+	if s := FormatCounts(got); s != "Must: 2, Should: 3, Could: 0, Won't: 0, NFR: 1" {
+		t.Errorf("FormatCounts = %q", s)
+	}
+}
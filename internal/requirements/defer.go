@@ -0,0 +1,58 @@
+package requirements
+
+import (
+	"fmt"
+	"strings"
+)
+
+// deferredPrefix opens the note sdd_defer_requirement appends to a
+// deferred requirement: "- **FR-004**: Offline mode _(deferred: v2)_".
+const deferredPrefix = "_(deferred: "
+
+// DeferredNote returns the note marking a requirement as deferred for
+// rationale, collapsed onto one line.
+func DeferredNote(rationale string) string {
+	return fmt.Sprintf(" %s%s)_", deferredPrefix, strings.Join(strings.Fields(rationale), " "))
+}
+
+// IsDeferred reports whether r was deferred: moved to Won't Have with a
+// deferred note. Deferred requirements need no task and no clarification.
+func IsDeferred(r Requirement) bool {
+	return r.Bucket == BucketWont && strings.Contains(r.Text, deferredPrefix)
+}
+
+// DeferredIDs returns the IDs of the deferred requirements in a
+// rendered requirements.md.
+func DeferredIDs(doc string) map[string]bool {
+	ids := make(map[string]bool)
+	for _, r := range ParseMarkdown(doc) {
+		if IsDeferred(r) {
+			ids[r.ID] = true
+		}
+	}
+	return ids
+}
+
+// CutItem removes the top-level list item with the given ID from field,
+// together with its indented continuation lines, and returns the item
+// and the remaining field. ok is false when field has no such item.
+func CutItem(field, id string) (item, rest string, ok bool) {
+	lines := strings.Split(field, "\n")
+	start := -1
+	for i, line := range lines {
+		if r, isItem := parseItem(line, ""); isItem && r.ID == id {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return "", field, false
+	}
+	end := start + 1
+	for end < len(lines) && (strings.HasPrefix(lines[end], " ") || strings.HasPrefix(lines[end], "\t")) {
+		end++
+	}
+	item = strings.Join(lines[start:end], "\n")
+	rest = strings.Join(append(lines[:start:start], lines[end:]...), "\n")
+	return item, strings.TrimSpace(rest), true
+}
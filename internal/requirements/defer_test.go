@@ -0,0 +1,37 @@
+package requirements
+
+import "testing"
+
+func TestCutItem(t *testing.T) {
+	field := "- **FR-001**: Log time\n- **FR-002**: Offline mode\n  - Sync on reconnect\n- **FR-003**: Export"
+	item, rest, ok := CutItem(field, "FR-002")
+	if !ok {
+		t.Fatal("FR-002 not found")
+	}
+	if want := "- **FR-002**: Offline mode\n  - Sync on reconnect"; item != want {
+		t.Errorf("item = %q, want %q", item, want)
+	}
+	if want := "- **FR-001**: Log time\n- **FR-003**: Export"; rest != want {
+		t.Errorf("rest = %q, want %q", rest, want)
+	}
+	if _, _, ok := CutItem(field, "FR-009"); ok {
+		t.Error("CutItem found an ID that isn't there")
+	}
+	// A nested mention isn't the item itself.
+	if _, _, ok := CutItem("- **FR-001**: Log time\n  - like FR-005", "FR-005"); ok {
+		t.Error("CutItem matched a sub-bullet mention")
+	}
+}
+
+func TestDeferredIDs(t *testing.T) {
+	doc := "### Must Have\n\n- **FR-001**: Log time\n\n" +
+		"### Won't Have (this version)\n\n- **FR-002**: Mobile app\n" +
+		"- **FR-003**: Offline mode" + DeferredNote("not needed\nfor v1") + "\n"
+	got := DeferredIDs(doc)
+	if len(got) != 1 || !got["FR-003"] {
+		t.Errorf("DeferredIDs = %v, want only FR-003", got)
+	}
+	if note := DeferredNote("not needed\nfor v1"); note != " _(deferred: not needed for v1)_" {
+		t.Errorf("DeferredNote = %q", note)
+	}
+}
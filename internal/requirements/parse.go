@@ -0,0 +1,133 @@
+package requirements
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+// Requirement is one identified requirement from a MoSCoW bucket or the
+// non-functional section.
+type Requirement struct {
+	ID     string `json:"id"`
+	Bucket string `json:"bucket"` // one of Buckets
+	Text   string `json:"text"`
+	// Line is the 1-based line in requirements.md, set by ParseMarkdown
+	// only.
+	Line int `json:"line,omitempty"`
+}
+
+// itemPattern matches a list item that opens with a requirement ID:
+// "- **FR-001**: text", "- **FR-001:** text", "1. FR-001 — text".
+var itemPattern = regexp.MustCompile(`^(?:[-*+]|\d+\.)\s+\**((?:FR|NFR)-\d{3,4})\b[*:\s—–-]*(.*)$`)
+
+// Parse extracts every ID-led list item from data, bucket by bucket in
+// Buckets order and in document order within a bucket. Items without an
+// ID and nested bullets that merely mention one are skipped. Duplicate
+// IDs are kept so callers can report them (see DuplicateIDs).
+func Parse(data templates.RequirementsData) []Requirement {
+	fields := []struct {
+		bucket string
+		text   string
+	}{
+		{BucketMust, data.MustHave},
+		{BucketShould, data.ShouldHave},
+		{BucketCould, data.CouldHave},
+		{BucketWont, data.WontHave},
+		{BucketNFR, data.NonFunctional},
+	}
+
+	var reqs []Requirement
+	for _, f := range fields {
+		for _, line := range strings.Split(f.text, "\n") {
+			if r, ok := parseItem(line, f.bucket); ok {
+				reqs = append(reqs, r)
+			}
+		}
+	}
+	return reqs
+}
+
+// bucketHeadings maps the lowercased start of a requirements.md heading
+// to its bucket.
+var bucketHeadings = []struct {
+	prefix string
+	bucket string
+}{
+	{"must have", BucketMust},
+	{"should have", BucketShould},
+	{"could have", BucketCould},
+	{"won't have", BucketWont},
+	{"wont have", BucketWont},
+	{"non-functional", BucketNFR},
+}
+
+// ParseMarkdown extracts the ID-led list items of a rendered
+// requirements.md, in document order, with their line numbers. An
+// item's bucket comes from the nearest heading above it; items under
+// other headings (Constraints, Assumptions, ...) are skipped.
+func ParseMarkdown(doc string) []Requirement {
+	var reqs []Requirement
+	bucket := ""
+	for i, line := range strings.Split(doc, "\n") {
+		if strings.HasPrefix(line, "#") {
+			bucket = headingBucket(strings.TrimLeft(line, "# "))
+			continue
+		}
+		if bucket == "" {
+			continue
+		}
+		if r, ok := parseItem(line, bucket); ok {
+			r.Line = i + 1
+			reqs = append(reqs, r)
+		}
+	}
+	return reqs
+}
+
+// headingBucket returns the bucket a heading opens, or "".
+func headingBucket(heading string) string {
+	h := strings.ToLower(strings.ReplaceAll(heading, "’", "'"))
+	for _, b := range bucketHeadings {
+		if strings.HasPrefix(h, b.prefix) {
+			return b.bucket
+		}
+	}
+	return ""
+}
+
+// parseItem parses one top-level, ID-led list item. Indented lines are
+// sub-bullets of the previous item and never match.
+func parseItem(line, bucket string) (Requirement, bool) {
+	if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+		return Requirement{}, false
+	}
+	m := itemPattern.FindStringSubmatch(strings.TrimSpace(line))
+	if m == nil {
+		return Requirement{}, false
+	}
+	return Requirement{
+		ID:     m[1],
+		Bucket: bucket,
+		Text:   strings.TrimSpace(strings.TrimRight(m[2], "*")),
+	}, true
+}
+
+// DuplicateIDs returns the IDs that appear more than once in reqs,
+// sorted.
+func DuplicateIDs(reqs []Requirement) []string {
+	seen := make(map[string]int, len(reqs))
+	for _, r := range reqs {
+		seen[r.ID]++
+	}
+	var dups []string
+	for id, n := range seen {
+		if n > 1 {
+			dups = append(dups, id)
+		}
+	}
+	sort.Strings(dups)
+	return dups
+}
@@ -0,0 +1,68 @@
+package requirements
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+func TestParse(t *testing.T) {
+	data := templates.RequirementsData{
+		MustHave: "- **FR-001**: Users can register\n" +
+			"  - sub-bullet mentioning FR-001 again\n" +
+			"- **FR-002:** Users can log in\n" +
+			"- An item without an ID",
+		ShouldHave:    "1. FR-003 — Export to CSV",
+		CouldHave:     "_None defined for this version._",
+		WontHave:      "* **FR-004**: Mobile app",
+		NonFunctional: "- **NFR-001**: p95 < 200ms\n- **FR-001**: duplicated here",
+	}
+
+	got := Parse(data)
+	want := []Requirement{
+		{ID: "FR-001", Bucket: BucketMust, Text: "Users can register"},
+		{ID: "FR-002", Bucket: BucketMust, Text: "Users can log in"},
+		{ID: "FR-003", Bucket: BucketShould, Text: "Export to CSV"},
+		{ID: "FR-004", Bucket: BucketWont, Text: "Mobile app"},
+		{ID: "NFR-001", Bucket: BucketNFR, Text: "p95 < 200ms"},
+		{ID: "FR-001", Bucket: BucketNFR, Text: "duplicated here"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if dups := DuplicateIDs(got); !reflect.DeepEqual(dups, []string{"FR-001"}) {
+		t.Errorf("DuplicateIDs = %v, want [FR-001]", dups)
+	}
+}
+
+func TestParse_Empty(t *testing.T) {
+	if got := Parse(templates.RequirementsData{}); len(got) != 0 {
+		t.Errorf("Parse(empty) = %+v, want none", got)
+	}
+}
+
+func TestParseMarkdown(t *testing.T) {
+	doc := "# app — Requirements\n\n" +
+		"## Functional Requirements\n\n" +
+		"### Must Have\n\n" +
+		"- **FR-001**: Users can register\n" +
+		"  - FR-009 mentioned in a sub-bullet\n\n" +
+		"### Won't Have (this version)\n\n" +
+		"- **FR-002**: Mobile app\n\n" +
+		"## Non-Functional Requirements\n\n" +
+		"- **NFR-001**: p95 < 200ms\n\n" +
+		"## Dependencies\n\n" +
+		"- **FR-003**: not a requirement section\n"
+
+	got := ParseMarkdown(doc)
+	want := []Requirement{
+		{ID: "FR-001", Bucket: BucketMust, Text: "Users can register", Line: 7},
+		{ID: "FR-002", Bucket: BucketWont, Text: "Mobile app", Line: 12},
+		{ID: "NFR-001", Bucket: BucketNFR, Text: "p95 < 200ms", Line: 16},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseMarkdown =\n%+v\nwant\n%+v", got, want)
+	}
+}
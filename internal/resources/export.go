@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// exportURI is the URI of the project export resource.
+const exportURI = "sdd://project/export.zip"
+
+// ExportResource returns the MCP resource definition for downloading the
+// whole project as a zip.
+func (h *Handler) ExportResource() mcp.Resource {
+	return mcp.NewResource(
+		exportURI,
+		"SDD Project Export",
+		mcp.WithResourceDescription(
+			"The project's docs directory — config, every artifact, ADRs, and change history — "+
+				"as a single zip archive, for archiving or handing off a spec. Lock files are excluded.",
+		),
+		mcp.WithMIMEType("application/zip"),
+	)
+}
+
+// HandleExport zips the project's docs directory and returns it as a
+// base64 blob, streaming file by file.
+func (h *Handler) HandleExport(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	projectRoot, err := findResourceRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+
+	if _, err := h.store.Load(projectRoot); err != nil {
+		return errorResource(req.Params.URI, err.Error()), nil
+	}
+
+	// Each file streams through the zip writer straight into the base64
+	// encoder: only the encoded blob the response needs is held in
+	// memory, not the raw archive as well.
+	var blob strings.Builder
+	enc := base64.NewEncoder(base64.StdEncoding, &blob)
+	if err := writeProjectZip(enc, projectRoot); err != nil {
+		return nil, fmt.Errorf("exporting project: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("exporting project: %w", err)
+	}
+
+	return []mcp.ResourceContents{
+		mcp.BlobResourceContents{
+			URI:      req.Params.URI,
+			MIMEType: "application/zip",
+			Blob:     blob.String(),
+		},
+	}, nil
+}
+
+// writeProjectZip writes every file under the project's docs directory
+// to w as a zip archive. Entry names are relative to the project root
+// (e.g. "docs/hoofy.json") so the archive unpacks into the same layout.
+func writeProjectZip(w io.Writer, projectRoot string) error {
+	zw := zip.NewWriter(w)
+	docsDir := config.DocsPath(projectRoot)
+
+	err := filepath.WalkDir(docsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !d.Type().IsRegular() || excludeFromExport(d.Name()) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(projectRoot, path)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		header.Method = zip.Deflate
+
+		entry, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(entry, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// excludeFromExport reports whether a file is a lock file, which is
+// transient process state rather than part of the spec.
+func excludeFromExport(name string) bool {
+	return strings.HasSuffix(name, ".lock")
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestHandleExport(t *testing.T) {
+	root := saveProject(t, t.TempDir(), "proj", config.StageSpecify, 0)
+	docs := config.DocsPath(root)
+	files := map[string]string{
+		"charter.md":                 "# Charter\n",
+		"adrs/001-use-go.md":         "# ADR-001\n",
+		"changes/history/c1/spec.md": "# Old change\n",
+		"hoofy.lock":                 "pid 123",
+	}
+	for rel, content := range files {
+		path := filepath.Join(docs, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(root)
+
+	req := mcp.ReadResourceRequest{}
+	req.Params.URI = exportURI
+	contents, err := NewHandler(config.NewFileStore()).HandleExport(context.Background(), req)
+	if err != nil {
+		t.Fatalf("HandleExport: %v", err)
+	}
+	blob, ok := contents[0].(mcp.BlobResourceContents)
+	if !ok {
+		t.Fatalf("contents = %T, want BlobResourceContents", contents[0])
+	}
+	if blob.MIMEType != "application/zip" {
+		t.Errorf("MIME type = %q, want application/zip", blob.MIMEType)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(blob.Blob)
+	if err != nil {
+		t.Fatalf("blob is not base64: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("blob is not a zip: %v", err)
+	}
+
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		if f.Name == "docs/charter.md" {
+			rc, _ := f.Open()
+			got, _ := io.ReadAll(rc)
+			_ = rc.Close()
+			if string(got) != "# Charter\n" {
+				t.Errorf("charter.md content = %q", got)
+			}
+		}
+	}
+	sort.Strings(names)
+	want := []string{
+		"docs/adrs/001-use-go.md",
+		"docs/changes/history/c1/spec.md",
+		"docs/charter.md",
+		"docs/hoofy.json",
+	}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("entries = %v, want %v (lock file excluded)", names, want)
+	}
+}
+
+func TestHandleExport_NoProject(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	req := mcp.ReadResourceRequest{}
+	req.Params.URI = exportURI
+	contents, err := NewHandler(config.NewFileStore()).HandleExport(context.Background(), req)
+	if err != nil {
+		t.Fatalf("HandleExport: %v", err)
+	}
+	text, ok := contents[0].(mcp.TextResourceContents)
+	if !ok || !strings.HasPrefix(text.Text, "Error:") {
+		t.Errorf("want an error resource without a project, got %+v", contents[0])
+	}
+}
@@ -8,7 +8,8 @@ import (
 	"github.com/HendryAvila/Hoofy/internal/config"
 )
 
-// findRoot walks up from cwd looking for docs/hoofy.json (or docs/specs/hoofy.json).
+// findRoot walks up from cwd looking for hoofy.json in any of
+// config.DocsDirCandidates (docs/, docs/specs/, or the SDD_DIR override).
 // Shared utility for resource handlers.
 func findRoot() (string, error) {
 	dir, err := os.Getwd()
@@ -18,16 +19,10 @@ func findRoot() (string, error) {
 
 	current := dir
 	for {
-		// Check primary: docs/hoofy.json
-		primary := filepath.Join(current, config.DocsDir, config.ConfigFile)
-		if _, err := os.Stat(primary); err == nil {
-			return current, nil
-		}
-
-		// Check fallback: docs/specs/hoofy.json
-		fallback := filepath.Join(current, config.DocsDir, config.DocsDirFallback, config.ConfigFile)
-		if _, err := os.Stat(fallback); err == nil {
-			return current, nil
+		for _, candidate := range config.DocsDirCandidates() {
+			if config.HasConfig(filepath.Join(current, candidate)) {
+				return current, nil
+			}
 		}
 
 		parent := filepath.Dir(current)
@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// InstructionsURI is the resource URI serving the server instructions.
+const InstructionsURI = "sdd://instructions"
+
+// Instructions serves the system instructions the server sent to the AI
+// at initialize time, so clients can display them or debug what the AI
+// was told. The text is injected by the composition root, which passes
+// the same string to server.WithInstructions.
+type Instructions struct {
+	text string
+}
+
+// NewInstructions creates an Instructions resource serving text.
+func NewInstructions(text string) *Instructions {
+	return &Instructions{text: text}
+}
+
+// Resource returns the MCP resource definition for the server instructions.
+func (i *Instructions) Resource() mcp.Resource {
+	return mcp.NewResource(
+		InstructionsURI,
+		"Hoofy Server Instructions",
+		mcp.WithResourceDescription("The system instructions this server gives the AI at initialize time"),
+		mcp.WithMIMEType("text/markdown"),
+	)
+}
+
+// Handle returns the server instructions as markdown.
+func (i *Instructions) Handle(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      req.Params.URI,
+			MIMEType: "text/markdown",
+			Text:     i.text,
+		},
+	}, nil
+}
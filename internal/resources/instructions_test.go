@@ -0,0 +1,32 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestInstructions_Handle(t *testing.T) {
+	res := NewInstructions("## WHEN TO ACTIVATE Hoofy\n")
+	if res.Resource().URI != InstructionsURI {
+		t.Errorf("URI = %q, want %q", res.Resource().URI, InstructionsURI)
+	}
+
+	req := mcp.ReadResourceRequest{}
+	req.Params.URI = InstructionsURI
+	contents, err := res.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if len(contents) != 1 {
+		t.Fatalf("expected 1 content, got %d", len(contents))
+	}
+	text, ok := contents[0].(mcp.TextResourceContents)
+	if !ok {
+		t.Fatalf("expected TextResourceContents, got %T", contents[0])
+	}
+	if text.Text != "## WHEN TO ACTIVATE Hoofy\n" || text.MIMEType != "text/markdown" {
+		t.Errorf("unexpected contents: %+v", text)
+	}
+}
@@ -0,0 +1,158 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// projectMetrics is the sdd://metrics document.
+type projectMetrics struct {
+	Root                string               `json:"root"`
+	MaxDepth            int                  `json:"max_depth"`
+	Projects            int                  `json:"projects"`
+	ByStage             map[config.Stage]int `json:"by_stage"`
+	AverageClarityScore float64              `json:"average_clarity_score"`
+	ClarityScored       int                  `json:"clarity_scored"`
+	ReachedValidate     int                  `json:"reached_validate"`
+	Verdicts            map[string]int       `json:"verdicts"`
+	Paths               []string             `json:"paths"`
+}
+
+// MetricsResource returns the MCP resource template for aggregate
+// pipeline metrics across every project under a root.
+func (h *Handler) MetricsResource() mcp.ResourceTemplate {
+	return mcp.NewResourceTemplate(
+		"sdd://metrics{?root,depth}",
+		"SDD Pipeline Metrics",
+		mcp.WithTemplateDescription(fmt.Sprintf(
+			"Aggregate stats for all SDD projects under a root directory: counts by current stage, "+
+				"average clarity score, and how many reached validate (with verdicts). "+
+				"root defaults to the current project root; depth defaults to %d (max %d). "+
+				"Paths listed in the root's .sddignore (gitignore-style globs) are skipped.",
+			config.DefaultDiscoveryDepth, config.MaxDiscoveryDepth,
+		)),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+}
+
+// HandleMetrics walks the root, loads each project, and returns the
+// aggregate metrics as JSON. Computed on demand; nothing is cached.
+func (h *Handler) HandleMetrics(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	root := templateArg(req, "root")
+	if root == "" {
+		var err error
+		root, err = findResourceRoot()
+		if err != nil {
+			return nil, fmt.Errorf("finding project root: %w", err)
+		}
+	}
+
+	depth := config.DefaultDiscoveryDepth
+	if v := templateArg(req, "depth"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return errorResource(req.Params.URI, fmt.Sprintf("depth must be a non-negative integer, got %q", v)), nil
+		}
+		depth = min(n, config.MaxDiscoveryDepth)
+	}
+
+	info, err := os.Stat(root)
+	if err != nil || !info.IsDir() {
+		return errorResource(req.Params.URI, fmt.Sprintf("root %q is not a directory", root)), nil
+	}
+
+	dirs, err := config.ListProjects(h.store, root, depth)
+	if err != nil {
+		return nil, err
+	}
+	metrics := h.collectMetrics(root, dirs)
+	metrics.MaxDepth = depth
+
+	data, err := json.MarshalIndent(metrics, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling metrics: %w", err)
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      req.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(data),
+		},
+	}, nil
+}
+
+// collectMetrics aggregates the given project roots. Projects whose
+// hoofy.json can't be loaded are skipped.
+func (h *Handler) collectMetrics(root string, projectRoots []string) projectMetrics {
+	m := projectMetrics{
+		Root:     root,
+		ByStage:  make(map[config.Stage]int),
+		Verdicts: make(map[string]int),
+		Paths:    []string{},
+	}
+
+	clarityTotal := 0
+	for _, dir := range projectRoots {
+		cfg, err := h.store.Load(dir)
+		if err != nil {
+			continue
+		}
+		m.Projects++
+		m.ByStage[cfg.CurrentStage]++
+		if rel, err := filepath.Rel(root, dir); err == nil {
+			m.Paths = append(m.Paths, filepath.ToSlash(rel))
+		}
+
+		if cfg.ClarityScore > 0 {
+			clarityTotal += cfg.ClarityScore
+			m.ClarityScored++
+		}
+
+		if cfg.CurrentStage == config.StageValidate || cfg.StageStatus[config.StageValidate].Status == "completed" {
+			m.ReachedValidate++
+			if verdict := readVerdict(dir); verdict != "" {
+				m.Verdicts[verdict]++
+			}
+		}
+	}
+
+	if m.ClarityScored > 0 {
+		avg := float64(clarityTotal) / float64(m.ClarityScored)
+		m.AverageClarityScore = float64(int(avg*10+0.5)) / 10
+	}
+	return m
+}
+
+// readVerdict returns the verdict recorded in a project's validation.md,
+// or "" if there is none.
+func readVerdict(projectRoot string) string {
+	data, err := os.ReadFile(config.StagePath(projectRoot, config.StageValidate))
+	if err != nil {
+		return ""
+	}
+	verdict, _ := pipeline.ParseVerdict(string(data))
+	return verdict
+}
+
+// templateArg returns a URI template variable as a string. mcp-go passes
+// matched variables as []string (query values) or string.
+func templateArg(req mcp.ReadResourceRequest, name string) string {
+	switch v := req.Params.Arguments[name].(type) {
+	case string:
+		return v
+	case []string:
+		if len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
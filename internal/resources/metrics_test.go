@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// saveProject writes a hoofy.json under root/rel at the given stage.
+func saveProject(t *testing.T, root, rel string, stage config.Stage, clarity int) string {
+	t.Helper()
+	dir := filepath.Join(root, rel)
+	cfg := config.NewProjectConfig(filepath.Base(rel), "test", config.ModeGuided)
+	cfg.CurrentStage = stage
+	cfg.ClarityScore = clarity
+	if err := config.NewFileStore().Save(dir, cfg); err != nil {
+		t.Fatalf("save %s: %v", rel, err)
+	}
+	return dir
+}
+
+func TestHandleMetrics(t *testing.T) {
+	root := t.TempDir()
+	saveProject(t, root, "a", config.StageCharter, 0)
+	saveProject(t, root, "b", config.StageDesign, 75)
+	validated := saveProject(t, root, "c", config.StageValidate, 90)
+	if err := os.WriteFile(config.StagePath(validated, config.StageValidate),
+		[]byte("# c — Validation\n\n## Verdict: PASS\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	req := mcp.ReadResourceRequest{}
+	req.Params.URI = "sdd://metrics?root=" + root
+	req.Params.Arguments = map[string]any{"root": []string{root}}
+
+	contents, err := NewHandler(config.NewFileStore()).HandleMetrics(context.Background(), req)
+	if err != nil {
+		t.Fatalf("HandleMetrics: %v", err)
+	}
+	text := contents[0].(mcp.TextResourceContents).Text
+
+	var m projectMetrics
+	if err := json.Unmarshal([]byte(text), &m); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, text)
+	}
+	if m.Projects != 3 {
+		t.Errorf("projects = %d, want 3", m.Projects)
+	}
+	if m.ByStage[config.StageDesign] != 1 || m.ByStage[config.StageCharter] != 1 {
+		t.Errorf("by_stage = %v", m.ByStage)
+	}
+	if m.AverageClarityScore != 82.5 || m.ClarityScored != 2 {
+		t.Errorf("average clarity = %v over %d, want 82.5 over 2", m.AverageClarityScore, m.ClarityScored)
+	}
+	if m.ReachedValidate != 1 || m.Verdicts["PASS"] != 1 {
+		t.Errorf("reached_validate = %d, verdicts = %v", m.ReachedValidate, m.Verdicts)
+	}
+}
+
+func TestHandleMetrics_InvalidDepth(t *testing.T) {
+	req := mcp.ReadResourceRequest{}
+	req.Params.URI = "sdd://metrics?depth=x"
+	req.Params.Arguments = map[string]any{"root": t.TempDir(), "depth": "x"}
+
+	contents, err := NewHandler(config.NewFileStore()).HandleMetrics(context.Background(), req)
+	if err != nil {
+		t.Fatalf("HandleMetrics: %v", err)
+	}
+	if text := contents[0].(mcp.TextResourceContents).Text; text[:6] != "Error:" {
+		t.Errorf("expected error resource, got %q", text)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -33,8 +35,42 @@ func (h *Handler) StatusResource() mcp.Resource {
 	)
 }
 
-// HandleStatus returns the current project status as JSON.
+// StatusSinceResource returns the MCP resource template for a
+// conditional read of the project status, for polling clients.
+func (h *Handler) StatusSinceResource() mcp.ResourceTemplate {
+	return mcp.NewResourceTemplate(
+		"sdd://project/status{?since}",
+		"SDD Project Status (conditional)",
+		mcp.WithTemplateDescription(
+			"The project status, or a small {\"unchanged\": true} marker when the project's revision "+
+				"still equals since — the revision field of the last status you read. "+
+				"Poll with the latest revision to skip re-reading an unchanged project.",
+		),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+}
+
+// statusUnchanged is the sdd://project/status?since=N document when the
+// project is still at revision N.
+type statusUnchanged struct {
+	Unchanged bool   `json:"unchanged"`
+	Revision  int    `json:"revision"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// HandleStatus returns the current project status as JSON. With a since
+// argument equal to the config's revision it returns statusUnchanged
+// instead.
 func (h *Handler) HandleStatus(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	since := -1
+	if v := templateArg(req, "since"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return errorResource(req.Params.URI, fmt.Sprintf("since must be a revision number, got %q", v)), nil
+		}
+		since = n
+	}
+
 	projectRoot, err := findResourceRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
@@ -45,7 +81,11 @@ func (h *Handler) HandleStatus(ctx context.Context, req mcp.ReadResourceRequest)
 		return errorResource(req.Params.URI, err.Error()), nil
 	}
 
-	data, err := json.MarshalIndent(cfg, "", "  ")
+	var payload any = newStatusPayload(cfg, time.Now())
+	if cfg.Revision == since {
+		payload = statusUnchanged{Unchanged: true, Revision: cfg.Revision, UpdatedAt: cfg.UpdatedAt}
+	}
+	data, err := json.MarshalIndent(payload, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("marshaling status: %w", err)
 	}
@@ -59,6 +99,35 @@ func (h *Handler) HandleStatus(ctx context.Context, req mcp.ReadResourceRequest)
 	}, nil
 }
 
+// statusPayload is the sdd://project/status document: the raw project
+// config plus human-friendly relative times ("3 days ago") alongside the
+// absolute timestamps. Relative fields are omitted when a timestamp is
+// empty or unparseable.
+type statusPayload struct {
+	*config.ProjectConfig
+	CreatedAgo        string                  `json:"created_ago,omitempty"`
+	UpdatedAgo        string                  `json:"updated_ago,omitempty"`
+	StageCompletedAgo map[config.Stage]string `json:"stage_completed_ago,omitempty"`
+}
+
+// newStatusPayload builds the status document relative to now.
+func newStatusPayload(cfg *config.ProjectConfig, now time.Time) statusPayload {
+	p := statusPayload{
+		ProjectConfig: cfg,
+		CreatedAgo:    config.HumanizeSince(cfg.CreatedAt, now),
+		UpdatedAgo:    config.HumanizeSince(cfg.UpdatedAt, now),
+	}
+	for stage, status := range cfg.StageStatus {
+		if ago := config.HumanizeSince(status.CompletedAt, now); ago != "" {
+			if p.StageCompletedAgo == nil {
+				p.StageCompletedAgo = make(map[config.Stage]string)
+			}
+			p.StageCompletedAgo[stage] = ago
+		}
+	}
+	return p
+}
+
 // errorResource returns a resource with an error message.
 func errorResource(uri, message string) []mcp.ResourceContents {
 	return []mcp.ResourceContents{
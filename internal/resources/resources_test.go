@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func readStatus(t *testing.T, since string) string {
+	t.Helper()
+	req := mcp.ReadResourceRequest{}
+	req.Params.URI = "sdd://project/status"
+	if since != "" {
+		req.Params.URI += "?since=" + since
+		req.Params.Arguments = map[string]any{"since": []string{since}}
+	}
+	contents, err := NewHandler(config.NewFileStore()).HandleStatus(context.Background(), req)
+	if err != nil {
+		t.Fatalf("HandleStatus: %v", err)
+	}
+	return contents[0].(mcp.TextResourceContents).Text
+}
+
+func TestHandleStatus_Since(t *testing.T) {
+	root := saveProject(t, t.TempDir(), "proj", config.StageSpecify, 0)
+	t.Chdir(root)
+
+	var full struct {
+		Name     string `json:"name"`
+		Revision int    `json:"revision"`
+	}
+	if err := json.Unmarshal([]byte(readStatus(t, "")), &full); err != nil {
+		t.Fatal(err)
+	}
+	if full.Name != "proj" || full.Revision != 1 {
+		t.Fatalf("status = %+v, want proj at revision 1", full)
+	}
+
+	var unchanged statusUnchanged
+	if err := json.Unmarshal([]byte(readStatus(t, "1")), &unchanged); err != nil {
+		t.Fatal(err)
+	}
+	if !unchanged.Unchanged || unchanged.Revision != 1 {
+		t.Errorf("since=1 at revision 1 = %+v, want the unchanged marker", unchanged)
+	}
+
+	store := config.NewFileStore()
+	cfg, _ := store.Load(root)
+	if err := store.Save(root, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if text := readStatus(t, "1"); strings.Contains(text, `"unchanged"`) || !strings.Contains(text, `"revision": 2`) {
+		t.Errorf("since=1 at revision 2 should return the full status:\n%s", text)
+	}
+
+	if text := readStatus(t, "latest"); !strings.Contains(text, "since must be a revision number") {
+		t.Errorf("a non-numeric since should be rejected, got: %s", text)
+	}
+}
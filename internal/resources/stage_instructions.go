@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/prompts"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// StageInstructionsURI is the resource URI serving the instructions for
+// the project's current and next stage.
+const StageInstructionsURI = "sdd://instructions/current"
+
+// StageInstructionsResource returns the MCP resource definition for the
+// progress-aware instructions. The server instructions are fixed at
+// initialize time, before any project is known, so they cover the whole
+// pipeline; this resource is re-read per turn and carries only the stage
+// guidance that applies now.
+func (h *Handler) StageInstructionsResource() mcp.Resource {
+	return mcp.NewResource(
+		StageInstructionsURI,
+		"Hoofy Current Stage Instructions",
+		mcp.WithResourceDescription(
+			"Stage guidance for the project's current and next pipeline stage only — "+
+				"a compact, per-turn alternative to the full /sdd-stage-guide prompt",
+		),
+		mcp.WithMIMEType("text/markdown"),
+	)
+}
+
+// HandleStageInstructions returns the current and next stage's guidance
+// as markdown.
+func (h *Handler) HandleStageInstructions(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	projectRoot, err := findResourceRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+
+	var text string
+	if cfg, err := h.store.Load(projectRoot); err != nil {
+		text = noProjectInstructions
+	} else {
+		text = stageInstructions(cfg)
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      req.Params.URI,
+			MIMEType: "text/markdown",
+			Text:     text,
+		},
+	}, nil
+}
+
+// noProjectInstructions is served when there is no project to follow.
+const noProjectInstructions = `# Hoofy — no project yet
+
+No SDD project was found here. Capture the user's goals with sdd_explore,
+then call sdd_init_project (or sdd_create_charter with auto_init=true).
+For changes to an existing codebase, use sdd_change instead.`
+
+// modeGuidance is the one-line tone reminder for each mode.
+var modeGuidance = map[config.Mode]string{
+	config.ModeGuided: "Guided mode — use simple language, give examples, be encouraging.",
+	config.ModeExpert: "Expert mode — be direct; technical language is fine.",
+}
+
+// stageInstructions renders the guidance for cfg's current stage in
+// full, followed by the next stage's.
+func stageInstructions(cfg *config.ProjectConfig) string {
+	order := config.StageOrderFor(cfg)
+	i := slices.Index(order, cfg.CurrentStage)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Hoofy — %s\n\n", cfg.Name)
+	if g, ok := modeGuidance[cfg.Mode]; ok {
+		fmt.Fprintf(&sb, "%s\n\n", g)
+	}
+
+	if i == len(order)-1 && pipeline.IsCompleted(cfg, cfg.CurrentStage) {
+		sb.WriteString("The pipeline is complete — the specs are ready for implementation. " +
+			"Use sdd_change for further changes and sdd_new_iteration for the next version.\n")
+		return sb.String()
+	}
+
+	meta := config.Stages[cfg.CurrentStage]
+	fmt.Fprintf(&sb, "**Current stage:** %s (%d of %d)\n", meta.Name, i+1, len(order))
+	if cfg.CurrentStage == config.StageClarify {
+		fmt.Fprintf(&sb, "**Clarity score:** %d/%d — read sdd_get_context blockers=true for what's missing\n",
+			cfg.ClarityScore, pipeline.ProjectClarityThreshold(cfg))
+	}
+	sb.WriteString("\n")
+	if section := prompts.StageGuideSection(string(cfg.CurrentStage)); section != "" {
+		fmt.Fprintf(&sb, "%s\n", section)
+	} else {
+		fmt.Fprintf(&sb, "_%s_\n", meta.Description)
+	}
+
+	if i >= 0 && i+1 < len(order) {
+		next := order[i+1]
+		fmt.Fprintf(&sb, "\n---\n\n# Up next: %s\n\n", config.Stages[next].Name)
+		if section := prompts.StageGuideSection(string(next)); section != "" {
+			fmt.Fprintf(&sb, "%s\n", section)
+		}
+	}
+	sb.WriteString("\n_Other stages are left out — invoke /sdd-stage-guide for the whole pipeline._\n")
+	return sb.String()
+}
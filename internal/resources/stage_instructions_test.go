@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestHandleStageInstructions(t *testing.T) {
+	read := func(t *testing.T) string {
+		t.Helper()
+		req := mcp.ReadResourceRequest{}
+		req.Params.URI = StageInstructionsURI
+		contents, err := NewHandler(config.NewFileStore()).HandleStageInstructions(context.Background(), req)
+		if err != nil {
+			t.Fatalf("HandleStageInstructions: %v", err)
+		}
+		return contents[0].(mcp.TextResourceContents).Text
+	}
+
+	t.Run("current and next stage only", func(t *testing.T) {
+		t.Chdir(saveProject(t, t.TempDir(), "shop", config.StageClarify, 40))
+		text := read(t)
+		for _, want := range []string{
+			"# Hoofy — shop",
+			"Guided mode",
+			"**Current stage:** Clarify",
+			"**Clarity score:** 40/70",
+			"## Stage 5: Clarify",
+			"# Up next: Design",
+			"## Stage 6: Design",
+		} {
+			if !strings.Contains(text, want) {
+				t.Errorf("instructions missing %q:\n%s", want, text)
+			}
+		}
+		for _, notWant := range []string{"## Stage 1: Principles", "## Stage 7: Tasks", "PRE-PIPELINE"} {
+			if strings.Contains(text, notWant) {
+				t.Errorf("instructions should trim %q:\n%s", notWant, text)
+			}
+		}
+	})
+
+	t.Run("last stage has no next", func(t *testing.T) {
+		t.Chdir(saveProject(t, t.TempDir(), "shop", config.StageValidate, 90))
+		text := read(t)
+		if !strings.Contains(text, "## Stage 8: Validate") || strings.Contains(text, "Up next") {
+			t.Errorf("unexpected validate instructions:\n%s", text)
+		}
+	})
+
+	t.Run("no project", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		if text := read(t); !strings.Contains(text, "sdd_init_project") {
+			t.Errorf("want init guidance without a project:\n%s", text)
+		}
+	})
+}
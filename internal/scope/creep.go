@@ -0,0 +1,177 @@
+// Package scope provides heuristic checks for scope creep: items the
+// charter declares out of scope that resurface in later artifacts.
+//
+// The checks are keyword-overlap heuristics meant for advisory warnings,
+// not gates — they favor flagging a possible reappearance over silence.
+package scope
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// listMarker matches bullet and numbered list markers.
+var listMarker = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+`)
+
+// exclusionHeading matches headings whose sections list exclusions, so
+// restating an out-of-scope item there is not creep.
+var exclusionHeading = regexp.MustCompile(`(?i)won'?t|out[ -]of[ -]scope|non[- ]goals?|exclu`)
+
+// negationPattern matches lines that mention an item only to exclude it.
+var negationPattern = regexp.MustCompile(`(?i)\b(?:out of scope|won'?t|will not|not|no|without|excluded?|excluding|non-goal|deferred|future)\b`)
+
+// stopwords are ignored when comparing phrases.
+var stopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "any": true, "all": true,
+	"from": true, "into": true, "via": true, "per": true, "our": true, "their": true,
+	"this": true, "that": true, "these": true, "those": true, "are": true, "was": true,
+	"will": true, "can": true, "its": true, "other": true, "support": true, "supports": true,
+}
+
+// maxExcerpt caps the length of the quoted line in a creep warning.
+const maxExcerpt = 100
+
+// DetectCreep returns one warning per out-of-scope item whose keywords
+// reappear in newContent, e.g.
+//
+//	Mobile app — "- **FR-010**: Native mobile app for iOS"
+//
+// outOfScope is the markdown list of exclusions (typically the charter's
+// "Out of Scope" section). An item matches a line of newContent when the
+// line contains all of the item's significant words (at least two thirds
+// for items of four or more words), after lowercasing and light
+// stemming. Lines that negate the item ("no mobile app") and sections
+// that list exclusions (Won't Have, Out of Scope) are ignored. Results
+// follow the order of outOfScope.
+func DetectCreep(outOfScope, newContent string) []string {
+	items := parseItems(outOfScope)
+	if len(items) == 0 {
+		return nil
+	}
+
+	type line struct {
+		text  string
+		words map[string]bool
+	}
+	var lines []line
+	excluded := false
+	for _, raw := range strings.Split(newContent, "\n") {
+		trimmed := strings.TrimSpace(raw)
+		if strings.HasPrefix(trimmed, "#") {
+			excluded = exclusionHeading.MatchString(trimmed)
+			continue
+		}
+		if excluded || trimmed == "" || negationPattern.MatchString(trimmed) {
+			continue
+		}
+		lines = append(lines, line{text: trimmed, words: wordSet(trimmed)})
+	}
+
+	var warnings []string
+	for _, item := range items {
+		keys := keywords(item)
+		if len(keys) == 0 {
+			continue
+		}
+		need := len(keys)
+		if need >= 4 {
+			need = (2*len(keys) + 2) / 3
+		}
+		for _, l := range lines {
+			hits := 0
+			for _, k := range keys {
+				if l.words[k] {
+					hits++
+				}
+			}
+			if hits >= need {
+				warnings = append(warnings, fmt.Sprintf("%s — %q", item, excerpt(l.text)))
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+// parseItems extracts the exclusion phrases from a markdown list,
+// dropping markers, emphasis, and trailing explanations such as
+// "Mobile app — planned for v2".
+func parseItems(outOfScope string) []string {
+	var items []string
+	seen := make(map[string]bool)
+	for _, raw := range strings.Split(outOfScope, "\n") {
+		if !listMarker.MatchString(raw) {
+			continue
+		}
+		item := listMarker.ReplaceAllString(raw, "")
+		item = strings.NewReplacer("**", "", "__", "", "`", "").Replace(item)
+		for _, sep := range []string{" — ", " – ", " - ", ": ", " (", "; "} {
+			if i := strings.Index(item, sep); i > 0 {
+				item = item[:i]
+			}
+		}
+		item = strings.TrimRight(strings.TrimSpace(item), ".,;:")
+		if item == "" || seen[strings.ToLower(item)] {
+			continue
+		}
+		seen[strings.ToLower(item)] = true
+		items = append(items, item)
+	}
+	return items
+}
+
+// keywords returns the significant, stemmed words of a phrase.
+func keywords(phrase string) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, w := range words(phrase) {
+		if len(w) < 3 || stopwords[w] {
+			continue
+		}
+		w = stem(w)
+		if !seen[w] {
+			seen[w] = true
+			keys = append(keys, w)
+		}
+	}
+	return keys
+}
+
+// wordSet returns the stemmed words of a line.
+func wordSet(line string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range words(line) {
+		set[stem(w)] = true
+	}
+	return set
+}
+
+// words splits text into lowercase alphanumeric words.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// stem folds simple English plurals so "apps" matches "app" and
+// "notifications" matches "notification".
+func stem(w string) string {
+	switch {
+	case len(w) > 4 && strings.HasSuffix(w, "ies"):
+		return w[:len(w)-3] + "y"
+	case len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss"):
+		return w[:len(w)-1]
+	default:
+		return w
+	}
+}
+
+// excerpt shortens a line for display.
+func excerpt(line string) string {
+	if r := []rune(line); len(r) > maxExcerpt {
+		return string(r[:maxExcerpt-1]) + "…"
+	}
+	return line
+}
@@ -0,0 +1,119 @@
+package scope
+
+import (
+	"strings"
+	"testing"
+)
+
+const outOfScope = `- Mobile app — planned for v2
+- **Invoicing**: handled by the accounting system
+- Real-time collaboration (multiple editors on one document)
+- Integration with third-party payroll providers
+`
+
+func TestDetectCreep(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string // item prefixes, in order
+	}{
+		{
+			name:    "no overlap",
+			content: "- FR-001: Users can log time entries\n- FR-002: CSV export",
+			want:    nil,
+		},
+		{
+			name:    "plural and case folded",
+			content: "- FR-003: Native Mobile Apps for iOS and Android",
+			want:    []string{"Mobile app"},
+		},
+		{
+			name:    "single keyword item",
+			content: "### Components\n\n- Invoicing engine generates invoices monthly",
+			want:    []string{"Invoicing"},
+		},
+		{
+			name:    "partial match for long items",
+			content: "- FR-007: Nightly sync with the third-party payroll provider",
+			want:    []string{"Integration with third-party payroll providers"},
+		},
+		{
+			name:    "words spread across lines do not match",
+			content: "- Real-time dashboard\n- Team collaboration notes",
+			want:    nil,
+		},
+		{
+			name:    "negated lines ignored",
+			content: "- The system will not include a mobile app\n- No invoicing in v1",
+			want:    nil,
+		},
+		{
+			name:    "exclusion sections ignored",
+			content: "### Won't Have (this time)\n\n- Mobile app\n- Invoicing\n\n### Must Have\n\n- Log hours",
+			want:    nil,
+		},
+		{
+			name:    "results follow out-of-scope order",
+			content: "- Invoicing module\n- Real-time collaboration on timesheets\n- Mobile app shell",
+			want:    []string{"Mobile app", "Invoicing", "Real-time collaboration"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DetectCreep(outOfScope, tt.content)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DetectCreep() = %q, want %d warnings", got, len(tt.want))
+			}
+			for i, prefix := range tt.want {
+				if !strings.HasPrefix(got[i], prefix+" — ") {
+					t.Errorf("warning %d = %q, want prefix %q", i, got[i], prefix)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectCreep_QuotesLine(t *testing.T) {
+	got := DetectCreep("- Mobile app", "intro\n  - FR-010: Mobile app for field staff  \n")
+	want := `Mobile app — "- FR-010: Mobile app for field staff"`
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("DetectCreep() = %q, want [%q]", got, want)
+	}
+}
+
+func TestDetectCreep_EmptyInputs(t *testing.T) {
+	if got := DetectCreep("", "- Mobile app"); got != nil {
+		t.Errorf("empty out-of-scope list should yield nil, got %q", got)
+	}
+	if got := DetectCreep("Mobile app and invoicing are out.", "- Mobile app"); got != nil {
+		t.Errorf("prose without list items should yield nil, got %q", got)
+	}
+	if got := DetectCreep(outOfScope, ""); got != nil {
+		t.Errorf("empty content should yield nil, got %q", got)
+	}
+}
+
+func TestParseItems(t *testing.T) {
+	got := parseItems(outOfScope + "1. Mobile app\n* `SSO` (SAML, OIDC)\n")
+	want := []string{
+		"Mobile app",
+		"Invoicing",
+		"Real-time collaboration",
+		"Integration with third-party payroll providers",
+		"SSO",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("parseItems() = %q, want %q", got, want)
+	}
+}
+
+func TestStem(t *testing.T) {
+	for in, want := range map[string]string{
+		"apps": "app", "notifications": "notification", "policies": "policy",
+		"access": "access", "bus": "bus", "ios": "ios",
+	} {
+		if got := stem(in); got != want {
+			t.Errorf("stem(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+// Health serves the liveness and readiness probes an orchestrator polls
+// when Hoofy runs behind an HTTP transport:
+//
+//	GET /healthz  200 {"version": ..., "uptime": ...} while the process is up
+//	GET /readyz   200 once the template renderer is initialized, 503 before
+//
+// The probes are plain HTTP, mounted beside the MCP endpoint rather than
+// inside it, and are never part of the stdio transport.
+type Health struct {
+	started  time.Time
+	renderer templates.Renderer
+}
+
+// NewHealth creates the probes for a server whose templates are rendered
+// by renderer. A nil renderer reports not ready.
+func NewHealth(renderer templates.Renderer) *Health {
+	return &Health{started: time.Now(), renderer: renderer}
+}
+
+// Handler returns an http.Handler serving /healthz and /readyz.
+func (h *Health) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", h.healthz)
+	mux.HandleFunc("GET /readyz", h.readyz)
+	return mux
+}
+
+// healthResponse is the /healthz body.
+type healthResponse struct {
+	Version string `json:"version"`
+	Uptime  string `json:"uptime"`
+}
+
+func (h *Health) healthz(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, healthResponse{
+		Version: Version,
+		Uptime:  time.Since(h.started).Round(time.Second).String(),
+	})
+}
+
+// readyResponse is the /readyz body.
+type readyResponse struct {
+	Ready     bool `json:"ready"`
+	Templates int  `json:"templates"`
+}
+
+func (h *Health) readyz(w http.ResponseWriter, _ *http.Request) {
+	var n int
+	if h.renderer != nil {
+		n = len(h.renderer.List())
+	}
+	status := http.StatusOK
+	if n == 0 {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, readyResponse{Ready: n > 0, Templates: n})
+}
+
+// writeJSON writes v as the JSON response body with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
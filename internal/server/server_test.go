@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/tools"
+)
+
+func TestNew_ReadOnly(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	s, cleanup, err := New(Options{ReadOnly: true})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer cleanup()
+
+	names := slices.Collect(maps.Keys(s.ListTools()))
+	if !slices.Equal(names, []string{"sdd_get_context"}) {
+		t.Errorf("read-only tools = %v, want [sdd_get_context]", names)
+	}
+	for _, name := range names {
+		if !slices.Contains(tools.ReadOnlyTools, name) {
+			t.Errorf("%s is registered read-only but missing from tools.ReadOnlyTools", name)
+		}
+	}
+
+	call := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"sdd_init_project",` +
+		`"arguments":{"name":"demo","description":"a demo"}}}`
+	resp, err := json.Marshal(s.HandleMessage(context.Background(), json.RawMessage(call)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(resp), `"error"`) {
+		t.Errorf("sdd_init_project should be rejected in read-only mode, got %s", resp)
+	}
+	if _, err := os.Stat(config.ConfigPath(dir)); !os.IsNotExist(err) {
+		t.Errorf("read-only init must not create a project (stat err: %v)", err)
+	}
+}
@@ -0,0 +1,193 @@
+// Package techstack provides a heuristic check that later artifacts use
+// the technologies the design chose: a design on PostgreSQL whose tasks
+// set up MongoDB is a classic cross-artifact inconsistency.
+//
+// Technologies come from a small built-in catalog, grouped by the role
+// they play (database, message queue, frontend framework, ...). Two
+// technologies conflict when they fill the same role. The check is an
+// advisory aid for sdd_validate, not a gate.
+package techstack
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// technology is one catalog entry, matched by any of its aliases as a
+// whole word.
+type technology struct {
+	name     string
+	role     string
+	patterns []*regexp.Regexp
+}
+
+// catalog lists the recognized technologies. Lowercase aliases match
+// case-insensitively; an alias with an uppercase letter matches exactly,
+// which keeps everyday words ("react to", "guard rails") from counting.
+// Leave out aliases that are ambiguous either way, such as "Go".
+var catalog = []technology{
+	tech("PostgreSQL", "database", "postgresql", "postgres", "pgsql"),
+	tech("MySQL", "database", "mysql"),
+	tech("MariaDB", "database", "mariadb"),
+	tech("SQLite", "database", "sqlite", "sqlite3"),
+	tech("SQL Server", "database", "sql server", "mssql"),
+	tech("MongoDB", "database", "mongodb", "mongo"),
+	tech("DynamoDB", "database", "dynamodb"),
+	tech("Cassandra", "database", "cassandra"),
+	tech("CockroachDB", "database", "cockroachdb"),
+	tech("Firestore", "database", "firestore"),
+
+	tech("Redis", "cache", "redis"),
+	tech("Memcached", "cache", "memcached"),
+
+	tech("Kafka", "message queue", "kafka"),
+	tech("RabbitMQ", "message queue", "rabbitmq"),
+	tech("Amazon SQS", "message queue", "sqs"),
+	tech("NATS", "message queue", "NATS"),
+
+	tech("React", "frontend framework", "React", "reactjs", "react.js"),
+	tech("Vue", "frontend framework", "vue", "vuejs", "vue.js"),
+	tech("Angular", "frontend framework", "Angular", "angularjs"),
+	tech("Svelte", "frontend framework", "svelte", "sveltekit"),
+
+	tech("Django", "backend framework", "django"),
+	tech("Flask", "backend framework", "Flask"),
+	tech("FastAPI", "backend framework", "fastapi"),
+	tech("Ruby on Rails", "backend framework", "Rails", "ruby on rails"),
+	tech("Express", "backend framework", "express.js", "expressjs"),
+	tech("NestJS", "backend framework", "nestjs"),
+	tech("Spring Boot", "backend framework", "spring boot"),
+	tech("Laravel", "backend framework", "laravel"),
+
+	tech("AWS", "cloud provider", "aws", "amazon web services"),
+	tech("Google Cloud", "cloud provider", "gcp", "google cloud"),
+	tech("Azure", "cloud provider", "Azure"),
+}
+
+func tech(name, role string, aliases ...string) technology {
+	t := technology{name: name, role: role}
+	for _, a := range aliases {
+		flags := "(?i)"
+		if strings.ToLower(a) != a {
+			flags = ""
+		}
+		t.patterns = append(t.patterns, regexp.MustCompile(flags+`(?:^|[^\w.])`+regexp.QuoteMeta(a)+`(?:$|[^\w])`))
+	}
+	return t
+}
+
+// dismissal marks where a line stops endorsing a technology: text after
+// "instead of", "not", "migrate from" and the like names alternatives
+// that were rejected or are being replaced.
+var dismissal = regexp.MustCompile(`(?i)\b(?:instead of|rather than|chosen over|over|vs\.?|versus|not|no|never|without|avoid\w*|replac\w*|migrat\w* (?:away )?from|away from)\b`)
+
+// maxExcerpt caps the length of the quoted line in a conflict.
+const maxExcerpt = 100
+
+// Conflict is a technology mentioned in an artifact that competes with
+// the design's choice for the same role.
+type Conflict struct {
+	Role   string   // e.g. "database"
+	Chosen []string // the design's technologies for the role
+	Found  string   // the competing technology
+	Line   string   // excerpt of the first line mentioning it
+}
+
+// String renders the conflict, e.g.
+// `database: design uses PostgreSQL, but MongoDB appears — "- TASK-005: Set up MongoDB"`.
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: design uses %s, but %s appears — %q",
+		c.Role, strings.Join(c.Chosen, ", "), c.Found, c.Line)
+}
+
+// Extract returns the catalog technologies endorsed in text, in catalog
+// order. Mentions after a dismissal on the same line ("PostgreSQL, chosen
+// over MongoDB") are not endorsements.
+func Extract(text string) []string {
+	var names []string
+	for _, m := range endorsedIn(text) {
+		names = append(names, m.tech.name)
+	}
+	return names
+}
+
+// Detect compares the technologies endorsed in techStack (the design's
+// Tech Stack section) with those endorsed in content (typically
+// tasks.md) and returns one conflict per competing technology, in
+// catalog order. Roles the design does not mention are not checked —
+// there is no choice to conflict with.
+func Detect(techStack, content string) []Conflict {
+	chosen := make(map[string][]string)
+	chosenName := make(map[string]bool)
+	for _, m := range endorsedIn(techStack) {
+		chosen[m.tech.role] = append(chosen[m.tech.role], m.tech.name)
+		chosenName[m.tech.name] = true
+	}
+	if len(chosen) == 0 {
+		return nil
+	}
+
+	var conflicts []Conflict
+	for _, m := range endorsedIn(content) {
+		if chosenName[m.tech.name] || len(chosen[m.tech.role]) == 0 {
+			continue
+		}
+		conflicts = append(conflicts, Conflict{
+			Role:   m.tech.role,
+			Chosen: chosen[m.tech.role],
+			Found:  m.tech.name,
+			Line:   excerpt(m.line),
+		})
+	}
+	return conflicts
+}
+
+// mention is a technology and the first line endorsing it.
+type mention struct {
+	tech technology
+	line string
+}
+
+// endorsedIn finds the catalog technologies endorsed in text, in
+// catalog order, each with the first line that endorses it.
+func endorsedIn(text string) []mention {
+	lines := strings.Split(text, "\n")
+	var found []mention
+	for _, t := range catalog {
+		for _, line := range lines {
+			if t.matches(endorsed(line)) {
+				found = append(found, mention{tech: t, line: strings.TrimSpace(line)})
+				break
+			}
+		}
+	}
+	return found
+}
+
+// matches reports whether any of t's aliases appears in s.
+func (t technology) matches(s string) bool {
+	for _, p := range t.patterns {
+		if p.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// endorsed returns the part of line before its first dismissal.
+func endorsed(line string) string {
+	if loc := dismissal.FindStringIndex(line); loc != nil {
+		return line[:loc[0]]
+	}
+	return line
+}
+
+// excerpt shortens s to maxExcerpt runes.
+func excerpt(s string) string {
+	r := []rune(s)
+	if len(r) <= maxExcerpt {
+		return s
+	}
+	return string(r[:maxExcerpt-1]) + "…"
+}
@@ -0,0 +1,89 @@
+package techstack
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const designStack = `- **Language**: TypeScript on Node.js
+- **Database**: Postgres 16, chosen over MongoDB for transactional integrity
+- **Cache**: Redis
+- **Frontend**: React 18`
+
+func TestExtract(t *testing.T) {
+	got := Extract(designStack)
+	want := []string{"PostgreSQL", "Redis", "React"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Extract = %v, want %v", got, want)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string // "role/found"
+	}{
+		{
+			name:    "consistent tasks",
+			content: "### TASK-001: Set up PostgreSQL schema\n### TASK-002: Add Redis session cache",
+		},
+		{
+			name:    "alias of the chosen database",
+			content: "### TASK-001: Provision postgresql on RDS",
+		},
+		{
+			name:    "competing database",
+			content: "### TASK-005: Set up MongoDB collections\n### TASK-006: Seed mongo again",
+			want:    []string{"database/MongoDB"},
+		},
+		{
+			name:    "competing cache and frontend",
+			content: "- Configure Memcached\n- Build the dashboard in Vue",
+			want:    []string{"cache/Memcached", "frontend framework/Vue"},
+		},
+		{
+			name:    "dismissed mention",
+			content: "- Use PostgreSQL instead of MySQL\n- Do not add MongoDB",
+		},
+		{
+			name:    "role the design does not cover",
+			content: "- Publish events to Kafka",
+		},
+		{
+			name:    "everyday words are not technologies",
+			content: "- Components react to store changes\n- Add guard rails to the importer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, c := range Detect(designStack, tt.content) {
+				got = append(got, c.Role+"/"+c.Found)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Detect = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetect_NoStack(t *testing.T) {
+	if got := Detect("- Whatever the team prefers", "- Set up MongoDB"); got != nil {
+		t.Errorf("Detect without a recognized stack = %v, want nil", got)
+	}
+}
+
+func TestConflict_String(t *testing.T) {
+	conflicts := Detect(designStack, "### TASK-005: Set up MongoDB collections")
+	if len(conflicts) != 1 {
+		t.Fatalf("Detect = %v, want one conflict", conflicts)
+	}
+	s := conflicts[0].String()
+	if !strings.HasPrefix(s, "database: design uses PostgreSQL, but MongoDB appears") ||
+		!strings.Contains(s, `"### TASK-005: Set up MongoDB collections"`) {
+		t.Errorf("String = %q", s)
+	}
+}
@@ -0,0 +1,132 @@
+package templates
+
+import (
+	"regexp"
+	"strings"
+)
+
+// referencesHeading opens the optional "References" section every
+// template with a References field ends with.
+const referencesHeading = "## References"
+
+// domainsHeading opens the "Domains" section of a requirements.md that
+// indexes per-domain requirement files.
+const domainsHeading = "## Domains"
+
+// charterSections maps each charter.md.tmpl "##" heading to its field.
+func charterSections(d *CharterData) []section {
+	return []section{
+		{"## Problem Statement", &d.ProblemStatement},
+		{"## Target Users", &d.TargetUsers},
+		{"## Proposed Solution", &d.ProposedSolution},
+		{"## Success Criteria", &d.SuccessCriteria},
+		{"## Domain Context", &d.DomainContext},
+		{"## Stakeholders", &d.Stakeholders},
+		{"## Vision", &d.Vision},
+		{"## Boundaries", &d.Boundaries},
+		{"## Existing Systems", &d.ExistingSystems},
+		{"## Constraints", &d.Constraints},
+	}
+}
+
+// requirementsSections maps each requirements.md.tmpl heading that
+// holds a field to that field. The MoSCoW buckets are "###" headings
+// under "## Functional Requirements", which itself holds nothing.
+func requirementsSections(d *RequirementsData) []section {
+	return []section{
+		{"### Must Have", &d.MustHave},
+		{"### Should Have", &d.ShouldHave},
+		{"### Could Have", &d.CouldHave},
+		{"### Won't Have (this version)", &d.WontHave},
+		{"## Non-Functional Requirements", &d.NonFunctional},
+		{"## Constraints", &d.Constraints},
+		{"## Assumptions", &d.Assumptions},
+		{"## Dependencies", &d.Dependencies},
+	}
+}
+
+// section is one heading line of a rendered template and the data
+// field its body came from.
+type section struct {
+	heading string
+	field   *string
+}
+
+// ParseCharter reads a rendered charter.md back into CharterData — the
+// inverse of rendering the Charter template. Only the template's own
+// "##" headings split sections, so deeper headings ("### In Scope")
+// and unknown "##" headings stay inside the body they appear in.
+// Section bodies are trimmed; sections that are absent stay empty.
+func ParseCharter(markdown string) CharterData {
+	var d CharterData
+	var refs string
+	parseSections(markdown, " — Charter", &d.Name, append(charterSections(&d), section{referencesHeading, &refs}))
+	d.References = parseReferences(refs)
+	return d
+}
+
+// ParseRequirements reads a rendered requirements.md back into
+// RequirementsData — the inverse of rendering the Requirements template.
+// Bodies are trimmed; the "## Functional Requirements" heading and the
+// attribution line are dropped.
+func ParseRequirements(markdown string) RequirementsData {
+	var d RequirementsData
+	var domains, refs string
+	parseSections(markdown, " — Requirements", &d.Name, append(requirementsSections(&d),
+		section{domainsHeading, &domains}, section{referencesHeading, &refs}))
+	d.Domains = parseReferences(domains)
+	d.References = parseReferences(refs)
+	return d
+}
+
+// referenceLine matches a rendered reference, "- [Title](URL)".
+var referenceLine = regexp.MustCompile(`^- \[(.*)\]\((\S+)\)$`)
+
+// parseReferences reads the list items of a "References" section back
+// into References. Lines that aren't rendered references are skipped.
+func parseReferences(body string) []Reference {
+	var refs []Reference
+	for _, line := range strings.Split(body, "\n") {
+		if m := referenceLine.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			refs = append(refs, Reference{Title: m[1], URL: m[2]})
+		}
+	}
+	return refs
+}
+
+// parseSections fills fields from a rendered artifact. The "# <name><titleSuffix>"
+// title line fills name; each known heading line starts its field's body.
+// A heading that isn't known ends nothing: it stays in the current body.
+func parseSections(markdown, titleSuffix string, name *string, sections []section) {
+	known := make(map[string]*string, len(sections))
+	for _, s := range sections {
+		known[s.heading] = s.field
+	}
+
+	var current *string
+	var body []string
+	flush := func() {
+		if current != nil {
+			*current = strings.TrimSpace(strings.Join(body, "\n"))
+		}
+		body = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		if title, ok := strings.CutPrefix(line, "# "); ok && current == nil && *name == "" {
+			*name = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(title), titleSuffix))
+			continue
+		}
+		if strings.HasPrefix(line, "##") {
+			if field, isKnown := known[strings.TrimSpace(line)]; isKnown {
+				flush()
+				current = field
+				continue
+			}
+		}
+		if current != nil {
+			body = append(body, line)
+		}
+	}
+	flush()
+}
@@ -0,0 +1,95 @@
+package templates
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCharter_RoundTrip(t *testing.T) {
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data CharterData
+	}{
+		{
+			name: "required fields only",
+			data: CharterData{
+				Name:             "time-tracker",
+				ProblemStatement: "Freelancers waste time tracking hours.",
+				TargetUsers:      "- **Freelance designers**\n- **Agency owners**",
+				ProposedSolution: "A simple web app for logging hours.",
+				SuccessCriteria:  "- Log time in under 10 seconds",
+			},
+		},
+		{
+			name: "every field, with nested headings",
+			data: CharterData{
+				Name:             "billing",
+				ProblemStatement: "Invoices are sent late.",
+				TargetUsers:      "- Finance team",
+				ProposedSolution: "Automated invoicing.",
+				SuccessCriteria:  "- Invoices sent on the 1st\n\n- Zero manual steps",
+				DomainContext:    "B2B SaaS, EU VAT rules apply.",
+				Stakeholders:     "- **CFO**: cash flow",
+				Vision:           "Full accounts receivable by v3.",
+				Boundaries:       "### In Scope\n- Invoicing\n\n### Out of Scope\n- Payroll",
+				ExistingSystems:  "- Legacy ERP",
+				Constraints:      "- Must run on AWS",
+				References: []Reference{
+					{Title: "RFC 5545 (iCalendar)", URL: "https://www.rfc-editor.org/rfc/rfc5545"},
+					{Title: "BILL-12", URL: "https://jira.example.com/browse/BILL-12"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := r.Render(Charter, tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ParseCharter(md); !reflect.DeepEqual(got, tt.data) {
+				t.Errorf("ParseCharter(Render(data)) =\n%+v\nwant\n%+v", got, tt.data)
+			}
+		})
+	}
+}
+
+func TestParseCharter_CRLF(t *testing.T) {
+	got := ParseCharter("# app — Charter\r\n\r\n## Vision\r\n\r\nBig.\r\n")
+	if got.Name != "app" || got.Vision != "Big." {
+		t.Errorf("ParseCharter = %+v", got)
+	}
+}
+
+func TestParseRequirements_RoundTrip(t *testing.T) {
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := RequirementsData{
+		Name:          "tracker",
+		MustHave:      "- **FR-001**: Users can log time\n  - Sub-point kept with its item",
+		ShouldHave:    "- **FR-002**: Users can export CSV",
+		CouldHave:     "- **FR-003**: Dark mode",
+		WontHave:      "- **FR-004**: Mobile app",
+		NonFunctional: "- **NFR-001**: Pages load in under 2s",
+		Constraints:   "- Must run on AWS",
+		Assumptions:   "- Users have a browser",
+		Dependencies:  "- Stripe",
+		Domains:       []Reference{{Title: "auth", URL: "requirements/auth.md"}, {Title: "billing", URL: "requirements/billing.md"}},
+		References:    []Reference{{Title: "PROJ-7 [import]", URL: "https://tracker.example.com/PROJ-7"}},
+	}
+	md, err := r.Render(Requirements, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ParseRequirements(md); !reflect.DeepEqual(got, data) {
+		t.Errorf("ParseRequirements(Render(data)) =\n%+v\nwant\n%+v", got, data)
+	}
+}
@@ -9,6 +9,11 @@ import (
 	"bytes"
 	"embed"
 	"fmt"
+	"io/fs"
+	"os"
+	"reflect"
+	"sort"
+	"sync/atomic"
 	"text/template"
 )
 
@@ -24,39 +29,198 @@ const (
 	Clarifications    = "clarifications.md.tmpl"
 	Design            = "design.md.tmpl"
 	Tasks             = "tasks.md.tmpl"
+	Research          = "research.md.tmpl"
+	Summary           = "summary.md.tmpl"
 	AgentInstructions = "agent-instructions.md.tmpl"
 )
 
+// dataTypes maps each template to the data struct it renders, so List
+// can report the fields a template override may use.
+var dataTypes = map[string]any{
+	Principles:        PrinciplesData{},
+	Charter:           CharterData{},
+	Requirements:      RequirementsData{},
+	BusinessRules:     BusinessRulesData{},
+	Clarifications:    ClarificationsData{},
+	Design:            DesignData{},
+	Tasks:             TasksData{},
+	Research:          ResearchData{},
+	Summary:           SummaryData{},
+	AgentInstructions: AgentInstructionsData{},
+}
+
+// Template sources reported by List.
+const (
+	SourceEmbed   = "embed"   // built into the binary
+	SourceOverlay = "overlay" // overridden by a file in the overlay directory
+)
+
+// TemplateInfo describes one active template: where it was loaded from
+// and the data fields it can reference (as {{ .Field }}).
+type TemplateInfo struct {
+	Name   string
+	Source string
+	Fields []string
+}
+
 // Renderer renders markdown templates with provided data.
 // Abstracted as interface for testability (DIP).
 type Renderer interface {
 	Render(templateName string, data any) (string, error)
+	// List describes the active templates, sorted by name.
+	List() []TemplateInfo
 }
 
 // EmbedRenderer renders templates from the embedded filesystem.
+//
+// Concurrency contract: Render is safe to call from many goroutines at
+// once. The parsed template set is immutable once published — executing
+// a *template.Template concurrently is safe, mutating it is not. Any
+// runtime re-parse (Reload) builds a brand-new set and swaps it in
+// atomically (copy-on-write), so in-flight renders keep using the set
+// they started with and never observe a half-parsed state.
 type EmbedRenderer struct {
-	templates *template.Template
+	templates atomic.Pointer[templateSet]
+	fsys      fs.FS
+	patterns  []string
+	overlay   fs.FS // nil when no overlay directory is configured
+}
+
+// templateSet is one parsed generation of templates, with the names an
+// overlay replaced.
+type templateSet struct {
+	tmpl     *template.Template
+	overlaid map[string]bool
 }
 
 // NewRenderer creates a renderer with all embedded templates parsed.
 func NewRenderer() (*EmbedRenderer, error) {
-	tmpl, err := template.ParseFS(templateFS, "*.tmpl")
+	return newRendererFromFS(templateFS, "*.tmpl")
+}
+
+// NewRendererWithOverlay creates a renderer whose *.tmpl files in dir
+// replace the embedded templates of the same name. An empty dir is
+// NewRenderer. Every overlay file must override an embedded template,
+// so a misspelled name fails loudly instead of being ignored.
+func NewRendererWithOverlay(dir string) (*EmbedRenderer, error) {
+	if dir == "" {
+		return NewRenderer()
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("template overlay %q is not a directory", dir)
+	}
+	r := &EmbedRenderer{fsys: templateFS, patterns: []string{"*.tmpl"}, overlay: bomFS{os.DirFS(dir)}}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// newRendererFromFS parses the templates matching patterns in fsys.
+func newRendererFromFS(fsys fs.FS, patterns ...string) (*EmbedRenderer, error) {
+	r := &EmbedRenderer{fsys: fsys, patterns: patterns}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// utf8BOM is the byte order mark some Windows editors prepend to
+// UTF-8 files.
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// bomFS strips a leading UTF-8 BOM from the files it reads, so an
+// overlay template saved with one doesn't render it into the artifact.
+type bomFS struct {
+	fs.FS
+}
+
+// ReadFile implements fs.ReadFileFS.
+func (b bomFS) ReadFile(name string) ([]byte, error) {
+	data, err := fs.ReadFile(b.FS, name)
+	return bytes.TrimPrefix(data, utf8BOM), err
+}
+
+// Reload re-parses the renderer's template source and atomically swaps
+// in the new set. On parse failure the previous set stays active.
+//
+// Templates are parsed with missingkey=error so a reference to a data
+// field that doesn't exist fails the render with an error naming the
+// field, instead of silently emitting "<no value>" into the artifact.
+// (Struct data already errors on unknown fields; this option covers
+// map data, which text/template otherwise renders leniently.)
+func (r *EmbedRenderer) Reload() error {
+	tmpl, err := template.New("").Option("missingkey=error").ParseFS(r.fsys, r.patterns...)
 	if err != nil {
-		return nil, fmt.Errorf("parsing templates: %w", err)
+		return fmt.Errorf("parsing templates: %w", err)
+	}
+	set := &templateSet{tmpl: tmpl, overlaid: map[string]bool{}}
+	if r.overlay != nil {
+		names, err := fs.Glob(r.overlay, "*.tmpl")
+		if err != nil {
+			return fmt.Errorf("listing overlay templates: %w", err)
+		}
+		for _, name := range names {
+			if tmpl.Lookup(name) == nil {
+				return fmt.Errorf("overlay template %s does not override any built-in template", name)
+			}
+			set.overlaid[name] = true
+		}
+		if len(names) > 0 {
+			if set.tmpl, err = tmpl.ParseFS(r.overlay, "*.tmpl"); err != nil {
+				return fmt.Errorf("parsing overlay templates: %w", err)
+			}
+		}
 	}
-	return &EmbedRenderer{templates: tmpl}, nil
+	r.templates.Store(set)
+	return nil
 }
 
 // Render executes the named template with the given data and returns
 // the resulting markdown string.
 func (r *EmbedRenderer) Render(templateName string, data any) (string, error) {
 	var buf bytes.Buffer
-	if err := r.templates.ExecuteTemplate(&buf, templateName, data); err != nil {
+	if err := r.templates.Load().tmpl.ExecuteTemplate(&buf, templateName, data); err != nil {
 		return "", fmt.Errorf("rendering %s: %w", templateName, err)
 	}
 	return buf.String(), nil
 }
 
+// List describes the active templates, sorted by name. Fields are the
+// exported fields of the template's data struct; they're empty for a
+// template no tool renders.
+func (r *EmbedRenderer) List() []TemplateInfo {
+	set := r.templates.Load()
+	var infos []TemplateInfo
+	for _, t := range set.tmpl.Templates() {
+		name := t.Name()
+		if name == "" || t.Tree == nil {
+			continue
+		}
+		info := TemplateInfo{Name: name, Source: SourceEmbed}
+		if set.overlaid[name] {
+			info.Source = SourceOverlay
+		}
+		if data, ok := dataTypes[name]; ok {
+			typ := reflect.TypeOf(data)
+			for i := range typ.NumField() {
+				if f := typ.Field(i); f.IsExported() {
+					info.Fields = append(info.Fields, f.Name)
+				}
+			}
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// DefaultAttribution is the attribution rendered into each artifact's
+// header line. Projects may replace it or remove it entirely (see
+// config.ProjectConfig.AttributionFooter). Each template data struct's
+// Attribution field carries the value to render; empty drops it.
+const DefaultAttribution = "Generated by [Hoofy](https://github.com/HendryAvila/Hoofy)"
+
 // --- Template data structures ---
 
 // PrinciplesData holds the data for rendering project principles.
@@ -65,6 +229,14 @@ type PrinciplesData struct {
 	Principles      string
 	CodingStandards string
 	DomainTruths    string
+	Attribution     string
+}
+
+// Reference is an external link — an RFC, a ticket, a prior design —
+// listed in an artifact's "References" section.
+type Reference struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
 }
 
 // CharterData holds the data for rendering a project charter.
@@ -80,6 +252,8 @@ type CharterData struct {
 	Boundaries       string
 	ExistingSystems  string
 	Constraints      string
+	References       []Reference
+	Attribution      string
 }
 
 // RequirementsData holds the data for rendering requirements.
@@ -93,6 +267,11 @@ type RequirementsData struct {
 	Constraints   string
 	Assumptions   string
 	Dependencies  string
+	// Domains links the per-domain requirement files when requirements
+	// are split by domain; this file is then their index.
+	Domains     []Reference
+	References  []Reference
+	Attribution string
 }
 
 // ClarificationsData holds the data for rendering the clarifications log.
@@ -103,6 +282,7 @@ type ClarificationsData struct {
 	Threshold    int
 	Status       string
 	Rounds       string
+	Attribution  string
 }
 
 // DesignData holds the data for rendering a technical design document.
@@ -115,7 +295,22 @@ type DesignData struct {
 	DataModel            string
 	Infrastructure       string
 	Security             string
+	DesignDecisions      string // optional: ADRs, one "### ADR-NNN: Title" block each
 	QualityAnalysis      string
+	References           []Reference
+	Attribution          string
+}
+
+// ResearchData holds the data for rendering the optional research stage.
+type ResearchData struct {
+	Name             string
+	Questions        string
+	OptionsEvaluated string
+	Spikes           string
+	Findings         string
+	Recommendation   string
+	OpenRisks        string
+	Attribution      string
 }
 
 // TasksData holds the data for rendering an implementation task breakdown.
@@ -127,6 +322,8 @@ type TasksData struct {
 	DependencyGraph    string
 	WaveAssignments    string // optional: parallel execution wave groupings
 	AcceptanceCriteria string
+	References         []Reference
+	Attribution        string
 }
 
 // BusinessRulesData holds the data for rendering business rules.
@@ -137,6 +334,21 @@ type BusinessRulesData struct {
 	Constraints string
 	Derivations string // optional: computed/inferred knowledge
 	Glossary    string // optional: additional domain vocabulary
+	Attribution string
+}
+
+// SummaryData holds the data for rendering the executive summary.
+type SummaryData struct {
+	Name            string
+	Problem         string
+	Solution        string
+	TopRequirements string
+	TechStack       string
+	TaskCount       int
+	EstimatedEffort string
+	Verdict         string
+	References      []Reference // every stage's references, deduplicated
+	Attribution     string
 }
 
 // AgentInstructionsData holds the data for rendering the agent instructions section.
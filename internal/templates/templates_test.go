@@ -1,8 +1,14 @@
 package templates
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"testing/fstest"
 )
 
 // --- NewRenderer ---
@@ -17,6 +23,110 @@ func TestNewRenderer_Succeeds(t *testing.T) {
 	}
 }
 
+// --- Overlay and List ---
+
+func TestNewRendererWithOverlay(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, Charter), []byte("# Custom {{ .Name }}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRendererWithOverlay(dir)
+	if err != nil {
+		t.Fatalf("NewRendererWithOverlay: %v", err)
+	}
+
+	out, err := r.Render(Charter, CharterData{Name: "tracker"})
+	if err != nil || out != "# Custom tracker\n" {
+		t.Errorf("overridden charter = %q, %v", out, err)
+	}
+	if out, _ := r.Render(Principles, PrinciplesData{Name: "tracker"}); !strings.Contains(out, "tracker") {
+		t.Errorf("templates without an override should still render, got %q", out)
+	}
+
+	infos := r.List()
+	if len(infos) != 10 {
+		t.Fatalf("List returned %d templates, want 10", len(infos))
+	}
+	for _, info := range infos {
+		want := SourceEmbed
+		if info.Name == Charter {
+			want = SourceOverlay
+		}
+		if info.Source != want {
+			t.Errorf("%s source = %s, want %s", info.Name, info.Source, want)
+		}
+		if info.Name == Tasks && !slices.Contains(info.Fields, "WaveAssignments") {
+			t.Errorf("tasks fields = %v", info.Fields)
+		}
+	}
+}
+
+func TestNewRendererWithOverlay_StripsBOM(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, Charter), []byte("\xef\xbb\xbf# {{ .Name }}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRendererWithOverlay(dir)
+	if err != nil {
+		t.Fatalf("NewRendererWithOverlay: %v", err)
+	}
+	if out, err := r.Render(Charter, CharterData{Name: "tracker"}); err != nil || out != "# tracker\n" {
+		t.Errorf("charter = %q, %v; want the BOM stripped", out, err)
+	}
+}
+
+func TestNewRendererWithOverlay_Errors(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "charterr.md.tmpl"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewRendererWithOverlay(dir); err == nil || !strings.Contains(err.Error(), "charterr.md.tmpl") {
+		t.Errorf("a misnamed overlay should fail naming the file, got %v", err)
+	}
+	if _, err := NewRendererWithOverlay(filepath.Join(dir, "missing")); err == nil {
+		t.Error("a missing overlay directory should fail")
+	}
+}
+
+// --- Missing data fields ---
+
+func TestRender_UnknownStructField_Errors(t *testing.T) {
+	r, err := newRendererFromFS(fstest.MapFS{
+		"typo.md.tmpl": {Data: []byte("# {{ .Name }}\n\n{{ .Nmae }}\n")},
+	}, "*.tmpl")
+	if err != nil {
+		t.Fatalf("newRendererFromFS: %v", err)
+	}
+
+	out, err := r.Render("typo.md.tmpl", PrinciplesData{Name: "x"})
+	if err == nil {
+		t.Fatalf("expected error for unknown field, got output: %q", out)
+	}
+	if !strings.Contains(err.Error(), "Nmae") {
+		t.Errorf("error should name the undefined field, got: %v", err)
+	}
+}
+
+func TestRender_MissingMapKey_Errors(t *testing.T) {
+	r, err := newRendererFromFS(fstest.MapFS{
+		"typo.md.tmpl": {Data: []byte("# {{ .Name }}\n\n{{ .Nmae }}\n")},
+	}, "*.tmpl")
+	if err != nil {
+		t.Fatalf("newRendererFromFS: %v", err)
+	}
+
+	out, err := r.Render("typo.md.tmpl", map[string]string{"Name": "x"})
+	if err == nil {
+		t.Fatalf("expected error for missing key, got output: %q", out)
+	}
+	if strings.Contains(out, "<no value>") {
+		t.Error("output must never contain <no value>")
+	}
+	if !strings.Contains(err.Error(), "Nmae") {
+		t.Errorf("error should name the missing key, got: %v", err)
+	}
+}
+
 // --- Render: Principles ---
 
 func TestRender_Principles(t *testing.T) {
@@ -27,6 +137,7 @@ func TestRender_Principles(t *testing.T) {
 
 	data := PrinciplesData{
 		Name:            "Test Project",
+		Attribution:     DefaultAttribution,
 		Principles:      "- Never store passwords in plain text\n- All API responses must include correlation IDs",
 		CodingStandards: "- Use conventional commits\n- No magic numbers",
 		DomainTruths:    "- Prices are always in cents (integer)\n- All timestamps are UTC",
@@ -46,7 +157,7 @@ func TestRender_Principles(t *testing.T) {
 		"conventional commits",
 		"## Domain Truths",
 		"Prices are always in cents",
-		"Hoofy", // Attribution link.
+		"> Generated by [Hoofy](https://github.com/HendryAvila/Hoofy) | ", // Attribution link.
 	}
 
 	for _, check := range checks {
@@ -94,6 +205,7 @@ func TestRender_Charter(t *testing.T) {
 
 	data := CharterData{
 		Name:             "Test Project",
+		Attribution:      DefaultAttribution,
 		ProblemStatement: "Users struggle with X",
 		TargetUsers:      "Developers and designers",
 		ProposedSolution: "Build a tool that does Y",
@@ -133,7 +245,7 @@ func TestRender_Charter(t *testing.T) {
 		"Legacy PHP app",
 		"## Constraints",
 		"AWS GovCloud",
-		"Hoofy", // Attribution link.
+		"> Generated by [Hoofy](https://github.com/HendryAvila/Hoofy) | ", // Attribution link.
 	}
 
 	for _, check := range checks {
@@ -188,6 +300,7 @@ func TestRender_Requirements(t *testing.T) {
 
 	data := RequirementsData{
 		Name:          "Test Project",
+		Attribution:   DefaultAttribution,
 		MustHave:      "- User authentication\n- Dashboard",
 		ShouldHave:    "- Email notifications",
 		CouldHave:     "- Dark mode",
@@ -221,7 +334,7 @@ func TestRender_Requirements(t *testing.T) {
 		"Users have modern browsers",
 		"## Dependencies",
 		"Auth0 for authentication",
-		"SDD-Hoffy", // Attribution link.
+		"> Generated by [Hoofy](https://github.com/HendryAvila/Hoofy) | ", // Attribution link.
 	}
 
 	for _, check := range checks {
@@ -306,6 +419,31 @@ func TestRender_EmptyCharterData(t *testing.T) {
 	}
 }
 
+// --- Render: Attribution ---
+
+func TestRender_Attribution(t *testing.T) {
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatalf("NewRenderer: %v", err)
+	}
+
+	custom, err := r.Render(Tasks, TasksData{Name: "X", Attribution: "Acme internal"})
+	if err != nil {
+		t.Fatalf("Render(custom attribution): %v", err)
+	}
+	if !strings.Contains(custom, "> Acme internal | Stage 5: Tasks") || strings.Contains(custom, "Generated by") {
+		t.Errorf("custom attribution not rendered:\n%s", custom)
+	}
+
+	omitted, err := r.Render(Tasks, TasksData{Name: "X"})
+	if err != nil {
+		t.Fatalf("Render(no attribution): %v", err)
+	}
+	if !strings.Contains(omitted, "\n> Stage 5: Tasks\n") {
+		t.Errorf("empty attribution should leave just the stage line:\n%s", omitted)
+	}
+}
+
 // --- Render: Tasks ---
 
 func TestRender_Tasks_WithWaveAssignments(t *testing.T) {
@@ -316,6 +454,7 @@ func TestRender_Tasks_WithWaveAssignments(t *testing.T) {
 
 	data := TasksData{
 		Name:               "Test Project",
+		Attribution:        DefaultAttribution,
 		TotalTasks:         "5",
 		EstimatedEffort:    "3-4 days",
 		Tasks:              "### TASK-001: Scaffolding\n**Component**: Setup",
@@ -341,7 +480,7 @@ func TestRender_Tasks_WithWaveAssignments(t *testing.T) {
 		"**Wave 2**",
 		"## Acceptance Criteria",
 		"All tests pass",
-		"SDD-Hoffy",
+		"> Generated by [Hoofy](https://github.com/HendryAvila/Hoofy) | ",
 	}
 
 	for _, check := range checks {
@@ -405,6 +544,7 @@ func TestRender_Design_WithQualityAnalysis(t *testing.T) {
 
 	data := DesignData{
 		Name:                 "Test Project",
+		Attribution:          DefaultAttribution,
 		ArchitectureOverview: "A modular monolith using Clean Architecture",
 		TechStack:            "- **Runtime**: Go 1.25",
 		Components:           "### AuthModule\n- Handles user auth",
@@ -441,7 +581,7 @@ func TestRender_Design_WithQualityAnalysis(t *testing.T) {
 		"Shotgun Surgery",
 		"Coupling & Cohesion",
 		"Mitigations",
-		"SDD-Hoffy", // Attribution link.
+		"> Generated by [Hoofy](https://github.com/HendryAvila/Hoofy) | ", // Attribution link.
 	}
 
 	for _, check := range checks {
@@ -488,3 +628,148 @@ func TestEmbedRenderer_ImplementsRenderer(t *testing.T) {
 	// Compile-time interface check.
 	var _ Renderer = r
 }
+
+// --- Concurrency ---
+
+// TestRender_ConcurrentWithReload hammers Render from many goroutines
+// while templates are re-parsed. Run with -race to verify the
+// copy-on-write contract.
+func TestRender_ConcurrentWithReload(t *testing.T) {
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatalf("NewRenderer: %v", err)
+	}
+
+	const workers = 32
+	const iterations = 50
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*iterations)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				out, err := r.Render(Principles, PrinciplesData{
+					Name:       fmt.Sprintf("project-%d-%d", w, i),
+					Principles: "- Never break the build",
+				})
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !strings.Contains(out, fmt.Sprintf("project-%d-%d", w, i)) {
+					errs <- fmt.Errorf("worker %d got output for another render", w)
+					return
+				}
+			}
+		}(w)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			if err := r.Reload(); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestReload_FailureKeepsPreviousSet(t *testing.T) {
+	fsys := fstest.MapFS{
+		"ok.md.tmpl": {Data: []byte("hello {{ .Name }}")},
+	}
+	r, err := newRendererFromFS(fsys, "*.tmpl")
+	if err != nil {
+		t.Fatalf("newRendererFromFS: %v", err)
+	}
+
+	fsys["ok.md.tmpl"] = &fstest.MapFile{Data: []byte("broken {{ .Name ")}
+	if err := r.Reload(); err == nil {
+		t.Fatal("expected Reload to fail on a malformed template")
+	}
+
+	out, err := r.Render("ok.md.tmpl", PrinciplesData{Name: "x"})
+	if err != nil || out != "hello x" {
+		t.Errorf("previous template set should remain active, got %q, %v", out, err)
+	}
+}
+
+// --- Render: Research ---
+
+func TestRender_Research(t *testing.T) {
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatalf("NewRenderer: %v", err)
+	}
+
+	result, err := r.Render(Research, ResearchData{
+		Name:             "Test Project",
+		Questions:        "- Which queue?",
+		OptionsEvaluated: "### NATS\n- lightweight",
+		Spikes:           "- Spike: 10k msgs/s",
+		Findings:         "NATS meets NFR-002",
+		Recommendation:   "Use NATS",
+		OpenRisks:        "- Ops familiarity",
+	})
+	if err != nil {
+		t.Fatalf("Render(Research) failed: %v", err)
+	}
+
+	for _, check := range []string{
+		"# Test Project — Research",
+		"## Options Evaluated",
+		"## Spikes & Experiments",
+		"## Recommendation",
+		"Use NATS",
+	} {
+		if !strings.Contains(result, check) {
+			t.Errorf("Research output missing: %q", check)
+		}
+	}
+}
+
+// --- Render: Summary ---
+
+func TestRender_Summary(t *testing.T) {
+	r, err := NewRenderer()
+	if err != nil {
+		t.Fatalf("NewRenderer: %v", err)
+	}
+
+	result, err := r.Render(Summary, SummaryData{
+		Name:            "Test Project",
+		Problem:         "Invoices are reconciled by hand",
+		Solution:        "Automatic bank matching",
+		TopRequirements: "- **FR-001**: Import bank statements",
+		TechStack:       "- Go\n- PostgreSQL",
+		TaskCount:       12,
+		EstimatedEffort: "3 weeks",
+		Verdict:         "PASS",
+	})
+	if err != nil {
+		t.Fatalf("Render(Summary) failed: %v", err)
+	}
+
+	for _, check := range []string{
+		"# Test Project — Executive Summary",
+		"## Top Requirements",
+		"**Tasks:** 12",
+		"**Estimated Effort:** 3 weeks",
+		"**Verdict:** PASS",
+	} {
+		if !strings.Contains(result, check) {
+			t.Errorf("Summary output missing: %q", check)
+		}
+	}
+}
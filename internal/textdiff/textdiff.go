@@ -0,0 +1,201 @@
+// Package textdiff produces line-based unified diffs of markdown
+// artifacts. It is intentionally small: artifacts are a few hundred
+// lines, so a longest-common-subsequence table is fast enough and keeps
+// the module free of diff dependencies.
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultContext is the number of unchanged lines shown around each change.
+const DefaultContext = 3
+
+// opKind classifies one line of an edit script.
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+// op is one line of an edit script, with its 0-based position in a and b.
+type op struct {
+	kind opKind
+	text string
+	aIdx int
+	bIdx int
+}
+
+// Unified returns a unified diff from a to b with the given number of
+// context lines, using aName and bName in the ---/+++ header.
+// Identical inputs return "". Line endings are normalized to LF.
+func Unified(aName, bName, a, b string, context int) string {
+	ops := editScript(splitLines(a), splitLines(b))
+	hs := hunks(ops, context)
+	if len(hs) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", aName, bName)
+	for _, h := range hs {
+		writeHunk(&sb, ops[h[0]:h[1]])
+	}
+	return sb.String()
+}
+
+// Stats counts the added and removed lines between a and b.
+func Stats(a, b string) (added, removed int) {
+	for _, o := range editScript(splitLines(a), splitLines(b)) {
+		switch o.kind {
+		case opInsert:
+			added++
+		case opDelete:
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// splitLines splits content into lines without their terminators.
+func splitLines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// editScript computes a minimal line edit script turning a into b.
+// The common prefix and suffix are stripped before building the LCS
+// table, so small edits to large files stay cheap.
+func editScript(a, b []string) []op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []op
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, op{kind: opEqual, text: a[i], aIdx: i, bIdx: i})
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	// lcs[i][j] is the LCS length of ma[i:] and mb[j:].
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			if ma[i] == mb[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < len(ma) || j < len(mb) {
+		switch {
+		case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+			ops = append(ops, op{kind: opEqual, text: ma[i], aIdx: prefix + i, bIdx: prefix + j})
+			i++
+			j++
+		case j < len(mb) && (i == len(ma) || lcs[i][j+1] > lcs[i+1][j]):
+			ops = append(ops, op{kind: opInsert, text: mb[j], aIdx: prefix + i, bIdx: prefix + j})
+			j++
+		default:
+			ops = append(ops, op{kind: opDelete, text: ma[i], aIdx: prefix + i, bIdx: prefix + j})
+			i++
+		}
+	}
+
+	for k := 0; k < suffix; k++ {
+		ai, bi := len(a)-suffix+k, len(b)-suffix+k
+		ops = append(ops, op{kind: opEqual, text: a[ai], aIdx: ai, bIdx: bi})
+	}
+	return ops
+}
+
+// hunks groups changed ops with up to context equal lines on each side,
+// merging groups whose context overlaps. Each hunk is a [start, end)
+// range into ops.
+func hunks(ops []op, context int) [][2]int {
+	var result [][2]int
+	for i := 0; i < len(ops); i++ {
+		if ops[i].kind == opEqual {
+			continue
+		}
+		start := max(i-context, 0)
+		end := i + 1
+		for end < len(ops) {
+			if ops[end].kind != opEqual {
+				end++
+				continue
+			}
+			// Look ahead: stop if the next change is beyond 2*context equal lines.
+			run := 0
+			for end+run < len(ops) && ops[end+run].kind == opEqual {
+				run++
+			}
+			if end+run == len(ops) || run > 2*context {
+				end += min(run, context)
+				break
+			}
+			end += run
+		}
+		if n := len(result); n > 0 && start <= result[n-1][1] {
+			result[n-1][1] = end
+		} else {
+			result = append(result, [2]int{start, end})
+		}
+		i = end - 1
+	}
+	return result
+}
+
+// writeHunk writes one @@ hunk.
+func writeHunk(sb *strings.Builder, ops []op) {
+	aStart, bStart := ops[0].aIdx, ops[0].bIdx
+	aCount, bCount := 0, 0
+	for _, o := range ops {
+		if o.kind != opInsert {
+			aCount++
+		}
+		if o.kind != opDelete {
+			bCount++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(aStart, aCount), hunkRange(bStart, bCount))
+	for _, o := range ops {
+		switch o.kind {
+		case opEqual:
+			sb.WriteString(" " + o.text + "\n")
+		case opDelete:
+			sb.WriteString("-" + o.text + "\n")
+		case opInsert:
+			sb.WriteString("+" + o.text + "\n")
+		}
+	}
+}
+
+// hunkRange formats a 0-based start and line count as a unified-diff
+// range. Empty ranges point at the line before the change, per GNU diff.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,53 @@
+package textdiff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnified_Identical(t *testing.T) {
+	if got := Unified("a", "b", "x\ny\n", "x\r\ny\r\n", DefaultContext); got != "" {
+		t.Errorf("identical content (modulo CRLF) should diff empty, got %q", got)
+	}
+}
+
+func TestUnified_SingleChange(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n"
+	b := "1\n2\n3\n4\nFIVE\n6\n7\n8\n9\n"
+	want := "--- a\n+++ b\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+FIVE\n 6\n 7\n 8\n"
+	if got := Unified("a", "b", a, b, DefaultContext); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestUnified_SeparateHunks(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 20; i++ {
+		line := string(rune('a' + i))
+		a = append(a, line)
+		b = append(b, line)
+	}
+	b[1] = "B"
+	b[18] = "S"
+	got := Unified("a", "b", strings.Join(a, "\n"), strings.Join(b, "\n"), 1)
+	if strings.Count(got, "@@ -") != 2 {
+		t.Errorf("expected two hunks, got:\n%s", got)
+	}
+	if !strings.Contains(got, "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n") {
+		t.Errorf("first hunk wrong:\n%s", got)
+	}
+}
+
+func TestUnified_AddedFile(t *testing.T) {
+	want := "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+	if got := Unified("a", "b", "", "x\ny\n", DefaultContext); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestStats(t *testing.T) {
+	added, removed := Stats("a\nb\nc\n", "a\nc\nd\ne\n")
+	if added != 2 || removed != 1 {
+		t.Errorf("Stats = +%d -%d, want +2 -1", added, removed)
+	}
+}
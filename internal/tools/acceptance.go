@@ -0,0 +1,277 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// AcceptanceTool handles the sdd_add_acceptance_tests MCP tool.
+// It attaches Gherkin-style Given/When/Then scenarios to requirement IDs
+// and keeps them in docs/acceptance.md, one section per requirement.
+//
+// Design: side artifact, not a pipeline stage. It never advances the
+// pipeline and can be called any time after specify has completed.
+type AcceptanceTool struct {
+	store config.Store
+}
+
+// NewAcceptanceTool creates an AcceptanceTool with its dependencies.
+func NewAcceptanceTool(store config.Store) *AcceptanceTool {
+	return &AcceptanceTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *AcceptanceTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_add_acceptance_tests",
+		mcp.WithDescription(
+			"Attach Gherkin-style acceptance scenarios (Given/When/Then) to requirement IDs. "+
+				"Scenarios are stored in `docs/acceptance.md`, keyed by FR/NFR ID, so each "+
+				"requirement maps to a test you can write first (TDD). "+
+				"Calling again for an ID replaces its scenarios; other IDs are kept. "+
+				"Every referenced ID must exist in requirements.md. "+
+				"The response lists which FRs still lack scenarios. "+
+				"Requires: sdd_generate_requirements must have been run first. "+
+				"Does not change pipeline state.",
+		),
+		mcp.WithString("scenarios",
+			mcp.Required(),
+			mcp.Description("Scenarios grouped by requirement ID. Start each group with a line "+
+				"holding the ID, followed by its Given/When/Then steps. "+
+				"Example: 'FR-001:\\nGiven a visitor on the signup page\\n"+
+				"When they submit a valid email and password\\nThen an account is created\\n\\n"+
+				"FR-002:\\nGiven a registered user\\nWhen they request a password reset\\n"+
+				"Then a reset link is emailed'"),
+		),
+	)
+}
+
+// Handle processes the sdd_add_acceptance_tests tool call.
+func (t *AcceptanceTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := acceptanceParams{Scenarios: req.GetString("scenarios", "")}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// acceptanceParams are the parsed sdd_add_acceptance_tests arguments.
+type acceptanceParams struct {
+	Scenarios string
+}
+
+// acceptanceParamSpecs declares the sdd_add_acceptance_tests argument checks.
+var acceptanceParamSpecs = []paramSpec{
+	{name: "scenarios", required: true, hint: "group Given/When/Then steps under requirement IDs like 'FR-001:'"},
+}
+
+// process validates the scenarios and merges them into acceptance.md.
+func (t *AcceptanceTool) process(projectRoot string, params acceptanceParams) (string, error) {
+	input := params.Scenarios
+	if err := validateParams(acceptanceParamSpecs, map[string]string{"scenarios": input}); err != nil {
+		return "", err
+	}
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+
+	if !pipeline.IsCompleted(cfg, config.StageSpecify) {
+		return "", newUserError(
+			"acceptance tests can only be added after the specify stage — run sdd_generate_requirements first",
+		)
+	}
+	if err := pipeline.RequireArtifacts(projectRoot, config.StageSpecify); err != nil {
+		return "", asUserError(err)
+	}
+
+	incoming, err := parseAcceptanceInput(input)
+	if err != nil {
+		return "", asUserError(err)
+	}
+
+	requirements, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return "", fmt.Errorf("reading requirements: %w", err)
+	}
+	known := make(map[string]bool)
+	for _, id := range extractRequirementIDs(requirements) {
+		known[id] = true
+	}
+	var unknown []string
+	for id := range incoming {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", newUserError(fmt.Sprintf(
+			"unknown requirement ID(s): %s — every ID must exist in requirements.md",
+			strings.Join(unknown, ", "),
+		))
+	}
+
+	path := config.AcceptancePath(projectRoot)
+	existing, err := readStageFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading acceptance tests: %w", err)
+	}
+	scenarios := parseAcceptanceFile(existing)
+	for id, body := range incoming {
+		scenarios[id] = body
+	}
+
+	if err := writeStageFile(path, renderAcceptance(cfg.Name, scenarios)); err != nil {
+		return "", fmt.Errorf("writing acceptance tests: %w", err)
+	}
+
+	return formatAcceptanceResponse(incoming, scenarios, requirements), nil
+}
+
+// acceptanceIDLine matches a line that opens a scenario group, e.g.
+// "FR-001:", "### FR-001" or "FR-001: Signup happy path".
+var acceptanceIDLine = regexp.MustCompile(`^\s*(?:#+\s*)?\**((?:FR|NFR)-\d{3,4})\**\s*:?\s*(.*)$`)
+
+// gherkinStep matches the Given/When/Then keywords at the start of a line.
+var gherkinStep = regexp.MustCompile(`(?im)^\s*(given|when|then)\b`)
+
+// parseAcceptanceInput groups the caller's scenarios by requirement ID.
+// Each group must contain at least one Given, When and Then step.
+func parseAcceptanceInput(input string) (map[string]string, error) {
+	groups := make(map[string][]string)
+	var order []string
+	current := ""
+
+	for _, line := range strings.Split(strings.ReplaceAll(input, "\r\n", "\n"), "\n") {
+		if m := acceptanceIDLine.FindStringSubmatch(line); m != nil {
+			current = m[1]
+			if _, seen := groups[current]; !seen {
+				order = append(order, current)
+				groups[current] = nil
+			}
+			if rest := strings.TrimSpace(m[2]); rest != "" {
+				groups[current] = append(groups[current], rest)
+			}
+			continue
+		}
+		if current == "" {
+			if strings.TrimSpace(line) != "" {
+				return nil, fmt.Errorf("scenario text before any requirement ID: %q — start each group with a line like 'FR-001:'", strings.TrimSpace(line))
+			}
+			continue
+		}
+		groups[current] = append(groups[current], line)
+	}
+
+	if len(order) == 0 {
+		return nil, fmt.Errorf("no requirement IDs found — start each group with a line like 'FR-001:'")
+	}
+
+	result := make(map[string]string, len(order))
+	for _, id := range order {
+		body := strings.TrimSpace(strings.Join(groups[id], "\n"))
+		if missing := missingGherkinSteps(body); len(missing) > 0 {
+			return nil, fmt.Errorf("%s: scenario is missing %s step(s) — use Given/When/Then", id, strings.Join(missing, ", "))
+		}
+		result[id] = body
+	}
+	return result, nil
+}
+
+// missingGherkinSteps returns the Given/When/Then keywords absent from body.
+func missingGherkinSteps(body string) []string {
+	found := make(map[string]bool)
+	for _, m := range gherkinStep.FindAllStringSubmatch(body, -1) {
+		found[strings.ToLower(m[1])] = true
+	}
+	var missing []string
+	for _, kw := range []string{"given", "when", "then"} {
+		if !found[kw] {
+			missing = append(missing, strings.ToUpper(kw[:1])+kw[1:])
+		}
+	}
+	return missing
+}
+
+// parseAcceptanceFile reads an existing acceptance.md back into
+// scenario bodies keyed by requirement ID.
+func parseAcceptanceFile(content string) map[string]string {
+	scenarios := make(map[string]string)
+	if content == "" {
+		return scenarios
+	}
+	for heading, body := range markdownSections(content) {
+		if requirementIDPattern.FindString(heading) != heading {
+			continue
+		}
+		var lines []string
+		for _, line := range strings.Split(body, "\n") {
+			if strings.HasPrefix(strings.TrimSpace(line), "```") {
+				continue
+			}
+			lines = append(lines, line)
+		}
+		scenarios[heading] = strings.TrimSpace(strings.Join(lines, "\n"))
+	}
+	return scenarios
+}
+
+// renderAcceptance renders all scenarios as markdown, sorted by ID.
+func renderAcceptance(name string, scenarios map[string]string) string {
+	ids := make([]string, 0, len(scenarios))
+	for id := range scenarios {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s — Acceptance Tests\n\n", name)
+	sb.WriteString("_Gherkin-style scenarios keyed by requirement ID. Managed by sdd_add_acceptance_tests._\n")
+	for _, id := range ids {
+		fmt.Fprintf(&sb, "\n## %s\n\n```gherkin\n%s\n```\n", id, scenarios[id])
+	}
+	return sb.String()
+}
+
+// formatAcceptanceResponse summarizes what was saved and which
+// functional requirements still have no scenario.
+func formatAcceptanceResponse(incoming, scenarios map[string]string, requirements string) string {
+	saved := make([]string, 0, len(incoming))
+	for id := range incoming {
+		saved = append(saved, id)
+	}
+	sort.Strings(saved)
+
+	var frs, uncovered []string
+	for _, id := range extractRequirementIDs(requirements) {
+		if isNFR(id) {
+			continue
+		}
+		frs = append(frs, id)
+		if _, ok := scenarios[id]; !ok {
+			uncovered = append(uncovered, id)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Acceptance Tests Saved\n\n")
+	fmt.Fprintf(&sb, "Saved to `docs/%s`\n\n", config.AcceptanceFile)
+	fmt.Fprintf(&sb, "**Updated:** %s\n\n", strings.Join(saved, ", "))
+	fmt.Fprintf(&sb, "## Coverage\n\n**FRs with scenarios:** %d/%d\n", len(frs)-len(uncovered), len(frs))
+	if len(uncovered) == 0 {
+		sb.WriteString("\n✅ Every functional requirement has at least one acceptance scenario.\n")
+	} else {
+		fmt.Fprintf(&sb, "\n⚠️ **Still missing scenarios:** %s\n", strings.Join(uncovered, ", "))
+	}
+	return sb.String()
+}
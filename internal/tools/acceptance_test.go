@@ -0,0 +1,129 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const acceptanceRequirements = `# demo — Requirements
+
+### Must Have
+
+- **FR-001**: Users can register
+- **FR-002**: Users can reset their password
+
+## Non-Functional Requirements
+
+- **NFR-001**: p95 latency under 200ms
+`
+
+func acceptanceRequest(scenarios string) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"scenarios": scenarios}
+	return req
+}
+
+func TestParseAcceptanceInput(t *testing.T) {
+	got, err := parseAcceptanceInput("FR-001: Signup\nGiven a visitor\nWhen they register\nThen an account exists\n\n### FR-002\ngiven a user\nwhen they reset\nthen mail is sent")
+	if err != nil {
+		t.Fatalf("parseAcceptanceInput: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 groups, got %v", got)
+	}
+	if !strings.HasPrefix(got["FR-001"], "Signup\nGiven a visitor") {
+		t.Errorf("header text should open the FR-001 body, got %q", got["FR-001"])
+	}
+
+	if _, err := parseAcceptanceInput("FR-001:\nGiven a visitor\nThen nothing"); err == nil || !strings.Contains(err.Error(), "When") {
+		t.Errorf("expected missing When step error, got %v", err)
+	}
+	if _, err := parseAcceptanceInput("Given a visitor\nFR-001:\nWhen x\nThen y"); err == nil {
+		t.Error("expected error for text before the first ID")
+	}
+}
+
+func TestAcceptanceTool_Handle_BeforeSpecify(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+
+	result, err := NewAcceptanceTool(config.NewFileStore()).Handle(context.Background(),
+		acceptanceRequest("FR-001:\nGiven a\nWhen b\nThen c"))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "after the specify stage") {
+		t.Errorf("expected specify-stage error, got: %s", getResultText(result))
+	}
+}
+
+func TestAcceptanceTool_Handle_UnknownID(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+	writeDesignPrerequisites(t, tmpDir, acceptanceRequirements)
+
+	result, err := NewAcceptanceTool(config.NewFileStore()).Handle(context.Background(),
+		acceptanceRequest("FR-009:\nGiven a\nWhen b\nThen c"))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "FR-009") {
+		t.Errorf("expected unknown ID error, got: %s", getResultText(result))
+	}
+	if _, err := os.Stat(config.AcceptancePath(tmpDir)); !os.IsNotExist(err) {
+		t.Error("acceptance.md must not be written when validation fails")
+	}
+}
+
+func TestAcceptanceTool_Handle_MergesAndReportsCoverage(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+	writeDesignPrerequisites(t, tmpDir, acceptanceRequirements)
+
+	store := config.NewFileStore()
+	before, _ := store.Load(tmpDir)
+	tool := NewAcceptanceTool(store)
+
+	result, err := tool.Handle(context.Background(),
+		acceptanceRequest("FR-001:\nGiven a visitor\nWhen they register\nThen an account exists"))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	text := getResultText(result)
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", text)
+	}
+	if !strings.Contains(text, "1/2") || !strings.Contains(text, "Still missing scenarios:** FR-002") {
+		t.Errorf("expected FR-002 reported as uncovered, got: %s", text)
+	}
+
+	result, _ = tool.Handle(context.Background(),
+		acceptanceRequest("FR-002:\nGiven a user\nWhen they reset\nThen mail is sent"))
+	if text := getResultText(result); !strings.Contains(text, "2/2") || strings.Contains(text, "Still missing") {
+		t.Errorf("expected full FR coverage, got: %s", text)
+	}
+
+	data, err := os.ReadFile(config.AcceptancePath(tmpDir))
+	if err != nil {
+		t.Fatalf("reading acceptance.md: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{"## FR-001", "Given a visitor", "## FR-002", "Then mail is sent", "```gherkin"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("acceptance.md missing %q:\n%s", want, content)
+		}
+	}
+	if strings.Index(content, "## FR-001") > strings.Index(content, "## FR-002") {
+		t.Error("scenarios should be sorted by requirement ID")
+	}
+
+	after, _ := store.Load(tmpDir)
+	if after.CurrentStage != before.CurrentStage {
+		t.Error("acceptance tests must not change the pipeline stage")
+	}
+}
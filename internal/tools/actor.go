@@ -0,0 +1,31 @@
+package tools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// actorParam is the optional "actor" argument shared by the stage tools.
+func actorParam() mcp.ToolOption {
+	return mcp.WithString("actor",
+		mcp.Description("Who is producing this artifact — an agent or client name such as 'claude-code' or 'reviewer-bot'. "+
+			"Recorded as the stage's completed_by. Defaults to the MCP client's name, or 'unknown'."),
+	)
+}
+
+// requestActor identifies the caller of a stage tool: the explicit
+// "actor" argument if given, otherwise the name the MCP client reported
+// at initialize. Returns "" when neither is known; the pipeline records
+// that as config.UnknownActor.
+func requestActor(ctx context.Context, req mcp.CallToolRequest) string {
+	if actor := strings.TrimSpace(req.GetString("actor", "")); actor != "" {
+		return actor
+	}
+	if session, ok := server.ClientSessionFromContext(ctx).(server.SessionWithClientInfo); ok {
+		return session.GetClientInfo().Name
+	}
+	return ""
+}
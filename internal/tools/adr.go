@@ -30,13 +30,8 @@ func NewADRTool(store changes.Store) *ADRTool {
 // SetBridge injects an optional ChangeObserver for memory persistence.
 func (t *ADRTool) SetBridge(obs ChangeObserver) { t.bridge = obs }
 
-// validADRStatuses contains the allowed ADR status values.
-var validADRStatuses = map[string]bool{
-	"proposed":   true,
-	"accepted":   true,
-	"deprecated": true,
-	"superseded": true,
-}
+// adrStatuses contains the allowed ADR status values.
+var adrStatuses = []string{"proposed", "accepted", "deprecated", "superseded"}
 
 // Definition returns the MCP tool definition for registration.
 func (t *ADRTool) Definition() mcp.Tool {
@@ -76,43 +71,65 @@ func (t *ADRTool) Definition() mcp.Tool {
 
 // Handle processes the sdd_adr tool call.
 func (t *ADRTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	title := req.GetString("title", "")
-	adrContext := req.GetString("context", "")
-	decision := req.GetString("decision", "")
-	rationale := req.GetString("rationale", "")
-	alternatives := req.GetString("alternatives_rejected", "")
-	status := req.GetString("status", "accepted")
-
-	// Validate required fields.
-	if strings.TrimSpace(title) == "" {
-		return mcp.NewToolResultError("'title' is required — provide a short title for the decision"), nil
-	}
-	if strings.TrimSpace(adrContext) == "" {
-		return mcp.NewToolResultError("'context' is required — describe the problem context"), nil
-	}
-	if strings.TrimSpace(decision) == "" {
-		return mcp.NewToolResultError("'decision' is required — state what was decided"), nil
-	}
-	if strings.TrimSpace(rationale) == "" {
-		return mcp.NewToolResultError("'rationale' is required — explain why this decision was made"), nil
-	}
-
-	// Validate status.
-	if !validADRStatuses[status] {
-		return mcp.NewToolResultError(fmt.Sprintf(
-			"invalid ADR status %q: must be one of: proposed, accepted, deprecated, superseded", status,
-		)), nil
+	params := adrParams{
+		Title:                req.GetString("title", ""),
+		Context:              req.GetString("context", ""),
+		Decision:             req.GetString("decision", ""),
+		Rationale:            req.GetString("rationale", ""),
+		AlternativesRejected: req.GetString("alternatives_rejected", ""),
+		Status:               req.GetString("status", "accepted"),
 	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// adrParams are the parsed sdd_adr arguments.
+type adrParams struct {
+	Title                string
+	Context              string
+	Decision             string
+	Rationale            string
+	AlternativesRejected string
+	Status               string
+}
+
+// adrParamSpecs declares the sdd_adr argument checks. Handle defaults
+// the status to accepted.
+var adrParamSpecs = []paramSpec{
+	{name: "title", required: true, hint: "provide a short title for the decision"},
+	{name: "context", required: true, hint: "describe the problem context"},
+	{name: "decision", required: true, hint: "state what was decided"},
+	{name: "rationale", required: true, hint: "explain why this decision was made"},
+	{name: "status", required: true, hint: "one of: " + strings.Join(adrStatuses, ", "), enum: adrStatuses},
+}
+
+// process records the decision as the next numbered ADR.
+func (t *ADRTool) process(projectRoot string, params adrParams) (string, error) {
+	title := params.Title
+	adrContext := params.Context
+	decision := params.Decision
+	rationale := params.Rationale
+	alternatives := params.AlternativesRejected
+	status := params.Status
+
+	if err := validateParams(adrParamSpecs, map[string]string{
+		"title":     title,
+		"context":   adrContext,
+		"decision":  decision,
+		"rationale": rationale,
+		"status":    status,
+	}); err != nil {
+		return "", err
+	}
 
 	// Determine next ADR number by scanning docs/adrs/.
 	adrsDir := config.ADRsPath(projectRoot)
 	if err := os.MkdirAll(adrsDir, 0o755); err != nil {
-		return nil, fmt.Errorf("creating adrs directory: %w", err)
+		return "", fmt.Errorf("creating adrs directory: %w", err)
 	}
 
 	adrNum := nextADRNumber(adrsDir)
@@ -129,7 +146,7 @@ func (t *ADRTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.Cal
 	// Check for active change and link it.
 	active, err := t.store.LoadActive(projectRoot)
 	if err != nil {
-		return nil, fmt.Errorf("loading active change: %w", err)
+		return "", fmt.Errorf("loading active change: %w", err)
 	}
 	if active != nil {
 		fmt.Fprintf(&content, "**Change:** `%s`\n\n", active.ID)
@@ -151,14 +168,14 @@ func (t *ADRTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.Cal
 	// Always write to docs/adrs/.
 	adrPath := filepath.Join(adrsDir, filename)
 	if err := writeStageFile(adrPath, adrContent); err != nil {
-		return nil, fmt.Errorf("writing ADR: %w", err)
+		return "", fmt.Errorf("writing ADR: %w", err)
 	}
 
 	if active != nil {
 		// Update change record to link this ADR.
 		active.ADRs = append(active.ADRs, adrID)
 		if err := t.store.Save(projectRoot, active); err != nil {
-			return nil, fmt.Errorf("saving change: %w", err)
+			return "", fmt.Errorf("saving change: %w", err)
 		}
 
 		// Notify bridge with ADR content.
@@ -176,7 +193,7 @@ func (t *ADRTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.Cal
 			filename,
 			adrContent,
 		)
-		return mcp.NewToolResultText(response), nil
+		return response, nil
 	}
 
 	// No active change — still saved to file, notify bridge for memory.
@@ -193,7 +210,7 @@ func (t *ADRTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.Cal
 		filename,
 		adrContent,
 	)
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
 
 // adrNumberPattern matches ADR filenames like "001-some-title.md".
@@ -185,6 +185,34 @@ func TestADRTool_Handle_Standalone(t *testing.T) {
 	}
 }
 
+func TestADRTool_Process_NoWorkingDirectory(t *testing.T) {
+	// process takes the project root explicitly, so no chdir is needed.
+	tmpDir := t.TempDir()
+	tool := NewADRTool(changes.NewFileStore())
+
+	text, err := tool.process(tmpDir, adrParams{
+		Title:     "Direct call",
+		Context:   "Embedding without MCP.",
+		Decision:  "Call process directly.",
+		Rationale: "No request plumbing needed.",
+		Status:    "proposed",
+	})
+	if err != nil {
+		t.Fatalf("process failed: %v", err)
+	}
+	if !strings.Contains(text, "ADR Captured") {
+		t.Errorf("response should contain 'ADR Captured': %s", text)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "docs", "adrs", "001-direct-call.md")); err != nil {
+		t.Errorf("ADR file not written under the given root: %v", err)
+	}
+
+	_, err = tool.process(tmpDir, adrParams{Title: "x", Context: "y", Decision: "z", Rationale: "r", Status: "maybe"})
+	if !IsUserError(err) {
+		t.Errorf("invalid status: err = %v, want a user error", err)
+	}
+}
+
 func TestADRTool_Handle_MissingTitle(t *testing.T) {
 	_, cleanup := setupChangeProject(t)
 	defer cleanup()
@@ -10,17 +10,31 @@
 package tools
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
 	"github.com/HendryAvila/Hoofy/internal/templates"
 )
 
 // autoGeneratedHeader is prepended to artifacts created by the bootstrap tool.
 const autoGeneratedHeader = "> ⚡ Auto-generated by sdd_reverse_engineer — review and refine as needed\n\n"
 
+// attributionFor returns the header attribution for cfg's artifacts:
+// templates.DefaultAttribution unless the project overrides it.
+func attributionFor(cfg *config.ProjectConfig) string {
+	if cfg == nil || cfg.AttributionFooter == nil {
+		return templates.DefaultAttribution
+	}
+	return strings.TrimSpace(*cfg.AttributionFooter)
+}
+
 // RenderAndWriteRequirements renders requirements.md using the template
-// and writes it to sdd/. Returns the rendered content.
+// and writes it, with its requirements.json sidecar, to sdd/. Returns the
+// rendered content.
 // If autoGenerated is true, prepends the auto-generated header.
 func RenderAndWriteRequirements(projectRoot string, renderer templates.Renderer, data templates.RequirementsData, autoGenerated bool) (string, error) {
 	content, err := renderer.Render(templates.Requirements, data)
@@ -37,9 +51,49 @@ func RenderAndWriteRequirements(projectRoot string, renderer templates.Renderer,
 		return "", fmt.Errorf("writing requirements: %w", err)
 	}
 
+	if err := writeRequirementsJSON(projectRoot, requirements.Parse(data)); err != nil {
+		return "", err
+	}
+
 	return content, nil
 }
 
+// writeRequirementsJSON writes the structured requirements sidecar
+// (docs/requirements.json) as an indented JSON array.
+func writeRequirementsJSON(projectRoot string, reqs []requirements.Requirement) error {
+	if reqs == nil {
+		reqs = []requirements.Requirement{}
+	}
+	data, err := json.MarshalIndent(reqs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding requirements.json: %w", err)
+	}
+	if err := writeStageFile(config.RequirementsJSONPath(projectRoot), string(data)+"\n"); err != nil {
+		return fmt.Errorf("writing requirements.json: %w", err)
+	}
+	return nil
+}
+
+// syncRequirementsJSON rewrites the requirements.json sidecar from the
+// requirements markdown — the index and any domain files cfg records —
+// after a tool edits the markdown in place, so the sidecar never brings
+// back what the edit changed.
+func syncRequirementsJSON(projectRoot string, cfg *config.ProjectConfig) error {
+	doc, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return fmt.Errorf("reading requirements: %w", err)
+	}
+	reqs := requirements.ParseMarkdown(doc)
+	for i := range reqs {
+		reqs[i].Line = 0 // lines of the joined files mean nothing on disk
+	}
+	// Bucket order, as requirements.Parse writes it.
+	slices.SortStableFunc(reqs, func(a, b requirements.Requirement) int {
+		return slices.Index(requirements.Buckets, a.Bucket) - slices.Index(requirements.Buckets, b.Bucket)
+	})
+	return writeRequirementsJSON(projectRoot, reqs)
+}
+
 // RenderAndWriteBusinessRules renders business-rules.md using the template
 // and writes it to sdd/. Returns the rendered content.
 // If autoGenerated is true, prepends the auto-generated header.
@@ -1,12 +1,14 @@
 package tools
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
 	"github.com/HendryAvila/Hoofy/internal/templates"
 )
 
@@ -54,6 +56,20 @@ func TestRenderAndWriteRequirements_Success(t *testing.T) {
 	if strings.Contains(content, autoGeneratedHeader) {
 		t.Error("should NOT have auto-generated header when autoGenerated=false")
 	}
+
+	// Verify the structured sidecar.
+	raw, err := os.ReadFile(config.RequirementsJSONPath(tmpDir))
+	if err != nil {
+		t.Fatalf("read requirements.json: %v", err)
+	}
+	var reqs []requirements.Requirement
+	if err := json.Unmarshal(raw, &reqs); err != nil {
+		t.Fatalf("requirements.json is not valid JSON: %v", err)
+	}
+	if len(reqs) != 3 || reqs[0].ID != "FR-001" || reqs[0].Bucket != requirements.BucketMust ||
+		reqs[0].Text != "Users can sign up" || reqs[2].Bucket != requirements.BucketNFR {
+		t.Errorf("requirements.json = %+v", reqs)
+	}
 }
 
 func TestRenderAndWriteRequirements_AutoGenerated(t *testing.T) {
@@ -50,6 +50,11 @@ func extractRequirementIDs(content string) []string {
 	return unique
 }
 
+// isNFR reports whether a requirement ID is non-functional (NFR-NNN).
+func isNFR(id string) bool {
+	return strings.HasPrefix(id, "NFR-")
+}
+
 // requirementWithDescription holds an ID and its surrounding context.
 type requirementWithDescription struct {
 	ID          string
@@ -108,7 +113,7 @@ type auditSourceFile struct {
 
 // scanSourceFiles walks the project tree and collects source file metadata.
 // Respects ignoreDirs (shared with reverse_engineer.go) and skips the docs dir.
-func scanSourceFiles(root, docsDir, scanPath string) []auditSourceFile {
+func scanSourceFiles(ctx context.Context, root, docsDir, scanPath string) []auditSourceFile {
 	scanRoot := root
 	if scanPath != "" {
 		scanRoot = filepath.Join(root, scanPath)
@@ -120,6 +125,9 @@ func scanSourceFiles(root, docsDir, scanPath string) []auditSourceFile {
 	var files []auditSourceFile
 
 	_ = filepath.WalkDir(scanRoot, func(path string, d os.DirEntry, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if err != nil {
 			return nil // graceful degradation
 		}
@@ -192,7 +200,7 @@ func readAuditArtifacts(docsDir string) []auditArtifact {
 	var artifacts []auditArtifact
 
 	for _, stage := range auditStages {
-		filename := config.StageFilename(stage)
+		filename := config.StageFilenameInDocs(docsDir, stage)
 		if filename == "" {
 			continue
 		}
@@ -439,24 +447,39 @@ func (t *AuditTool) Definition() mcp.Tool {
 
 // Handle processes the sdd_audit tool call.
 func (t *AuditTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	detailLevel := memory.ParseDetailLevel(req.GetString("detail_level", ""))
-	scanPath := req.GetString("scan_path", "")
+	params := auditParams{
+		DetailLevel: memory.ParseDetailLevel(req.GetString("detail_level", "")),
+		ScanPath:    req.GetString("scan_path", ""),
+	}
 
-	// Resolve project root.
 	root, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return toolResult(t.process(ctx, root, params))
+}
+
+// auditParams are the parsed sdd_audit arguments.
+type auditParams struct {
+	DetailLevel string
+	ScanPath    string
+}
+
+// process compares the spec artifacts against the source tree, returning
+// ctx's error instead of a partial report once ctx is done.
+func (t *AuditTool) process(ctx context.Context, root string, params auditParams) (string, error) {
+	detailLevel := params.DetailLevel
+	scanPath := params.ScanPath
 
 	// Validate scan_path if provided.
 	if scanPath != "" {
 		candidate := filepath.Join(root, scanPath)
 		info, err := os.Stat(candidate)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("scan_path '%s' not found: %v", scanPath, err)), nil
+			return "", newUserError(fmt.Sprintf("scan_path '%s' not found: %v", scanPath, err))
 		}
 		if !info.IsDir() {
-			return mcp.NewToolResultError(fmt.Sprintf("scan_path '%s' is not a directory", scanPath)), nil
+			return "", newUserError(fmt.Sprintf("scan_path '%s' is not a directory", scanPath))
 		}
 	}
 
@@ -468,8 +491,12 @@ func (t *AuditTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.C
 	// Read all spec artifacts.
 	artifacts := readAuditArtifacts(docsDir)
 
-	// Scan source files.
-	sourceFiles := scanSourceFiles(root, docsDir, scanPath)
+	// Scan source files, skipping the top-level docs directory — not just
+	// a config name's artifacts subdirectory within it.
+	sourceFiles := scanSourceFiles(ctx, root, filepath.Join(root, config.ResolveDocsDir(root)), scanPath)
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 
 	duration := time.Since(start)
 
@@ -480,5 +507,5 @@ func (t *AuditTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.C
 	tokens := memory.EstimateTokens(result)
 	result += memory.TokenFooter(tokens)
 
-	return mcp.NewToolResultText(result), nil
+	return result, nil
 }
@@ -158,7 +158,7 @@ func TestScanSourceFiles_GoProject(t *testing.T) {
 	root := setupGoProject(t)
 	docsDir := filepath.Join(root, "docs")
 
-	files := scanSourceFiles(root, docsDir, "")
+	files := scanSourceFiles(context.Background(), root, docsDir, "")
 
 	if len(files) == 0 {
 		t.Fatal("should find source files in Go project")
@@ -190,7 +190,7 @@ func TestScanSourceFiles_SkipsIgnoreDirs(t *testing.T) {
 	writeTestFile(t, root, "vendor/dep/dep.go", "package dep\n")
 
 	docsDir := filepath.Join(root, "docs")
-	files := scanSourceFiles(root, docsDir, "")
+	files := scanSourceFiles(context.Background(), root, docsDir, "")
 
 	for _, f := range files {
 		if strings.Contains(f.Path, "node_modules") {
@@ -216,7 +216,7 @@ func TestScanSourceFiles_SkipsDocsDir(t *testing.T) {
 	writeTestFile(t, root, "docs/something.go", "package docs\n")
 
 	docsDir := filepath.Join(root, "docs")
-	files := scanSourceFiles(root, docsDir, "")
+	files := scanSourceFiles(context.Background(), root, docsDir, "")
 
 	for _, f := range files {
 		if strings.Contains(f.Path, "docs") {
@@ -232,7 +232,7 @@ func TestScanSourceFiles_WithScanPath(t *testing.T) {
 	writeTestFile(t, root, "other/c.go", "package other\n")
 
 	docsDir := filepath.Join(root, "docs")
-	files := scanSourceFiles(root, docsDir, "pkg")
+	files := scanSourceFiles(context.Background(), root, docsDir, "pkg")
 
 	// Should only find files under pkg/
 	for _, f := range files {
@@ -252,7 +252,7 @@ func TestScanSourceFiles_NonSourceExtensions(t *testing.T) {
 	writeTestFile(t, root, "style.css", "body{}\n")
 
 	docsDir := filepath.Join(root, "docs")
-	files := scanSourceFiles(root, docsDir, "")
+	files := scanSourceFiles(context.Background(), root, docsDir, "")
 
 	if len(files) != 0 {
 		t.Errorf("should find 0 source files (only non-source exts), got %d", len(files))
@@ -262,7 +262,7 @@ func TestScanSourceFiles_NonSourceExtensions(t *testing.T) {
 func TestScanSourceFiles_Empty(t *testing.T) {
 	root := t.TempDir()
 	docsDir := filepath.Join(root, "docs")
-	files := scanSourceFiles(root, docsDir, "")
+	files := scanSourceFiles(context.Background(), root, docsDir, "")
 
 	if len(files) != 0 {
 		t.Errorf("should find 0 files in empty dir, got %d", len(files))
@@ -274,7 +274,7 @@ func TestScanSourceFiles_LineCount(t *testing.T) {
 	writeTestFile(t, root, "main.go", "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hello\")\n}\n")
 
 	docsDir := filepath.Join(root, "docs")
-	files := scanSourceFiles(root, docsDir, "")
+	files := scanSourceFiles(context.Background(), root, docsDir, "")
 
 	if len(files) != 1 {
 		t.Fatalf("got %d files, want 1", len(files))
@@ -289,7 +289,7 @@ func TestScanSourceFiles_LineCountNoTrailingNewline(t *testing.T) {
 	writeTestFile(t, root, "main.go", "package main\nfunc main() {}") // no trailing newline
 
 	docsDir := filepath.Join(root, "docs")
-	files := scanSourceFiles(root, docsDir, "")
+	files := scanSourceFiles(context.Background(), root, docsDir, "")
 
 	if len(files) != 1 {
 		t.Fatalf("got %d files, want 1", len(files))
@@ -119,13 +119,68 @@ func (t *BootstrapTool) Definition() mcp.Tool {
 
 // Handle processes the sdd_bootstrap tool call.
 func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := bootstrapParams{
+		ProjectName:               req.GetString("project_name", ""),
+		RequirementsMustHave:      req.GetString("requirements_must_have", ""),
+		RequirementsShouldHave:    req.GetString("requirements_should_have", ""),
+		RequirementsNonFunctional: req.GetString("requirements_non_functional", ""),
+		RequirementsCouldHave:     req.GetString("requirements_could_have", ""),
+		RequirementsWontHave:      req.GetString("requirements_wont_have", ""),
+		RequirementsConstraints:   req.GetString("requirements_constraints", ""),
+		RequirementsAssumptions:   req.GetString("requirements_assumptions", ""),
+		RequirementsDependencies:  req.GetString("requirements_dependencies", ""),
+		BusinessRulesDefinitions:  req.GetString("business_rules_definitions", ""),
+		BusinessRulesFacts:        req.GetString("business_rules_facts", ""),
+		BusinessRulesConstraints:  req.GetString("business_rules_constraints", ""),
+		BusinessRulesDerivations:  req.GetString("business_rules_derivations", ""),
+		BusinessRulesGlossary:     req.GetString("business_rules_glossary", ""),
+		DesignArchitecture:        req.GetString("design_architecture", ""),
+		DesignTechStack:           req.GetString("design_tech_stack", ""),
+		DesignComponents:          req.GetString("design_components", ""),
+		DesignDataModel:           req.GetString("design_data_model", ""),
+		DesignAPIContracts:        req.GetString("design_api_contracts", ""),
+		DesignInfrastructure:      req.GetString("design_infrastructure", ""),
+		DesignSecurity:            req.GetString("design_security", ""),
+		DesignQualityAnalysis:     req.GetString("design_quality_analysis", ""),
+	}
+
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// bootstrapParams are the parsed sdd_bootstrap arguments.
+type bootstrapParams struct {
+	ProjectName               string
+	RequirementsMustHave      string
+	RequirementsShouldHave    string
+	RequirementsNonFunctional string
+	RequirementsCouldHave     string
+	RequirementsWontHave      string
+	RequirementsConstraints   string
+	RequirementsAssumptions   string
+	RequirementsDependencies  string
+	BusinessRulesDefinitions  string
+	BusinessRulesFacts        string
+	BusinessRulesConstraints  string
+	BusinessRulesDerivations  string
+	BusinessRulesGlossary     string
+	DesignArchitecture        string
+	DesignTechStack           string
+	DesignComponents          string
+	DesignDataModel           string
+	DesignAPIContracts        string
+	DesignInfrastructure      string
+	DesignSecurity            string
+	DesignQualityAnalysis     string
+}
 
+// process writes the requested baseline artifacts that do not exist yet.
+func (t *BootstrapTool) process(projectRoot string, params bootstrapParams) (string, error) {
 	// Resolve project name.
-	projectName := req.GetString("project_name", "")
+	projectName := params.ProjectName
 	if projectName == "" {
 		projectName = filepath.Base(projectRoot)
 	}
@@ -133,7 +188,7 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 	// Ensure docs/ directory exists.
 	docsDir := config.DocsPath(projectRoot)
 	if err := os.MkdirAll(docsDir, 0o755); err != nil {
-		return nil, fmt.Errorf("creating docs directory: %w", err)
+		return "", fmt.Errorf("creating docs directory: %w", err)
 	}
 
 	// Check which artifacts exist.
@@ -141,33 +196,32 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 	hasRules := ArtifactExists(projectRoot, config.StageBusinessRules)
 	hasDesign := ArtifactExists(projectRoot, config.StageDesign)
 
-	// Parse all parameters.
 	// Requirements group.
-	reqMustHave := req.GetString("requirements_must_have", "")
-	reqShouldHave := req.GetString("requirements_should_have", "")
-	reqNonFunctional := req.GetString("requirements_non_functional", "")
-	reqCouldHave := req.GetString("requirements_could_have", "")
-	reqWontHave := req.GetString("requirements_wont_have", "")
-	reqConstraints := req.GetString("requirements_constraints", "")
-	reqAssumptions := req.GetString("requirements_assumptions", "")
-	reqDependencies := req.GetString("requirements_dependencies", "")
+	reqMustHave := params.RequirementsMustHave
+	reqShouldHave := params.RequirementsShouldHave
+	reqNonFunctional := params.RequirementsNonFunctional
+	reqCouldHave := params.RequirementsCouldHave
+	reqWontHave := params.RequirementsWontHave
+	reqConstraints := params.RequirementsConstraints
+	reqAssumptions := params.RequirementsAssumptions
+	reqDependencies := params.RequirementsDependencies
 
 	// Business rules group.
-	brDefinitions := req.GetString("business_rules_definitions", "")
-	brFacts := req.GetString("business_rules_facts", "")
-	brConstraints := req.GetString("business_rules_constraints", "")
-	brDerivations := req.GetString("business_rules_derivations", "")
-	brGlossary := req.GetString("business_rules_glossary", "")
+	brDefinitions := params.BusinessRulesDefinitions
+	brFacts := params.BusinessRulesFacts
+	brConstraints := params.BusinessRulesConstraints
+	brDerivations := params.BusinessRulesDerivations
+	brGlossary := params.BusinessRulesGlossary
 
 	// Design group.
-	desArch := req.GetString("design_architecture", "")
-	desTechStack := req.GetString("design_tech_stack", "")
-	desComponents := req.GetString("design_components", "")
-	desDataModel := req.GetString("design_data_model", "")
-	desAPI := req.GetString("design_api_contracts", "")
-	desInfra := req.GetString("design_infrastructure", "")
-	desSecurity := req.GetString("design_security", "")
-	desQualityAnalysis := req.GetString("design_quality_analysis", "")
+	desArch := params.DesignArchitecture
+	desTechStack := params.DesignTechStack
+	desComponents := params.DesignComponents
+	desDataModel := params.DesignDataModel
+	desAPI := params.DesignAPIContracts
+	desInfra := params.DesignInfrastructure
+	desSecurity := params.DesignSecurity
+	desQualityAnalysis := params.DesignQualityAnalysis
 
 	// Check if at least one artifact group has content.
 	hasReqContent := reqMustHave != "" || reqShouldHave != "" || reqNonFunctional != ""
@@ -175,13 +229,13 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 	hasDesignContent := desArch != "" || desTechStack != "" || desComponents != "" || desDataModel != ""
 
 	if !hasReqContent && !hasRulesContent && !hasDesignContent {
-		return mcp.NewToolResultError(
+		return "", newUserError(
 			"No artifact content provided. At least one artifact group must have content.\n\n" +
 				"Provide parameters for one or more of:\n" +
 				"- **Requirements**: requirements_must_have, requirements_should_have, requirements_non_functional\n" +
 				"- **Business rules**: business_rules_definitions, business_rules_facts, business_rules_constraints\n" +
 				"- **Design**: design_architecture, design_tech_stack, design_components, design_data_model",
-		), nil
+		)
 	}
 
 	var written []string
@@ -218,6 +272,7 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 
 		data := templates.RequirementsData{
 			Name:          projectName,
+			Attribution:   templates.DefaultAttribution,
 			MustHave:      reqMustHave,
 			ShouldHave:    reqShouldHave,
 			CouldHave:     reqCouldHave,
@@ -229,7 +284,7 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 		}
 
 		if _, err := RenderAndWriteRequirements(projectRoot, t.renderer, data, true); err != nil {
-			return nil, fmt.Errorf("writing requirements: %w", err)
+			return "", fmt.Errorf("writing requirements: %w", err)
 		}
 		written = append(written, "requirements.md")
 	} else if hasReqs {
@@ -250,6 +305,7 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 
 		data := templates.BusinessRulesData{
 			Name:        projectName,
+			Attribution: templates.DefaultAttribution,
 			Definitions: brDefinitions,
 			Facts:       brFacts,
 			Constraints: brConstraints,
@@ -258,7 +314,7 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 		}
 
 		if _, err := RenderAndWriteBusinessRules(projectRoot, t.renderer, data, true); err != nil {
-			return nil, fmt.Errorf("writing business rules: %w", err)
+			return "", fmt.Errorf("writing business rules: %w", err)
 		}
 		written = append(written, "business-rules.md")
 	} else if hasRules {
@@ -294,6 +350,7 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 
 		data := templates.DesignData{
 			Name:                 projectName,
+			Attribution:          templates.DefaultAttribution,
 			ArchitectureOverview: desArch,
 			TechStack:            desTechStack,
 			Components:           desComponents,
@@ -305,7 +362,7 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 		}
 
 		if _, err := RenderAndWriteDesign(projectRoot, t.renderer, data, true); err != nil {
-			return nil, fmt.Errorf("writing design: %w", err)
+			return "", fmt.Errorf("writing design: %w", err)
 		}
 		written = append(written, "design.md")
 	} else if hasDesign {
@@ -342,5 +399,5 @@ func (t *BootstrapTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*m
 	response.WriteString("2. **Run `sdd_change`** to start making changes with full context awareness\n")
 	response.WriteString("3. The `context-check` stage will now have architecture and requirements context\n")
 
-	return mcp.NewToolResultText(response.String()), nil
+	return response.String(), nil
 }
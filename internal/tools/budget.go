@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// defaultWordBudgets are the expert-mode word budgets of the artifacts
+// the stage tools write, template headings included. Experts want
+// concise docs; an AI left to itself tends to pad them. Guided mode has
+// no budget by default — there the minimum-length checks on the tool
+// arguments keep artifacts from being too thin instead.
+//
+// hoofy.json can set or turn off a stage's budget (word_budgets).
+var defaultWordBudgets = map[config.Stage]int{
+	config.StagePrinciples:    600,
+	config.StageCharter:       1200,
+	config.StageSpecify:       2500,
+	config.StageBusinessRules: 1500,
+	config.StageDesign:        3000,
+	config.StageTasks:         3000,
+}
+
+// wordBudget returns the word budget of stage's artifact, or 0 when it
+// has none.
+func wordBudget(cfg *config.ProjectConfig, stage config.Stage) int {
+	if n, ok := cfg.WordBudgets[stage]; ok {
+		return n
+	}
+	if cfg.Mode == config.ModeExpert {
+		return defaultWordBudgets[stage]
+	}
+	return 0
+}
+
+// wordBudgetNote returns a response note when content, the artifact just
+// written for stage, runs over the stage's word budget, or "" when it
+// fits or has no budget. Like recommendedFieldsNote it only advises —
+// the artifact is saved either way.
+func wordBudgetNote(cfg *config.ProjectConfig, stage config.Stage, content string) string {
+	budget := wordBudget(cfg, stage)
+	if budget <= 0 {
+		return ""
+	}
+	words := len(strings.Fields(content))
+	if words <= budget {
+		return ""
+	}
+	return fmt.Sprintf("\n\n✂️ **Over the word budget** — `%s` is %d words, %d over its budget of %d. "+
+		"Consider trimming repetition and restated context; concise specs are read more closely.\n\n"+
+		"_Set `word_budgets` in hoofy.json to change the budget for this stage, or 0 to turn the note off._",
+		cfg.StageFilename(stage), words, words-budget, budget)
+}
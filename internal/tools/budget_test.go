@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestWordBudgetNote(t *testing.T) {
+	long := strings.Repeat("word ", 2000)
+
+	tests := []struct {
+		name string
+		mode config.Mode
+		cfg  map[config.Stage]int
+		want string
+	}{
+		{name: "expert default", mode: config.ModeExpert, want: "`charter.md` is 2000 words, 800 over its budget of 1200"},
+		{name: "guided has no default", mode: config.ModeGuided},
+		{name: "configured in guided", mode: config.ModeGuided, cfg: map[config.Stage]int{config.StageCharter: 1500}, want: "500 over its budget of 1500"},
+		{name: "configured above the length", mode: config.ModeExpert, cfg: map[config.Stage]int{config.StageCharter: 2000}},
+		{name: "zero turns it off", mode: config.ModeExpert, cfg: map[config.Stage]int{config.StageCharter: 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewProjectConfig("p", "d", tt.mode)
+			cfg.WordBudgets = tt.cfg
+			note := wordBudgetNote(cfg, config.StageCharter, long)
+			if tt.want == "" {
+				if note != "" {
+					t.Fatalf("want no note, got:\n%s", note)
+				}
+				return
+			}
+			if !strings.Contains(note, tt.want) {
+				t.Errorf("note missing %q:\n%s", tt.want, note)
+			}
+		})
+	}
+}
+
+func TestPrinciplesTool_Handle_OverWordBudget(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StagePrinciples)
+	defer cleanup()
+	store := config.NewFileStore()
+	cfg, _ := store.Load(tmpDir)
+	cfg.WordBudgets = map[config.Stage]int{config.StagePrinciples: 20}
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"principles": "- Never lose data\n- " + strings.Repeat("Every change is reviewed by a second person. ", 10),
+	}
+	result, err := NewPrinciplesTool(store, mustRenderer(t)).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("a long artifact must not be rejected: %s", getResultText(result))
+	}
+	if text := getResultText(result); !strings.Contains(text, "**Over the word budget** — `principles.md`") {
+		t.Errorf("response should note the budget:\n%s", text)
+	}
+}
@@ -0,0 +1,252 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// bulkStage is a stage sdd_bulk_advance can run: the parameter holding
+// its payload and the stage tool the payload is passed to.
+type bulkStage struct {
+	stage config.Stage
+	param string
+	tool  string
+}
+
+// bulkStages are the stages sdd_bulk_advance runs, in pipeline order.
+// Validate is left out: it reviews the artifacts this call writes.
+var bulkStages = []bulkStage{
+	{config.StagePrinciples, "principles", "sdd_create_principles"},
+	{config.StageCharter, "charter", "sdd_create_charter"},
+	{config.StageSpecify, "requirements", "sdd_generate_requirements"},
+	{config.StageBusinessRules, "business_rules", "sdd_create_business_rules"},
+	{config.StageClarify, "clarify", "sdd_clarify"},
+	{config.StageResearch, "research", "sdd_record_research"},
+	{config.StageDesign, "design", "sdd_create_design"},
+	{config.StageTasks, "tasks", "sdd_create_tasks"},
+}
+
+// bulkStageFor returns the bulk stage entry for stage.
+func bulkStageFor(stage config.Stage) (bulkStage, bool) {
+	for _, b := range bulkStages {
+		if b.stage == stage {
+			return b, true
+		}
+	}
+	return bulkStage{}, false
+}
+
+// BulkAdvanceTool handles the sdd_bulk_advance MCP tool.
+// It runs the stage tools in sequence from one call, for experts who
+// already have a full spec: each payload goes through the stage tool's
+// own Handle, so validation, stage order and the Clarity Gate apply
+// exactly as they do one call at a time.
+//
+// Design: not transactional. Stages that succeed stay saved; the run
+// stops at the first stage that fails or doesn't advance, and a second
+// call resumes from the pipeline's current stage.
+type BulkAdvanceTool struct {
+	store  config.Store
+	stages map[config.Stage]server.ToolHandlerFunc
+}
+
+// NewBulkAdvanceTool creates a BulkAdvanceTool that runs each stage
+// through the handler registered for it in stages.
+func NewBulkAdvanceTool(store config.Store, stages map[config.Stage]server.ToolHandlerFunc) *BulkAdvanceTool {
+	return &BulkAdvanceTool{store: store, stages: stages}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *BulkAdvanceTool) Definition() mcp.Tool {
+	opts := []mcp.ToolOption{
+		mcp.WithDescription(
+			"Populate several pipeline stages in one call — for expert users who already have a full spec. " +
+				"Pass one object per stage holding exactly the arguments of that stage's tool. " +
+				"Stages run in pipeline order from the current stage, through the same logic as the " +
+				"individual tools; the clarify payload must carry 'dimension_scores' that pass the Clarity Gate. " +
+				"Stops at the first stage that fails or doesn't advance and reports each stage's outcome. " +
+				"Completed stages stay saved — fix the failing payload and call again to resume. " +
+				"Validation is not included: run sdd_validate afterwards. " +
+				"Requires: sdd_init_project must have been run first.",
+		),
+	}
+	for _, b := range bulkStages {
+		opts = append(opts, mcp.WithObject(b.param,
+			mcp.Description(fmt.Sprintf("Arguments for %s (the %s stage), e.g. the same JSON object you would pass to that tool.",
+				b.tool, config.Stages[b.stage].Name)),
+		))
+	}
+	opts = append(opts, actorParam())
+	return mcp.NewTool("sdd_bulk_advance", opts...)
+}
+
+// bulkOutcome is the result of one stage of a bulk run.
+type bulkOutcome struct {
+	stage  bulkStage
+	status string
+	detail string
+}
+
+// Handle processes the sdd_bulk_advance tool call.
+func (t *BulkAdvanceTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(ctx, projectRoot, req))
+}
+
+// process runs every supplied payload in pipeline order and reports
+// each stage's outcome. A failed run is a user error carrying the report.
+func (t *BulkAdvanceTool) process(ctx context.Context, projectRoot string, req mcp.CallToolRequest) (string, error) {
+	args := req.GetArguments()
+	payloads := make(map[config.Stage]map[string]any)
+	for _, b := range bulkStages {
+		raw, ok := args[b.param]
+		if !ok || raw == nil {
+			continue
+		}
+		payload, ok := raw.(map[string]any)
+		if !ok {
+			return "", toolError(CodeInvalidInput, fmt.Sprintf("'%s' must be an object of %s arguments", b.param, b.tool))
+		}
+		payloads[b.stage] = payload
+	}
+	if len(payloads) == 0 {
+		names := make([]string, 0, len(bulkStages))
+		for _, b := range bulkStages {
+			names = append(names, b.param)
+		}
+		return "", toolError(CodeMissingField, "at least one stage payload is required: "+strings.Join(names, ", "))
+	}
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	actor := strings.TrimSpace(req.GetString("actor", ""))
+
+	var outcomes []bulkOutcome
+	ran := make(map[config.Stage]bool)
+	failure := ""
+	for {
+		b, ok := bulkStageFor(cfg.CurrentStage)
+		if !ok {
+			break
+		}
+		payload, ok := payloads[b.stage]
+		if !ok {
+			if remaining := t.pending(payloads, ran, cfg); len(remaining) > 0 {
+				failure = fmt.Sprintf("no payload for the %s stage — pass '%s' to continue to %s",
+					config.Stages[b.stage].Name, b.param, strings.Join(remaining, ", "))
+				outcomes = append(outcomes, bulkOutcome{stage: b, status: "❌ missing", detail: "no payload"})
+			}
+			break
+		}
+
+		handle, ok := t.stages[b.stage]
+		if !ok {
+			return "", fmt.Errorf("no handler registered for the %s stage", b.stage)
+		}
+		if _, set := payload["actor"]; !set && actor != "" {
+			payload["actor"] = actor
+		}
+		stageReq := mcp.CallToolRequest{}
+		stageReq.Params.Name = b.tool
+		stageReq.Params.Arguments = payload
+
+		res, err := handle(ctx, stageReq)
+		if err != nil {
+			return "", fmt.Errorf("running %s: %w", b.tool, err)
+		}
+		ran[b.stage] = true
+		text := getTextContent(res)
+
+		if cfg, err = t.store.Load(projectRoot); err != nil {
+			return "", fmt.Errorf("reloading config after %s: %w", b.tool, err)
+		}
+		if res.IsError || !pipeline.IsCompleted(cfg, b.stage) {
+			failure = text
+			detail := "rejected"
+			if !res.IsError {
+				detail = "saved but did not advance"
+			}
+			outcomes = append(outcomes, bulkOutcome{stage: b, status: "❌ failed", detail: detail})
+			break
+		}
+		outcomes = append(outcomes, bulkOutcome{stage: b, status: "✅ completed", detail: firstLine(text)})
+	}
+
+	for _, b := range bulkStages {
+		if _, supplied := payloads[b.stage]; !supplied || ran[b.stage] {
+			continue
+		}
+		detail := "not run"
+		if failure == "" && pipeline.IsCompleted(cfg, b.stage) {
+			detail = "already completed — skipped"
+		}
+		outcomes = append(outcomes, bulkOutcome{stage: b, status: "⏭ skipped", detail: detail})
+	}
+
+	report := formatBulkReport(cfg, outcomes, failure)
+	if failure != "" {
+		return "", newUserError(report)
+	}
+	return report, nil
+}
+
+// pending lists the tool names of supplied payloads that haven't run
+// and whose stage isn't completed yet.
+func (t *BulkAdvanceTool) pending(payloads map[config.Stage]map[string]any, ran map[config.Stage]bool, cfg *config.ProjectConfig) []string {
+	var names []string
+	for _, b := range bulkStages {
+		if _, ok := payloads[b.stage]; ok && !ran[b.stage] && !pipeline.IsCompleted(cfg, b.stage) {
+			names = append(names, b.param)
+		}
+	}
+	return names
+}
+
+// firstLine returns the first non-empty line of s without heading marks.
+func firstLine(s string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(strings.TrimLeft(line, "# ")); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// formatBulkReport renders the per-stage outcomes, and the failing
+// stage's full response when the run stopped early.
+func formatBulkReport(cfg *config.ProjectConfig, outcomes []bulkOutcome, failure string) string {
+	var sb strings.Builder
+	if failure == "" {
+		sb.WriteString("# Bulk Advance Complete\n\n")
+	} else {
+		sb.WriteString("# Bulk Advance Stopped\n\n")
+	}
+	sb.WriteString("| Stage | Tool | Result | Detail |\n|-------|------|--------|--------|\n")
+	for _, o := range outcomes {
+		fmt.Fprintf(&sb, "| %s | `%s` | %s | %s |\n",
+			config.Stages[o.stage.stage].Name, o.stage.tool, o.status, tableCell(o.detail))
+	}
+	fmt.Fprintf(&sb, "\n**Current stage:** %s\n", config.Stages[cfg.CurrentStage].Name)
+
+	if failure != "" {
+		fmt.Fprintf(&sb, "\n## Failure\n\n%s\n\n"+
+			"Stages before the failure are saved. Fix the payload and call sdd_bulk_advance again — "+
+			"it resumes from the current stage.\n", failure)
+		return sb.String()
+	}
+	if cfg.CurrentStage == config.StageValidate {
+		sb.WriteString("\n## Next Step\n\nReview the generated artifacts, then call `sdd_validate`.\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,184 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// newTestBulkAdvanceTool wires a BulkAdvanceTool to fresh stage tools,
+// as the server does.
+func newTestBulkAdvanceTool(t *testing.T) *BulkAdvanceTool {
+	t.Helper()
+	store := config.NewFileStore()
+	renderer := mustRenderer(t)
+	return NewBulkAdvanceTool(store, map[config.Stage]server.ToolHandlerFunc{
+		config.StagePrinciples:    NewPrinciplesTool(store, renderer).Handle,
+		config.StageCharter:       NewCharterTool(store, renderer).Handle,
+		config.StageSpecify:       NewSpecifyTool(store, renderer).Handle,
+		config.StageBusinessRules: NewBusinessRulesTool(store, renderer).Handle,
+		config.StageClarify:       NewClarifyTool(store, renderer).Handle,
+		config.StageResearch:      NewResearchTool(store, renderer).Handle,
+		config.StageDesign:        NewDesignTool(store, renderer).Handle,
+		config.StageTasks:         NewTasksTool(store, renderer).Handle,
+	})
+}
+
+// setupBulkProject creates a guided project at specify with its charter written.
+func setupBulkProject(t *testing.T) (string, func()) {
+	t.Helper()
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	for stage, content := range map[config.Stage]string{
+		config.StagePrinciples: "# Principles\n\nTest principles.",
+		config.StageCharter:    "# Charter\n\nA test charter.",
+	} {
+		if err := writeStageFile(config.StagePath(tmpDir, stage), content); err != nil {
+			cleanup()
+			t.Fatal(err)
+		}
+	}
+	return tmpDir, cleanup
+}
+
+var (
+	bulkRequirements = map[string]any{
+		"must_have":      "- **FR-001**: Users can create an account\n- **FR-002**: Users can log time entries",
+		"should_have":    "- **FR-003**: Users can export time entries as CSV",
+		"non_functional": "- **NFR-001**: Page load time must be under 2 seconds",
+	}
+	bulkBusinessRules = map[string]any{
+		"definitions": "- **Customer**: A person who has completed at least one purchase",
+		"facts":       "- A Customer has exactly one Account",
+		"constraints": "- When an Order total exceeds $500, Then manager approval is required",
+	}
+)
+
+func bulkRequest(args map[string]any) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	return req
+}
+
+func TestBulkAdvanceTool_Handle_RunsStagesInOrder(t *testing.T) {
+	tmpDir, cleanup := setupBulkProject(t)
+	defer cleanup()
+
+	// Payloads are run in pipeline order regardless of argument order.
+	result, err := newTestBulkAdvanceTool(t).Handle(context.Background(), bulkRequest(map[string]any{
+		"business_rules": bulkBusinessRules,
+		"requirements":   bulkRequirements,
+		"actor":          "spec-importer",
+	}))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"# Bulk Advance Complete",
+		"| Specify | `sdd_generate_requirements` | ✅ completed |",
+		"| Business Rules | `sdd_create_business_rules` | ✅ completed |",
+		"**Current stage:** Clarify",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("report missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Index(text, "| Specify |") > strings.Index(text, "| Business Rules |") {
+		t.Errorf("stages should be reported in pipeline order:\n%s", text)
+	}
+
+	cfg, err := config.NewFileStore().Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.CurrentStage != config.StageClarify {
+		t.Errorf("current stage = %s, want clarify", cfg.CurrentStage)
+	}
+	if got := cfg.StageStatus[config.StageSpecify].CompletedBy; got != "spec-importer" {
+		t.Errorf("specify completed_by = %q, want the bulk call's actor", got)
+	}
+}
+
+func TestBulkAdvanceTool_Handle_StopsAtClarityGate(t *testing.T) {
+	tmpDir, cleanup := setupBulkProject(t)
+	defer cleanup()
+
+	result, err := newTestBulkAdvanceTool(t).Handle(context.Background(), bulkRequest(map[string]any{
+		"requirements":   bulkRequirements,
+		"business_rules": bulkBusinessRules,
+		"clarify": map[string]any{
+			"answers":          "Users are freelancers.",
+			"dimension_scores": "target_users:20,core_functionality:20,data_model:20,integrations:20,edge_cases:20,security:20,scale_performance:20,scope_boundaries:20",
+		},
+		"design": map[string]any{"architecture_overview": "Monolith."},
+	}))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !isErrorResult(result) {
+		t.Fatalf("a failed Clarity Gate should stop the run with an error:\n%s", getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"# Bulk Advance Stopped",
+		"| Business Rules | `sdd_create_business_rules` | ✅ completed |",
+		"| Clarify | `sdd_clarify` | ❌ failed | saved but did not advance |",
+		"| Design | `sdd_create_design` | ⏭ skipped | not run |",
+		"## Failure",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("report missing %q:\n%s", want, text)
+		}
+	}
+
+	cfg, _ := config.NewFileStore().Load(tmpDir)
+	if cfg.CurrentStage != config.StageClarify {
+		t.Errorf("current stage = %s, want clarify (earlier stages stay saved)", cfg.CurrentStage)
+	}
+}
+
+func TestBulkAdvanceTool_Handle_MissingStagePayload(t *testing.T) {
+	_, cleanup := setupBulkProject(t)
+	defer cleanup()
+
+	// business_rules is missing between requirements and clarify.
+	result, _ := newTestBulkAdvanceTool(t).Handle(context.Background(), bulkRequest(map[string]any{
+		"requirements": bulkRequirements,
+		"clarify":      map[string]any{"answers": "x", "dimension_scores": "target_users:90"},
+	}))
+	if !isErrorResult(result) {
+		t.Fatal("a gap in the payloads should stop the run")
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"| Business Rules | `sdd_create_business_rules` | ❌ missing |",
+		"pass 'business_rules' to continue to clarify",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("report missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestBulkAdvanceTool_Handle_InvalidInput(t *testing.T) {
+	_, cleanup := setupBulkProject(t)
+	defer cleanup()
+	tool := newTestBulkAdvanceTool(t)
+
+	result, _ := tool.Handle(context.Background(), bulkRequest(map[string]any{}))
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "at least one stage payload") {
+		t.Errorf("no payloads should be rejected, got: %s", getResultText(result))
+	}
+
+	result, _ = tool.Handle(context.Background(), bulkRequest(map[string]any{"requirements": "FR-001"}))
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "'requirements' must be an object") {
+		t.Errorf("a non-object payload should be rejected, got: %s", getResultText(result))
+	}
+}
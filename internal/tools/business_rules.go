@@ -74,73 +74,73 @@ func (t *BusinessRulesTool) Definition() mcp.Tool {
 			mcp.Description("Additional domain vocabulary and abbreviations beyond the core definitions. "+
 				"Use for industry jargon, acronyms, or terms the team needs to agree on."),
 		),
+		actorParam(),
 	)
 }
 
 // Handle processes the sdd_create_business_rules tool call.
 func (t *BusinessRulesTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	definitions := req.GetString("definitions", "")
-	facts := req.GetString("facts", "")
-	constraints := req.GetString("constraints", "")
-	derivations := req.GetString("derivations", "")
-	glossary := req.GetString("glossary", "")
-
-	// Validate required fields.
-	if definitions == "" {
-		return mcp.NewToolResultError("'definitions' is required — list domain terms with precise definitions (Ubiquitous Language)"), nil
-	}
-	if facts == "" {
-		return mcp.NewToolResultError("'facts' is required — list relationships between domain terms that are always true"), nil
-	}
-	if constraints == "" {
-		return mcp.NewToolResultError("'constraints' is required — list behavioral boundaries using When/Then/Otherwise format"), nil
+	data := templates.BusinessRulesData{
+		Definitions: req.GetString("definitions", ""),
+		Facts:       req.GetString("facts", ""),
+		Constraints: req.GetString("constraints", ""),
+		Derivations: req.GetString("derivations", ""),
+		Glossary:    req.GetString("glossary", ""),
 	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return stageToolResult(t.run(projectRoot, data, requestActor(ctx, req)))
+}
 
-	cfg, err := t.store.Load(projectRoot)
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+// Run saves the business rules for the project at projectRoot and
+// advances the pipeline. data.Name is ignored — the project's name is used.
+func (t *BusinessRulesTool) Run(projectRoot string, data templates.BusinessRulesData) (*StageResult, error) {
+	return t.run(projectRoot, data, "")
+}
+
+// run is Run with the completed stage attributed to actor.
+func (t *BusinessRulesTool) run(projectRoot string, data templates.BusinessRulesData, actor string) (*StageResult, error) {
+	// Validate required fields — before the load error, so a bad call is
+	// reported as such even outside a project.
+	cfg, loadErr := t.store.Load(projectRoot)
+	if err := checkRequiredFields(cfg, config.StageBusinessRules, map[string]string{
+		"definitions": data.Definitions,
+		"facts":       data.Facts,
+		"constraints": data.Constraints,
+		"derivations": data.Derivations,
+		"glossary":    data.Glossary,
+	}); err != nil {
+		return nil, err
+	}
+	if loadErr != nil {
+		return nil, asUserError(loadErr)
 	}
 
 	// Validate pipeline stage.
 	if err := pipeline.RequireStage(cfg, config.StageBusinessRules); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
 	}
 
-	// Verify requirements exist.
-	reqPath := config.StagePath(projectRoot, config.StageSpecify)
-	reqContent, err := readStageFile(reqPath)
-	if err != nil {
-		return nil, fmt.Errorf("reading requirements: %w", err)
-	}
-	if reqContent == "" {
-		return mcp.NewToolResultError("requirements.md is empty — run sdd_generate_requirements first"), nil
+	// Verify prerequisite artifacts exist.
+	if err := pipeline.RequireArtifacts(projectRoot, pipeline.PrerequisiteArtifacts(cfg, config.StageBusinessRules)...); err != nil {
+		return nil, asUserError(err)
 	}
 
 	pipeline.MarkInProgress(cfg)
 
-	// Build template data.
-	data := templates.BusinessRulesData{
-		Name:        cfg.Name,
-		Definitions: definitions,
-		Facts:       facts,
-		Constraints: constraints,
-		Derivations: derivations,
-		Glossary:    glossary,
-	}
-
 	// Render and write via shared function (ADR-001).
+	data.Name = cfg.Name
+	data.Attribution = attributionFor(cfg)
 	content, err := RenderAndWriteBusinessRules(projectRoot, t.renderer, data, false)
 	if err != nil {
 		return nil, err
 	}
 
 	// Advance pipeline.
-	if err := pipeline.Advance(cfg); err != nil {
+	if err := pipeline.AdvanceBy(cfg, actor); err != nil {
 		return nil, fmt.Errorf("advancing pipeline: %w", err)
 	}
 
@@ -163,8 +163,9 @@ func (t *BusinessRulesTool) Handle(ctx context.Context, req mcp.CallToolRequest)
 			"**Why this matters:** Business rules are the DNA of your system. "+
 			"The Clarity Gate validates that every constraint is unambiguous and every "+
 			"term in your Ubiquitous Language has exactly one meaning.",
-		content, pipeline.ClarityThreshold(cfg.Mode), cfg.Mode,
+		content, pipeline.ProjectClarityThreshold(cfg), cfg.Mode,
 	)
+	response += wordBudgetNote(cfg, config.StageBusinessRules, content)
 
-	return mcp.NewToolResultText(response), nil
+	return &StageResult{Content: content, Config: cfg, Response: response}, nil
 }
@@ -55,57 +55,80 @@ func (t *ChangeTool) Definition() mcp.Tool {
 
 // Handle processes the sdd_change tool call.
 func (t *ChangeTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	changeType := changes.ChangeType(req.GetString("type", ""))
-	changeSize := changes.ChangeSize(req.GetString("size", ""))
-	description := req.GetString("description", "")
+	params := changeParams{
+		Type:        changes.ChangeType(req.GetString("type", "")),
+		Size:        changes.ChangeSize(req.GetString("size", "")),
+		Description: req.GetString("description", ""),
+	}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// changeParams are the parsed sdd_change arguments.
+type changeParams struct {
+	Type        changes.ChangeType
+	Size        changes.ChangeSize
+	Description string
+}
+
+// changeParamSpecs declares the sdd_change argument checks; type and
+// size are checked by the changes package.
+var changeParamSpecs = []paramSpec{
+	{name: "description", required: true, hint: "briefly describe the change"},
+}
+
+// process validates the change, checks the pipeline guards, and creates the change record.
+func (t *ChangeTool) process(projectRoot string, params changeParams) (string, error) {
+	changeType := params.Type
+	changeSize := params.Size
+	description := params.Description
 
 	// Validate required fields.
 	if err := changes.ValidateType(changeType); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return "", asUserError(err)
 	}
 	if err := changes.ValidateSize(changeSize); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
-	}
-	if strings.TrimSpace(description) == "" {
-		return mcp.NewToolResultError("'description' is required — briefly describe the change"), nil
+		return "", asUserError(err)
 	}
-
-	projectRoot, err := findProjectRoot()
-	if err != nil {
-		return nil, fmt.Errorf("finding project root: %w", err)
+	if err := validateParams(changeParamSpecs, map[string]string{"description": description}); err != nil {
+		return "", err
 	}
 
 	// Guard: only one active change at a time.
 	active, err := t.store.LoadActive(projectRoot)
 	if err != nil {
-		return nil, fmt.Errorf("checking active changes: %w", err)
+		return "", fmt.Errorf("checking active changes: %w", err)
 	}
 	if active != nil {
-		return mcp.NewToolResultError(fmt.Sprintf(
+		return "", newUserError(fmt.Sprintf(
 			"An active change already exists: %q (%s/%s, stage: %s). "+
 				"Complete or archive it before starting a new one.",
 			active.ID, active.Type, active.Size, active.CurrentStage,
-		)), nil
+		))
 	}
 
 	// Guard: check for SDD artifacts (context-aware changes).
 	// Medium/large changes are blocked without artifacts; small changes get a warning.
 	hasArtifacts := CheckSDDArtifacts(projectRoot)
 	if !hasArtifacts && (changeSize == changes.SizeMedium || changeSize == changes.SizeLarge) {
-		return mcp.NewToolResultError(
+		return "", newUserError(
 			"❌ No SDD artifacts found in this project.\n\n" +
 				"Medium and large changes require project context for accurate " +
 				"architecture-aware suggestions.\n\n" +
 				"**Run `sdd_reverse_engineer` first** to scan your project and " +
 				"generate baseline SDD artifacts (`business-rules.md`, `design.md`, " +
 				"`requirements.md`). Then retry this change.",
-		), nil
+		)
 	}
 
 	// Look up stage flow.
 	flow, err := changes.StageFlow(changeType, changeSize)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("invalid flow: %v", err)), nil
+		return "", newUserError(fmt.Sprintf("invalid flow: %v", err))
 	}
 
 	// Build stage entries — first stage starts in_progress.
@@ -141,7 +164,7 @@ func (t *ChangeTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 	}
 
 	if err := t.store.Create(projectRoot, change); err != nil {
-		return nil, fmt.Errorf("creating change: %w", err)
+		return "", fmt.Errorf("creating change: %w", err)
 	}
 
 	// Build response.
@@ -185,5 +208,5 @@ func (t *ChangeTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 			"limited context information without them."
 	}
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
@@ -50,38 +50,58 @@ func (t *ChangeAdvanceTool) Definition() mcp.Tool {
 
 // Handle processes the sdd_change_advance tool call.
 func (t *ChangeAdvanceTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	content := req.GetString("content", "")
-	title := req.GetString("title", "")
-
-	if strings.TrimSpace(content) == "" {
-		return mcp.NewToolResultError("'content' is required — provide the AI-generated content for the current stage"), nil
+	params := changeAdvanceParams{
+		Content: req.GetString("content", ""),
+		Title:   req.GetString("title", ""),
 	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// changeAdvanceParams are the parsed sdd_change_advance arguments.
+type changeAdvanceParams struct {
+	Content string
+	Title   string
+}
+
+// changeAdvanceParamSpecs declares the sdd_change_advance argument checks.
+var changeAdvanceParamSpecs = []paramSpec{
+	{name: "content", required: true, hint: "provide the AI-generated content for the current stage"},
+}
+
+// process writes the current stage's artifact and advances the active change.
+func (t *ChangeAdvanceTool) process(projectRoot string, params changeAdvanceParams) (string, error) {
+	content := params.Content
+	title := params.Title
+
+	if err := validateParams(changeAdvanceParamSpecs, map[string]string{"content": content}); err != nil {
+		return "", err
+	}
 
 	active, err := t.store.LoadActive(projectRoot)
 	if err != nil {
-		return nil, fmt.Errorf("loading active change: %w", err)
+		return "", fmt.Errorf("loading active change: %w", err)
 	}
 	if active == nil {
-		return mcp.NewToolResultError("No active change found. Create one with `sdd_change` first."), nil
+		return "", newUserError("No active change found. Create one with `sdd_change` first.")
 	}
 
 	// Determine current stage and its filename.
 	currentStage := active.CurrentStage
 	filename := changes.StageFilename(currentStage)
 	if filename == "" {
-		return nil, fmt.Errorf("unknown stage %q — no filename mapping", currentStage)
+		return "", fmt.Errorf("unknown stage %q — no filename mapping", currentStage)
 	}
 
 	// Write content to sdd/changes/<id>/<stage>.md
 	changeDir := changes.ChangePath(projectRoot, active.ID)
 	stagePath := filepath.Join(changeDir, filename)
 	if err := writeStageFile(stagePath, content); err != nil {
-		return nil, fmt.Errorf("writing %s: %w", filename, err)
+		return "", fmt.Errorf("writing %s: %w", filename, err)
 	}
 
 	// Check if this is the final stage (verify).
@@ -90,18 +110,18 @@ func (t *ChangeAdvanceTool) Handle(ctx context.Context, req mcp.CallToolRequest)
 	if isLast {
 		// Final stage — complete the change.
 		if err := changes.CompleteChange(active); err != nil {
-			return nil, fmt.Errorf("completing change: %w", err)
+			return "", fmt.Errorf("completing change: %w", err)
 		}
 	} else {
 		// Advance to the next stage.
 		if err := changes.Advance(active); err != nil {
-			return nil, fmt.Errorf("advancing change: %w", err)
+			return "", fmt.Errorf("advancing change: %w", err)
 		}
 	}
 
 	// Persist updated change record.
 	if err := t.store.Save(projectRoot, active); err != nil {
-		return nil, fmt.Errorf("saving change: %w", err)
+		return "", fmt.Errorf("saving change: %w", err)
 	}
 
 	// Notify bridge.
@@ -121,7 +141,7 @@ func (t *ChangeAdvanceTool) Handle(ctx context.Context, req mcp.CallToolRequest)
 				"or start a new change with `sdd_change`.",
 			currentStage, active.ID, active.Type, active.Size, active.ID,
 		)
-		return mcp.NewToolResultText(response), nil
+		return response, nil
 	}
 
 	// Build stage progress.
@@ -158,5 +178,5 @@ func (t *ChangeAdvanceTool) Handle(ctx context.Context, req mcp.CallToolRequest)
 		nextStage, nextStage,
 	)
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
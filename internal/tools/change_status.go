@@ -38,26 +38,40 @@ func (t *ChangeStatusTool) Definition() mcp.Tool {
 
 // Handle processes the sdd_change_status tool call.
 func (t *ChangeStatusTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	changeID := req.GetString("change_id", "")
+	params := changeStatusParams{
+		ChangeID: req.GetString("change_id", ""),
+	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// changeStatusParams are the parsed sdd_change_status arguments.
+type changeStatusParams struct {
+	ChangeID string
+}
+
+// process renders the progress of the requested (or active) change.
+func (t *ChangeStatusTool) process(projectRoot string, params changeStatusParams) (string, error) {
+	changeID := params.ChangeID
 
 	var change *changes.ChangeRecord
+	var err error
 	if changeID != "" {
 		change, err = t.store.Load(projectRoot, changeID)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Change %q not found: %v", changeID, err)), nil
+			return "", newUserError(fmt.Sprintf("Change %q not found: %v", changeID, err))
 		}
 	} else {
 		change, err = t.store.LoadActive(projectRoot)
 		if err != nil {
-			return nil, fmt.Errorf("loading active change: %w", err)
+			return "", fmt.Errorf("loading active change: %w", err)
 		}
 		if change == nil {
-			return mcp.NewToolResultError("No active change found. Create one with `sdd_change` first."), nil
+			return "", newUserError("No active change found. Create one with `sdd_change` first.")
 		}
 	}
 
@@ -123,5 +137,5 @@ func (t *ChangeStatusTool) Handle(ctx context.Context, req mcp.CallToolRequest)
 		adrSection,
 	)
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
 	"github.com/HendryAvila/Hoofy/internal/pipeline"
@@ -16,6 +17,11 @@ type CharterTool struct {
 	store    config.Store
 	renderer templates.Renderer
 	bridge   StageObserver
+
+	// Optional quick-start collaborators used when auto_init=true and
+	// no project exists yet. Nil disables auto_init.
+	initTool       *InitTool
+	principlesTool *PrinciplesTool
 }
 
 // NewCharterTool creates a CharterTool with its dependencies.
@@ -27,6 +33,13 @@ func NewCharterTool(store config.Store, renderer templates.Renderer) *CharterToo
 // when the charter stage completes. Nil is safe (disables bridge).
 func (t *CharterTool) SetBridge(obs StageObserver) { t.bridge = obs }
 
+// SetAutoInit enables the auto_init quick start: when no project exists,
+// the charter call first runs init and principles through these tools.
+func (t *CharterTool) SetAutoInit(initTool *InitTool, principlesTool *PrinciplesTool) {
+	t.initTool = initTool
+	t.principlesTool = principlesTool
+}
+
 // Definition returns the MCP tool definition for registration.
 func (t *CharterTool) Definition() mcp.Tool {
 	return mcp.NewTool("sdd_create_charter",
@@ -88,68 +101,142 @@ func (t *CharterTool) Definition() mcp.Tool {
 			mcp.Description("Technical, business, or regulatory constraints that shape the solution. "+
 				"Example: '- Must deploy to AWS GovCloud (FedRAMP requirement)\\n- Budget: $500/month max for infrastructure\\n- Team: 2 developers, 1 designer'"),
 		),
+		mcp.WithBoolean("update",
+			mcp.Description("Refine the saved charter instead of submitting a new one: only the fields you pass "+
+				"are replaced, the rest are kept from charter.md. Only possible right after the charter, "+
+				"before sdd_generate_requirements has run. Does not move the pipeline. Defaults to false."),
+		),
+		mcp.WithBoolean("auto_init",
+			mcp.Description("Quick start: if no SDD project exists yet, initialize one and record its principles "+
+				"before saving the charter — collapsing init, principles, and charter into one call. "+
+				"Requires 'name', 'mode', and 'principles'. Ignored when a project already exists. Defaults to false."),
+		),
+		mcp.WithString("name",
+			mcp.Description("Project name. Only used with auto_init when no project exists."),
+		),
+		mcp.WithString("mode",
+			mcp.Description("Interaction mode for the new project. Only used with auto_init when no project exists."),
+			mcp.Enum("guided", "expert"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Brief project description. Only used with auto_init; defaults to proposed_solution."),
+		),
+		mcp.WithString("principles",
+			mcp.Description("Golden invariants for the new project (see sdd_create_principles). "+
+				"Only used with auto_init when no project exists."),
+		),
+		referencesParam(),
+		actorParam(),
 	)
 }
 
 // Handle processes the sdd_create_charter tool call.
 func (t *CharterTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	problemStatement := req.GetString("problem_statement", "")
-	targetUsers := req.GetString("target_users", "")
-	proposedSolution := req.GetString("proposed_solution", "")
-	successCriteria := req.GetString("success_criteria", "")
-	domainContext := req.GetString("domain_context", "")
-	stakeholders := req.GetString("stakeholders", "")
-	vision := req.GetString("vision", "")
-	boundaries := req.GetString("boundaries", "")
-	existingSystems := req.GetString("existing_systems", "")
-	constraints := req.GetString("constraints", "")
-
-	// Validate required fields.
-	if problemStatement == "" {
-		return mcp.NewToolResultError("'problem_statement' is required — describe the problem this project solves"), nil
-	}
-	if targetUsers == "" {
-		return mcp.NewToolResultError("'target_users' is required — who will use this?"), nil
-	}
-	if proposedSolution == "" {
-		return mcp.NewToolResultError("'proposed_solution' is required — describe what we're building"), nil
+	data := templates.CharterData{
+		ProblemStatement: req.GetString("problem_statement", ""),
+		TargetUsers:      req.GetString("target_users", ""),
+		ProposedSolution: req.GetString("proposed_solution", ""),
+		SuccessCriteria:  req.GetString("success_criteria", ""),
+		DomainContext:    req.GetString("domain_context", ""),
+		Stakeholders:     req.GetString("stakeholders", ""),
+		Vision:           req.GetString("vision", ""),
+		Boundaries:       req.GetString("boundaries", ""),
+		ExistingSystems:  req.GetString("existing_systems", ""),
+		Constraints:      req.GetString("constraints", ""),
 	}
-	if successCriteria == "" {
-		return mcp.NewToolResultError("'success_criteria' is required — how do we know this succeeded?"), nil
+	refs, err := requestReferences(req)
+	if err != nil {
+		return toolResult("", err)
 	}
+	data.References = refs
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
 
+	if req.GetBool("update", false) {
+		return stageToolResult(t.Update(projectRoot, data))
+	}
+	// A project that fails to load (or doesn't exist yet, with auto_init)
+	// is checked against the default required fields.
+	cfg, _ := t.store.Load(projectRoot)
+	if err := validateCharter(cfg, data); err != nil {
+		return toolResult("", err)
+	}
+
+	autoInitNote := ""
+	if req.GetBool("auto_init", false) && !config.Exists(projectRoot) {
+		errResult, err := t.autoInit(ctx, req, data.ProposedSolution)
+		if err != nil || errResult != nil {
+			return errResult, err
+		}
+		autoInitNote = "_Auto-initialized a new SDD project and recorded its principles._\n\n"
+	}
+
+	return stageToolResult(t.run(projectRoot, data, autoInitNote, requestActor(ctx, req)))
+}
+
+// Run saves the charter for the project at projectRoot and advances the
+// pipeline. data.Name is ignored — the project's name is used.
+func (t *CharterTool) Run(projectRoot string, data templates.CharterData) (*StageResult, error) {
+	cfg, _ := t.store.Load(projectRoot)
+	if err := validateCharter(cfg, data); err != nil {
+		return nil, err
+	}
+	return t.run(projectRoot, data, "", "")
+}
+
+// validateCharter checks the charter's required fields against cfg's
+// required_fields, or the defaults when cfg is nil, and its references.
+func validateCharter(cfg *config.ProjectConfig, data templates.CharterData) error {
+	if err := checkRequiredFields(cfg, config.StageCharter, map[string]string{
+		"problem_statement": data.ProblemStatement,
+		"target_users":      data.TargetUsers,
+		"proposed_solution": data.ProposedSolution,
+		"success_criteria":  data.SuccessCriteria,
+		"domain_context":    data.DomainContext,
+		"stakeholders":      data.Stakeholders,
+		"vision":            data.Vision,
+		"boundaries":        data.Boundaries,
+		"existing_systems":  data.ExistingSystems,
+		"constraints":       data.Constraints,
+	}); err != nil {
+		return err
+	}
+	return validateReferences(data.References)
+}
+
+// run writes a validated charter, attributing the stage to actor. note is
+// prepended to the response body (used to report an auto-init).
+func (t *CharterTool) run(projectRoot string, data templates.CharterData, note, actor string) (*StageResult, error) {
 	cfg, err := t.store.Load(projectRoot)
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
 	}
 
 	// Validate we're at the right stage.
 	if err := pipeline.RequireStage(cfg, config.StageCharter); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
 	}
 
 	pipeline.MarkInProgress(cfg)
 
-	// Build charter with REAL content from the AI.
-	data := templates.CharterData{
-		Name:             cfg.Name,
-		ProblemStatement: problemStatement,
-		TargetUsers:      targetUsers,
-		ProposedSolution: proposedSolution,
-		SuccessCriteria:  successCriteria,
-		DomainContext:    domainContext,
-		Stakeholders:     stakeholders,
-		Vision:           vision,
-		Boundaries:       boundaries,
-		ExistingSystems:  existingSystems,
-		Constraints:      constraints,
-	}
+	nudge := recommendedFieldsNote(cfg, config.StageCharter, map[string]string{
+		"domain_context":   data.DomainContext,
+		"stakeholders":     data.Stakeholders,
+		"vision":           data.Vision,
+		"boundaries":       data.Boundaries,
+		"existing_systems": data.ExistingSystems,
+		"constraints":      data.Constraints,
+	})
+	nudge += jargonNote(cfg, map[string]string{
+		"problem_statement": data.ProblemStatement,
+		"proposed_solution": data.ProposedSolution,
+	})
 
+	data.Name = cfg.Name
+	data.Attribution = attributionFor(cfg)
 	content, err := t.renderer.Render(templates.Charter, data)
 	if err != nil {
 		return nil, fmt.Errorf("rendering charter: %w", err)
@@ -161,8 +248,10 @@ func (t *CharterTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp
 		return nil, fmt.Errorf("writing charter: %w", err)
 	}
 
+	recordReferences(cfg, config.StageCharter, data.References)
+
 	// Advance pipeline to next stage.
-	if err := pipeline.Advance(cfg); err != nil {
+	if err := pipeline.AdvanceBy(cfg, actor); err != nil {
 		return nil, fmt.Errorf("advancing pipeline: %w", err)
 	}
 
@@ -172,19 +261,154 @@ func (t *CharterTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp
 
 	notifyObserver(t.bridge, cfg.Name, config.StageCharter, content)
 
+	response := msg(cfg, "charter.created", note, config.DocsDir, content)
+	response += nudge
+	response += wordBudgetNote(cfg, config.StageCharter, content)
+
+	return &StageResult{Content: content, Config: cfg, Response: response}, nil
+}
+
+// Update overlays the non-empty fields of data onto the saved charter and
+// re-renders it. It is allowed only while nothing downstream builds on
+// the charter yet: the pipeline sits at the stage after it and that
+// stage's artifact doesn't exist. The pipeline state is left unchanged.
+func (t *CharterTool) Update(projectRoot string, data templates.CharterData) (*StageResult, error) {
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return nil, asUserError(err)
+	}
+
+	order := config.StageOrderFor(cfg)
+	i := pipeline.StageIndexFor(cfg, config.StageCharter)
+	next := order[i+1]
+	charterPath := config.StagePath(projectRoot, config.StageCharter)
+	switch {
+	case !pipeline.IsCompleted(cfg, config.StageCharter) || !fileExists(charterPath):
+		return nil, newUserError("there is no saved charter to update yet — submit the full charter without 'update'")
+	case cfg.CurrentStage != next || fileExists(config.StagePath(projectRoot, next)):
+		return nil, newUserError(fmt.Sprintf(
+			"the charter can only be updated before %s builds on it (currently at '%s') — "+
+				"later changes go through sdd_change",
+			config.Stages[next].Name, cfg.CurrentStage))
+	}
+
+	existing, err := readStageFile(charterPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading charter: %w", err)
+	}
+	merged := templates.ParseCharter(existing)
+	updated := overlayCharter(&merged, data)
+	if len(updated) == 0 {
+		return nil, newUserError("'update' needs at least one charter field to change")
+	}
+	if err := validateCharter(cfg, merged); err != nil {
+		return nil, err
+	}
+
+	merged.Name = cfg.Name
+	merged.Attribution = attributionFor(cfg)
+	content, err := t.renderer.Render(templates.Charter, merged)
+	if err != nil {
+		return nil, fmt.Errorf("rendering charter: %w", err)
+	}
+	if err := writeStageFile(charterPath, content); err != nil {
+		return nil, fmt.Errorf("writing charter: %w", err)
+	}
+	if len(data.References) > 0 {
+		recordReferences(cfg, config.StageCharter, merged.References)
+		if err := t.store.Save(projectRoot, cfg); err != nil {
+			return nil, fmt.Errorf("saving config: %w", err)
+		}
+	}
+
+	notifyObserver(t.bridge, cfg.Name, config.StageCharter, content)
+
 	response := fmt.Sprintf(
-		"# Charter Created\n\n"+
-			"Saved to `%s/charter.md`\n\n"+
+		"# Charter Updated\n\n"+
+			"Updated %s in `%s/charter.md`; other sections were kept.\n\n"+
 			"## Content\n\n%s\n\n"+
 			"---\n\n"+
 			"## Next Step\n\n"+
-			"Pipeline advanced to **Stage 3: Specify**.\n\n"+
-			"Now analyze this charter and extract formal requirements using MoSCoW prioritization "+
-			"(Must Have, Should Have, Could Have, Won't Have). Each requirement needs a unique ID "+
-			"(FR-001 for functional, NFR-001 for non-functional).\n\n"+
-			"Call `sdd_generate_requirements` with the extracted requirements.",
-		config.DocsDir, content,
+			"The pipeline is still at **%s**. Call `sdd_generate_requirements` when the charter is settled.",
+		strings.Join(updated, ", "), config.DocsDir, content, config.Stages[next].Name,
 	)
+	response += wordBudgetNote(cfg, config.StageCharter, content)
+	return &StageResult{Content: content, Config: cfg, Response: response}, nil
+}
+
+// overlayCharter copies the non-empty fields of src onto dst and returns
+// the parameter names of the fields it replaced.
+func overlayCharter(dst *templates.CharterData, src templates.CharterData) []string {
+	fields := []struct {
+		param string
+		dst   *string
+		src   string
+	}{
+		{"problem_statement", &dst.ProblemStatement, src.ProblemStatement},
+		{"target_users", &dst.TargetUsers, src.TargetUsers},
+		{"proposed_solution", &dst.ProposedSolution, src.ProposedSolution},
+		{"success_criteria", &dst.SuccessCriteria, src.SuccessCriteria},
+		{"domain_context", &dst.DomainContext, src.DomainContext},
+		{"stakeholders", &dst.Stakeholders, src.Stakeholders},
+		{"vision", &dst.Vision, src.Vision},
+		{"boundaries", &dst.Boundaries, src.Boundaries},
+		{"existing_systems", &dst.ExistingSystems, src.ExistingSystems},
+		{"constraints", &dst.Constraints, src.Constraints},
+	}
+	var updated []string
+	for _, f := range fields {
+		if strings.TrimSpace(f.src) != "" {
+			*f.dst = f.src
+			updated = append(updated, "`"+f.param+"`")
+		}
+	}
+	if len(src.References) > 0 {
+		dst.References = src.References
+		updated = append(updated, "`references`")
+	}
+	return updated
+}
+
+// autoInit runs sdd_init_project and sdd_create_principles on behalf of
+// an auto_init charter call. It returns a non-nil error result if either
+// step is rejected, so the caller can surface it unchanged.
+func (t *CharterTool) autoInit(ctx context.Context, req mcp.CallToolRequest, proposedSolution string) (*mcp.CallToolResult, error) {
+	if t.initTool == nil || t.principlesTool == nil {
+		return toolResult("", toolError(CodeNotInitialized, "auto_init is not available on this server — call sdd_init_project first"))
+	}
+
+	name := req.GetString("name", "")
+	mode := req.GetString("mode", "")
+	principles := req.GetString("principles", "")
+	if name == "" || mode == "" {
+		return toolResult("", toolError(CodeMissingField, "auto_init requires 'name' and 'mode' to initialize a new project"))
+	}
+	if principles == "" {
+		return toolResult("", toolError(CodeMissingField,
+			"auto_init requires 'principles' — the pipeline records principles before the charter"))
+	}
+
+	initReq := mcp.CallToolRequest{}
+	initReq.Params.Arguments = map[string]any{
+		"name":        name,
+		"mode":        mode,
+		"description": req.GetString("description", proposedSolution),
+		"actor":       requestActor(ctx, req),
+	}
+	result, err := t.initTool.Handle(ctx, initReq)
+	if err != nil || result.IsError {
+		return result, err
+	}
+
+	principlesReq := mcp.CallToolRequest{}
+	principlesReq.Params.Arguments = map[string]any{
+		"principles": principles,
+		"actor":      requestActor(ctx, req),
+	}
+	result, err = t.principlesTool.Handle(ctx, principlesReq)
+	if err != nil || result.IsError {
+		return result, err
+	}
 
-	return mcp.NewToolResultText(response), nil
+	return nil, nil
 }
@@ -2,9 +2,13 @@ package tools
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/HendryAvila/Hoofy/internal/clarify"
 	"github.com/HendryAvila/Hoofy/internal/config"
 	"github.com/HendryAvila/Hoofy/internal/pipeline"
 	"github.com/HendryAvila/Hoofy/internal/templates"
@@ -41,7 +45,8 @@ func (t *ClarifyTool) Definition() mcp.Tool {
 				"\n\nUSAGE: "+
 				"\n- Call WITHOUT 'answers' to get the analysis framework and dimensions. "+
 				"The AI should then analyze the requirements, generate 3-5 specific questions, "+
-				"and present them to the user. "+
+				"and present them to the user. After a scored round, the response includes a "+
+				"Question Plan saying exactly how many questions to ask per weak dimension. "+
 				"\n- Call WITH 'answers' and 'dimension_scores' after the user answers the questions. "+
 				"The AI should assess each dimension based on the requirements + answers. "+
 				"\n\nThe pipeline cannot advance until the clarity score meets the threshold. "+
@@ -58,6 +63,8 @@ func (t *ClarifyTool) Definition() mcp.Tool {
 			mcp.Description(
 				"AI-assessed scores for each clarity dimension after analyzing requirements + answers. "+
 					"Comma-separated list of dimension_name:score pairs (score 0-100). "+
+					"Each pair may cite evidence after a '|': 'security:70|JWT+bcrypt specified in FR-009'. "+
+					"Justifications are recorded next to each score in clarifications.md. "+
 					"ALL 8 dimensions should be scored: "+
 					"target_users, core_functionality, data_model, integrations, "+
 					"edge_cases, security, scale_performance, scope_boundaries. "+
@@ -65,49 +72,106 @@ func (t *ClarifyTool) Definition() mcp.Tool {
 					"edge_cases:55,security:70,scale_performance:60,scope_boundaries:85'",
 			),
 		),
+		mcp.WithString("focus",
+			mcp.Description(
+				"Optional comma-separated dimension names to work on this round, e.g. 'security,edge_cases'. "+
+					"Without answers, only these dimensions are presented. With answers, only these scores "+
+					"are taken from 'dimension_scores'; every other dimension keeps its previous score.",
+			),
+		),
+		actorParam(),
 	)
 }
 
+// ClarifyParams are the inputs of a Clarity Gate round. Leave Answers
+// empty to get the analysis framework for a new round of questions.
+type ClarifyParams struct {
+	Answers         string
+	DimensionScores string // "name:score[|evidence],..." as in sdd_clarify's dimension_scores
+	Actor           string // recorded as the stage's completed_by when the gate passes; "" is unknown
+	// Focus limits the round to these dimension names. Empty means all.
+	Focus []string
+}
+
 // Handle processes the sdd_clarify tool call.
 func (t *ClarifyTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	answers := req.GetString("answers", "")
-	dimensionScores := req.GetString("dimension_scores", "")
+	params := ClarifyParams{
+		Answers:         req.GetString("answers", ""),
+		DimensionScores: req.GetString("dimension_scores", ""),
+		Actor:           requestActor(ctx, req),
+		Focus:           splitFocus(req.GetString("focus", "")),
+	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return stageToolResult(t.Run(projectRoot, params))
+}
+
+// Run runs one Clarity Gate round for the project at projectRoot. Without
+// answers it returns the question framework (StageResult.Content is
+// empty); with answers it records the round, rescoring clarity, and
+// advances the pipeline when the gate passes.
+//
+// A round that loses a race to save hoofy.json (config.ErrConflict) —
+// e.g. a retried call overlapping the original — is rerun once against
+// the reloaded config, so neither round's score is silently lost.
+func (t *ClarifyTool) Run(projectRoot string, params ClarifyParams) (*StageResult, error) {
+	res, err := t.run(projectRoot, params)
+	if errors.Is(err, config.ErrConflict) {
+		res, err = t.run(projectRoot, params)
+	}
+	if errors.Is(err, config.ErrConflict) {
+		return nil, newUserError("hoofy.json kept changing while this round was saved — " +
+			"another sdd_clarify call may be running; check sdd_get_context and retry")
+	}
+	return res, err
+}
+
+// run is one attempt at Run.
+func (t *ClarifyTool) run(projectRoot string, params ClarifyParams) (*StageResult, error) {
+	if err := validateFocus(params.Focus); err != nil {
+		return nil, err
+	}
+
+	// Answers without usable scores would silently score 0 and "fail"
+	// the gate with no explanation — ask for the scores instead.
+	if params.Answers != "" && parseDimensionScores(params.DimensionScores, focusDimensions(pipeline.DefaultDimensions(), params.Focus)) == 0 {
+		return nil, newUserError(missingScoresError(params.DimensionScores))
+	}
 
 	cfg, err := t.store.Load(projectRoot)
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
 	}
 
 	// Validate pipeline stage.
 	if err := pipeline.RequireStage(cfg, config.StageClarify); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
+	}
+
+	// Verify prerequisite artifacts exist.
+	if err := pipeline.RequireArtifacts(projectRoot, pipeline.PrerequisiteArtifacts(cfg, config.StageClarify)...); err != nil {
+		return nil, asUserError(err)
 	}
 
 	// Read requirements for analysis.
-	reqPath := config.StagePath(projectRoot, config.StageSpecify)
-	requirements, err := readStageFile(reqPath)
+	requirements, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
 	if err != nil {
 		return nil, fmt.Errorf("reading requirements: %w", err)
 	}
-	if requirements == "" {
-		return mcp.NewToolResultError("requirements.md is empty — run sdd_generate_requirements first"), nil
-	}
 
 	pipeline.MarkInProgress(cfg)
 
-	threshold := pipeline.ClarityThreshold(cfg.Mode)
+	threshold := pipeline.ProjectClarityThreshold(cfg)
 
 	// Branch: generating questions vs processing answers.
-	if answers == "" {
-		return t.generateQuestions(cfg, requirements, projectRoot, threshold)
+	if params.Answers == "" {
+		return t.generateQuestions(cfg, requirements, projectRoot, threshold, params.Focus)
 	}
 
-	return t.processAnswers(cfg, requirements, answers, dimensionScores, projectRoot, threshold)
+	return t.processAnswers(cfg, requirements, params, projectRoot, threshold)
 }
 
 // generateQuestions analyzes requirements and produces the clarity analysis framework.
@@ -116,17 +180,44 @@ func (t *ClarifyTool) generateQuestions(
 	requirements string,
 	projectRoot string,
 	threshold int,
-) (*mcp.CallToolResult, error) {
-	dimensions := pipeline.DefaultDimensions()
+	focus []string,
+) (*StageResult, error) {
+	dimensions := focusDimensions(pipeline.ApplyWeights(pipeline.DefaultDimensions(), cfg.DimensionWeights), focus)
+
+	// Read existing clarifications: their history is shown below and
+	// the previous round's scores drive the question plan.
+	clarifyPath := config.StagePath(projectRoot, config.StageClarify)
+	existing, _ := readStageFile(clarifyPath)
+	var plan []pipeline.QuestionAllocation
+	scored, hasScores := scoredDimensions(previousDimensionScores(cfg, existing))
+	if hasScores {
+		scored = pipeline.ApplyWeights(scored, cfg.DimensionWeights)
+		plan = focusPlan(pipeline.PlanQuestions(scored, threshold), focus)
+	}
 
 	var sb strings.Builder
 	sb.WriteString("# Clarity Gate Analysis\n\n")
 	fmt.Fprintf(&sb, "**Mode:** %s | **Threshold:** %d/100\n\n", cfg.Mode, threshold)
+	if cfg.ScoringScheme == config.ScoringPenalized {
+		fmt.Fprintf(&sb, "**Scoring:** penalized — each dimension scoring under %d costs up to %d points per unit of weight, "+
+			"so one unaddressed critical dimension can hold the gate shut even if the rest score high.\n\n",
+			pipeline.PenaltyFloor, pipeline.PenaltyPerWeight)
+	}
 	sb.WriteString("## Requirements Under Analysis\n\n")
 	sb.WriteString(requirements)
 	sb.WriteString("\n\n---\n\n")
+	markers := clarify.ExtractMarkers(requirements)
+	if len(markers) > 0 {
+		sb.WriteString(formatFlaggedQuestions(markers))
+		sb.WriteString("---\n\n")
+	}
 	sb.WriteString("## Clarity Dimensions\n\n")
-	sb.WriteString("Analyze the requirements above across these 8 dimensions. ")
+	if len(focus) > 0 {
+		fmt.Fprintf(&sb, "This round focuses on %d of the %d dimensions; the others keep their previous scores. ",
+			len(dimensions), len(pipeline.DefaultDimensions()))
+	} else {
+		sb.WriteString("Analyze the requirements above across these 8 dimensions. ")
+	}
 	sb.WriteString("For each dimension with gaps, generate 1-2 specific, answerable questions.\n\n")
 
 	for _, d := range dimensions {
@@ -134,18 +225,33 @@ func (t *ClarifyTool) generateQuestions(
 		fmt.Fprintf(&sb, "%s\n\n", d.Description)
 	}
 
+	if len(plan) > 0 {
+		sb.WriteString("---\n\n")
+		sb.WriteString(formatQuestionPlan(plan, pipeline.ProjectScore(cfg, scored), threshold))
+	}
+
 	sb.WriteString("---\n\n")
 	sb.WriteString("## What To Do Next\n\n")
 	sb.WriteString("1. Analyze the requirements for gaps in each dimension\n")
-	sb.WriteString("2. Generate 3-5 total questions targeting the WEAKEST dimensions\n")
+	if len(plan) > 0 {
+		fmt.Fprintf(&sb, "2. Generate exactly %d questions, distributed as in the Question Plan above\n", questionPlanTotal(plan))
+	} else {
+		fmt.Fprintf(&sb, "2. Generate %d-%d total questions targeting the WEAKEST dimensions\n",
+			pipeline.MinRoundQuestions, pipeline.MaxRoundQuestions)
+	}
+	if len(markers) > 0 {
+		fmt.Fprintf(&sb, "   - Plus the %d Author-Flagged Questions above — ask every one of them\n", len(markers))
+	}
 	sb.WriteString("3. Present the questions to the user and collect their answers\n")
 	sb.WriteString("4. After receiving answers, call `sdd_clarify` again with:\n")
 	sb.WriteString("   - `answers`: the Q&A from this round (as markdown)\n")
-	sb.WriteString("   - `dimension_scores`: your assessment of each dimension (0-100)\n")
+	if len(focus) > 0 {
+		fmt.Fprintf(&sb, "   - `dimension_scores`: your assessment of %s (0-100)\n", strings.Join(focus, ", "))
+		fmt.Fprintf(&sb, "   - `focus`: %s\n", strings.Join(focus, ","))
+	} else {
+		sb.WriteString("   - `dimension_scores`: your assessment of each dimension (0-100)\n")
+	}
 
-	// Read existing clarifications to show history.
-	clarifyPath := config.StagePath(projectRoot, config.StageClarify)
-	existing, _ := readStageFile(clarifyPath)
 	if existing != "" {
 		sb.WriteString("\n---\n\n## Previous Clarification Rounds\n\n")
 		sb.WriteString(existing)
@@ -156,24 +262,53 @@ func (t *ClarifyTool) generateQuestions(
 		return nil, fmt.Errorf("saving config: %w", err)
 	}
 
-	return mcp.NewToolResultText(sb.String()), nil
+	return &StageResult{Config: cfg, Response: sb.String()}, nil
+}
+
+// formatFlaggedQuestions renders the requirements' [NEEDS CLARIFICATION]
+// markers as questions the round must ask.
+func formatFlaggedQuestions(markers []clarify.Marker) string {
+	var sb strings.Builder
+	sb.WriteString("## Author-Flagged Questions\n\n")
+	fmt.Fprintf(&sb, "The requirements contain %d `[NEEDS CLARIFICATION]` marker(s) — gaps the author already "+
+		"knows about. Ask each one this round, whatever the dimension scores say:\n\n", len(markers))
+	for i, m := range markers {
+		fmt.Fprintf(&sb, "%d. %s _(line %d)_\n", i+1, m.Question(), m.Line)
+	}
+	sb.WriteString("\n_Once answered, remove the markers from the requirements so they stop coming back._\n\n")
+	return sb.String()
 }
 
 // processAnswers records answers, updates clarity score, and checks the gate.
 func (t *ClarifyTool) processAnswers(
 	cfg *config.ProjectConfig,
-	requirements, answers, dimensionScores string,
+	requirements string,
+	params ClarifyParams,
 	projectRoot string,
 	threshold int,
-) (*mcp.CallToolResult, error) {
-	// Parse dimension scores if provided.
-	dimensions := pipeline.DefaultDimensions()
-	if dimensionScores != "" {
-		parseDimensionScores(dimensionScores, dimensions)
+) (*StageResult, error) {
+	// A focused round rescores only the focused dimensions; the rest
+	// carry over from the previous round.
+	dimensions := pipeline.ApplyWeights(pipeline.DefaultDimensions(), cfg.DimensionWeights)
+	if len(params.Focus) > 0 {
+		carryOverScores(cfg, projectRoot, dimensions)
+	}
+	focused := focusDimensions(dimensions, params.Focus)
+	parseDimensionScores(params.DimensionScores, focused)
+	for _, d := range focused {
+		for i := range dimensions {
+			if dimensions[i].Name == d.Name {
+				dimensions[i] = d
+			}
+		}
+	}
+	cfg.DimensionScores = make(map[string]int, len(dimensions))
+	for _, d := range dimensions {
+		cfg.DimensionScores[d.Name] = d.Score
 	}
 
 	// Calculate new clarity score.
-	newScore := pipeline.CalculateScore(dimensions)
+	newScore := pipeline.ProjectScore(cfg, dimensions)
 	cfg.ClarityScore = newScore
 
 	// Read existing clarifications and append this round.
@@ -181,9 +316,16 @@ func (t *ClarifyTool) processAnswers(
 	existing, _ := readStageFile(clarifyPath)
 
 	iteration := cfg.StageStatus[config.StageClarify].Iterations
+	// Each round records the threshold and mode in effect, so the history
+	// stays unambiguous if the mode or threshold changes later.
+	focusNote := ""
+	if len(params.Focus) > 0 {
+		focusNote = fmt.Sprintf("_Focus: %s_\n\n", strings.Join(params.Focus, ", "))
+	}
 	roundContent := fmt.Sprintf(
-		"\n### Round %d\n\n%s\n\n**Clarity Score after this round:** %d/100\n",
-		iteration, answers, newScore,
+		"\n### Round %d\n\n_Threshold for this round: %d/100 (%s mode)_\n\n%s%s\n\n#### Dimension Scores\n\n%s\n"+
+			"**Clarity Score after this round:** %d/100\n",
+		iteration, threshold, cfg.Mode, focusNote, params.Answers, formatDimensionScores(dimensions), newScore,
 	)
 
 	updatedContent := existing + roundContent
@@ -199,6 +341,7 @@ func (t *ClarifyTool) processAnswers(
 
 	fullDoc, err := t.renderer.Render(templates.Clarifications, templates.ClarificationsData{
 		Name:         cfg.Name,
+		Attribution:  attributionFor(cfg),
 		ClarityScore: newScore,
 		Mode:         string(cfg.Mode),
 		Threshold:    threshold,
@@ -216,23 +359,21 @@ func (t *ClarifyTool) processAnswers(
 	// Check if we passed the gate.
 	var response string
 	if newScore >= threshold {
-		// Gate passed! Advance pipeline.
-		if err := pipeline.Advance(cfg); err != nil {
+		// Gate passed! Advance the pipeline, which records the pass, and
+		// add this round's evidence to the record.
+		if err := pipeline.AdvanceBy(cfg, params.Actor); err != nil {
 			return nil, fmt.Errorf("advancing pipeline: %w", err)
 		}
+		cfg.ClarityGatePassed.Dimensions = gateDimensionScores(dimensions)
 
 		notifyObserver(t.bridge, cfg.Name, config.StageClarify, fullDoc)
 
-		response = fmt.Sprintf(
-			"# Clarity Gate PASSED\n\n"+
-				"**Score:** %d/100 (threshold: %d)\n\n"+
-				"Your requirements are now clear enough to proceed.\n\n"+
-				"## Next Step\n\n"+
-				"Pipeline advanced to **Stage 4: Design**.\n\n"+
-				"The AI can now create a technical design based on these well-defined requirements. "+
-				"Use `sdd_get_context` to review all artifacts before proceeding.",
-			newScore, threshold,
-		)
+		marginal := ""
+		if pipeline.MarginalPass(cfg, newScore, threshold) {
+			marginal = msg(cfg, "clarify.marginal", newScore, threshold)
+		}
+		response = msg(cfg, "clarify.passed",
+			newScore, threshold, marginal, config.Stages[cfg.CurrentStage].Name, nextStepGuidance(cfg))
 	} else {
 		// Need more clarification.
 		uncovered := pipeline.UncoveredDimensions(dimensions)
@@ -241,52 +382,288 @@ func (t *ClarifyTool) processAnswers(
 			uncoveredNames = append(uncoveredNames, d.Name)
 		}
 
-		response = fmt.Sprintf(
-			"# Clarity Gate: More Clarification Needed\n\n"+
-				"**Score:** %d/100 (need %d to pass)\n\n"+
-				"## Weak Areas\n\n"+
-				"These dimensions still need attention: %s\n\n"+
-				"## What to Do\n\n"+
-				"Call `sdd_clarify` again (without answers) to get the next round of questions "+
-				"targeting these weak areas.",
-			newScore, threshold, strings.Join(uncoveredNames, ", "),
-		)
+		response = msg(cfg, "clarify.needed", newScore, threshold, strings.Join(uncoveredNames, ", "))
 	}
 
 	if err := t.store.Save(projectRoot, cfg); err != nil {
+		if errors.Is(err, config.ErrConflict) {
+			// Undo this round's write so a retry doesn't record it twice,
+			// unless another round has already replaced it.
+			if current, _ := readStageFile(clarifyPath); current == fullDoc {
+				_ = writeStageFile(clarifyPath, existing)
+			}
+		}
 		return nil, fmt.Errorf("saving config: %w", err)
 	}
 
-	return mcp.NewToolResultText(response), nil
+	return &StageResult{Content: fullDoc, Config: cfg, Response: response}, nil
+}
+
+// splitFocus splits a comma-separated focus list, dropping blanks.
+func splitFocus(input string) []string {
+	var focus []string
+	for _, name := range strings.Split(input, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			focus = append(focus, name)
+		}
+	}
+	return focus
+}
+
+// validateFocus checks that every focus name is a clarity dimension.
+func validateFocus(focus []string) error {
+	known := make(map[string]bool)
+	var names []string
+	for _, d := range pipeline.DefaultDimensions() {
+		known[d.Name] = true
+		names = append(names, d.Name)
+	}
+	for _, name := range focus {
+		if !known[name] {
+			return newUserError(fmt.Sprintf("'focus': unknown dimension %q — valid dimensions: %s",
+				name, strings.Join(names, ", ")))
+		}
+	}
+	return nil
+}
+
+// focusDimensions returns copies of the dimensions named in focus, in
+// their usual order, or all of them when focus is empty.
+func focusDimensions(dimensions []pipeline.ClarityDimension, focus []string) []pipeline.ClarityDimension {
+	if len(focus) == 0 {
+		return slices.Clone(dimensions)
+	}
+	var out []pipeline.ClarityDimension
+	for _, d := range dimensions {
+		if slices.Contains(focus, d.Name) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// focusPlan drops question allocations outside focus. An empty focus
+// keeps the whole plan.
+func focusPlan(plan []pipeline.QuestionAllocation, focus []string) []pipeline.QuestionAllocation {
+	if len(focus) == 0 {
+		return plan
+	}
+	var out []pipeline.QuestionAllocation
+	for _, a := range plan {
+		if slices.Contains(focus, a.Dimension) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// carryOverScores fills dimensions with the previous round's scores.
+func carryOverScores(cfg *config.ProjectConfig, projectRoot string, dimensions []pipeline.ClarityDimension) {
+	existing, _ := readStageFile(config.StagePath(projectRoot, config.StageClarify))
+	previous := previousDimensionScores(cfg, existing)
+	for i := range dimensions {
+		if score, ok := previous[dimensions[i].Name]; ok {
+			dimensions[i].Score = score
+			dimensions[i].Covered = score > 30
+			dimensions[i].Justification = "carried over from the previous round"
+		}
+	}
 }
 
 // parseDimensionScores parses "name:score,name:score" format into dimensions.
-func parseDimensionScores(input string, dimensions []pipeline.ClarityDimension) {
-	pairs := strings.Split(input, ",")
-	scoreMap := make(map[string]int)
+// Each pair may carry an evidence citation after a '|' delimiter, e.g.
+// "security:70|JWT+bcrypt specified in FR-009". A comma-separated segment
+// that is not itself a name:score pair continues the previous pair's
+// justification, so citations may contain commas.
+// Returns how many dimensions received a score.
+func parseDimensionScores(input string, dimensions []pipeline.ClarityDimension) int {
+	type parsed struct {
+		score         int
+		justification string
+	}
+	scoreMap := make(map[string]*parsed)
+	var last *parsed
+
+	for _, pair := range strings.Split(input, ",") {
+		name, score, justification, ok := parseDimensionPair(pair)
+		if !ok {
+			if last != nil && last.justification != "" {
+				last.justification += ", " + strings.TrimSpace(pair)
+			}
+			continue
+		}
+		last = &parsed{score: score, justification: justification}
+		scoreMap[name] = last
+	}
 
-	for _, pair := range pairs {
-		parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
-		if len(parts) != 2 {
+	matched := 0
+	for i := range dimensions {
+		if p, ok := scoreMap[dimensions[i].Name]; ok {
+			dimensions[i].Score = p.score
+			dimensions[i].Covered = p.score > 30 // Consider "covered" if score > 30
+			dimensions[i].Justification = p.justification
+			matched++
+		}
+	}
+	return matched
+}
+
+// dimensionNamePattern matches snake_case clarity dimension names.
+var dimensionNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// parseDimensionPair parses one "name:score" or "name:score|justification"
+// segment, clamping the score to 0-100.
+func parseDimensionPair(pair string) (name string, score int, justification string, ok bool) {
+	parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+	if len(parts) != 2 {
+		return "", 0, "", false
+	}
+	name = strings.TrimSpace(parts[0])
+	if !dimensionNamePattern.MatchString(name) {
+		return "", 0, "", false
+	}
+	value, justification, _ := strings.Cut(parts[1], "|")
+	if _, err := fmt.Sscanf(value, "%d", &score); err != nil {
+		return "", 0, "", false
+	}
+	if score < 0 {
+		score = 0
+	}
+	if score > 100 {
+		score = 100
+	}
+	return name, score, strings.TrimSpace(justification), true
+}
+
+// formatDimensionScores renders the scored dimensions of a clarify round
+// as a markdown table, with the cited evidence when any was given.
+func formatDimensionScores(dimensions []pipeline.ClarityDimension) string {
+	var sb strings.Builder
+	sb.WriteString("| Dimension | Score | Evidence |\n")
+	sb.WriteString("|-----------|-------|----------|\n")
+	for _, d := range dimensions {
+		evidence := d.Justification
+		if evidence == "" {
+			evidence = "—"
+		}
+		fmt.Fprintf(&sb, "| %s | %d | %s |\n", d.Name, d.Score, strings.ReplaceAll(evidence, "|", "\\|"))
+	}
+	return sb.String()
+}
+
+// gateDimensionScores converts a round's scored dimensions into the
+// ClarityGatePass audit record's form.
+func gateDimensionScores(dimensions []pipeline.ClarityDimension) []config.DimensionScore {
+	scores := make([]config.DimensionScore, len(dimensions))
+	for i, d := range dimensions {
+		scores[i] = config.DimensionScore{Name: d.Name, Score: d.Score, Evidence: d.Justification}
+	}
+	return scores
+}
+
+// dimensionScoresHeading opens the score table of each recorded round.
+const dimensionScoresHeading = "#### Dimension Scores"
+
+// previousDimensionScores returns the previous round's scores by
+// dimension name: those persisted in hoofy.json, or for projects scored
+// before they were persisted, the latest score table in clarifications.md.
+func previousDimensionScores(cfg *config.ProjectConfig, clarifications string) map[string]int {
+	if len(cfg.DimensionScores) > 0 {
+		return cfg.DimensionScores
+	}
+	return latestDimensionScores(clarifications)
+}
+
+// latestDimensionScores reads the score table of the most recent round
+// in clarifications.md. Returns nil when no round has recorded scores yet.
+func latestDimensionScores(clarifications string) map[string]int {
+	idx := strings.LastIndex(clarifications, dimensionScoresHeading)
+	if idx < 0 {
+		return nil
+	}
+	scores := make(map[string]int)
+	for _, line := range strings.Split(clarifications[idx+len(dimensionScoresHeading):], "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if len(scores) > 0 {
+				break
+			}
+			continue
+		}
+		if !strings.HasPrefix(line, "|") {
+			break
+		}
+		cells := strings.Split(strings.Trim(line, "|"), "|")
+		if len(cells) < 2 {
 			continue
 		}
-		name := strings.TrimSpace(parts[0])
 		var score int
-		if _, err := fmt.Sscanf(parts[1], "%d", &score); err == nil {
-			if score < 0 {
-				score = 0
-			}
-			if score > 100 {
-				score = 100
-			}
-			scoreMap[name] = score
+		name := strings.TrimSpace(cells[0])
+		if _, err := fmt.Sscanf(strings.TrimSpace(cells[1]), "%d", &score); err != nil || !dimensionNamePattern.MatchString(name) {
+			continue
 		}
+		scores[name] = score
 	}
+	return scores
+}
 
+// scoredDimensions fills the default dimensions with scores. Returns
+// false when none of them has a score.
+func scoredDimensions(scores map[string]int) ([]pipeline.ClarityDimension, bool) {
+	dimensions := pipeline.DefaultDimensions()
+	matched := 0
 	for i := range dimensions {
-		if score, ok := scoreMap[dimensions[i].Name]; ok {
+		if score, ok := scores[dimensions[i].Name]; ok {
 			dimensions[i].Score = score
-			dimensions[i].Covered = score > 30 // Consider "covered" if score > 30
+			dimensions[i].Covered = score > 30
+			matched++
+		}
+	}
+	return dimensions, matched > 0
+}
+
+// formatQuestionPlan renders the per-dimension question counts for the
+// next round.
+func formatQuestionPlan(plan []pipeline.QuestionAllocation, score, threshold int) string {
+	var sb strings.Builder
+	sb.WriteString("## Question Plan\n\n")
+	fmt.Fprintf(&sb, "Based on the last round's scores (overall %d/100, threshold %d), "+
+		"ask **%d questions** this round:\n\n", score, threshold, questionPlanTotal(plan))
+	for _, a := range plan {
+		noun := "questions"
+		if a.Questions == 1 {
+			noun = "question"
 		}
+		fmt.Fprintf(&sb, "- Ask %d %s about **%s** (score %d, weight %d/10)\n",
+			a.Questions, noun, a.Dimension, a.Score, a.Weight)
 	}
+	sb.WriteString("\nDimensions not listed are clear enough for now — don't spend questions on them.\n\n")
+	return sb.String()
+}
+
+// questionPlanTotal returns the number of questions a plan asks for.
+func questionPlanTotal(plan []pipeline.QuestionAllocation) int {
+	total := 0
+	for _, a := range plan {
+		total += a.Questions
+	}
+	return total
+}
+
+// missingScoresError explains that answers need dimension scores, listing
+// the expected dimension names so the AI can retry in one step.
+func missingScoresError(dimensionScores string) string {
+	var names []string
+	for _, d := range pipeline.DefaultDimensions() {
+		names = append(names, d.Name)
+	}
+	problem := "'dimension_scores' is empty"
+	if strings.TrimSpace(dimensionScores) != "" {
+		problem = "'dimension_scores' has no recognizable dimension:score pairs"
+	}
+	return fmt.Sprintf(
+		"%s — answers were provided, so score each clarity dimension (0-100) "+
+			"instead of letting the gate score 0. Expected format: 'name:score,name:score' for: %s",
+		problem, strings.Join(names, ", "),
+	)
 }
@@ -0,0 +1,265 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/textdiff"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CompareProjectsTool handles the sdd_compare_projects MCP tool.
+// It compares two Hoofy projects — typically a spec and its fork for a
+// sibling service — reporting stage progress, clarity scores, and a
+// unified diff per artifact.
+//
+// Design: read-only. Neither project's hoofy.json is modified.
+type CompareProjectsTool struct {
+	store config.Store
+}
+
+// NewCompareProjectsTool creates a CompareProjectsTool with its dependencies.
+func NewCompareProjectsTool(store config.Store) *CompareProjectsTool {
+	return &CompareProjectsTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *CompareProjectsTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_compare_projects",
+		mcp.WithDescription(
+			"Compare the specs of two Hoofy projects, e.g. a spec and its fork for a sibling service. "+
+				"Reports stage progress and clarity scores side by side, then a unified diff for "+
+				"each artifact (artifacts present in only one project are flagged). "+
+				"Read-only — works at any stage and never modifies either project.",
+		),
+		mcp.WithString("root_a",
+			mcp.Required(),
+			mcp.Description("Project root of the first project (absolute, or relative to the working directory)."),
+		),
+		mcp.WithString("root_b",
+			mcp.Required(),
+			mcp.Description("Project root of the second project (absolute, or relative to the working directory)."),
+		),
+		mcp.WithString("stage",
+			mcp.Description("Only diff this artifact: a stage name (e.g. 'specify', 'design') "+
+				"or a side artifact ('acceptance', 'summary'). Omit to diff all artifacts."),
+		),
+		mcp.WithNumber("context_lines",
+			mcp.Description("Unchanged lines shown around each change in the diffs. Defaults to 3."),
+		),
+		mcp.WithNumber("max_tokens",
+			mcp.Description("Token budget cap. When set, truncates the response to stay within budget. 0 or omit for no cap."),
+		),
+	)
+}
+
+// comparedArtifact is one artifact file compared across both projects.
+type comparedArtifact struct {
+	Name      string
+	Filename  string // in project A
+	FilenameB string // in project B, which may rename stage files differently
+	PathA     string
+	PathB     string
+}
+
+// heading titles the artifact's section of the comparison, naming both
+// files when the projects call it differently.
+func (a comparedArtifact) heading() string {
+	if a.FilenameB == a.Filename {
+		return fmt.Sprintf("%s (`%s`)", a.Name, a.Filename)
+	}
+	return fmt.Sprintf("%s (`%s` in A, `%s` in B)", a.Name, a.Filename, a.FilenameB)
+}
+
+// Handle processes the sdd_compare_projects tool call.
+func (t *CompareProjectsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := compareProjectsParams{
+		RootA:        strings.TrimSpace(req.GetString("root_a", "")),
+		RootB:        strings.TrimSpace(req.GetString("root_b", "")),
+		Stage:        strings.TrimSpace(req.GetString("stage", "")),
+		ContextLines: intArgTools(req, "context_lines", textdiff.DefaultContext),
+	}
+	result, err := toolResult(t.process(params))
+	return applyBudgetAndFooter(result, intArgTools(req, "max_tokens", 0)), err
+}
+
+// compareProjectsParams are the parsed sdd_compare_projects arguments.
+type compareProjectsParams struct {
+	RootA        string
+	RootB        string
+	Stage        string
+	ContextLines int
+}
+
+// process diffs the two projects' state and artifacts.
+func (t *CompareProjectsTool) process(params compareProjectsParams) (string, error) {
+	rootA := params.RootA
+	rootB := params.RootB
+	stageFilter := params.Stage
+	contextLines := params.ContextLines
+
+	if rootA == "" || rootB == "" {
+		return "", toolError(CodeMissingField, "'root_a' and 'root_b' are both required")
+	}
+	if contextLines < 0 {
+		return "", newUserError("'context_lines' must not be negative")
+	}
+
+	var err error
+	if rootA, err = filepath.Abs(rootA); err != nil {
+		return "", fmt.Errorf("resolving root_a: %w", err)
+	}
+	if rootB, err = filepath.Abs(rootB); err != nil {
+		return "", fmt.Errorf("resolving root_b: %w", err)
+	}
+	if rootA == rootB {
+		return "", newUserError("'root_a' and 'root_b' point to the same project")
+	}
+
+	cfgA, err := t.store.Load(rootA)
+	if err != nil {
+		return "", asUserError(fmt.Errorf("project A (%s): %w", rootA, err))
+	}
+	cfgB, err := t.store.Load(rootB)
+	if err != nil {
+		return "", asUserError(fmt.Errorf("project B (%s): %w", rootB, err))
+	}
+
+	order := unionStageOrder(cfgA, cfgB)
+	artifacts, msg := comparedArtifacts(order, rootA, rootB, stageFilter)
+	if msg != "" {
+		return "", newUserError(msg)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Project Comparison\n\n")
+	sb.WriteString("| | A | B |\n|---|---|---|\n")
+	fmt.Fprintf(&sb, "| **Root** | `%s` | `%s` |\n", rootA, rootB)
+	fmt.Fprintf(&sb, "| **Name** | %s | %s |\n", cfgA.Name, cfgB.Name)
+	fmt.Fprintf(&sb, "| **Mode** | %s | %s |\n", cfgA.Mode, cfgB.Mode)
+	fmt.Fprintf(&sb, "| **Current Stage** | %s | %s |\n", cfgA.CurrentStage, cfgB.CurrentStage)
+	fmt.Fprintf(&sb, "| **Clarity Score** | %d/%d | %d/%d |\n",
+		cfgA.ClarityScore, clarityThresholdFor(cfgA), cfgB.ClarityScore, clarityThresholdFor(cfgB))
+	fmt.Fprintf(&sb, "| **Progress** | %s | %s |\n", stageProgress(cfgA), stageProgress(cfgB))
+
+	sb.WriteString("\n## Stage Progress\n\n")
+	sb.WriteString("| Stage | A | B |\n|-------|---|---|\n")
+	for _, stage := range order {
+		fmt.Fprintf(&sb, "| %s | %s | %s |\n",
+			config.Stages[stage].Name, compareStageStatus(cfgA, stage), compareStageStatus(cfgB, stage))
+	}
+
+	sb.WriteString("\n## Artifacts\n")
+	for _, a := range artifacts {
+		contentA, err := readStageFile(a.PathA)
+		if err != nil {
+			return "", fmt.Errorf("reading %s in project A: %w", a.Filename, err)
+		}
+		contentB, err := readStageFile(a.PathB)
+		if err != nil {
+			return "", fmt.Errorf("reading %s in project B: %w", a.FilenameB, err)
+		}
+
+		switch {
+		case contentA == "" && contentB == "":
+			if stageFilter != "" {
+				fmt.Fprintf(&sb, "\n### %s\n\n_Missing in both projects._\n", a.heading())
+			}
+			continue
+		case contentB == "":
+			fmt.Fprintf(&sb, "\n### %s\n\n⚠️ Only in A (%d lines).\n", a.heading(), strings.Count(contentA, "\n"))
+			continue
+		case contentA == "":
+			fmt.Fprintf(&sb, "\n### %s\n\n⚠️ Only in B (%d lines).\n", a.heading(), strings.Count(contentB, "\n"))
+			continue
+		}
+
+		diff := textdiff.Unified("a/"+a.Filename, "b/"+a.FilenameB, contentA, contentB, contextLines)
+		if diff == "" {
+			fmt.Fprintf(&sb, "\n### %s\n\n✅ Identical.\n", a.heading())
+			continue
+		}
+		added, removed := textdiff.Stats(contentA, contentB)
+		fmt.Fprintf(&sb, "\n### %s\n\n**+%d / −%d lines**\n\n```diff\n%s```\n",
+			a.heading(), added, removed, diff)
+	}
+
+	return sb.String(), nil
+}
+
+// unionStageOrder returns the pipeline order covering every stage
+// enabled in either project.
+func unionStageOrder(a, b *config.ProjectConfig) []config.Stage {
+	union := &config.ProjectConfig{}
+	seen := make(map[config.Stage]bool)
+	for _, stage := range append(append([]config.Stage{}, a.OptionalStages...), b.OptionalStages...) {
+		if !seen[stage] {
+			seen[stage] = true
+			union.OptionalStages = append(union.OptionalStages, stage)
+		}
+	}
+	return config.StageOrderFor(union)
+}
+
+// comparedArtifacts lists the artifact files to compare, optionally
+// narrowed to a single stage or side artifact. Returns an error message
+// for an unknown filter.
+func comparedArtifacts(order []config.Stage, rootA, rootB, filter string) ([]comparedArtifact, string) {
+	var all []comparedArtifact
+	for _, stage := range order {
+		if !config.HasArtifact(stage) || (filter != "" && filter != string(stage)) {
+			continue
+		}
+		all = append(all, comparedArtifact{
+			Name:      config.Stages[stage].Name,
+			Filename:  config.StageFilenameIn(rootA, stage),
+			FilenameB: config.StageFilenameIn(rootB, stage),
+			PathA:     config.StagePath(rootA, stage),
+			PathB:     config.StagePath(rootB, stage),
+		})
+	}
+	for _, side := range config.SideArtifacts {
+		if filter != "" && filter != side.Key {
+			continue
+		}
+		all = append(all, comparedArtifact{
+			Name:      side.Name,
+			Filename:  side.Filename,
+			FilenameB: side.Filename,
+			PathA:     side.Path(rootA),
+			PathB:     side.Path(rootB),
+		})
+	}
+	if filter != "" && len(all) == 0 {
+		return nil, fmt.Sprintf("unknown stage or artifact %q", filter)
+	}
+	return all, ""
+}
+
+// compareStageStatus renders a stage's status, or "—" when the stage
+// is not part of the project's pipeline.
+func compareStageStatus(cfg *config.ProjectConfig, stage config.Stage) string {
+	if !cfg.HasStage(stage) {
+		return "—"
+	}
+	status := cfg.StageStatus[stage].Status
+	if status == "" {
+		status = "pending"
+	}
+	return statusIndicator(status) + " " + status
+}
+
+// stageProgress renders "completed/total" for a project's pipeline.
+func stageProgress(cfg *config.ProjectConfig) string {
+	order := config.StageOrderFor(cfg)
+	completed := 0
+	for _, stage := range order {
+		if cfg.StageStatus[stage].Status == "completed" {
+			completed++
+		}
+	}
+	return fmt.Sprintf("%d/%d stages", completed, len(order))
+}
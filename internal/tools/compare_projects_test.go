@@ -0,0 +1,152 @@
+package tools
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// writeCompareProject creates a project under dir at the given stage
+// with the given artifacts.
+func writeCompareProject(t *testing.T, dir, name string, score int, artifacts map[config.Stage]string) {
+	t.Helper()
+	cfg := config.NewProjectConfig(name, "compare test", config.ModeExpert)
+	cfg.ClarityScore = score
+	if err := config.NewFileStore().Save(dir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	for stage, content := range artifacts {
+		if err := writeStageFile(config.StagePath(dir, stage), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func compareRequest(args map[string]interface{}) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	return req
+}
+
+func TestCompareProjectsTool_Handle(t *testing.T) {
+	base := t.TempDir()
+	rootA, rootB := filepath.Join(base, "billing"), filepath.Join(base, "invoicing")
+
+	writeCompareProject(t, rootA, "billing", 72, map[config.Stage]string{
+		config.StageCharter: "# Charter\n\nSame vision.\n",
+		config.StageSpecify: "# Requirements\n\n- FR-001: Charge cards\n- FR-002: Refunds\n",
+		config.StageDesign:  "# Design\n\nGo service.\n",
+	})
+	writeCompareProject(t, rootB, "invoicing", 85, map[config.Stage]string{
+		config.StageCharter: "# Charter\n\nSame vision.\n",
+		config.StageSpecify: "# Requirements\n\n- FR-001: Charge cards\n- FR-002: Send invoices\n",
+	})
+
+	tool := NewCompareProjectsTool(config.NewFileStore())
+	result, err := tool.Handle(context.Background(), compareRequest(map[string]interface{}{
+		"root_a": rootA,
+		"root_b": rootB,
+	}))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	text := getResultText(result)
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", text)
+	}
+
+	for _, want := range []string{
+		"| **Name** | billing | invoicing |",
+		"| **Clarity Score** | 72/",
+		"### Charter (`charter.md`)\n\n✅ Identical.",
+		"-- a/requirements.md\n+++ b/requirements.md",
+		"-- FR-002: Refunds\n+- FR-002: Send invoices",
+		"**+1 / −1 lines**",
+		"### Design (`design.md`)\n\n⚠️ Only in A",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("comparison missing %q\ngot:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "tasks.md") {
+		t.Error("artifacts missing in both projects should be omitted")
+	}
+
+	// Narrowed to a single artifact.
+	result, _ = tool.Handle(context.Background(), compareRequest(map[string]interface{}{
+		"root_a": rootA,
+		"root_b": rootB,
+		"stage":  "design",
+	}))
+	text = getResultText(result)
+	if strings.Contains(text, "requirements.md") || !strings.Contains(text, "design.md") {
+		t.Errorf("stage filter should limit the diff to design.md, got:\n%s", text)
+	}
+}
+
+func TestCompareProjectsTool_Handle_RenamedStageFiles(t *testing.T) {
+	base := t.TempDir()
+	rootA, rootB := filepath.Join(base, "billing"), filepath.Join(base, "invoicing")
+	writeCompareProject(t, rootA, "billing", 72, map[config.Stage]string{
+		config.StageSpecify: "# Requirements\n\n- FR-001: Charge cards\n",
+	})
+	writeCompareProject(t, rootB, "invoicing", 85, nil)
+	store := config.NewFileStore()
+	cfg, _ := store.Load(rootB)
+	cfg.StageFiles = map[config.Stage]string{config.StageSpecify: "spec.md"}
+	if err := store.Save(rootB, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeStageFile(config.StagePath(rootB, config.StageSpecify), "# Requirements\n\n- FR-001: Send invoices\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewCompareProjectsTool(store).Handle(context.Background(), compareRequest(map[string]interface{}{
+		"root_a": rootA,
+		"root_b": rootB,
+		"stage":  "specify",
+	}))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"### Specify (`requirements.md` in A, `spec.md` in B)",
+		"-- a/requirements.md\n+++ b/spec.md",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("comparison missing %q\ngot:\n%s", want, text)
+		}
+	}
+}
+
+func TestCompareProjectsTool_Handle_Errors(t *testing.T) {
+	base := t.TempDir()
+	rootA := filepath.Join(base, "a")
+	writeCompareProject(t, rootA, "a", 0, nil)
+
+	tool := NewCompareProjectsTool(config.NewFileStore())
+	tests := map[string]struct {
+		args map[string]interface{}
+		want string
+	}{
+		"missing root":    {map[string]interface{}{"root_a": rootA}, "both required"},
+		"same project":    {map[string]interface{}{"root_a": rootA, "root_b": rootA + "/"}, "same project"},
+		"no project at b": {map[string]interface{}{"root_a": rootA, "root_b": filepath.Join(base, "nope")}, "project B"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := tool.Handle(context.Background(), compareRequest(tt.args))
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if !isErrorResult(result) || !strings.Contains(getResultText(result), tt.want) {
+				t.Errorf("expected error containing %q, got: %s", tt.want, getResultText(result))
+			}
+		})
+	}
+}
@@ -2,11 +2,19 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/i18n"
 	"github.com/HendryAvila/Hoofy/internal/memory"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/plaintext"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
@@ -14,6 +22,7 @@ import (
 // It provides a read-only view of the current SDD project state.
 type ContextTool struct {
 	store config.Store
+	ascii bool // default for the 'ascii' parameter
 }
 
 // SDDContextTool handles the unified sdd_context MCP tool.
@@ -43,9 +52,27 @@ func (t *SDDContextTool) Definition() mcp.Tool {
 		mcp.WithString("detail_level",
 			mcp.Description("Optional detail level: summary, standard, full"),
 		),
+		mcp.WithString("format",
+			mcp.Description("For mode=get: output format, markdown (default) or json"),
+		),
+		mcp.WithBoolean("blockers",
+			mcp.Description("For mode=get: report only what blocks the Clarity Gate (score, threshold, failing dimensions)"),
+		),
 		mcp.WithNumber("max_tokens",
 			mcp.Description("Optional token budget cap"),
 		),
+		mcp.WithBoolean("ascii",
+			mcp.Description("For mode=get: use ASCII status markers ([x], [~], [>], [ ]) instead of emoji"),
+		),
+		mcp.WithNumber("from_line",
+			mcp.Description("For mode=get with stage: first line to return (1-based)"),
+		),
+		mcp.WithNumber("to_line",
+			mcp.Description("For mode=get with stage: last line to return (inclusive)"),
+		),
+		mcp.WithString("against",
+			mcp.Description("For mode=get with stage: git revision to diff the artifact against"),
+		),
 		mcp.WithString("change_description",
 			mcp.Description("For mode=check: description of the change to scan against context"),
 		),
@@ -70,16 +97,16 @@ func (t *SDDContextTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*
 		return t.getTool.Handle(ctx, req)
 	case "check":
 		if strings.TrimSpace(req.GetString("change_description", "")) == "" {
-			return mcp.NewToolResultError("'change_description' is required for mode=check"), nil
+			return toolResult("", toolError(CodeMissingField, "'change_description' is required for mode=check"))
 		}
 		return t.checkTool.Handle(ctx, req)
 	case "suggest":
 		if strings.TrimSpace(req.GetString("task_description", "")) == "" {
-			return mcp.NewToolResultError("'task_description' is required for mode=suggest"), nil
+			return toolResult("", toolError(CodeMissingField, "'task_description' is required for mode=suggest"))
 		}
 		return t.suggestTool.Handle(ctx, req)
 	default:
-		return mcp.NewToolResultError("'mode' must be one of: get, check, suggest"), nil
+		return toolResult("", newUserError("'mode' must be one of: get, check, suggest"))
 	}
 }
 
@@ -88,6 +115,10 @@ func NewContextTool(store config.Store) *ContextTool {
 	return &ContextTool{store: store}
 }
 
+// SetASCII sets whether overviews use ASCII status markers instead of
+// emoji when the caller doesn't pass 'ascii' (e.g. under NO_COLOR).
+func (t *ContextTool) SetASCII(ascii bool) { t.ascii = ascii }
+
 // Definition returns the MCP tool definition for registration.
 func (t *ContextTool) Definition() mcp.Tool {
 	return mcp.NewTool("sdd_get_context",
@@ -100,7 +131,8 @@ func (t *ContextTool) Definition() mcp.Tool {
 		mcp.WithString("stage",
 			mcp.Description(
 				"Specific stage artifact to read: 'principles', 'charter', 'requirements', 'clarifications', "+
-					"'design', 'tasks'. Leave empty to get an overview of all stages.",
+					"'design', 'tasks'. Side artifacts 'acceptance' and 'summary' can also be read. "+
+					"Leave empty to get an overview of all stages.",
 			),
 		),
 		mcp.WithString("detail_level",
@@ -113,55 +145,192 @@ func (t *ContextTool) Definition() mcp.Tool {
 			),
 			mcp.Enum("summary", "standard", "full"),
 		),
+		mcp.WithString("format",
+			mcp.Description(
+				"Output format: 'markdown' (default), 'json' or 'plain'. "+
+					"JSON returns the project config plus computed fields (progress percent, "+
+					"per-stage durations, artifact sizes) for structured clients; it applies to the overview only "+
+					"and ignores detail_level and max_tokens. "+
+					"'plain' strips markdown (headings become upper-case lines, bullets dashes, emphasis removed) "+
+					"for clients that show text raw, such as terminals; it applies to overviews and artifacts alike.",
+			),
+			mcp.Enum("markdown", "json", "plain"),
+		),
+		mcp.WithBoolean("blockers",
+			mcp.Description(
+				"Return only what blocks the Clarity Gate: the clarity score, the threshold, and each "+
+					"dimension scoring below the threshold with the points it needs. Use when stuck at clarify. "+
+					"Can't be combined with 'stage'.",
+			),
+		),
 		mcp.WithNumber("max_tokens",
 			mcp.Description("Token budget cap. When set, truncates the response to stay within budget. 0 or omit for no cap."),
 		),
+		mcp.WithBoolean("ascii",
+			mcp.Description(
+				"Use ASCII status markers ([x] completed, [~] in progress, [>] skipped, [ ] pending) "+
+					"instead of emoji, for terminals and logs that can't render them.",
+			),
+		),
+		mcp.WithNumber("from_line",
+			mcp.Description(
+				"With 'stage': first line of the artifact to return (1-based). Use with to_line to page "+
+					"through artifacts too large for the context budget. Values past the end are clamped.",
+			),
+		),
+		mcp.WithNumber("to_line",
+			mcp.Description(
+				"With 'stage': last line to return (inclusive). Omit to read to the end. "+
+					"Must not be smaller than from_line.",
+			),
+		),
+		mcp.WithString("version",
+			mcp.Description(
+				"With 'stage': read the artifact as it was in an earlier iteration of the project "+
+					"(e.g. '0.1.0'), archived by sdd_new_iteration, or in a snapshot tagged by sdd_tag_version "+
+					"(e.g. 'v1.0-approved'). Omit for the current iteration.",
+			),
+		),
+		mcp.WithString("against",
+			mcp.Description(
+				"With 'stage': a git revision (e.g. 'HEAD~1', 'main', a commit hash) to diff the artifact "+
+					"against. Returns a unified diff of what changed since that revision instead of the content.",
+			),
+		),
 	)
 }
 
 // Handle processes the sdd_get_context tool call.
 func (t *ContextTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	stageFilter := req.GetString("stage", "")
-	detailLevel := req.GetString("detail_level", "summary")
-	maxTokens := intArgTools(req, "max_tokens", 0)
+	params := contextParams{
+		Stage:       req.GetString("stage", ""),
+		DetailLevel: req.GetString("detail_level", "summary"),
+		Format:      req.GetString("format", "markdown"),
+		Lines:       lineRange{From: intArgTools(req, "from_line", 0), To: intArgTools(req, "to_line", 0)},
+		ASCII:       req.GetBool("ascii", t.ascii),
+		Against:     strings.TrimSpace(req.GetString("against", "")),
+		Version:     strings.TrimSpace(req.GetString("version", "")),
+		Blockers:    req.GetBool("blockers", false),
+	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	result, err := toolResult(t.process(ctx, projectRoot, params))
+	// JSON is a structured snapshot — no budget truncation or token
+	// footer, either of which would corrupt the payload.
+	if params.Format == "json" && params.Stage == "" && !params.Blockers {
+		return result, err
+	}
+	footer := memory.TokenFooter
+	if params.ASCII {
+		footer = asciiTokenFooter
+	}
+	return applyBudget(result, intArgTools(req, "max_tokens", 0), footer), err
+}
+
+// contextParams are the parsed sdd_get_context arguments.
+type contextParams struct {
+	Stage       string
+	DetailLevel string
+	Format      string
+	Lines       lineRange
+	ASCII       bool
+	Against     string // git revision to diff the stage artifact against
+	Version     string // earlier iteration to read the stage artifact from
+	Blockers    bool   // report only what blocks the Clarity Gate
+}
+
+// process renders the requested stage artifact or project overview,
+// as plain text when format=plain.
+func (t *ContextTool) process(ctx context.Context, projectRoot string, params contextParams) (string, error) {
+	if params.Format != "markdown" && params.Format != "json" && params.Format != "plain" {
+		return "", newUserError("'format' must be 'markdown', 'json' or 'plain'")
+	}
+	out, err := t.render(ctx, projectRoot, params)
+	if err != nil || params.Format != "plain" {
+		return out, err
+	}
+	return plaintext.FromMarkdown(out), nil
+}
+
+// render renders the requested stage artifact or project overview as
+// markdown, or the overview as JSON.
+func (t *ContextTool) render(ctx context.Context, projectRoot string, params contextParams) (string, error) {
+	stageFilter := params.Stage
+	lines := params.Lines
+	if lines.set() {
+		if stageFilter == "" {
+			return "", newUserError("'from_line' and 'to_line' require 'stage'")
+		}
+		if lines.To > 0 && lines.From > lines.To {
+			return "", newUserError(fmt.Sprintf(
+				"'from_line' (%d) must not be greater than 'to_line' (%d)", lines.From, lines.To))
+		}
+	}
+
+	if params.Against != "" {
+		if stageFilter == "" {
+			return "", newUserError("'against' requires 'stage'")
+		}
+		if lines.set() {
+			return "", newUserError("'against' can't be combined with 'from_line' or 'to_line'")
+		}
+	}
+
+	if params.Version != "" {
+		if stageFilter == "" {
+			return "", newUserError("'version' requires 'stage'")
+		}
+		if params.Against != "" {
+			return "", newUserError("'version' can't be combined with 'against'")
+		}
+	}
+
+	if params.Blockers && stageFilter != "" {
+		return "", newUserError("'blockers' can't be combined with 'stage'")
+	}
 
 	cfg, err := t.store.Load(projectRoot)
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return "", asUserError(err)
+	}
+
+	if params.Blockers {
+		return buildGateBlockers(cfg), nil
+	}
+
+	if tag, ok := cfg.FindTag(params.Version); ok {
+		return readSnapshotStage(projectRoot, versionTagPath(projectRoot, tag.Tag), "tag "+tag.Tag,
+			config.Stage(stageFilter), lines)
+	}
+	if params.Version != "" && strings.TrimPrefix(params.Version, "v") != strings.TrimPrefix(cfg.Version, "v") {
+		return readArchivedStage(projectRoot, params.Version, config.Stage(stageFilter), lines)
+	}
+
+	if params.Against != "" {
+		return diffStageAgainst(ctx, projectRoot, config.Stage(stageFilter), params.Against)
 	}
 
 	// If a specific stage was requested, return its content (detail_level ignored).
 	if stageFilter != "" {
-		result, stageErr := t.readStageContent(cfg, projectRoot, config.Stage(stageFilter))
-		if stageErr != nil {
-			return nil, stageErr
-		}
-		return applyBudgetAndFooter(result, maxTokens), nil
+		return t.readStageContent(cfg, projectRoot, config.Stage(stageFilter), lines)
+	}
+
+	if params.Format == "json" {
+		return t.buildJSONOverview(cfg, projectRoot)
 	}
 
 	// Route to the appropriate overview builder based on detail level.
-	var result *mcp.CallToolResult
-	switch detailLevel {
+	switch params.DetailLevel {
 	case "summary":
-		result = t.buildSummaryOverview(cfg)
+		return t.buildSummaryOverview(cfg, projectRoot, params.ASCII), nil
 	case "full":
-		result, err = t.buildFullOverview(cfg, projectRoot)
-		if err != nil {
-			return nil, err
-		}
+		return t.buildFullOverview(cfg, projectRoot, params.ASCII)
 	default:
-		result, err = t.buildOverview(cfg, projectRoot)
-		if err != nil {
-			return nil, err
-		}
+		return t.buildOverview(cfg, projectRoot, params.ASCII)
 	}
-
-	return applyBudgetAndFooter(result, maxTokens), nil
 }
 
 // intArgTools extracts an integer argument from a tool request (JSON numbers are float64).
@@ -176,6 +345,16 @@ func intArgTools(req mcp.CallToolRequest, key string, defaultVal int) int {
 // applyBudgetAndFooter applies post-hoc budget truncation and appends a token footer.
 // Error results are returned as-is (no budget or footer applied).
 func applyBudgetAndFooter(result *mcp.CallToolResult, maxTokens int) *mcp.CallToolResult {
+	return applyBudget(result, maxTokens, memory.TokenFooter)
+}
+
+// asciiTokenFooter is memory.TokenFooter without the emoji.
+func asciiTokenFooter(estimatedTokens int) string {
+	return strings.Replace(memory.TokenFooter(estimatedTokens), "📏 ", "", 1)
+}
+
+// applyBudget is applyBudgetAndFooter with a custom footer.
+func applyBudget(result *mcp.CallToolResult, maxTokens int, footer func(int) string) *mcp.CallToolResult {
 	if result == nil || result.IsError {
 		return result
 	}
@@ -196,43 +375,178 @@ func applyBudgetAndFooter(result *mcp.CallToolResult, maxTokens int) *mcp.CallTo
 		}
 	}
 
-	text += memory.TokenFooter(memory.EstimateTokens(text))
+	text += footer(memory.EstimateTokens(text))
 	return mcp.NewToolResultText(text)
 }
 
-// readStageContent returns the markdown content for a specific stage.
-func (t *ContextTool) readStageContent(cfg *config.ProjectConfig, projectRoot string, stage config.Stage) (*mcp.CallToolResult, error) {
-	path := config.StagePath(projectRoot, stage)
-	if path == "" {
-		return mcp.NewToolResultError(fmt.Sprintf("unknown stage: %s", stage)), nil
+// lineRange selects a 1-based, inclusive slice of an artifact's lines.
+// Zero fields mean "from the start" and "to the end".
+type lineRange struct {
+	From, To int
+}
+
+// set reports whether the caller asked for a slice at all.
+func (r lineRange) set() bool { return r.From != 0 || r.To != 0 }
+
+// apply returns the selected lines of content, clamped to the content,
+// prefixed with a header giving the range and the total line count.
+// An unset range returns content unchanged.
+func (r lineRange) apply(content, displayPath string) string {
+	if !r.set() {
+		return content
+	}
+	all := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	total := len(all)
+	from, to := r.From, r.To
+	if to <= 0 || to > total {
+		to = total
 	}
+	if from < 1 {
+		from = 1
+	}
+	if from > to {
+		from = to
+	}
+	return fmt.Sprintf("_Lines %d-%d of %d (`%s`)._\n\n%s",
+		from, to, total, displayPath, strings.Join(all[from-1:to], "\n"))
+}
 
-	content, err := readStageFile(path)
+// readStageContent returns the markdown content for a specific stage,
+// optionally restricted to a range of lines.
+func (t *ContextTool) readStageContent(cfg *config.ProjectConfig, projectRoot string, stage config.Stage, lines lineRange) (string, error) {
+	if !config.HasArtifact(stage) {
+		if _, known := config.Stages[stage]; known {
+			return noArtifactStage(cfg, projectRoot, stage), nil
+		}
+		if side, ok := config.FindSideArtifact(string(stage)); ok {
+			return readSideArtifact(projectRoot, side, lines)
+		}
+		return "", newUserError(fmt.Sprintf("unknown stage: %s", stage))
+	}
+
+	path := config.StagePath(projectRoot, stage)
+	content, err := readStageArtifacts(projectRoot, cfg, stage)
 	if err != nil {
-		return nil, fmt.Errorf("reading stage %s: %w", stage, err)
+		return "", fmt.Errorf("reading stage %s: %w", stage, err)
 	}
 
 	if content == "" {
 		meta := config.Stages[stage]
-		return mcp.NewToolResultText(fmt.Sprintf(
+		return fmt.Sprintf(
 			"# Stage: %s\n\n**Status:** Not yet completed\n\n_%s_",
 			meta.Name, meta.Description,
-		)), nil
+		), nil
+	}
+
+	return lines.apply(content, filepathRel(projectRoot, path)), nil
+}
+
+// noArtifactStage describes a stage that writes no markdown artifact —
+// init, whose output is the project config — instead of reporting it
+// as unknown.
+func noArtifactStage(cfg *config.ProjectConfig, projectRoot string, stage config.Stage) string {
+	meta := config.Stages[stage]
+	status := cfg.StageStatus[stage].Status
+	if status == "" {
+		status = "pending"
 	}
+	return fmt.Sprintf(
+		"# Stage: %s\n\n**Status:** %s\n\n_%s_\n\n"+
+			"This stage has no markdown artifact — its output is the project config (`%s`). "+
+			"Call sdd_get_context without 'stage' for the project overview.",
+		meta.Name, status, meta.Description, filepathRel(projectRoot, config.ConfigPath(projectRoot)),
+	)
+}
 
-	return mcp.NewToolResultText(content), nil
+// noArtifactError rejects reading a stage without an artifact where
+// only an artifact's content makes sense (an archive, a diff).
+func noArtifactError(stage config.Stage) error {
+	return newUserError(fmt.Sprintf("stage %s has no markdown artifact — its output is the project config", stage))
+}
+
+// readSideArtifact returns the content of a non-stage artifact,
+// optionally restricted to a range of lines.
+func readSideArtifact(projectRoot string, side config.SideArtifact, lines lineRange) (string, error) {
+	content, err := readStageFile(side.Path(projectRoot))
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", side.Filename, err)
+	}
+	if content == "" {
+		return fmt.Sprintf(
+			"# %s\n\n**Status:** Not yet created (`docs/%s`)", side.Name, side.Filename,
+		), nil
+	}
+	return lines.apply(content, "docs/"+side.Filename), nil
+}
+
+// readArchivedStage returns a stage or side artifact from the iteration
+// at version, archived under docs/history/v<version>/.
+func readArchivedStage(projectRoot, version string, stage config.Stage, lines lineRange) (string, error) {
+	archiveDir := iterationArchivePath(projectRoot, version)
+	if info, err := os.Stat(archiveDir); err != nil || !info.IsDir() {
+		versions := archivedVersions(projectRoot)
+		if len(versions) == 0 {
+			return "", newUserError(fmt.Sprintf("no archived iteration %s — this project has no earlier iterations", version))
+		}
+		return "", newUserError(fmt.Sprintf("no archived iteration %s — archived versions: %s",
+			version, strings.Join(versions, ", ")))
+	}
+	return readSnapshotStage(projectRoot, archiveDir, "iteration "+version, stage, lines)
+}
+
+// readSnapshotStage returns a stage or side artifact from snapshotDir, a
+// copy of the docs directory described by label ("iteration 1.0.0",
+// "tag v1.0-approved").
+func readSnapshotStage(projectRoot, snapshotDir, label string, stage config.Stage, lines lineRange) (string, error) {
+	var name string
+	if config.HasArtifact(stage) {
+		name = config.StageFilenameInDocs(snapshotDir, stage)
+	} else if _, known := config.Stages[stage]; known {
+		return "", noArtifactError(stage)
+	} else if side, ok := config.FindSideArtifact(string(stage)); ok {
+		name = side.Filename
+	}
+	if name == "" {
+		return "", newUserError(fmt.Sprintf("unknown stage: %s", stage))
+	}
+
+	path := filepath.Join(snapshotDir, name)
+	content, err := readStageFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", name, err)
+	}
+	if content == "" {
+		return fmt.Sprintf("_`%s` was not part of %s._", name, label), nil
+	}
+	return lines.apply(content, filepathRel(projectRoot, path)), nil
+}
+
+// archivedVersions lists the versions archived by sdd_new_iteration.
+func archivedVersions(projectRoot string) []string {
+	entries, err := os.ReadDir(filepath.Join(config.DocsPath(projectRoot), "history"))
+	if err != nil {
+		return nil
+	}
+	var versions []string
+	for _, e := range entries {
+		if name := e.Name(); e.IsDir() && strings.HasPrefix(name, "v") {
+			versions = append(versions, strings.TrimPrefix(name, "v"))
+		}
+	}
+	return versions
 }
 
 // buildOverview creates a summary of the entire SDD project state.
 // This is the "standard" detail level — the default behavior.
-func (t *ContextTool) buildOverview(cfg *config.ProjectConfig, projectRoot string) (*mcp.CallToolResult, error) {
+// With ascii set, status markers are ASCII instead of emoji.
+func (t *ContextTool) buildOverview(cfg *config.ProjectConfig, projectRoot string, ascii bool) (string, error) {
 	var sb strings.Builder
 
 	fmt.Fprintf(&sb, "# SDD Project: %s\n\n", cfg.Name)
 	fmt.Fprintf(&sb, "**Description:** %s\n", cfg.Description)
 	fmt.Fprintf(&sb, "**Mode:** %s\n", cfg.Mode)
-	fmt.Fprintf(&sb, "**Created:** %s\n", cfg.CreatedAt)
-	fmt.Fprintf(&sb, "**Last Updated:** %s\n\n", cfg.UpdatedAt)
+	fmt.Fprintf(&sb, "**Created:** %s\n", formatTimestamp(cfg.CreatedAt))
+	fmt.Fprintf(&sb, "**Last Updated:** %s\n\n", formatTimestamp(cfg.UpdatedAt))
 
 	// Pipeline status.
 	currentMeta := config.Stages[cfg.CurrentStage]
@@ -241,42 +555,49 @@ func (t *ContextTool) buildOverview(cfg *config.ProjectConfig, projectRoot strin
 
 	if cfg.CurrentStage == config.StageClarify {
 		fmt.Fprintf(&sb, "**Clarity Score:** %d/100 (need %d for %s mode)\n\n",
-			cfg.ClarityScore, clarityThresholdForMode(cfg.Mode), cfg.Mode)
+			cfg.ClarityScore, clarityThresholdFor(cfg), cfg.Mode)
+		if blockers := gateBlockers(cfg); len(blockers) > 0 {
+			sb.WriteString(formatGateBlockers(blockers))
+			sb.WriteString("\n")
+		}
 	}
 
 	// Stage overview table.
 	sb.WriteString("## Pipeline Progress\n\n")
-	sb.WriteString("| Stage | Status | Iterations |\n")
-	sb.WriteString("|-------|--------|------------|\n")
+	sb.WriteString("| Stage | Status | Iterations | Completed | By |\n")
+	sb.WriteString("|-------|--------|------------|-----------|----|\n")
 
-	for _, stage := range config.StageOrder {
+	for _, stage := range config.StageOrderFor(cfg) {
 		meta := config.Stages[stage]
 		status := cfg.StageStatus[stage]
-		indicator := statusIndicator(status.Status)
+		indicator := indicatorFor(ascii)(status.Status)
 		current := ""
 		if stage == cfg.CurrentStage {
-			current = " **← current**"
+			current = " **" + currentMarker(ascii) + " current**"
 		}
-		fmt.Fprintf(&sb, "| %s %s | %s%s | %d |\n",
-			indicator, meta.Name, status.Status, current, status.Iterations)
+		completed := formatTimestamp(status.CompletedAt)
+		if ascii && status.CompletedAt == "" {
+			completed = "-"
+		}
+		completedBy := status.CompletedBy
+		if completedBy == "" {
+			completedBy = "—"
+			if ascii {
+				completedBy = "-"
+			}
+		}
+		fmt.Fprintf(&sb, "| %s %s | %s%s | %d | %s | %s |\n",
+			indicator, meta.Name, status.Status, current, status.Iterations, completed, completedBy)
 	}
 
 	// Artifacts summary.
 	sb.WriteString("\n## Artifacts\n\n")
-	artifactStages := []config.Stage{
-		config.StagePrinciples,
-		config.StageCharter,
-		config.StageSpecify,
-		config.StageClarify,
-		config.StageDesign,
-		config.StageTasks,
-		config.StageValidate,
-	}
+	artifactStages := overviewArtifactStages(cfg)
 	for _, stage := range artifactStages {
-		path := config.StagePath(projectRoot, stage)
-		if path == "" {
+		if !config.HasArtifact(stage) {
 			continue
 		}
+		path := config.StagePath(projectRoot, stage)
 		content, _ := readStageFile(path)
 		exists := "not created"
 		if content != "" {
@@ -285,79 +606,352 @@ func (t *ContextTool) buildOverview(cfg *config.ProjectConfig, projectRoot strin
 		}
 		meta := config.Stages[stage]
 		fmt.Fprintf(&sb, "- **%s** (`docs/%s`): %s\n",
-			meta.Name, config.StageFilename(stage), exists)
+			meta.Name, cfg.StageFilename(stage), exists)
+	}
+	// Side artifacts are optional, so only list the ones that exist.
+	for _, side := range config.SideArtifacts {
+		content, _ := readStageFile(side.Path(projectRoot))
+		if content == "" {
+			continue
+		}
+		fmt.Fprintf(&sb, "- **%s** (`docs/%s`): %d lines\n",
+			side.Name, side.Filename, strings.Count(content, "\n"))
+	}
+
+	if p, ok := projectImplementationProgress(cfg, projectRoot); ok {
+		fmt.Fprintf(&sb, "\n## Implementation\n\n%s\n", p)
 	}
 
 	// Next steps.
 	sb.WriteString("\n## Next Steps\n\n")
 	sb.WriteString(nextStepGuidance(cfg))
 
-	return mcp.NewToolResultText(sb.String()), nil
+	return sb.String(), nil
 }
 
 // buildSummaryOverview creates a minimal overview with stage names and status only.
 // Designed for minimal token usage — progressive disclosure pattern.
-func (t *ContextTool) buildSummaryOverview(cfg *config.ProjectConfig) *mcp.CallToolResult {
+func (t *ContextTool) buildSummaryOverview(cfg *config.ProjectConfig, projectRoot string, ascii bool) string {
 	var sb strings.Builder
 
 	fmt.Fprintf(&sb, "# %s [%s mode]\n\n", cfg.Name, cfg.Mode)
 
 	if cfg.CurrentStage == config.StageClarify {
 		fmt.Fprintf(&sb, "Clarity: %d/%d\n\n",
-			cfg.ClarityScore, clarityThresholdForMode(cfg.Mode))
+			cfg.ClarityScore, clarityThresholdFor(cfg))
 	}
 
-	for _, stage := range config.StageOrder {
+	for _, stage := range config.StageOrderFor(cfg) {
 		meta := config.Stages[stage]
 		status := cfg.StageStatus[stage]
-		indicator := statusIndicator(status.Status)
+		indicator := indicatorFor(ascii)(status.Status)
 		current := ""
 		if stage == cfg.CurrentStage {
-			current = " ←"
+			current = " " + currentMarker(ascii)
 		}
 		fmt.Fprintf(&sb, "%s %s%s\n", indicator, meta.Name, current)
 	}
+	if p, ok := projectImplementationProgress(cfg, projectRoot); ok {
+		fmt.Fprintf(&sb, "\nImplementation: %d/%d done\n", p.Done, p.Total)
+	}
 
-	return mcp.NewToolResultText(sb.String())
+	return sb.String()
 }
 
 // buildFullOverview creates a comprehensive overview including all artifact content inline.
 // This provides all project context in a single call — useful for full-context loading.
-func (t *ContextTool) buildFullOverview(cfg *config.ProjectConfig, projectRoot string) (*mcp.CallToolResult, error) {
+func (t *ContextTool) buildFullOverview(cfg *config.ProjectConfig, projectRoot string, ascii bool) (string, error) {
 	// Start with the standard overview.
-	standardResult, err := t.buildOverview(cfg, projectRoot)
+	standard, err := t.buildOverview(cfg, projectRoot, ascii)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	var sb strings.Builder
-	sb.WriteString(getTextContent(standardResult))
+	sb.WriteString(standard)
 
 	// Append full artifact content for each completed stage.
-	artifactStages := []config.Stage{
-		config.StagePrinciples,
-		config.StageCharter,
-		config.StageSpecify,
-		config.StageClarify,
-		config.StageDesign,
-		config.StageTasks,
-		config.StageValidate,
-	}
+	artifactStages := overviewArtifactStages(cfg)
 
 	for _, stage := range artifactStages {
+		if !config.HasArtifact(stage) {
+			continue
+		}
 		path := config.StagePath(projectRoot, stage)
-		if path == "" {
+		appendArtifactSection(&sb, config.Stages[stage].Name, path)
+	}
+	for _, side := range config.SideArtifacts {
+		appendArtifactSection(&sb, side.Name, side.Path(projectRoot))
+	}
+
+	return sb.String(), nil
+}
+
+// appendArtifactSection streams the artifact at path into sb under a
+// "## <name> Content" heading, so the full overview holds each artifact
+// once instead of a read copy plus the overview's. Missing and empty
+// artifacts are left out.
+func appendArtifactSection(sb *strings.Builder, name, path string) {
+	if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "\n---\n\n## %s Content\n\n", name)
+	_, _ = copyStageFile(sb, path)
+	sb.WriteString("\n")
+}
+
+// contextJSON is the format=json overview payload.
+type contextJSON struct {
+	Project          *config.ProjectConfig `json:"project"`
+	ProgressPercent  int                   `json:"progress_percent"`
+	ClarityThreshold int                   `json:"clarity_threshold"`
+	Stages           []stageJSON           `json:"stages"`
+	SideArtifacts    []sideArtifactJSON    `json:"side_artifacts,omitempty"`
+	GateBlockers     []gateBlocker         `json:"gate_blockers,omitempty"`
+	// Implementation is task progress recorded by sdd_update_task_status.
+	Implementation *implementationProgress `json:"implementation,omitempty"`
+	// Transitions are the operations the project allows now, for
+	// clients deciding which actions to offer.
+	Transitions []transitionJSON `json:"transitions"`
+}
+
+// transitionJSON is one allowed pipeline operation and the tool call
+// that performs it.
+type transitionJSON struct {
+	Op   pipeline.Transition `json:"op"`
+	Tool string              `json:"tool"`
+}
+
+// sideArtifactJSON describes an existing non-stage artifact.
+type sideArtifactJSON struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	artifactJSON
+}
+
+// stageJSON describes one pipeline stage with computed fields.
+type stageJSON struct {
+	Stage           config.Stage  `json:"stage"`
+	Name            string        `json:"name"`
+	Status          string        `json:"status"`
+	Current         bool          `json:"current"`
+	Iterations      int           `json:"iterations"`
+	StartedAt       string        `json:"started_at,omitempty"`
+	CompletedAt     string        `json:"completed_at,omitempty"`
+	CompletedBy     string        `json:"completed_by,omitempty"`
+	DurationSeconds *int64        `json:"duration_seconds,omitempty"`
+	Artifact        *artifactJSON `json:"artifact,omitempty"`
+}
+
+// artifactJSON describes a stage's markdown artifact on disk.
+type artifactJSON struct {
+	Path   string `json:"path"`
+	Exists bool   `json:"exists"`
+	Bytes  int    `json:"bytes"`
+	Lines  int    `json:"lines"`
+}
+
+// buildJSONOverview returns the project state as a JSON object.
+func (t *ContextTool) buildJSONOverview(cfg *config.ProjectConfig, projectRoot string) (string, error) {
+	out := contextJSON{
+		Project:          cfg,
+		ClarityThreshold: clarityThresholdFor(cfg),
+	}
+	if cfg.CurrentStage == config.StageClarify {
+		out.GateBlockers = gateBlockers(cfg)
+	}
+	if p, ok := projectImplementationProgress(cfg, projectRoot); ok {
+		out.Implementation = &p
+	}
+	for _, tr := range pipeline.ValidTransitions(cfg) {
+		out.Transitions = append(out.Transitions, transitionJSON{Op: tr, Tool: pipeline.TransitionTool(cfg, tr)})
+	}
+
+	completed := 0
+	for _, stage := range config.StageOrderFor(cfg) {
+		status := cfg.StageStatus[stage]
+		if status.Status == "completed" {
+			completed++
+		}
+
+		entry := stageJSON{
+			Stage:       stage,
+			Name:        config.Stages[stage].Name,
+			Status:      status.Status,
+			Current:     stage == cfg.CurrentStage,
+			Iterations:  status.Iterations,
+			StartedAt:   status.StartedAt,
+			CompletedAt: status.CompletedAt,
+			CompletedBy: status.CompletedBy,
+		}
+		if d, ok := stageDuration(status); ok {
+			secs := int64(d / time.Second)
+			entry.DurationSeconds = &secs
+		}
+
+		if path := config.StagePath(projectRoot, stage); path != "" {
+			size, lines, err := statStageFile(path)
+			if err != nil {
+				return "", fmt.Errorf("reading stage %s: %w", stage, err)
+			}
+			entry.Artifact = &artifactJSON{
+				Path:   filepathRel(projectRoot, path),
+				Exists: size > 0,
+				Bytes:  size,
+				Lines:  lines,
+			}
+		}
+
+		out.Stages = append(out.Stages, entry)
+	}
+	for _, side := range config.SideArtifacts {
+		path := side.Path(projectRoot)
+		size, lines, err := statStageFile(path)
+		if err != nil {
+			return "", fmt.Errorf("reading %s: %w", side.Filename, err)
+		}
+		if size == 0 {
 			continue
 		}
-		content, _ := readStageFile(path)
-		if content == "" {
+		out.SideArtifacts = append(out.SideArtifacts, sideArtifactJSON{
+			Key:  side.Key,
+			Name: side.Name,
+			artifactJSON: artifactJSON{
+				Path:   filepathRel(projectRoot, path),
+				Exists: true,
+				Bytes:  size,
+				Lines:  lines,
+			},
+		})
+	}
+	if order := config.StageOrderFor(cfg); len(order) > 0 {
+		out.ProgressPercent = completed * 100 / len(order)
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling context: %w", err)
+	}
+	return string(data), nil
+}
+
+// gateBlocker is a clarity dimension scoring below the Clarity Gate
+// threshold.
+type gateBlocker struct {
+	Dimension string `json:"dimension"`
+	Score     int    `json:"score"`
+	Weight    int    `json:"weight"`
+	Needed    int    `json:"points_needed"` // to reach the threshold
+}
+
+// gateBlockers returns the dimensions whose latest score is below the
+// Clarity Gate threshold, biggest lever on the overall score first. A
+// dimension never scored counts as 0. It returns nil once the gate has
+// passed.
+func gateBlockers(cfg *config.ProjectConfig) []gateBlocker {
+	threshold := clarityThresholdFor(cfg)
+	if cfg.ClarityScore >= threshold {
+		return nil
+	}
+	var blockers []gateBlocker
+	for _, d := range pipeline.ApplyWeights(pipeline.DefaultDimensions(), cfg.DimensionWeights) {
+		score := cfg.DimensionScores[d.Name]
+		if score >= threshold {
 			continue
 		}
-		meta := config.Stages[stage]
-		fmt.Fprintf(&sb, "\n---\n\n## %s Content\n\n%s\n", meta.Name, content)
+		blockers = append(blockers, gateBlocker{Dimension: d.Name, Score: score, Weight: d.Weight, Needed: threshold - score})
+	}
+	sort.SliceStable(blockers, func(i, j int) bool {
+		return blockers[i].Needed*blockers[i].Weight > blockers[j].Needed*blockers[j].Weight
+	})
+	return blockers
+}
+
+// formatGateBlockers renders blockers as a markdown table.
+func formatGateBlockers(blockers []gateBlocker) string {
+	var sb strings.Builder
+	sb.WriteString("| Dimension | Score | Weight | Needs |\n")
+	sb.WriteString("|-----------|-------|--------|-------|\n")
+	for _, b := range blockers {
+		fmt.Fprintf(&sb, "| %s | %d | %d | +%d |\n", b.Dimension, b.Score, b.Weight, b.Needed)
+	}
+	return sb.String()
+}
+
+// buildGateBlockers reports why the pipeline can't advance past the
+// Clarity Gate: the score, the threshold and the dimensions holding it
+// back, with the sdd_clarify call that works on them.
+func buildGateBlockers(cfg *config.ProjectConfig) string {
+	threshold := clarityThresholdFor(cfg)
+	if cfg.CurrentStage != config.StageClarify {
+		return fmt.Sprintf("Nothing blocks the Clarity Gate — the project is at '%s', not clarify.", cfg.CurrentStage)
+	}
+	if cfg.ClarityScore >= threshold {
+		return fmt.Sprintf("Nothing blocks the Clarity Gate — score %d/100 meets the %d threshold. "+
+			"Call `sdd_clarify` to record the passing round.", cfg.ClarityScore, threshold)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Clarity Gate Blockers\n\n**Score:** %d/100 — need %d for %s mode (%d short)\n\n",
+		cfg.ClarityScore, threshold, cfg.Mode, threshold-cfg.ClarityScore)
+	if len(cfg.DimensionScores) == 0 {
+		sb.WriteString("No clarify round has been scored yet — call `sdd_clarify` to get the questions.\n")
+		return sb.String()
+	}
+	blockers := gateBlockers(cfg)
+	sb.WriteString(formatGateBlockers(blockers))
+	names := make([]string, len(blockers))
+	for i, b := range blockers {
+		names[i] = b.Dimension
+	}
+	fmt.Fprintf(&sb, "\nNext: `sdd_clarify` with focus='%s' — highest impact first.\n", strings.Join(names, ","))
+	return sb.String()
+}
+
+// stageDuration returns CompletedAt − StartedAt for a stage, if both
+// timestamps are present and parseable.
+func stageDuration(status config.StageStatus) (time.Duration, bool) {
+	if status.StartedAt == "" || status.CompletedAt == "" {
+		return 0, false
+	}
+	start, err := time.Parse(time.RFC3339, status.StartedAt)
+	if err != nil {
+		return 0, false
+	}
+	end, err := time.Parse(time.RFC3339, status.CompletedAt)
+	if err != nil || end.Before(start) {
+		return 0, false
 	}
+	return end.Sub(start), true
+}
 
-	return mcp.NewToolResultText(sb.String()), nil
+// filepathRel returns path relative to root using forward slashes,
+// falling back to the absolute path if it can't be made relative.
+func filepathRel(root, path string) string {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// overviewArtifactStages lists the stages whose artifacts the overview
+// reports, including enabled optional stages in pipeline order.
+func overviewArtifactStages(cfg *config.ProjectConfig) []config.Stage {
+	stages := []config.Stage{
+		config.StagePrinciples,
+		config.StageCharter,
+		config.StageSpecify,
+		config.StageClarify,
+	}
+	if cfg.HasStage(config.StageResearch) {
+		stages = append(stages, config.StageResearch)
+	}
+	return append(stages,
+		config.StageDesign,
+		config.StageTasks,
+		config.StageValidate,
+	)
 }
 
 // getTextContent extracts the text from an MCP tool result.
@@ -387,35 +981,63 @@ func statusIndicator(status string) string {
 	}
 }
 
+// asciiStatusIndicator is statusIndicator for terminals and logs that
+// can't render emoji.
+func asciiStatusIndicator(status string) string {
+	switch status {
+	case "completed":
+		return "[x]"
+	case "in_progress":
+		return "[~]"
+	case "skipped":
+		return "[>]"
+	default:
+		return "[ ]"
+	}
+}
+
+// indicatorFor returns the status marker function for the output style.
+func indicatorFor(ascii bool) func(string) string {
+	if ascii {
+		return asciiStatusIndicator
+	}
+	return statusIndicator
+}
+
+// currentMarker points at the current stage in overviews.
+func currentMarker(ascii bool) string {
+	if ascii {
+		return "<-"
+	}
+	return "←"
+}
+
 // nextStepGuidance returns mode-appropriate guidance for the current stage.
 func nextStepGuidance(cfg *config.ProjectConfig) string {
 	switch cfg.CurrentStage {
-	case config.StagePrinciples:
-		return "Use `sdd_create_principles` to define your project's golden invariants, coding standards, and domain truths."
-	case config.StageCharter:
-		return "Use `sdd_create_charter` with your project idea to create a structured charter."
-	case config.StageSpecify:
-		return "Use `sdd_generate_requirements` to extract formal requirements from the charter."
+	case config.StagePrinciples, config.StageCharter, config.StageSpecify,
+		config.StageResearch, config.StageDesign, config.StageTasks, config.StageValidate:
+		return msg(cfg, "next."+string(cfg.CurrentStage))
 	case config.StageClarify:
-		return fmt.Sprintf(
-			"Use `sdd_clarify` to run the Clarity Gate. Current score: %d/%d needed.",
-			cfg.ClarityScore, clarityThresholdForMode(cfg.Mode),
-		)
-	case config.StageDesign:
-		return "Use `sdd_create_design` to create the technical architecture document. " +
-			"Read all previous artifacts first (use `sdd_get_context`), then design the system " +
-			"addressing ALL requirements. Include tech stack, components, data model, and key design decisions."
-	case config.StageTasks:
-		return "Use `sdd_create_tasks` to break the design into atomic implementation tasks. " +
-			"Read the design document first (use `sdd_get_context stage=design`). " +
-			"Each task should have a unique ID, clear scope, requirements covered, and acceptance criteria."
-	case config.StageValidate:
-		return "Use `sdd_validate` to run a cross-artifact consistency check. " +
-			"Read ALL artifacts and verify: requirement coverage, component coverage, " +
-			"consistency between documents, and identify any gaps or risks."
+		return msg(cfg, "next.clarify", cfg.ClarityScore, clarityThresholdFor(cfg))
 	default:
-		return "Use `sdd_init_project` to start a new SDD project."
+		return msg(cfg, "next.init")
+	}
+}
+
+// msg renders the message catalog entry key in the project's response
+// language, falling back to English.
+func msg(cfg *config.ProjectConfig, key string, args ...any) string {
+	return i18n.T(cfg.Lang, key, args...)
+}
+
+// clarityThresholdFor returns the project's clarity threshold, honoring
+// an explicit per-project override before falling back to the mode default.
+func clarityThresholdFor(cfg *config.ProjectConfig) int {
+	if cfg.ClarityThreshold > 0 {
+		return cfg.ClarityThreshold
 	}
+	return clarityThresholdForMode(cfg.Mode)
 }
 
 // clarityThresholdForMode returns the clarity threshold. This is a thin
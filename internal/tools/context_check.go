@@ -88,19 +88,43 @@ const maxMemoryResults = 5
 
 // Handle processes the sdd_context_check tool call.
 func (t *ContextCheckTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	changeDesc := strings.TrimSpace(req.GetString("change_description", ""))
-	projectName := req.GetString("project_name", "")
-	detailLevel := memory.ParseDetailLevel(req.GetString("detail_level", ""))
-	maxTokens := intArgContextCheck(req, "max_tokens", 0)
-
-	if changeDesc == "" {
-		return mcp.NewToolResultError("'change_description' is required — describe the change to check context for"), nil
+	params := contextCheckParams{
+		ChangeDescription: strings.TrimSpace(req.GetString("change_description", "")),
+		ProjectName:       req.GetString("project_name", ""),
+		DetailLevel:       memory.ParseDetailLevel(req.GetString("detail_level", "")),
+		MaxTokens:         intArgContextCheck(req, "max_tokens", 0),
 	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// contextCheckParams are the parsed sdd_context_check arguments.
+type contextCheckParams struct {
+	ChangeDescription string
+	ProjectName       string
+	DetailLevel       string
+	MaxTokens         int
+}
+
+// contextCheckParamSpecs declares the sdd_context_check argument checks.
+var contextCheckParamSpecs = []paramSpec{
+	{name: "change_description", required: true, hint: "describe the change to check context for"},
+}
+
+// process scans the project's artifacts and memory for context relevant to the change.
+func (t *ContextCheckTool) process(projectRoot string, params contextCheckParams) (string, error) {
+	changeDesc := params.ChangeDescription
+	projectName := params.ProjectName
+	detailLevel := params.DetailLevel
+	maxTokens := params.MaxTokens
+
+	if err := validateParams(contextCheckParamSpecs, map[string]string{"change_description": changeDesc}); err != nil {
+		return "", err
+	}
 
 	var sb strings.Builder
 	sb.WriteString("# Context Check Report\n\n")
@@ -218,7 +242,7 @@ func (t *ContextCheckTool) Handle(ctx context.Context, req mcp.CallToolRequest)
 
 	response += memory.TokenFooter(memory.EstimateTokens(response))
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
 
 // intArgContextCheck extracts an integer argument from a tool request (JSON numbers are float64).
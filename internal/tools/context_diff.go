@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/textdiff"
+)
+
+// gitTimeout bounds each git invocation, so a hung repository (a lock,
+// a credential prompt) can't stall the tool call. A sooner deadline on
+// the caller's ctx wins.
+const gitTimeout = 10 * time.Second
+
+// diffStageAgainst renders a unified diff of a stage or side artifact
+// between git revision ref and the working tree.
+func diffStageAgainst(ctx context.Context, projectRoot string, stage config.Stage, ref string) (string, error) {
+	if strings.HasPrefix(ref, "-") {
+		return "", newUserError(fmt.Sprintf("'against' must be a git revision, not an option: %s", ref))
+	}
+
+	path := config.StagePath(projectRoot, stage)
+	if path == "" {
+		if _, known := config.Stages[stage]; known {
+			return "", noArtifactError(stage)
+		}
+		side, ok := config.FindSideArtifact(string(stage))
+		if !ok {
+			return "", newUserError(fmt.Sprintf("unknown stage: %s", stage))
+		}
+		path = side.Path(projectRoot)
+	}
+	display := filepathRel(projectRoot, path)
+
+	top, err := runGit(ctx, projectRoot, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", newUserError(fmt.Sprintf(
+			"'against' needs the project to be in a git repository: %v", err))
+	}
+	// Resolve symlinks on both sides so the path git sees is relative to
+	// the same top level (e.g. /tmp vs /private/tmp on macOS).
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", display, err)
+	}
+	if dir, err := filepath.EvalSymlinks(filepath.Dir(absPath)); err == nil {
+		absPath = filepath.Join(dir, filepath.Base(absPath))
+	}
+	topDir := strings.TrimSpace(top)
+	if resolved, err := filepath.EvalSymlinks(topDir); err == nil {
+		topDir = resolved
+	}
+	rel, err := filepath.Rel(topDir, absPath)
+	if err != nil {
+		return "", fmt.Errorf("locating %s in the repository: %w", display, err)
+	}
+	rel = filepath.ToSlash(rel)
+
+	if _, err := runGit(ctx, projectRoot, "rev-parse", "--verify", "--quiet", ref+"^{commit}"); err != nil {
+		return "", newUserError(fmt.Sprintf("unknown git revision: %s", ref))
+	}
+	old, err := runGit(ctx, projectRoot, "show", ref+":"+rel)
+	if err != nil {
+		return "", newUserError(fmt.Sprintf(
+			"`%s` did not exist at %s — nothing to diff against", display, ref))
+	}
+
+	current, err := readStageFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", display, err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Changes to `%s` since %s\n\n", display, ref)
+	diff := textdiff.Unified(ref+":"+display, "working tree:"+display, old, current, textdiff.DefaultContext)
+	if diff == "" {
+		sb.WriteString("✅ No changes.\n")
+		return sb.String(), nil
+	}
+	added, removed := textdiff.Stats(old, current)
+	fmt.Fprintf(&sb, "**+%d / −%d lines**\n\n```diff\n%s```\n", added, removed, diff)
+	return sb.String(), nil
+}
+
+// runGit runs git in dir and returns its stdout. On failure the error
+// carries git's first line of stderr.
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, gitTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", errors.New("git is not installed")
+		}
+		if msg, _, _ := strings.Cut(strings.TrimSpace(stderr.String()), "\n"); msg != "" {
+			return "", errors.New(msg)
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
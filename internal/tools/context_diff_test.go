@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+func TestContextTool_Against(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	tmpDir, cleanup := setupTestProject(t, config.ModeExpert)
+	defer cleanup()
+
+	git := func(args ...string) {
+		t.Helper()
+		if _, err := runGit(context.Background(), tmpDir, args...); err != nil {
+			t.Fatalf("git %s: %v", strings.Join(args, " "), err)
+		}
+	}
+	design := config.StagePath(tmpDir, config.StageDesign)
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(design, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tool := NewContextTool(config.NewFileStore())
+	against := func(ref string) (string, error) {
+		return tool.process(context.Background(), tmpDir, contextParams{Stage: "design", Format: "markdown", Against: ref})
+	}
+
+	// Not yet a repository.
+	if _, err := against("HEAD"); err == nil || !strings.Contains(err.Error(), "git repository") {
+		t.Errorf("want a not-a-repository error, got %v", err)
+	}
+
+	git("init", "-q")
+	git("config", "user.email", "test@example.com")
+	git("config", "user.name", "Test")
+	git("add", "-A")
+	git("commit", "-q", "-m", "init")
+
+	write("# Design\n\nUse SQLite.\n")
+	git("add", "-A")
+	git("commit", "-q", "-m", "design")
+
+	// design.md didn't exist at the first commit.
+	if _, err := against("HEAD~1"); err == nil || !strings.Contains(err.Error(), "did not exist at HEAD~1") {
+		t.Errorf("want a did-not-exist error, got %v", err)
+	}
+	if _, err := against("no-such-ref"); err == nil || !strings.Contains(err.Error(), "unknown git revision") {
+		t.Errorf("want an unknown revision error, got %v", err)
+	}
+	if _, err := against("--output=x"); err == nil {
+		t.Error("an option-like revision should be rejected")
+	}
+
+	text, err := against("HEAD")
+	if err != nil {
+		t.Fatalf("unchanged: %v", err)
+	}
+	if !strings.Contains(text, "No changes") {
+		t.Errorf("unchanged artifact should report no changes, got:\n%s", text)
+	}
+
+	write("# Design\n\nUse PostgreSQL.\n")
+	text, err = against("HEAD")
+	if err != nil {
+		t.Fatalf("changed: %v", err)
+	}
+	for _, want := range []string{"-Use SQLite.", "+Use PostgreSQL.", "+1 / −1 lines"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("diff missing %q:\n%s", want, text)
+		}
+	}
+
+	if _, err := tool.process(context.Background(), tmpDir, contextParams{Format: "markdown", Against: "HEAD"}); err == nil {
+		t.Error("'against' without 'stage' should be rejected")
+	}
+}
@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/scope"
+)
+
+// scopeCreepSection compares newContent against the charter's "Out of
+// Scope" list and renders an advisory response section for any items
+// that seem to reappear. It returns "" when there is no charter, no
+// out-of-scope list, or nothing matches — the check never blocks.
+func scopeCreepSection(projectRoot, newContent string) string {
+	charter, err := readStageFile(config.StagePath(projectRoot, config.StageCharter))
+	if err != nil || charter == "" {
+		return ""
+	}
+	outOfScope := markdownSections(charter)["Out of Scope"]
+	warnings := scope.DetectCreep(outOfScope, newContent)
+	if len(warnings) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n\n## ⚠️ Possible Scope Creep\n\n")
+	sb.WriteString("These items are listed as **Out of Scope** in the charter but seem to reappear here " +
+		"(keyword overlap — advisory only):\n\n")
+	for _, w := range warnings {
+		fmt.Fprintf(&sb, "- %s\n", w)
+	}
+	sb.WriteString("\nIf they are intentional, update the charter's boundaries; otherwise remove them.")
+	return sb.String()
+}
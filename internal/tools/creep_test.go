@@ -0,0 +1,118 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const creepCharter = "# Charter\n\n## Boundaries\n\n### In Scope\n\n- Web app for time tracking\n- CSV export\n\n" +
+	"### Out of Scope\n\n- Mobile app — planned for v2\n- Invoicing\n\n## Stakeholders\n\n- Freelancers\n"
+
+func TestSpecifyTool_Handle_WarnsOnScopeCreep(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), creepCharter); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"must_have":      "- **FR-001**: Users can log time entries\n- **FR-002**: Native mobile apps for iOS and Android",
+		"should_have":    "- **FR-003**: Users can export time entries as CSV",
+		"wont_have":      "- Invoicing",
+		"non_functional": "- **NFR-001**: Pages load in under 2 seconds",
+	}
+	result, err := NewSpecifyTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("creep is advisory and must not fail the call: %s", getResultText(result))
+	}
+
+	text := getResultText(result)
+	if !strings.Contains(text, "## ⚠️ Possible Scope Creep") ||
+		!strings.Contains(text, `- Mobile app — "- **FR-002**: Native mobile apps for iOS and Android"`) {
+		t.Errorf("response should flag the mobile app requirement:\n%s", text)
+	}
+	if strings.Contains(text, "- Invoicing —") {
+		t.Errorf("items restated under Won't Have are not creep:\n%s", text)
+	}
+}
+
+func TestDesignTool_Handle_WarnsOnScopeCreep(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+	writeDesignPrerequisites(t, tmpDir, "# Requirements\n\n- FR-001: Users can log time")
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), creepCharter); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+
+	renderer, _ := templates.NewRenderer()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"architecture_overview": "A modular monolith",
+		"tech_stack":            "- **Runtime**: Go 1.22",
+		"components":            "### TimeModule\n- Logs hours\n\n### InvoicingModule\n- Invoicing for billed hours",
+		"data_model":            "### Entry\n| id | UUID |",
+	}
+	result, err := NewDesignTool(config.NewFileStore(), renderer).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, `- Invoicing — "- Invoicing for billed hours"`) {
+		t.Errorf("response should flag the invoicing component:\n%s", text)
+	}
+	if strings.Contains(text, "- Mobile app —") {
+		t.Errorf("mobile app does not appear in the design:\n%s", text)
+	}
+}
+
+func TestScopeCreepSection_NoCharterOrNoMatch(t *testing.T) {
+	tmpDir := t.TempDir()
+	if got := scopeCreepSection(tmpDir, "- Mobile app"); got != "" {
+		t.Errorf("without a charter the check is skipped, got %q", got)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), creepCharter); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+	if got := scopeCreepSection(tmpDir, "- Web app for time tracking"); got != "" {
+		t.Errorf("no overlap should render nothing, got %q", got)
+	}
+}
+
+func TestDesignTool_Handle_WarnsOnBrokenMermaid(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+	writeDesignPrerequisites(t, tmpDir, "# Requirements\n\n- FR-001: Users can log time")
+
+	renderer, _ := templates.NewRenderer()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"architecture_overview": "A modular monolith\n\n```mermaid\ngraph TD\n    Web[Web --> API\n```",
+		"tech_stack":            "- **Runtime**: Go 1.22",
+		"components":            "### TimeModule\n\n```mermaid\nclassDiagram\n    class Entry\n```",
+		"data_model":            "### Entry\n| id | UUID |",
+	}
+	result, err := NewDesignTool(config.NewFileStore(), renderer).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("a broken diagram must not block the design: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "**Diagram check**") ||
+		!strings.Contains(text, "- `architecture_overview`: mermaid block at line 3: '[' opened on line 5 is never closed") {
+		t.Errorf("response should flag the broken diagram:\n%s", text)
+	}
+	if strings.Contains(text, "- `components`:") {
+		t.Errorf("the valid class diagram should not be flagged:\n%s", text)
+	}
+}
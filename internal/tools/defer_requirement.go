@@ -0,0 +1,201 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/clarify"
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// DeferRequirementTool handles the sdd_defer_requirement MCP tool.
+// When the Clarity Gate stalls on a feature that is really out of scope
+// for this version, the feature is deferred instead of clarified: its
+// requirement moves to "Won't Have (this version)" with the rationale,
+// and stops counting toward task coverage and clarification markers.
+//
+// Design: requirements.md is parsed back into its template fields and
+// re-rendered, so the document keeps the template's shape. The pipeline
+// state is left unchanged.
+type DeferRequirementTool struct {
+	store    config.Store
+	renderer templates.Renderer
+}
+
+// NewDeferRequirementTool creates a DeferRequirementTool with its dependencies.
+func NewDeferRequirementTool(store config.Store, renderer templates.Renderer) *DeferRequirementTool {
+	return &DeferRequirementTool{store: store, renderer: renderer}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *DeferRequirementTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_defer_requirement",
+		mcp.WithDescription(
+			"Defer a functional requirement to a later version: move it from Must/Should/Could Have into "+
+				"\"Won't Have (this version)\" in requirements.md, with the rationale. Use it when clarification "+
+				"stalls on a feature that is out of scope for now. Deferred requirements need no task in "+
+				"validation coverage, and their [NEEDS CLARIFICATION] markers are dropped. "+
+				"Sub-bullets move with the requirement. The pipeline stage doesn't change.",
+		),
+		mcp.WithString("id",
+			mcp.Required(),
+			mcp.Description("The functional requirement to defer, e.g. 'FR-004'."),
+		),
+		mcp.WithString("rationale",
+			mcp.Required(),
+			mcp.Description("Why it is deferred, e.g. 'Offline sync is a v2 goal; v1 targets always-online users'."),
+		),
+	)
+}
+
+// deferRequirementParams are the parsed sdd_defer_requirement arguments.
+type deferRequirementParams struct {
+	ID        string
+	Rationale string
+}
+
+// deferRequirementParamSpecs declares the sdd_defer_requirement argument checks.
+var deferRequirementParamSpecs = []paramSpec{
+	{name: "id", required: true, hint: "the FR-XXX requirement to defer"},
+	{name: "rationale", required: true, hint: "record why the requirement is deferred"},
+}
+
+// Handle processes the sdd_defer_requirement tool call.
+func (t *DeferRequirementTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := deferRequirementParams{
+		ID:        strings.ToUpper(strings.TrimSpace(req.GetString("id", ""))),
+		Rationale: strings.TrimSpace(req.GetString("rationale", "")),
+	}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// wontHavePlaceholder is what sdd_generate_requirements renders for an
+// empty Could Have or Won't Have bucket.
+const wontHavePlaceholder = "_None defined for this version._"
+
+// process moves the requirement to Won't Have and re-renders requirements.md.
+func (t *DeferRequirementTool) process(projectRoot string, params deferRequirementParams) (string, error) {
+	if err := validateParams(deferRequirementParamSpecs, map[string]string{
+		"id":        params.ID,
+		"rationale": params.Rationale,
+	}); err != nil {
+		return "", err
+	}
+	if !importIDPattern.MatchString(params.ID) {
+		return "", newUserError(fmt.Sprintf("'id' must be a requirement ID like FR-004 — got: %s", params.ID))
+	}
+	if isNFR(params.ID) {
+		return "", newUserError(fmt.Sprintf(
+			"%s is non-functional — only functional requirements (FR-XXX) can be deferred to Won't Have", params.ID))
+	}
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if err := pipeline.RequireArtifacts(projectRoot, config.StageSpecify); err != nil {
+		return "", asUserError(err)
+	}
+	// The requirement may live in the index or, when requirements are
+	// split by domain, in a domain file; it is deferred where it is.
+	var (
+		path, from, item string
+		data             templates.RequirementsData
+	)
+	for _, p := range config.StagePaths(projectRoot, cfg, config.StageSpecify) {
+		doc, err := readStageFile(p)
+		if err != nil {
+			return "", fmt.Errorf("reading requirements: %w", err)
+		}
+		data = templates.ParseRequirements(doc)
+		if _, _, ok := requirements.CutItem(data.WontHave, params.ID); ok {
+			return "", newUserError(fmt.Sprintf("%s is already in Won't Have (this version)", params.ID))
+		}
+		if from, item = cutDeferrable(&data, params.ID); from != "" {
+			path = p
+			break
+		}
+	}
+	if from == "" {
+		return "", newUserError(fmt.Sprintf(
+			"%s is not a Must, Should or Could Have requirement in requirements.md", params.ID))
+	}
+
+	markers := len(clarify.ExtractMarkers(item))
+	lines := strings.Split(clarify.StripMarkers(item), "\n")
+	lines[0] = strings.Join(strings.Fields(lines[0]), " ") + requirements.DeferredNote(params.Rationale)
+	deferred := strings.Join(lines, "\n")
+	if wont := strings.TrimSpace(data.WontHave); wont == "" || wont == wontHavePlaceholder {
+		data.WontHave = deferred
+	} else {
+		data.WontHave = wont + "\n" + deferred
+	}
+
+	if path == config.StagePath(projectRoot, config.StageSpecify) {
+		data.Name = cfg.Name // a domain file keeps its "<name>: <domain>" title
+	}
+	data.Attribution = attributionFor(cfg)
+	content, err := t.renderer.Render(templates.Requirements, data)
+	if err != nil {
+		return "", fmt.Errorf("rendering requirements: %w", err)
+	}
+	if err := writeStageFile(path, content); err != nil {
+		return "", fmt.Errorf("writing requirements: %w", err)
+	}
+	if err := syncRequirementsJSON(projectRoot, cfg); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Requirement Deferred\n\n"+
+		"**%s** moved from %s Have to **Won't Have (this version)** in `%s`.\n\n"+
+		"**Rationale:** %s\n\n",
+		params.ID, from, filepathRel(projectRoot, path), strings.Join(strings.Fields(params.Rationale), " "))
+	if markers > 0 {
+		fmt.Fprintf(&sb, "Dropped %d `[NEEDS CLARIFICATION]` marker(s) with it — deferred requirements aren't clarified.\n\n", markers)
+	}
+	sb.WriteString("Validation coverage no longer expects a task for it. ")
+	if cfg.CurrentStage == config.StageClarify {
+		sb.WriteString("Rescore the affected dimensions in your next `sdd_clarify` round.")
+	} else {
+		sb.WriteString("Remove it from the design and tasks if they already cover it.")
+	}
+	return sb.String(), nil
+}
+
+// cutDeferrable removes id's item from the first Must, Should or Could
+// Have bucket of data that holds it, leaving the placeholder when the
+// bucket empties. Returns the bucket and the item, or "" when no bucket
+// holds it.
+func cutDeferrable(data *templates.RequirementsData, id string) (from, item string) {
+	buckets := []struct {
+		name  string
+		field *string
+	}{
+		{requirements.BucketMust, &data.MustHave},
+		{requirements.BucketShould, &data.ShouldHave},
+		{requirements.BucketCould, &data.CouldHave},
+	}
+	for _, b := range buckets {
+		cut, rest, ok := requirements.CutItem(*b.field, id)
+		if !ok {
+			continue
+		}
+		*b.field = rest
+		if rest == "" {
+			*b.field = wontHavePlaceholder
+		}
+		return b.name, cut
+	}
+	return "", ""
+}
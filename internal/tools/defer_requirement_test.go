@@ -0,0 +1,154 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/clarify"
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// writeRequirementsDoc renders data as the project's requirements.md.
+func writeRequirementsDoc(t *testing.T, projectRoot string, data templates.RequirementsData) {
+	t.Helper()
+	data.Name = "test-project"
+	content, err := mustRenderer(t).Render(templates.Requirements, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeStageFile(config.StagePath(projectRoot, config.StageSpecify), content); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeferRequirementTool_Handle(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+	writeRequirementsDoc(t, tmpDir, templates.RequirementsData{
+		MustHave: "- **FR-001**: Users can log time\n" +
+			"- **FR-002**: Offline mode [NEEDS CLARIFICATION: which data syncs?]\n  - Sync on reconnect",
+		ShouldHave:    "- **FR-003**: CSV export",
+		CouldHave:     "_None defined for this version._",
+		WontHave:      "_None defined for this version._",
+		NonFunctional: "- **NFR-001**: Pages load in under 2s",
+		References:    []templates.Reference{{Title: "TRACK-9", URL: "https://tracker.example.com/TRACK-9"}},
+	})
+
+	tool := NewDeferRequirementTool(config.NewFileStore(), mustRenderer(t))
+	call := func(args map[string]interface{}) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := tool.Handle(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Handle: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]interface{}{"id": "fr-002", "rationale": "Offline is a v2 goal"})
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{"**FR-002** moved from Must Have", "Offline is a v2 goal", "Dropped 1"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+
+	doc, _ := readStageFile(config.StagePath(tmpDir, config.StageSpecify))
+	data := templates.ParseRequirements(doc)
+	if strings.Contains(data.MustHave, "FR-002") || !strings.Contains(data.MustHave, "FR-001") {
+		t.Errorf("Must Have = %q, want FR-001 only", data.MustHave)
+	}
+	wantWont := "- **FR-002**: Offline mode _(deferred: Offline is a v2 goal)_\n  - Sync on reconnect"
+	if data.WontHave != wantWont {
+		t.Errorf("Won't Have = %q, want %q", data.WontHave, wantWont)
+	}
+	if len(data.References) != 1 || data.References[0].Title != "TRACK-9" {
+		t.Errorf("References = %+v, want TRACK-9 kept", data.References)
+	}
+	if markers := clarify.ExtractMarkers(doc); len(markers) != 0 {
+		t.Errorf("deferred requirement kept its markers: %v", markers)
+	}
+	if ids := requirements.DeferredIDs(doc); !ids["FR-002"] {
+		t.Errorf("DeferredIDs = %v, want FR-002", ids)
+	}
+
+	for _, tt := range []struct {
+		args map[string]interface{}
+		want string
+	}{
+		{map[string]interface{}{"id": "FR-002", "rationale": "again"}, "already in Won't Have"},
+		{map[string]interface{}{"id": "FR-009", "rationale": "x"}, "not a Must, Should or Could Have"},
+		{map[string]interface{}{"id": "NFR-001", "rationale": "x"}, "non-functional"},
+		{map[string]interface{}{"id": "FR-003"}, "'rationale' is required"},
+	} {
+		result := call(tt.args)
+		if !isErrorResult(result) || !strings.Contains(getResultText(result), tt.want) {
+			t.Errorf("%v: got %s, want an error mentioning %q", tt.args, getResultText(result), tt.want)
+		}
+	}
+}
+
+func TestDeferRequirementTool_SurvivesRegenerate(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+	renderer := mustRenderer(t)
+	if _, err := RenderAndWriteRequirements(tmpDir, renderer, templates.RequirementsData{
+		Name:          "test-project",
+		MustHave:      "- **FR-001**: Users can log time\n- **FR-002**: Offline mode",
+		CouldHave:     wontHavePlaceholder,
+		WontHave:      wontHavePlaceholder,
+		NonFunctional: "- **NFR-001**: Pages load in under 2s",
+	}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"id": "FR-002", "rationale": "Offline is a v2 goal"}
+	if result, err := NewDeferRequirementTool(store, renderer).Handle(context.Background(), req); err != nil || isErrorResult(result) {
+		t.Fatalf("defer: %v %s", err, getResultText(result))
+	}
+
+	// Rebuild requirements.md from the sidecar alone.
+	if err := os.Remove(config.StagePath(tmpDir, config.StageSpecify)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Regenerate(tmpDir, store, renderer); err != nil {
+		t.Fatalf("Regenerate: %v", err)
+	}
+	doc, _ := readStageFile(config.StagePath(tmpDir, config.StageSpecify))
+	data := templates.ParseRequirements(doc)
+	if strings.Contains(data.MustHave, "FR-002") || !requirements.DeferredIDs(doc)["FR-002"] {
+		t.Errorf("FR-002 should stay deferred after regenerating:\n%s", doc)
+	}
+	if !strings.Contains(data.MustHave, "FR-001") || !strings.Contains(data.NonFunctional, "NFR-001") {
+		t.Errorf("the other requirements should survive regenerating:\n%s", doc)
+	}
+}
+
+func TestAnalyzeCoverage_IgnoresDeferred(t *testing.T) {
+	doc := "### Must Have\n\n- **FR-001**: Sign up\n\n" +
+		"### Won't Have (this version)\n\n" +
+		"- **FR-002**: Offline mode" + requirements.DeferredNote("v2") + "\n" +
+		"- **FR-003**: Mobile app\n"
+	tasks := "### TASK-001\n**Covers**: FR-001"
+
+	c, err := analyzeCoverage(context.Background(), doc, tasks, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.FRTotal != 2 {
+		t.Errorf("FRTotal = %d, want 2 (the deferred FR-002 is not counted)", c.FRTotal)
+	}
+	if len(c.FRUncovered) != 1 || c.FRUncovered[0] != "FR-003" {
+		t.Errorf("FRUncovered = %v, want [FR-003]", c.FRUncovered)
+	}
+}
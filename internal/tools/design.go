@@ -103,86 +103,117 @@ func (t *DesignTool) Definition() mcp.Tool {
 				"4. **Mitigations**: How the architecture prevents or mitigates each detected smell. "+
 				"Reference Martin Fowler's Refactoring catalog for smell definitions."),
 		),
+		mcp.WithString("design_decisions",
+			mcp.Description("Architecture Decision Records for this design, as markdown. Start each with a "+
+				"'### ADR-NNN: Title' header followed by its status, context, decision and consequences. "+
+				"Example: '### ADR-001: Use PostgreSQL\\n**Status:** Accepted\\n#### Context\\n...\\n#### Decision\\n...'"),
+		),
+		mcp.WithBoolean("split_adrs",
+			mcp.Description("Also write each '### ADR-NNN' block of design_decisions to its own numbered file "+
+				"in docs/adrs/ (e.g. docs/adrs/001-use-postgresql.md). design.md keeps the combined section. "+
+				"Default false."),
+		),
+		referencesParam(),
+		actorParam(),
 	)
 }
 
 // Handle processes the sdd_create_design tool call.
 func (t *DesignTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	archOverview := req.GetString("architecture_overview", "")
-	techStack := req.GetString("tech_stack", "")
-	components := req.GetString("components", "")
-	apiContracts := req.GetString("api_contracts", "")
-	dataModel := req.GetString("data_model", "")
-	infrastructure := req.GetString("infrastructure", "")
-	security := req.GetString("security", "")
-	qualityAnalysis := req.GetString("quality_analysis", "")
-
-	// Validate required fields.
-	if archOverview == "" {
-		return mcp.NewToolResultError("'architecture_overview' is required — describe the system architecture"), nil
-	}
-	if techStack == "" {
-		return mcp.NewToolResultError("'tech_stack' is required — list technology choices with rationale"), nil
-	}
-	if components == "" {
-		return mcp.NewToolResultError("'components' is required — break down the system into components with responsibilities"), nil
+	data := templates.DesignData{
+		ArchitectureOverview: req.GetString("architecture_overview", ""),
+		TechStack:            req.GetString("tech_stack", ""),
+		Components:           req.GetString("components", ""),
+		APIContracts:         req.GetString("api_contracts", ""),
+		DataModel:            req.GetString("data_model", ""),
+		Infrastructure:       req.GetString("infrastructure", ""),
+		Security:             req.GetString("security", ""),
+		DesignDecisions:      req.GetString("design_decisions", ""),
+		QualityAnalysis:      req.GetString("quality_analysis", ""),
 	}
-	if dataModel == "" {
-		return mcp.NewToolResultError("'data_model' is required — define the data schema and relationships"), nil
+	refs, err := requestReferences(req)
+	if err != nil {
+		return toolResult("", err)
 	}
+	data.References = refs
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return stageToolResult(t.run(projectRoot, data, requestActor(ctx, req), req.GetBool("split_adrs", false)))
+}
 
-	cfg, err := t.store.Load(projectRoot)
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+// Run saves the technical design for the project at projectRoot and
+// advances the pipeline. data.Name is ignored — the project's name is used.
+func (t *DesignTool) Run(projectRoot string, data templates.DesignData) (*StageResult, error) {
+	return t.run(projectRoot, data, "", false)
+}
+
+// run is Run with the completed stage attributed to actor. With
+// splitADRs, each ADR in data.DesignDecisions is also written to
+// docs/adrs/.
+func (t *DesignTool) run(projectRoot string, data templates.DesignData, actor string, splitADRs bool) (*StageResult, error) {
+	// Validate required fields — before the load error, so a bad call is
+	// reported as such even outside a project.
+	cfg, loadErr := t.store.Load(projectRoot)
+	if err := checkRequiredFields(cfg, config.StageDesign, map[string]string{
+		"architecture_overview": data.ArchitectureOverview,
+		"tech_stack":            data.TechStack,
+		"components":            data.Components,
+		"api_contracts":         data.APIContracts,
+		"data_model":            data.DataModel,
+		"infrastructure":        data.Infrastructure,
+		"security":              data.Security,
+		"design_decisions":      data.DesignDecisions,
+		"quality_analysis":      data.QualityAnalysis,
+	}); err != nil {
+		return nil, err
+	}
+	if err := validateReferences(data.References); err != nil {
+		return nil, err
+	}
+	if loadErr != nil {
+		return nil, asUserError(loadErr)
 	}
 
 	// Validate we're at the right stage.
 	if err := pipeline.RequireStage(cfg, config.StageDesign); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
 	}
 
-	// Verify requirements and clarifications exist.
-	reqPath := config.StagePath(projectRoot, config.StageSpecify)
-	requirements, err := readStageFile(reqPath)
-	if err != nil {
-		return nil, fmt.Errorf("reading requirements: %w", err)
-	}
-	if requirements == "" {
-		return mcp.NewToolResultError("requirements.md is empty — the specify stage must be completed first"), nil
+	// Verify prerequisite artifacts exist.
+	if err := pipeline.RequireArtifacts(projectRoot, pipeline.PrerequisiteArtifacts(cfg, config.StageDesign)...); err != nil {
+		return nil, asUserError(err)
 	}
 
 	pipeline.MarkInProgress(cfg)
 
+	nudge := recommendedFieldsNote(cfg, config.StageDesign, map[string]string{
+		"api_contracts":    data.APIContracts,
+		"infrastructure":   data.Infrastructure,
+		"security":         data.Security,
+		"quality_analysis": data.QualityAnalysis,
+	})
+	diagrams := mermaidNote(map[string]string{
+		"architecture_overview": data.ArchitectureOverview,
+		"components":            data.Components,
+	})
+
 	// Fill optional fields with defaults.
-	if apiContracts == "" {
-		apiContracts = "_No API contracts defined — this project does not expose an API._"
-	}
-	if infrastructure == "" {
-		infrastructure = "_Not yet defined._"
+	data.Name = cfg.Name
+	data.Attribution = attributionFor(cfg)
+	if data.APIContracts == "" {
+		data.APIContracts = "_No API contracts defined — this project does not expose an API._"
 	}
-	if security == "" {
-		security = "_Not yet defined._"
+	if data.Infrastructure == "" {
+		data.Infrastructure = "_Not yet defined._"
 	}
-	if qualityAnalysis == "" {
-		qualityAnalysis = "_No structural quality analysis provided._"
+	if data.Security == "" {
+		data.Security = "_Not yet defined._"
 	}
-
-	// Build design document with REAL content from the AI.
-	data := templates.DesignData{
-		Name:                 cfg.Name,
-		ArchitectureOverview: archOverview,
-		TechStack:            techStack,
-		Components:           components,
-		APIContracts:         apiContracts,
-		DataModel:            dataModel,
-		Infrastructure:       infrastructure,
-		Security:             security,
-		QualityAnalysis:      qualityAnalysis,
+	if data.QualityAnalysis == "" {
+		data.QualityAnalysis = "_No structural quality analysis provided._"
 	}
 
 	// Render and write via shared function (ADR-001).
@@ -191,8 +222,24 @@ func (t *DesignTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 		return nil, err
 	}
 
+	adrSection := ""
+	if splitADRs {
+		written, warnings, err := writeDesignADRs(projectRoot, parseDesignADRs(data.DesignDecisions))
+		if err != nil {
+			return nil, err
+		}
+		adrSection = formatDesignADRs(written, warnings)
+	}
+
+	requirements, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return nil, fmt.Errorf("reading requirements: %w", err)
+	}
+	recordSourceHash(cfg, config.StageDesign, config.StageSpecify, requirements)
+	recordReferences(cfg, config.StageDesign, data.References)
+
 	// Advance pipeline to next stage.
-	if err := pipeline.Advance(cfg); err != nil {
+	if err := pipeline.AdvanceBy(cfg, actor); err != nil {
 		return nil, fmt.Errorf("advancing pipeline: %w", err)
 	}
 
@@ -215,6 +262,11 @@ func (t *DesignTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 			"Call `sdd_create_tasks` with the task breakdown.",
 		content,
 	)
+	response += adrSection
+	response += scopeCreepSection(projectRoot, content)
+	response += nudge
+	response += diagrams
+	response += wordBudgetNote(cfg, config.StageDesign, content)
 
-	return mcp.NewToolResultText(response), nil
+	return &StageResult{Content: content, Config: cfg, Response: response}, nil
 }
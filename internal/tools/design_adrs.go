@@ -0,0 +1,166 @@
+package tools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+)
+
+// designADRHeader matches an ADR block header in design_decisions:
+// "### ADR-003: Use PostgreSQL" (the separator may also be a dash).
+var designADRHeader = regexp.MustCompile(`^###\s+ADR-(\d+)\s*(?:[:—–-]\s*)?(.*?)\s*$`)
+
+// designADR is one "### ADR-NNN" block of design_decisions.
+type designADR struct {
+	Num   int
+	Title string
+	Body  string // everything under the header, verbatim
+}
+
+// ID returns the ADR's identifier, e.g. "ADR-003".
+func (a designADR) ID() string { return fmt.Sprintf("ADR-%03d", a.Num) }
+
+// parseDesignADRs splits design_decisions on its "### ADR-" headers.
+// A block ends at the next "###" or higher heading; text before the
+// first header is ignored.
+func parseDesignADRs(decisions string) []designADR {
+	var adrs []designADR
+	var current *designADR
+	var body []string
+	flush := func() {
+		if current != nil {
+			current.Body = strings.TrimSpace(strings.Join(body, "\n"))
+			adrs = append(adrs, *current)
+		}
+		current, body = nil, nil
+	}
+
+	inFence := false
+	for _, line := range strings.Split(pipeline.NormalizeNewlines(decisions), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+		}
+		if !inFence {
+			if m := designADRHeader.FindStringSubmatch(line); m != nil {
+				flush()
+				num, _ := strconv.Atoi(m[1])
+				current = &designADR{Num: num, Title: m[2]}
+				continue
+			}
+			if isHeadingAtMost(line, 3) {
+				flush()
+				continue
+			}
+		}
+		if current != nil {
+			body = append(body, line)
+		}
+	}
+	flush()
+	return adrs
+}
+
+// isHeadingAtMost reports whether line is a markdown heading of level
+// 1 through level.
+func isHeadingAtMost(line string, level int) bool {
+	hashes := len(line) - len(strings.TrimLeft(line, "#"))
+	return hashes >= 1 && hashes <= level && strings.HasPrefix(line[hashes:], " ")
+}
+
+// promoteHeadings raises headings of level 4 and deeper by two levels,
+// so an ADR's "#### Context" reads as "## Context" in its own file.
+func promoteHeadings(body string) string {
+	lines := strings.Split(body, "\n")
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+		}
+		if !inFence && strings.HasPrefix(line, "####") {
+			lines[i] = line[2:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// writeDesignADRs writes each ADR block to docs/adrs/NNN-slug.md,
+// numbered like sdd_adr's files so both share one sequence. A file for
+// the same number with a different slug belongs to another ADR and is
+// never overwritten. It returns the written paths, relative to the
+// project root, and a warning per skipped ADR.
+func writeDesignADRs(projectRoot string, adrs []designADR) (written, warnings []string, err error) {
+	adrsDir := config.ADRsPath(projectRoot)
+	if err := os.MkdirAll(adrsDir, 0o755); err != nil {
+		return nil, nil, fmt.Errorf("creating adrs directory: %w", err)
+	}
+	existing := listADRFiles(adrsDir)
+
+	seen := make(map[int]bool)
+	for _, adr := range adrs {
+		if seen[adr.Num] {
+			warnings = append(warnings, fmt.Sprintf("%s appears more than once — only the first was written", adr.ID()))
+			continue
+		}
+		seen[adr.Num] = true
+
+		title := adr.Title
+		if title == "" {
+			title = adr.ID()
+		}
+		filename := fmt.Sprintf("%03d-%s.md", adr.Num, slugifyTitle(title))
+		if clash := adrFileForNumber(existing, adr.Num); clash != "" && clash != filename {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s was not written: `docs/adrs/%s` already uses that number", adr.ID(), clash))
+			continue
+		}
+
+		var content strings.Builder
+		fmt.Fprintf(&content, "# %s\n\n", title)
+		fmt.Fprintf(&content, "**ID:** %s\n", adr.ID())
+		content.WriteString("**Source:** `docs/design.md` (Design Decisions)\n\n")
+		if adr.Body != "" {
+			content.WriteString(promoteHeadings(adr.Body) + "\n")
+		}
+
+		path := filepath.Join(adrsDir, filename)
+		if err := writeStageFile(path, content.String()); err != nil {
+			return written, warnings, fmt.Errorf("writing %s: %w", adr.ID(), err)
+		}
+		written = append(written, filepathRel(projectRoot, path))
+	}
+	return written, warnings, nil
+}
+
+// adrFileForNumber returns the ADR filename numbered num, or "".
+func adrFileForNumber(files []string, num int) string {
+	prefix := fmt.Sprintf("%03d-", num)
+	for _, f := range files {
+		if strings.HasPrefix(f, prefix) {
+			return f
+		}
+	}
+	return ""
+}
+
+// formatDesignADRs renders the ADR split outcome for the design response.
+func formatDesignADRs(written, warnings []string) string {
+	var sb strings.Builder
+	sb.WriteString("\n\n## ADR Files\n\n")
+	if len(written) == 0 && len(warnings) == 0 {
+		sb.WriteString("_No `### ADR-NNN` headers found in design_decisions — nothing was split out._\n")
+		return sb.String()
+	}
+	for _, path := range written {
+		fmt.Fprintf(&sb, "- `%s`\n", path)
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(&sb, "- ⚠️ %s\n", w)
+	}
+	return sb.String()
+}
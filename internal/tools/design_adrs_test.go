@@ -0,0 +1,120 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const designDecisions = `Decisions made for v1.
+
+### ADR-001: Use PostgreSQL
+**Status:** Accepted
+
+#### Context
+We need ACID transactions.
+
+#### Decision
+PostgreSQL 16.
+
+### ADR-002 — Monolith first
+**Status:** Proposed
+
+` + "```md\n### ADR-999: not a header inside a fence\n```\n" + `
+## Unrelated section
+Trailing text.
+`
+
+func TestParseDesignADRs(t *testing.T) {
+	adrs := parseDesignADRs(designDecisions)
+	if len(adrs) != 2 {
+		t.Fatalf("got %d ADRs, want 2: %+v", len(adrs), adrs)
+	}
+	if adrs[0].ID() != "ADR-001" || adrs[0].Title != "Use PostgreSQL" {
+		t.Errorf("first ADR = %s %q", adrs[0].ID(), adrs[0].Title)
+	}
+	if !strings.Contains(adrs[0].Body, "#### Decision\nPostgreSQL 16.") || strings.Contains(adrs[0].Body, "Monolith") {
+		t.Errorf("first ADR body = %q", adrs[0].Body)
+	}
+	if adrs[1].Title != "Monolith first" || !strings.Contains(adrs[1].Body, "ADR-999") || strings.Contains(adrs[1].Body, "Trailing") {
+		t.Errorf("second ADR = %q / %q", adrs[1].Title, adrs[1].Body)
+	}
+}
+
+func TestDesignTool_Handle_SplitADRs(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+
+	writeDesignPrerequisites(t, tmpDir, "# Requirements\n\n- FR-001: Users can sign up")
+	adrsDir := config.ADRsPath(tmpDir)
+	if err := os.MkdirAll(adrsDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	// An ADR captured earlier with sdd_adr owns number 002.
+	if err := os.WriteFile(filepath.Join(adrsDir, "002-use-grpc.md"), []byte("# Use gRPC\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"architecture_overview": "Modular monolith",
+		"tech_stack":            "- Go",
+		"components":            "### API",
+		"data_model":            "### User",
+		"design_decisions":      designDecisions,
+		"split_adrs":            true,
+	}
+	result, err := NewDesignTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), req)
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "- `docs/adrs/001-use-postgresql.md`") ||
+		!strings.Contains(text, "ADR-002 was not written: `docs/adrs/002-use-grpc.md` already uses that number") {
+		t.Errorf("response should list written and skipped ADRs:\n%s", text)
+	}
+
+	adr, err := os.ReadFile(filepath.Join(adrsDir, "001-use-postgresql.md"))
+	if err != nil {
+		t.Fatalf("ADR-001 file: %v", err)
+	}
+	for _, want := range []string{"# Use PostgreSQL\n", "**ID:** ADR-001", "**Status:** Accepted", "## Context\nWe need ACID", "## Decision\nPostgreSQL 16."} {
+		if !strings.Contains(string(adr), want) {
+			t.Errorf("ADR file missing %q:\n%s", want, adr)
+		}
+	}
+	if got, _ := os.ReadFile(filepath.Join(adrsDir, "002-use-grpc.md")); string(got) != "# Use gRPC\n" {
+		t.Errorf("existing ADR-002 was overwritten: %q", got)
+	}
+
+	design, _ := readStageFile(config.StagePath(tmpDir, config.StageDesign))
+	if !strings.Contains(design, "## Design Decisions") || !strings.Contains(design, "### ADR-002 — Monolith first") {
+		t.Errorf("design.md should keep the combined decisions:\n%s", design)
+	}
+}
+
+func TestDesignTool_Run_NoSplitByDefault(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+
+	writeDesignPrerequisites(t, tmpDir, "# Requirements\n\n- FR-001: Users can sign up")
+	tool := NewDesignTool(config.NewFileStore(), mustRenderer(t))
+	if _, err := tool.Run(tmpDir, templates.DesignData{
+		ArchitectureOverview: "Modular monolith",
+		TechStack:            "- Go",
+		Components:           "### API",
+		DataModel:            "### User",
+		DesignDecisions:      designDecisions,
+	}); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if _, err := os.Stat(config.ADRsPath(tmpDir)); !os.IsNotExist(err) {
+		t.Errorf("docs/adrs should not be created without split_adrs (stat err: %v)", err)
+	}
+}
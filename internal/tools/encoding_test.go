@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// mojibakeSequences are what UTF-8 text turns into after being decoded as
+// Windows-1252 or Mac Roman and re-encoded: "—" becomes "â€”", "←"
+// becomes "‚Üê", emoji start with "ðŸ". U+FFFD marks bytes that were
+// not valid UTF-8 at all.
+var mojibakeSequences = []string{"�", "â€", "Ã", "Â", "ðŸ", "âœ", "â†", "‚Ä", "‚Ü"}
+
+// mojibakeAllowed lists files that contain the sequences on purpose.
+var mojibakeAllowed = map[string]bool{
+	"internal/tools/encoding_test.go": true,
+	"internal/doctor/doctor.go":       true, // detects mojibake in hoofy.json
+	"internal/doctor/doctor_test.go":  true,
+}
+
+func assertCleanUTF8(t *testing.T, name, s string) {
+	t.Helper()
+	if !utf8.ValidString(s) {
+		t.Errorf("%s is not valid UTF-8: %q", name, s)
+		return
+	}
+	for _, seq := range mojibakeSequences {
+		if strings.Contains(s, seq) {
+			t.Errorf("%s contains mojibake %q: %q", name, seq, s)
+		}
+	}
+}
+
+func TestOutputStrings_NoMojibake(t *testing.T) {
+	for _, status := range []string{"completed", "in_progress", "skipped", "pending"} {
+		assertCleanUTF8(t, "statusIndicator("+status+")", statusIndicator(status))
+	}
+	assertCleanUTF8(t, "currentMarker", currentMarker(false))
+	assertCleanUTF8(t, "formatTimestamp(\"\")", formatTimestamp(""))
+
+	_, err := config.NewFileStore().Load(t.TempDir())
+	if err == nil {
+		t.Fatal("Load of an empty dir should fail")
+	}
+	assertCleanUTF8(t, "not-initialized error", err.Error())
+	if !strings.Contains(err.Error(), "not initialized — run") {
+		t.Errorf("not-initialized error lost its em dash: %q", err.Error())
+	}
+}
+
+func TestSourceFiles_NoMojibake(t *testing.T) {
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name := d.Name(); name == ".git" || name == "node_modules" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch filepath.Ext(path) {
+		case ".go", ".md", ".tmpl":
+		default:
+			return nil
+		}
+		rel, _ := filepath.Rel(root, path)
+		rel = filepath.ToSlash(rel)
+		if mojibakeAllowed[rel] {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for i, line := range strings.Split(string(data), "\n") {
+			assertCleanUTF8(t, fmt.Sprintf("%s:%d", rel, i+1), line)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/clarify"
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// EstimateClarityTool handles the sdd_estimate_clarity MCP tool.
+// It scores requirements.md against the Clarity Gate dimensions with
+// deterministic heuristics (clarify.Heuristics), so the AI starts its
+// assessment from evidence rather than a blank page.
+//
+// Design: advisory and read-only. The estimate never advances the
+// pipeline; only sdd_clarify's dimension_scores count toward the gate.
+type EstimateClarityTool struct {
+	store config.Store
+}
+
+// NewEstimateClarityTool creates an EstimateClarityTool with its dependencies.
+func NewEstimateClarityTool(store config.Store) *EstimateClarityTool {
+	return &EstimateClarityTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *EstimateClarityTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_estimate_clarity",
+		mcp.WithDescription(
+			"Estimate the Clarity Gate dimension scores of requirements.md with deterministic heuristics: "+
+				"requirement counts, missing IDs, vague words ('some', 'fast', 'user-friendly'), "+
+				"measurable NFRs, explicit Won't Haves and domain keywords. "+
+				"Returns suggested per-dimension scores with their evidence, plus a ready-to-edit "+
+				"'dimension_scores' value for sdd_clarify. Advisory only: refine the scores with your "+
+				"own judgment — the estimate does not pass the gate. Read-only. "+
+				"Requires: sdd_generate_requirements must have been run first.",
+		),
+	)
+}
+
+// Handle processes the sdd_estimate_clarity tool call.
+func (t *EstimateClarityTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot))
+}
+
+// process estimates the clarity dimensions and renders the report.
+func (t *EstimateClarityTool) process(projectRoot string) (string, error) {
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if err := pipeline.RequireArtifacts(projectRoot, config.StageSpecify); err != nil {
+		return "", asUserError(err)
+	}
+	doc, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return "", fmt.Errorf("reading requirements: %w", err)
+	}
+
+	dims := pipeline.ApplyWeights(clarify.Heuristics(doc), cfg.DimensionWeights)
+	score := pipeline.ProjectScore(cfg, dims)
+	threshold := pipeline.ProjectClarityThreshold(cfg)
+
+	var sb strings.Builder
+	sb.WriteString("# Clarity Estimate (heuristic)\n\n")
+	fmt.Fprintf(&sb, "**Estimated score:** %d/100 | **Threshold:** %d/100 (%s mode)\n\n", score, threshold, cfg.Mode)
+	sb.WriteString("_Advisory only — these scores come from keyword and structure checks, not understanding. " +
+		"Refine them before passing them to `sdd_clarify`._\n\n")
+
+	sb.WriteString("| Dimension | Weight | Suggested | Evidence |\n|-----------|--------|-----------|----------|\n")
+	for _, d := range dims {
+		fmt.Fprintf(&sb, "| %s | %d | %d | %s |\n",
+			d.Name, d.Weight, d.Score, tableCell(strings.TrimPrefix(d.Justification, "heuristic: ")))
+	}
+
+	if vague := clarify.FindVagueTerms(doc); len(vague) > 0 {
+		sb.WriteString("\n## Vague Wording\n\n")
+		sb.WriteString("Replace these with something testable (a number, a named role, an explicit list):\n\n")
+		for _, v := range vague {
+			fmt.Fprintf(&sb, "- **%s**: %q\n", v.ID, v.Word)
+		}
+	}
+
+	pairs := make([]string, 0, len(dims))
+	for _, d := range dims {
+		pairs = append(pairs, fmt.Sprintf("%s:%d|%s", d.Name, d.Score, d.Justification))
+	}
+	sb.WriteString("\n## Suggested dimension_scores\n\n")
+	sb.WriteString("Adjust after reviewing the requirements and the user's answers:\n\n")
+	fmt.Fprintf(&sb, "```\n%s\n```\n", strings.Join(pairs, ","))
+	return sb.String(), nil
+}
@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestEstimateClarityTool_Handle(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+
+	doc := "# Shop — Requirements\n\n## Functional Requirements\n\n### Must Have\n\n" +
+		"- **FR-001**: Customers can log in with a password\n" +
+		"- **FR-002**: Checkout should be fast\n\n" +
+		"## Non-Functional Requirements\n\n- **NFR-001**: Checkout responds in under 300 ms\n"
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), doc); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	before, _ := store.Load(tmpDir)
+	result, err := NewEstimateClarityTool(store).Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"# Clarity Estimate (heuristic)",
+		"**Threshold:** 70/100 (guided mode)",
+		"| core_functionality | 10 | 25 | 2 functional requirement(s) with IDs; 1 vague term(s) in FRs |",
+		"- **FR-002**: \"fast\"",
+		"## Suggested dimension_scores",
+		"scale_performance:",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("output missing %q:\n%s", want, text)
+		}
+	}
+
+	// The suggestion must be a valid sdd_clarify dimension_scores value.
+	_, block, _ := strings.Cut(text, "```\n")
+	block, _, _ = strings.Cut(block, "\n```")
+	if n := parseDimensionScores(block, pipeline.DefaultDimensions()); n != 8 {
+		t.Errorf("suggested dimension_scores matched %d dimensions, want 8: %s", n, block)
+	}
+
+	after, _ := store.Load(tmpDir)
+	if after.CurrentStage != before.CurrentStage || after.ClarityScore != before.ClarityScore {
+		t.Error("the estimate must not change pipeline state")
+	}
+}
+
+func TestEstimateClarityTool_RequiresRequirements(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+
+	result, err := NewEstimateClarityTool(config.NewFileStore()).Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !isErrorResult(result) {
+		t.Fatalf("expected an error without requirements.md, got %s", getResultText(result))
+	}
+}
@@ -72,37 +72,75 @@ var exploreCategories = []string{
 
 // Handle processes the sdd_explore tool call.
 func (t *ExploreTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	title := strings.TrimSpace(req.GetString("title", ""))
-	if title == "" {
-		return mcp.NewToolResultError("'title' is required"), nil
+	params := exploreParams{
+		Title:       strings.TrimSpace(req.GetString("title", "")),
+		Goals:       strings.TrimSpace(req.GetString("goals", "")),
+		Constraints: strings.TrimSpace(req.GetString("constraints", "")),
+		Preferences: strings.TrimSpace(req.GetString("preferences", "")),
+		Unknowns:    strings.TrimSpace(req.GetString("unknowns", "")),
+		Decisions:   strings.TrimSpace(req.GetString("decisions", "")),
+		Context:     strings.TrimSpace(req.GetString("context", "")),
+		Project:     req.GetString("project", ""),
+		Scope:       req.GetString("scope", "project"),
+		SessionID:   req.GetString("session_id", "manual-save"),
+	}
+	return toolResult(t.process(params))
+}
+
+// exploreParams are the parsed sdd_explore arguments.
+type exploreParams struct {
+	Title       string
+	Goals       string
+	Constraints string
+	Preferences string
+	Unknowns    string
+	Decisions   string
+	Context     string
+	Project     string
+	Scope       string
+	SessionID   string
+}
+
+// exploreParamSpecs declares the sdd_explore argument checks; the
+// context fields are checked together by hasAnyContent.
+var exploreParamSpecs = []paramSpec{
+	{name: "title", required: true},
+}
+
+// process saves the exploration context, merging it into any earlier
+// observation with the same topic.
+func (t *ExploreTool) process(params exploreParams) (string, error) {
+	title := params.Title
+	if err := validateParams(exploreParamSpecs, map[string]string{"title": title}); err != nil {
+		return "", err
 	}
 
 	// Collect content sections from parameters.
 	incoming := map[string]string{
-		"Goals":       strings.TrimSpace(req.GetString("goals", "")),
-		"Constraints": strings.TrimSpace(req.GetString("constraints", "")),
-		"Preferences": strings.TrimSpace(req.GetString("preferences", "")),
-		"Unknowns":    strings.TrimSpace(req.GetString("unknowns", "")),
-		"Decisions":   strings.TrimSpace(req.GetString("decisions", "")),
-		"Context":     strings.TrimSpace(req.GetString("context", "")),
+		"Goals":       params.Goals,
+		"Constraints": params.Constraints,
+		"Preferences": params.Preferences,
+		"Unknowns":    params.Unknowns,
+		"Decisions":   params.Decisions,
+		"Context":     params.Context,
 	}
 
 	if !hasAnyContent(incoming) {
-		return mcp.NewToolResultError(
+		return "", newUserError(
 			"At least one context field (goals, constraints, preferences, unknowns, decisions, context) is required",
-		), nil
+		)
 	}
 
-	project := req.GetString("project", "")
-	scope := req.GetString("scope", "project")
-	sessionID := req.GetString("session_id", "manual-save")
+	project := params.Project
+	scope := params.Scope
+	sessionID := params.SessionID
 
 	topicKey := memory.SuggestTopicKey("explore", title, "")
 
 	// Check for existing observation to merge with.
 	existing, err := t.store.FindByTopicKey(topicKey, project, scope)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to check existing context: %v", err)), nil
+		return "", newUserError(fmt.Sprintf("failed to check existing context: %v", err))
 	}
 
 	action := "Created"
@@ -125,7 +163,7 @@ func (t *ExploreTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp
 		TopicKey:  topicKey,
 	})
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("failed to save exploration context: %v", err)), nil
+		return "", newUserError(fmt.Sprintf("failed to save exploration context: %v", err))
 	}
 
 	// Build response.
@@ -151,7 +189,7 @@ func (t *ExploreTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp
 	fmt.Fprintf(&sb, "- **Suggested type:** %s — %s\n", sugType, reasoning)
 	fmt.Fprintf(&sb, "- **Suggested size:** %s\n", sugSize)
 
-	return mcp.NewToolResultText(sb.String()), nil
+	return sb.String(), nil
 }
 
 // ─── Private Helpers ────────────────────────────────────────────────────────
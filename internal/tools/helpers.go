@@ -10,15 +10,21 @@
 package tools
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
 )
 
 // findProjectRoot walks up from the current working directory looking
-// for an existing docs/hoofy.json (or docs/specs/hoofy.json fallback).
+// for an existing docs/hoofy.json (or docs/specs/hoofy.json fallback,
+// or the SDD_DIR override — see config.DocsDirCandidates).
 // If none is found, returns cwd.
 // This allows tools to work from any subdirectory of the project.
 func findProjectRoot() (string, error) {
@@ -27,17 +33,13 @@ func findProjectRoot() (string, error) {
 		return "", fmt.Errorf("getting working directory: %w", err)
 	}
 
-	// Walk up looking for docs/hoofy.json or docs/specs/hoofy.json
+	// Walk up looking for hoofy.json in any candidate docs directory.
 	current := dir
 	for {
-		primary := filepath.Join(current, config.DocsDir, config.ConfigFile)
-		if _, err := os.Stat(primary); err == nil {
-			return current, nil
-		}
-
-		fallback := filepath.Join(current, config.DocsDir, config.DocsDirFallback, config.ConfigFile)
-		if _, err := os.Stat(fallback); err == nil {
-			return current, nil
+		for _, candidate := range config.DocsDirCandidates() {
+			if config.HasConfig(filepath.Join(current, candidate)) {
+				return current, nil
+			}
 		}
 
 		parent := filepath.Dir(current)
@@ -50,26 +52,134 @@ func findProjectRoot() (string, error) {
 	}
 }
 
+// FileOptions controls how artifacts are written to disk.
+// The zero value writes LF line endings with mode 0644.
+type FileOptions struct {
+	// LineEndings is config.LineEndingsLF (default) or config.LineEndingsCRLF.
+	LineEndings string
+	// Mode is the permission applied to written files. Zero means 0644.
+	// A non-zero mode is applied explicitly, so it is not narrowed by umask.
+	Mode os.FileMode
+}
+
+// fileOptions is the write configuration installed by SetFileOptions.
+var fileOptions FileOptions
+
+// SetFileOptions installs the line-ending and permission settings used
+// by every artifact write. Intended to be called once at startup.
+func SetFileOptions(opts FileOptions) { fileOptions = opts }
+
+// FileOptionsFromDefaults builds FileOptions from the SDD_* env defaults.
+func FileOptionsFromDefaults(d config.Defaults) FileOptions {
+	return FileOptions{LineEndings: d.LineEndings, Mode: d.FileMode}
+}
+
 // readStageFile reads the content of a stage's markdown artifact.
 // Returns empty string if the file doesn't exist (not an error —
-// the stage just hasn't been completed yet).
+// the stage just hasn't been completed yet). See
+// pipeline.ReadArtifactFile for the normalization applied.
 func readStageFile(path string) (string, error) {
-	data, err := os.ReadFile(path)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return "", nil
-		}
-		return "", fmt.Errorf("reading %s: %w", path, err)
-	}
-	return string(data), nil
+	return pipeline.ReadArtifactFile(path)
+}
+
+// readStageArtifacts reads every file of a stage's artifact — the stage
+// file, then the split parts cfg records — joined by a blank line (see
+// pipeline.ReadArtifact).
+func readStageArtifacts(projectRoot string, cfg *config.ProjectConfig, stage config.Stage) (string, error) {
+	return pipeline.ReadArtifact(projectRoot, cfg, stage)
 }
 
 // writeStageFile writes content to a stage's markdown artifact,
-// creating parent directories as needed.
+// creating parent directories as needed. Line endings and file mode
+// follow the installed FileOptions. Transient write failures are
+// retried (see config.WriteFile).
 func writeStageFile(path, content string) error {
 	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return fmt.Errorf("creating directory %s: %w", dir, err)
 	}
-	return os.WriteFile(path, []byte(content), 0o644)
+
+	content = pipeline.NormalizeNewlines(content)
+	if fileOptions.LineEndings == config.LineEndingsCRLF {
+		content = strings.ReplaceAll(content, "\n", "\r\n")
+	}
+
+	mode := fileOptions.Mode
+	if mode == 0 {
+		return config.WriteFile(path, []byte(content), 0o644)
+	}
+	if err := config.WriteFile(path, []byte(content), mode); err != nil {
+		return err
+	}
+	return os.Chmod(path, mode)
+}
+
+// copyStageFile streams a stage's markdown artifact to w with line
+// endings normalized as readStageFile does, without holding the whole
+// file in memory — for bundling many artifacts at once. A missing file
+// copies nothing and is not an error.
+func copyStageFile(w io.Writer, path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("reading %s: %w", path, err)
+	}
+	defer f.Close()
+
+	// Skip a UTF-8 BOM, as config.StripBOM does for whole-file reads.
+	br := bufio.NewReader(f)
+	if head, _ := br.Peek(3); bytes.Equal(head, []byte("\xef\xbb\xbf")) {
+		_, _ = br.Discard(3)
+	}
+	n, err := io.Copy(w, &lfReader{r: br})
+	if err != nil {
+		return n, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return n, nil
+}
+
+// statStageFile returns the size and line count of a stage's artifact
+// as readStageFile would return it, streaming rather than loading it.
+// A missing file has size 0.
+func statStageFile(path string) (size, lines int, err error) {
+	var c lineCounter
+	n, err := copyStageFile(&c, path)
+	return int(n), c.lines, err
+}
+
+// lfReader converts CRLF and lone CR line endings to LF as it reads,
+// carrying a trailing CR across reads.
+type lfReader struct {
+	r  io.Reader
+	cr bool // the previous read ended with a CR, already emitted as LF
+}
+
+func (l *lfReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	out := 0
+	for _, b := range p[:n] {
+		if l.cr {
+			l.cr = false
+			if b == '\n' {
+				continue
+			}
+		}
+		if b == '\r' {
+			b = '\n'
+			l.cr = true
+		}
+		p[out] = b
+		out++
+	}
+	return out, err
+}
+
+// lineCounter is an io.Writer that counts the LF bytes written to it.
+type lineCounter struct{ lines int }
+
+func (c *lineCounter) Write(p []byte) (int, error) {
+	c.lines += bytes.Count(p, []byte{'\n'})
+	return len(p), nil
 }
@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+func TestWriteStageFile_CRLFRoundTrip(t *testing.T) {
+	SetFileOptions(FileOptions{LineEndings: config.LineEndingsCRLF})
+	t.Cleanup(func() { SetFileOptions(FileOptions{}) })
+
+	path := filepath.Join(t.TempDir(), "docs", "requirements.md")
+	// Mixed input: writes must not double up existing CRs.
+	if err := writeStageFile(path, "# Title\r\n\n- FR-001: Sign up\n"); err != nil {
+		t.Fatalf("writeStageFile: %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "# Title\r\n\r\n- FR-001: Sign up\r\n"; string(raw) != want {
+		t.Errorf("on-disk content = %q, want %q", raw, want)
+	}
+
+	got, err := readStageFile(path)
+	if err != nil {
+		t.Fatalf("readStageFile: %v", err)
+	}
+	if want := "# Title\n\n- FR-001: Sign up\n"; got != want {
+		t.Errorf("readStageFile = %q, want LF-normalized %q", got, want)
+	}
+}
+
+func TestWriteStageFile_Defaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "design.md")
+	if err := writeStageFile(path, "a\r\nb\n"); err != nil {
+		t.Fatalf("writeStageFile: %v", err)
+	}
+	raw, _ := os.ReadFile(path)
+	if string(raw) != "a\nb\n" {
+		t.Errorf("default writes should use LF, got %q", raw)
+	}
+}
+
+func TestWriteStageFile_Mode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("POSIX permissions not supported on Windows")
+	}
+	SetFileOptions(FileOptions{Mode: 0o664})
+	t.Cleanup(func() { SetFileOptions(FileOptions{}) })
+
+	path := filepath.Join(t.TempDir(), "tasks.md")
+	if err := writeStageFile(path, "content"); err != nil {
+		t.Fatalf("writeStageFile: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o664 {
+		t.Errorf("mode = %#o, want 0664 regardless of umask", info.Mode().Perm())
+	}
+}
+
+func TestCopyStageFile_MatchesReadStageFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tasks.md")
+	raw := "\xef\xbb\xbf# Tasks\r\n\r\n- TASK-001\rold mac line\n- TASK-002\r\n"
+	if err := os.WriteFile(path, []byte(raw), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	want, _ := readStageFile(path)
+	if !strings.HasPrefix(want, "# Tasks\n") {
+		t.Fatalf("readStageFile should drop the BOM and CRs, got %q", want)
+	}
+
+	var sb strings.Builder
+	n, err := copyStageFile(&sb, path)
+	if err != nil {
+		t.Fatalf("copyStageFile: %v", err)
+	}
+	if sb.String() != want || int(n) != len(want) {
+		t.Errorf("copyStageFile = %q (%d bytes), want %q", sb.String(), n, want)
+	}
+
+	size, lines, err := statStageFile(path)
+	if err != nil || size != len(want) || lines != strings.Count(want, "\n") {
+		t.Errorf("statStageFile = %d, %d, %v; want %d, %d", size, lines, err, len(want), strings.Count(want, "\n"))
+	}
+
+	if n, err := copyStageFile(&sb, filepath.Join(t.TempDir(), "missing.md")); n != 0 || err != nil {
+		t.Errorf("missing file: copied %d, err %v; want nothing and no error", n, err)
+	}
+}
+
+func TestLFReader_CRLFSplitAcrossReads(t *testing.T) {
+	r := &lfReader{r: iotest.OneByteReader(strings.NewReader("a\r\nb\r\rc\n"))}
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "a\nb\n\nc\n"; string(got) != want {
+		t.Errorf("lfReader = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,306 @@
+package tools
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ImportRequirementsTool handles the sdd_import_requirements MCP tool.
+// Teams moving from a tracker or a spreadsheet paste their requirements
+// as a CSV or markdown table instead of retyping them; each row lands in
+// its MoSCoW bucket and the result goes through sdd_generate_requirements
+// — same stage checks, same requirements.md, same pipeline advance.
+//
+// Design: all-or-nothing. Every bad row is reported at once and nothing
+// is written until the whole table checks out.
+type ImportRequirementsTool struct {
+	specify *SpecifyTool
+}
+
+// NewImportRequirementsTool creates an ImportRequirementsTool that saves
+// through specify.
+func NewImportRequirementsTool(specify *SpecifyTool) *ImportRequirementsTool {
+	return &ImportRequirementsTool{specify: specify}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *ImportRequirementsTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_import_requirements",
+		mcp.WithDescription(
+			"Import requirements from a CSV or markdown table (e.g. a Jira or spreadsheet export) instead of "+
+				"sdd_generate_requirements. The first row names the columns: id, bucket, text and optionally priority. "+
+				"Buckets are must, should, could, wont and nfr; IDs are FR-001 (NFR-001 for nfr). "+
+				"Bad rows are all reported and nothing is saved until the table is clean. "+
+				"Runs at the specify stage and advances the pipeline like sdd_generate_requirements.",
+		),
+		mcp.WithString("table",
+			mcp.Required(),
+			mcp.Description("The table, header row first. CSV example: "+
+				"'id,bucket,text,priority\\nFR-001,must,Users can sign up,High\\nNFR-001,nfr,Pages load in under 2s,'. "+
+				"A markdown table ('| id | bucket | text |') works the same way."),
+		),
+		mcp.WithString("format",
+			mcp.Description("'csv' or 'markdown'. Defaults to markdown when the table starts with '|', CSV otherwise."),
+			mcp.Enum("csv", "markdown"),
+		),
+		actorParam(),
+	)
+}
+
+// importRequirementsParams are the parsed sdd_import_requirements arguments.
+type importRequirementsParams struct {
+	Table  string
+	Format string
+}
+
+// importRequirementsParamSpecs declares the sdd_import_requirements
+// argument checks; the format is checked while parsing the table.
+var importRequirementsParamSpecs = []paramSpec{
+	{name: "table", required: true, hint: "a CSV or markdown table with id, bucket and text columns"},
+}
+
+// Handle processes the sdd_import_requirements tool call.
+func (t *ImportRequirementsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := importRequirementsParams{
+		Table:  req.GetString("table", ""),
+		Format: req.GetString("format", ""),
+	}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return stageToolResult(t.run(projectRoot, params, requestActor(ctx, req)))
+}
+
+// run converts the table and saves it as the project's requirements,
+// attributing the stage to actor.
+func (t *ImportRequirementsTool) run(projectRoot string, params importRequirementsParams, actor string) (*StageResult, error) {
+	if err := validateParams(importRequirementsParamSpecs, map[string]string{"table": params.Table}); err != nil {
+		return nil, err
+	}
+
+	rows, err := parseRequirementsTable(params.Table, params.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.specify.run(projectRoot, importedRequirementsData(rows), actor, false)
+	if err != nil {
+		return nil, err
+	}
+	res.Response = fmt.Sprintf("_Imported %d requirements from the table._\n\n", len(rows)) + res.Response
+	return res, nil
+}
+
+// importedRow is one requirement row of an imported table.
+type importedRow struct {
+	id       string
+	bucket   string // one of requirements.Buckets
+	text     string
+	priority string
+}
+
+// importBuckets maps the accepted bucket spellings to their bucket.
+var importBuckets = map[string]string{
+	"must":           requirements.BucketMust,
+	"must have":      requirements.BucketMust,
+	"should":         requirements.BucketShould,
+	"should have":    requirements.BucketShould,
+	"could":          requirements.BucketCould,
+	"could have":     requirements.BucketCould,
+	"wont":           requirements.BucketWont,
+	"won't":          requirements.BucketWont,
+	"wont have":      requirements.BucketWont,
+	"won't have":     requirements.BucketWont,
+	"nfr":            requirements.BucketNFR,
+	"non functional": requirements.BucketNFR,
+}
+
+// importIDPattern matches a whole requirement ID cell.
+var importIDPattern = regexp.MustCompile(`^(FR|NFR)-\d{3,4}$`)
+
+// parseRequirementsTable reads the rows of a CSV or markdown table.
+// format is "csv", "markdown" or "" to detect it. Every invalid row is
+// collected into a single user error, one line each.
+func parseRequirementsTable(table, format string) ([]importedRow, error) {
+	if format == "" {
+		format = "csv"
+		if strings.HasPrefix(strings.TrimSpace(table), "|") {
+			format = "markdown"
+		}
+	}
+
+	var records [][]string
+	var lines []int
+	var err error
+	switch format {
+	case "csv":
+		records, lines, err = readCSVTable(table)
+	case "markdown":
+		records, lines = readMarkdownTable(table)
+	default:
+		return nil, newUserError(fmt.Sprintf("'format' must be 'csv' or 'markdown' — got: %s", format))
+	}
+	if err != nil {
+		return nil, newUserError(fmt.Sprintf("reading the CSV table: %v", err))
+	}
+	if len(records) < 2 {
+		return nil, newUserError("the table needs a header row and at least one requirement row")
+	}
+
+	cols := map[string]int{}
+	for i, name := range records[0] {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"id", "bucket", "text"} {
+		if _, ok := cols[name]; !ok {
+			return nil, newUserError(fmt.Sprintf(
+				"the header row has no '%s' column — columns are id, bucket, text and optionally priority", name))
+		}
+	}
+	cell := func(record []string, name string) string {
+		i, ok := cols[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []importedRow
+	var problems []string
+	seen := map[string]int{}
+	for n, record := range records[1:] {
+		line := lines[n+1]
+		row := importedRow{
+			id:       strings.ToUpper(cell(record, "id")),
+			text:     cell(record, "text"),
+			priority: cell(record, "priority"),
+		}
+		if row.id == "" && row.text == "" && cell(record, "bucket") == "" {
+			continue
+		}
+
+		rawBucket := cell(record, "bucket")
+		bucket, ok := importBuckets[strings.NewReplacer("_", " ", "-", " ").Replace(strings.ToLower(rawBucket))]
+		var rowProblems []string
+		switch {
+		case !ok:
+			rowProblems = append(rowProblems, fmt.Sprintf("bucket '%s' is not one of must, should, could, wont, nfr", rawBucket))
+		case !importIDPattern.MatchString(row.id):
+			rowProblems = append(rowProblems, fmt.Sprintf("id '%s' is not an FR-001 or NFR-001 style ID", row.id))
+		case bucket == requirements.BucketNFR && !strings.HasPrefix(row.id, "NFR-"):
+			rowProblems = append(rowProblems, fmt.Sprintf("id '%s' is in the nfr bucket but isn't an NFR ID", row.id))
+		case bucket != requirements.BucketNFR && strings.HasPrefix(row.id, "NFR-"):
+			rowProblems = append(rowProblems, fmt.Sprintf("id '%s' is an NFR ID outside the nfr bucket", row.id))
+		}
+		if first, dup := seen[row.id]; dup && row.id != "" {
+			rowProblems = append(rowProblems, fmt.Sprintf("id '%s' already appears on line %d", row.id, first))
+		}
+		if row.text == "" {
+			rowProblems = append(rowProblems, "text is empty")
+		}
+		if len(rowProblems) > 0 {
+			problems = append(problems, fmt.Sprintf("- line %d: %s", line, strings.Join(rowProblems, "; ")))
+			continue
+		}
+		seen[row.id] = line
+		row.bucket = bucket
+		rows = append(rows, row)
+	}
+
+	if len(problems) > 0 {
+		return nil, newUserError(fmt.Sprintf("%d rows can't be imported — fix them and retry:\n%s",
+			len(problems), strings.Join(problems, "\n")))
+	}
+	if len(rows) == 0 {
+		return nil, newUserError("the table has no requirement rows")
+	}
+	return rows, nil
+}
+
+// readCSVTable parses CSV records along with each record's line number.
+// Rows may have fewer cells than the header.
+func readCSVTable(table string) ([][]string, []int, error) {
+	r := csv.NewReader(strings.NewReader(table))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	var records [][]string
+	var lines []int
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return records, lines, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := r.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+}
+
+// readMarkdownTable parses the rows of a markdown table along with each
+// row's line number, skipping the |---| separator. An escaped pipe (\|)
+// stays in its cell.
+func readMarkdownTable(table string) ([][]string, []int) {
+	var records [][]string
+	var lines []int
+	for i, line := range strings.Split(table, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "|") {
+			continue
+		}
+		line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+		cells := strings.Split(strings.ReplaceAll(line, `\|`, "\x00"), "|")
+		separator := true
+		for j, c := range cells {
+			cells[j] = strings.TrimSpace(strings.ReplaceAll(c, "\x00", "|"))
+			if strings.Trim(cells[j], ":-") != "" {
+				separator = false
+			}
+		}
+		if separator {
+			continue
+		}
+		records = append(records, cells)
+		lines = append(lines, i+1)
+	}
+	return records, lines
+}
+
+// importedRequirementsData renders rows as the markdown lists of their
+// buckets, in table order, with any priority after the text.
+func importedRequirementsData(rows []importedRow) templates.RequirementsData {
+	lists := map[string]*strings.Builder{}
+	for _, b := range requirements.Buckets {
+		lists[b] = &strings.Builder{}
+	}
+	for _, row := range rows {
+		fmt.Fprintf(lists[row.bucket], "- **%s**: %s", row.id, row.text)
+		if row.priority != "" {
+			fmt.Fprintf(lists[row.bucket], " _(priority: %s)_", row.priority)
+		}
+		lists[row.bucket].WriteString("\n")
+	}
+	field := func(bucket string) string {
+		return strings.TrimSuffix(lists[bucket].String(), "\n")
+	}
+	return templates.RequirementsData{
+		MustHave:      field(requirements.BucketMust),
+		ShouldHave:    field(requirements.BucketShould),
+		CouldHave:     field(requirements.BucketCould),
+		WontHave:      field(requirements.BucketWont),
+		NonFunctional: field(requirements.BucketNFR),
+	}
+}
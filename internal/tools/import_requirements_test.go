@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+func TestParseRequirementsTable(t *testing.T) {
+	tests := []struct {
+		name   string
+		table  string
+		format string
+		want   []importedRow
+		errs   []string
+	}{
+		{
+			name:  "csv with quoted commas",
+			table: "ID,Bucket,Text,Priority\nfr-001,Must Have,\"Users sign up with email, password\",High\nNFR-001,non-functional,Fast,\n",
+			want: []importedRow{
+				{id: "FR-001", bucket: "Must", text: "Users sign up with email, password", priority: "High"},
+				{id: "NFR-001", bucket: "NFR", text: "Fast"},
+			},
+		},
+		{
+			name:  "markdown detected",
+			table: "| id | bucket | text |\n|----|--------|------|\n| FR-001 | should | Export as CSV \\| PDF |\n",
+			want:  []importedRow{{id: "FR-001", bucket: "Should", text: "Export as CSV | PDF"}},
+		},
+		{
+			name: "bad rows reported together with their lines",
+			table: "id,bucket,text\nFR-001,must,Sign up\nFR-1,must,Log in\nFR-002,maybe,Export\n" +
+				"FR-003,nfr,Fast\nFR-001,should,Again\nFR-004,could,\n",
+			errs: []string{
+				"5 rows can't be imported",
+				"line 3: id 'FR-1' is not an FR-001 or NFR-001 style ID",
+				"line 4: bucket 'maybe' is not one of must, should, could, wont, nfr",
+				"line 5: id 'FR-003' is in the nfr bucket but isn't an NFR ID",
+				"line 6: id 'FR-001' already appears on line 2",
+				"line 7: text is empty",
+			},
+		},
+		{name: "missing column", table: "id,text\nFR-001,Sign up\n", errs: []string{"no 'bucket' column"}},
+		{name: "header only", table: "id,bucket,text\n", errs: []string{"at least one requirement row"}},
+		{name: "unknown format", table: "id,bucket,text\n", format: "xlsx", errs: []string{"'format' must be"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseRequirementsTable(tt.table, tt.format)
+			if len(tt.errs) > 0 {
+				if err == nil || !IsUserError(err) {
+					t.Fatalf("want a user error, got %v", err)
+				}
+				for _, want := range tt.errs {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("error missing %q:\n%v", want, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("rows = %+v, want %+v", rows, tt.want)
+			}
+			for i := range rows {
+				if rows[i] != tt.want[i] {
+					t.Errorf("row %d = %+v, want %+v", i, rows[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestImportRequirementsTool_Run(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), "# Charter\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	tool := NewImportRequirementsTool(NewSpecifyTool(store, mustRenderer(t)))
+
+	table := "id,bucket,text,priority\n" +
+		"FR-001,must,Users can sign up,High\n" +
+		"FR-002,should,Users can export invoices,\n" +
+		"NFR-001,nfr,Pages load in under 2s,\n"
+	res, err := tool.run(tmpDir, importRequirementsParams{Table: table}, "")
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if !strings.Contains(res.Response, "Imported 3 requirements") {
+		t.Errorf("response should report the import:\n%s", res.Response)
+	}
+	doc, _ := readStageFile(config.StagePath(tmpDir, config.StageSpecify))
+	for _, want := range []string{"- **FR-001**: Users can sign up _(priority: High)_", "- **NFR-001**: Pages load in under 2s"} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("requirements.md missing %q:\n%s", want, doc)
+		}
+	}
+	if cfg, _ := store.Load(tmpDir); cfg.CurrentStage == config.StageSpecify {
+		t.Error("a successful import should advance the pipeline")
+	}
+
+	if _, err := tool.run(tmpDir, importRequirementsParams{Table: table}, ""); err == nil || ErrorCodeOf(err) != CodeWrongStage {
+		t.Errorf("importing past the specify stage should fail with %s, got %v", CodeWrongStage, err)
+	}
+}
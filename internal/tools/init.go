@@ -3,11 +3,16 @@ package tools
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/i18n"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
 	"github.com/HendryAvila/Hoofy/internal/templates"
 	"github.com/mark3labs/mcp-go/mcp"
 )
@@ -21,6 +26,7 @@ const agentSectionMarker = "## Hoofy SDD Project"
 type InitTool struct {
 	store    config.Store
 	renderer templates.Renderer
+	defaults config.Defaults
 }
 
 // NewInitTool creates an InitTool with the given config store and template renderer.
@@ -28,6 +34,10 @@ func NewInitTool(store config.Store, renderer templates.Renderer) *InitTool {
 	return &InitTool{store: store, renderer: renderer}
 }
 
+// SetDefaults injects house defaults (typically parsed from SDD_* env vars)
+// used when the caller omits mode or clarity_threshold.
+func (t *InitTool) SetDefaults(d config.Defaults) { t.defaults = d }
+
 // Definition returns the MCP tool definition for registration.
 func (t *InitTool) Definition() mcp.Tool {
 	return mcp.NewTool("sdd_init_project",
@@ -45,41 +55,173 @@ func (t *InitTool) Definition() mcp.Tool {
 			mcp.Description("Brief description of what the project does"),
 		),
 		mcp.WithString("mode",
-			mcp.Description("Interaction mode: 'guided' (step-by-step for non-technical users) or 'expert' (streamlined for developers). Defaults to 'guided'."),
-			mcp.DefaultString("guided"),
+			mcp.Description("Interaction mode: 'guided' (step-by-step for non-technical users) or 'expert' (streamlined for developers). Defaults to 'guided' unless the server sets SDD_DEFAULT_MODE."),
 			mcp.Enum("guided", "expert"),
 		),
+		mcp.WithString("lang",
+			mcp.Description("Language of Hoofy's responses and next-step guidance: 'en' (default) or 'es' (Spanish). "+
+				"Useful in guided mode for users who don't read English. Artifacts are not translated."),
+			mcp.Enum("en", "es"),
+		),
+		mcp.WithString("preset",
+			mcp.Description("Optional project type preset: "+strings.Join(pipeline.PresetNames(), ", ")+". "+
+				"Tunes the Clarity Gate dimension weights for the project type (e.g. libraries weigh "+
+				"scale_performance less, web APIs weigh security more) and adds type-specific spec guidance."),
+		),
+		mcp.WithBoolean("enable_research",
+			mcp.Description("Enable the optional Research stage (sdd_record_research) between Clarify and Design, "+
+				"for projects that need to evaluate libraries or run spikes first. Defaults to false."),
+		),
+		mcp.WithNumber("clarity_threshold",
+			mcp.Description("Optional Clarity Gate threshold (1-100) overriding the mode default (guided 70, expert 50). "+
+				"Falls back to SDD_CLARITY_THRESHOLD when the server sets it."),
+		),
+		mcp.WithString("stage_files",
+			mcp.Description("Optional artifact filename overrides as comma-separated stage=filename pairs, "+
+				"relative to the docs directory. Example: 'specify=spec.md,design=architecture/design.md'. "+
+				"Stages not listed keep their default filenames."),
+		),
+		mcp.WithBoolean("reconstruct",
+			mcp.Description("Rebuild hoofy.json for orphaned artifacts — stage files left in the docs directory "+
+				"after hoofy.json was deleted. The furthest stage with an artifact is marked completed and the "+
+				"pipeline resumes after it. Artifacts are never modified. Ignored when force=true."),
+		),
+		mcp.WithBoolean("force",
+			mcp.Description("Reinitialize an existing project. The current hoofy.json and stage artifacts are moved "+
+				"to docs/history/archive-<timestamp>/ before starting fresh. Changes, ADRs, and history are kept. "+
+				"Defaults to false (init fails if a project already exists)."),
+		),
+		actorParam(),
 	)
 }
 
+// InitParams are the settings for a new SDD project. Zero values fall
+// back to the server's house defaults (SDD_DEFAULT_MODE,
+// SDD_CLARITY_THRESHOLD) and then to the built-in ones.
+type InitParams struct {
+	Name             string
+	Description      string
+	Mode             config.Mode
+	ClarityThreshold int
+	EnableResearch   bool
+	StageFiles       map[config.Stage]string
+	Lang             i18n.Lang
+	Preset           string // project type preset name; "" for none
+	Reconstruct      bool   // rebuild hoofy.json for orphaned artifacts
+	Force            bool   // archive an existing project and start over
+	Actor            string // recorded as the init stage's completed_by; "" is unknown
+}
+
+// initParamSpecs declares the sdd_init_project argument checks; mode
+// and lang are checked by the config and i18n packages.
+var initParamSpecs = []paramSpec{
+	{name: "name", required: true},
+	{name: "description", required: true},
+}
+
 // Handle processes the sdd_init_project tool call.
 func (t *InitTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetString("name", "")
-	description := req.GetString("description", "")
-	modeStr := req.GetString("mode", "guided")
-
-	if name == "" {
-		return mcp.NewToolResultError("'name' is required"), nil
-	}
-	if description == "" {
-		return mcp.NewToolResultError("'description' is required"), nil
+	stageFiles, err := config.ParseStageFiles(req.GetString("stage_files", ""))
+	if err != nil {
+		return toolResult("", asUserError(err))
 	}
-
-	mode := config.Mode(modeStr)
-	if mode != config.ModeGuided && mode != config.ModeExpert {
-		return mcp.NewToolResultError("'mode' must be 'guided' or 'expert'"), nil
+	params := InitParams{
+		Name:             req.GetString("name", ""),
+		Description:      req.GetString("description", ""),
+		Mode:             config.Mode(req.GetString("mode", "")),
+		ClarityThreshold: intArgTools(req, "clarity_threshold", 0),
+		EnableResearch:   req.GetBool("enable_research", false),
+		StageFiles:       stageFiles,
+		Lang:             i18n.Lang(req.GetString("lang", "")),
+		Preset:           strings.TrimSpace(req.GetString("preset", "")),
+		Reconstruct:      req.GetBool("reconstruct", false),
+		Force:            req.GetBool("force", false),
+		Actor:            requestActor(ctx, req),
 	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return stageToolResult(t.Run(projectRoot, params))
+}
+
+// Run initializes (or, with Force, reinitializes) the project at
+// projectRoot. StageResult.Content is empty — init writes no artifact.
+func (t *InitTool) Run(projectRoot string, params InitParams) (*StageResult, error) {
+	if err := validateParams(initParamSpecs, map[string]string{
+		"name":        params.Name,
+		"description": params.Description,
+	}); err != nil {
+		return nil, err
+	}
+
+	modeStr := string(params.Mode)
+	if modeStr == "" {
+		modeStr = string(config.ModeGuided)
+		if t.defaults.Mode != "" {
+			modeStr = string(t.defaults.Mode)
+		}
+	}
+	mode, err := config.ParseMode(modeStr)
+	if err != nil {
+		return nil, newUserError("'mode' must be 'guided' or 'expert'")
+	}
+	threshold := params.ClarityThreshold
+	if threshold == 0 {
+		threshold = t.defaults.ClarityThreshold
+	}
+	if threshold != 0 {
+		if err := config.ValidateClarityThreshold(threshold); err != nil {
+			return nil, newUserError("'clarity_threshold': " + err.Error())
+		}
+	}
+	if err := config.ValidateStageFiles(params.StageFiles); err != nil {
+		return nil, asUserError(err)
+	}
+	var lang i18n.Lang
+	if params.Lang != "" {
+		if lang, err = i18n.ParseLang(string(params.Lang)); err != nil {
+			return nil, newUserError("'lang': " + err.Error())
+		}
+	}
+	var preset *pipeline.Preset
+	if params.Preset != "" {
+		p, err := pipeline.LookupPreset(params.Preset)
+		if err != nil {
+			return nil, newUserError("'preset': " + err.Error())
+		}
+		preset = &p
+	}
+
+	// Guard: don't overwrite an existing project unless forced,
+	// in which case the old pipeline is archived first. Artifacts left
+	// behind without a hoofy.json are never silently reused or clobbered.
+	exists := config.Exists(projectRoot)
+	var furthest config.Stage
+	var orphaned []config.Stage
+	if !exists {
+		furthest, orphaned = config.InferStageFromArtifacts(projectRoot)
+	}
 
-	// Guard: don't overwrite an existing project.
-	if config.Exists(projectRoot) {
-		return mcp.NewToolResultError(
-			"SDD project already exists in this directory. Use sdd_get_context to see current state.",
-		), nil
+	switch {
+	case exists && !params.Force:
+		return nil, newUserError(
+			"SDD project already exists in this directory. Use sdd_get_context to see current state, " +
+				"or pass force=true to archive it and start over.",
+		)
+	case len(orphaned) > 0 && !params.Force && !params.Reconstruct:
+		return nil, newUserError(formatOrphanedWarning(orphaned, furthest))
+	}
+
+	archiveSection := ""
+	if params.Force && (exists || len(orphaned) > 0) {
+		archiveDir, archived, err := archiveProject(config.DocsPath(projectRoot))
+		if err != nil {
+			return nil, fmt.Errorf("archiving existing project: %w", err)
+		}
+		archiveSection = formatArchiveSummary(projectRoot, archiveDir, archived)
+		orphaned = nil
 	}
 
 	// Create directory structure.
@@ -95,13 +237,36 @@ func (t *InitTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.Ca
 	}
 
 	// Write initial config.
-	cfg := config.NewProjectConfig(name, description, mode)
+	cfg := config.NewProjectConfig(params.Name, params.Description, mode)
+	cfg.ClarityThreshold = threshold
+	cfg.Lang = lang
+	initStatus := cfg.StageStatus[config.StageInit]
+	initStatus.CompletedBy = pipeline.ActorOrUnknown(params.Actor)
+	cfg.StageStatus[config.StageInit] = initStatus
+	if len(params.StageFiles) > 0 {
+		cfg.StageFiles = params.StageFiles
+	}
+	if preset != nil {
+		cfg.Preset = preset.Name
+		cfg.DimensionWeights = maps.Clone(preset.Weights)
+	}
+	if params.EnableResearch || slices.Contains(orphaned, config.StageResearch) {
+		if err := cfg.EnableOptionalStage(config.StageResearch); err != nil {
+			return nil, fmt.Errorf("enabling research stage: %w", err)
+		}
+	}
+	if len(orphaned) > 0 {
+		if err := reconstructState(projectRoot, cfg, furthest); err != nil {
+			return nil, newUserError("cannot reconstruct hoofy.json: " + err.Error())
+		}
+	}
 	if err := t.store.Save(projectRoot, cfg); err != nil {
 		return nil, fmt.Errorf("saving config: %w", err)
 	}
 
 	// Generate and write/append agent instructions file.
-	agentFile, agentAction, err := t.writeAgentInstructions(projectRoot, name, config.DocsDir)
+	docsRel := config.ResolveDocsDir(projectRoot)
+	agentFile, agentAction, err := t.writeAgentInstructions(projectRoot, params.Name, docsRel)
 	if err != nil {
 		// Non-fatal: log but don't fail initialization.
 		agentFile = ""
@@ -121,24 +286,195 @@ func (t *InitTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.Ca
 		agentLine = fmt.Sprintf("├── %s   # Agent instructions (%s)\n", filepath.Base(agentFile), agentAction)
 	}
 
+	if len(orphaned) > 0 {
+		return &StageResult{Config: cfg, Response: formatReconstructed(cfg, docsRel, orphaned)}, nil
+	}
+
 	response := fmt.Sprintf(
 		"# SDD Project Initialized\n\n"+
+			"%s"+
 			"**Project:** %s\n"+
 			"**Mode:** %s\n"+
+			"**Clarity Threshold:** %d/100\n"+
 			"**Location:** `%s/`\n\n"+
 			"## What was created\n\n"+
 			"```\n%s/\n├── hoofy.json        # Project configuration\n└── history/          # For completed changes\n```\n\n"+
 			"%s"+
+			"%s"+
 			"## Next Step\n\n"+
 			"The pipeline is now at **Stage 1: Principles**.\n\n"+
 			"%s\n\n"+
 			"Use `sdd_create_principles` to define your project's golden invariants.\n\n"+
 			"**Tell me about your project's core beliefs** — what rules should NEVER be broken?",
-		name, modeLabel, config.DocsDir, config.DocsDir,
-		agentLine, modeHint,
+		archiveSection, params.Name, modeLabel, clarityThresholdFor(cfg), docsRel, docsRel,
+		agentLine, formatPreset(preset), modeHint,
 	)
 
-	return mcp.NewToolResultText(response), nil
+	return &StageResult{Config: cfg, Response: response}, nil
+}
+
+// formatPreset renders the chosen preset's weights and spec guidance,
+// or "" when no preset was chosen.
+func formatPreset(p *pipeline.Preset) string {
+	if p == nil {
+		return ""
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## Preset: %s\n\n", p.Name)
+	fmt.Fprintf(&sb, "Configured for a %s.\n\n", p.Summary)
+	sb.WriteString("**Clarity weights adjusted:** ")
+	var weights []string
+	for _, d := range pipeline.ApplyWeights(pipeline.DefaultDimensions(), p.Weights) {
+		if _, ok := p.Weights[d.Name]; ok {
+			weights = append(weights, fmt.Sprintf("%s %d/10", d.Name, d.Weight))
+		}
+	}
+	sb.WriteString(strings.Join(weights, ", "))
+	sb.WriteString("\n\n")
+	fmt.Fprintf(&sb, "**Spec guidance:** %s\n\n", p.Guidance)
+	sb.WriteString("**Usually out of scope** (confirm in the charter):\n")
+	for _, item := range p.OutOfScope {
+		fmt.Fprintf(&sb, "- %s\n", item)
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// archiveProject moves the project config (see config.ConfigPathFor) and
+// every stage artifact from docsDir into docsDir/history/archive-<timestamp>/.
+// Only Hoofy pipeline files are moved — changes/, adrs/, history/, and
+// unrelated docs stay in place. Returns the archive directory and the
+// archived filenames.
+func archiveProject(docsDir string) (string, []string, error) {
+	candidates := archiveCandidates(docsDir)
+
+	stamp := timeNow().UTC().Format("20060102T150405Z")
+	archiveDir := filepath.Join(docsDir, "history", "archive-"+stamp)
+	if _, err := os.Stat(archiveDir); err == nil {
+		return "", nil, fmt.Errorf("archive %s already exists", filepath.Base(archiveDir))
+	}
+	if err := os.MkdirAll(archiveDir, 0o755); err != nil {
+		return "", nil, fmt.Errorf("creating archive directory: %w", err)
+	}
+
+	var archived []string
+	for _, c := range candidates {
+		if !fileExists(c.src) {
+			continue
+		}
+		dst := filepath.Join(archiveDir, c.name)
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return "", nil, fmt.Errorf("archiving %s: %w", c.name, err)
+		}
+		if err := config.Rename(c.src, dst); err != nil {
+			return "", nil, fmt.Errorf("archiving %s: %w", c.name, err)
+		}
+		archived = append(archived, c.name)
+	}
+	return archiveDir, archived, nil
+}
+
+// archiveCandidate is a file an archive may hold: its path and its name
+// inside the archive.
+type archiveCandidate struct {
+	src  string
+	name string
+}
+
+// archiveCandidates lists the Hoofy files of the project whose artifacts
+// live in docsDir: the config in every format — beside docsDir under a
+// config name override (see config.ConfigPathFor) — then each stage and
+// side artifact, by name.
+func archiveCandidates(docsDir string) []archiveCandidate {
+	var names []string
+	for stage := range config.Stages {
+		if name := config.StageFilenameInDocs(docsDir, stage); name != "" {
+			names = append(names, name)
+		}
+	}
+	for _, side := range config.SideArtifacts {
+		names = append(names, side.Filename)
+	}
+	sort.Strings(names)
+
+	var candidates []archiveCandidate
+	configDir := filepath.Dir(config.ConfigPathFor(docsDir))
+	for _, name := range config.ConfigNames() {
+		candidates = append(candidates, archiveCandidate{src: filepath.Join(configDir, name), name: name})
+	}
+	for _, name := range names {
+		if !slices.ContainsFunc(candidates, func(c archiveCandidate) bool { return c.name == name }) {
+			candidates = append(candidates, archiveCandidate{src: filepath.Join(docsDir, name), name: name})
+		}
+	}
+	return candidates
+}
+
+// reconstructState rebuilds pipeline state for orphaned artifacts: every
+// stage through furthest is marked completed. The clarity score is
+// recovered from clarifications.md when the gate was already passed.
+func reconstructState(projectRoot string, cfg *config.ProjectConfig, furthest config.Stage) error {
+	if pipeline.StageIndexFor(cfg, furthest) >= pipeline.StageIndexFor(cfg, config.StageClarify) {
+		clarifications, err := readStageFile(config.StagePath(projectRoot, config.StageClarify))
+		if err != nil {
+			return fmt.Errorf("reading clarifications: %w", err)
+		}
+		score, ok := pipeline.ParseClarityScore(clarifications)
+		if !ok {
+			// The gate was passed (later artifacts exist) but the score is
+			// unrecoverable — record the threshold as the minimum it had.
+			score = clarityThresholdFor(cfg)
+		}
+		cfg.ClarityScore = score
+	}
+	return pipeline.CompleteThrough(cfg, furthest)
+}
+
+// formatOrphanedWarning explains the orphaned-artifacts state and the
+// two ways out of it.
+func formatOrphanedWarning(found []config.Stage, furthest config.Stage) string {
+	var sb strings.Builder
+	sb.WriteString("Found SDD artifacts but no hoofy.json — refusing to initialize over them.\n\n")
+	sb.WriteString("Artifacts present:\n")
+	for _, stage := range found {
+		fmt.Fprintf(&sb, "- `%s`\n", config.StageFilename(stage))
+	}
+	fmt.Fprintf(&sb, "\nFurthest completed stage: **%s**.\n\n", config.Stages[furthest].Name)
+	sb.WriteString("Call sdd_init_project again with:\n")
+	sb.WriteString("- `reconstruct=true` to rebuild hoofy.json from these artifacts and resume the pipeline, or\n")
+	sb.WriteString("- `force=true` to archive them to history/ and start fresh.")
+	return sb.String()
+}
+
+// formatReconstructed renders the response for a reconstructed project.
+func formatReconstructed(cfg *config.ProjectConfig, docsRel string, found []config.Stage) string {
+	var sb strings.Builder
+	sb.WriteString("# SDD Project Reconstructed\n\n")
+	fmt.Fprintf(&sb, "**Project:** %s\n", cfg.Name)
+	fmt.Fprintf(&sb, "**Mode:** %s\n", cfg.Mode)
+	fmt.Fprintf(&sb, "**Location:** `%s/`\n\n", docsRel)
+	sb.WriteString("Rebuilt `hoofy.json` from existing artifacts (left unchanged):\n\n")
+	for _, stage := range found {
+		fmt.Fprintf(&sb, "- `%s`\n", config.StageFilename(stage))
+	}
+	if pipeline.IsCompleted(cfg, config.StageClarify) {
+		fmt.Fprintf(&sb, "\n**Clarity Score:** %d/100\n", cfg.ClarityScore)
+	}
+	fmt.Fprintf(&sb, "\n## Next Step\n\nThe pipeline resumes at **%s**.\n\n%s",
+		config.Stages[cfg.CurrentStage].Name, nextStepGuidance(cfg))
+	return sb.String()
+}
+
+// formatArchiveSummary describes what a forced reinitialization archived.
+func formatArchiveSummary(projectRoot, archiveDir string, archived []string) string {
+	var sb strings.Builder
+	sb.WriteString("## Previous Project Archived\n\n")
+	fmt.Fprintf(&sb, "Moved %d file(s) to `%s/`:\n\n", len(archived), filepathRel(projectRoot, archiveDir))
+	for _, name := range archived {
+		fmt.Fprintf(&sb, "- `%s`\n", name)
+	}
+	sb.WriteString("\n")
+	return sb.String()
 }
 
 // writeAgentInstructions generates the Hoofy SDD section and writes or appends
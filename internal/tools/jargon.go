@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/lint"
+)
+
+// jargonFields are the charter fields a non-technical reader must
+// follow, in the order their findings are reported.
+var jargonFields = []string{"problem_statement", "proposed_solution"}
+
+// jargonCheckEnabled reports whether cfg wants the jargon note: its
+// jargon_check setting when set, otherwise guided mode only.
+func jargonCheckEnabled(cfg *config.ProjectConfig) bool {
+	if cfg.JargonCheck != nil {
+		return *cfg.JargonCheck
+	}
+	return cfg.Mode == config.ModeGuided
+}
+
+// jargonDict returns lint.DefaultJargon with cfg's jargon overrides
+// applied.
+func jargonDict(cfg *config.ProjectConfig) map[string]string {
+	dict := make(map[string]string, len(lint.DefaultJargon)+len(cfg.Jargon))
+	for term, plain := range lint.DefaultJargon {
+		dict[term] = plain
+	}
+	for term, plain := range cfg.Jargon {
+		term = strings.ToLower(strings.TrimSpace(term))
+		if strings.TrimSpace(plain) == "" {
+			delete(dict, term)
+			continue
+		}
+		dict[term] = plain
+	}
+	return dict
+}
+
+// jargonNote returns a response note listing likely jargon in the
+// fields of jargonFields, with plainer wording, or "" when the check is
+// off or nothing was found. fields maps parameter names to the values
+// the caller passed. Like recommendedFieldsNote it only coaches —
+// nothing is blocked or persisted.
+func jargonNote(cfg *config.ProjectConfig, fields map[string]string) string {
+	if !jargonCheckEnabled(cfg) {
+		return ""
+	}
+	dict := jargonDict(cfg)
+
+	var sb strings.Builder
+	for _, name := range jargonFields {
+		for _, f := range lint.Jargon(fields[name], dict) {
+			fmt.Fprintf(&sb, "- `%s`: \"%s\" → try \"%s\"\n", name, f.Term, f.Suggestion)
+		}
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	return "\n\n🗣️ **Plain language** — these terms may lose a non-technical reader:\n" + sb.String() +
+		"\n_Consider rewording with `update`. Set `jargon_check` to false in hoofy.json to silence this note._"
+}
@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func jargonCharterRequest() mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"problem_statement": "Freelancers lose track of hours",
+		"target_users":      "- **Freelance designers**",
+		"proposed_solution": "A SaaS frontend over a scalable backend API",
+		"success_criteria":  "- Users log time in under 10 seconds",
+	}
+	return req
+}
+
+func TestCharterTool_Handle_JargonNote(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+	defer cleanup()
+
+	result, err := NewCharterTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), jargonCharterRequest())
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"**Plain language**",
+		"- `proposed_solution`: \"SaaS\" → try \"an online service people subscribe to\"",
+		"- `proposed_solution`: \"API\" → try",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestJargonNote_Settings(t *testing.T) {
+	fields := map[string]string{"proposed_solution": "An API for partners", "problem_statement": "Slow onboarding"}
+
+	expert := &config.ProjectConfig{Mode: config.ModeExpert}
+	if note := jargonNote(expert, fields); note != "" {
+		t.Errorf("expert mode should be off by default, got %q", note)
+	}
+	on := true
+	expert.JargonCheck = &on
+	if note := jargonNote(expert, fields); !strings.Contains(note, `"API"`) {
+		t.Errorf("jargon_check=true should enable the note in expert mode, got %q", note)
+	}
+
+	guided := &config.ProjectConfig{Mode: config.ModeGuided, Jargon: map[string]string{"API": "", "onboarding": "getting started"}}
+	note := jargonNote(guided, fields)
+	if strings.Contains(note, `"API"`) {
+		t.Errorf("an empty wording should drop the built-in term:\n%s", note)
+	}
+	if !strings.Contains(note, `"onboarding" → try "getting started"`) {
+		t.Errorf("a custom term should be reported:\n%s", note)
+	}
+}
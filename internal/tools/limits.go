@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxInputBytes caps the size of each tool argument. Set once at server
+// startup via SetMaxInputBytes.
+var maxInputBytes = config.DefaultMaxInputBytes
+
+// SetMaxInputBytes sets the per-argument input limit. Zero or negative
+// restores DefaultMaxInputBytes.
+func SetMaxInputBytes(n int) {
+	if n <= 0 {
+		n = config.DefaultMaxInputBytes
+	}
+	maxInputBytes = n
+}
+
+// LimitInputSize is tool handler middleware that rejects a call when any
+// argument exceeds the input limit, before the tool's Handle runs — so
+// a runaway multi-megabyte argument never reaches an artifact on disk.
+func LimitInputSize(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if err := checkInputSize(req); err != nil {
+			return toolResult("", err)
+		}
+		return next(ctx, req)
+	}
+}
+
+// checkInputSize returns a user error naming the first oversized
+// argument, in name order. Strings nested in arrays or objects count
+// toward their top-level argument.
+func checkInputSize(req mcp.CallToolRequest) error {
+	args := req.GetArguments()
+	names := make([]string, 0, len(args))
+	for name := range args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if n := inputSize(args[name]); n > maxInputBytes {
+			return newUserError(fmt.Sprintf(
+				"'%s' is %s, over the %s input limit — split the content across calls or tighten it "+
+					"(the server's limit is set by %s)",
+				name, formatBytes(n), formatBytes(maxInputBytes), config.EnvMaxInputBytes))
+		}
+	}
+	return nil
+}
+
+// inputSize returns the total length of the strings in v.
+func inputSize(v any) int {
+	switch v := v.(type) {
+	case string:
+		return len(v)
+	case []any:
+		n := 0
+		for _, item := range v {
+			n += inputSize(item)
+		}
+		return n
+	case map[string]any:
+		n := 0
+		for k, item := range v {
+			n += len(k) + inputSize(item)
+		}
+		return n
+	default:
+		return 0
+	}
+}
+
+// formatBytes renders a size as bytes or KB.
+func formatBytes(n int) string {
+	if n < 1<<10 {
+		return fmt.Sprintf("%d bytes", n)
+	}
+	return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+}
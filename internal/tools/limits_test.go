@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestLimitInputSize_RejectsOversizedField(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageTasks)
+	defer cleanup()
+
+	SetMaxInputBytes(config.MinMaxInputBytes)
+	t.Cleanup(func() { SetMaxInputBytes(0) })
+
+	handle := LimitInputSize(NewTasksTool(config.NewFileStore(), mustRenderer(t)).Handle)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"total_tasks":      "1",
+		"estimated_effort": "1 day",
+		"tasks":            "### TASK-001: Build it\n" + strings.Repeat("x", 2*config.MinMaxInputBytes),
+	}
+
+	result, err := handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handler error: %v", err)
+	}
+	text := getResultText(result)
+	if !isErrorResult(result) || !strings.Contains(text, "'tasks' is 2.0 KB, over the 1.0 KB input limit") {
+		t.Errorf("want an input limit error naming 'tasks', got: %s", text)
+	}
+	if _, err := os.Stat(config.StagePath(tmpDir, config.StageTasks)); !os.IsNotExist(err) {
+		t.Errorf("tasks.md should not be written for an oversized input (stat err: %v)", err)
+	}
+}
+
+func TestLimitInputSize_PassesSmallInput(t *testing.T) {
+	called := false
+	handle := LimitInputSize(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		called = true
+		return mcp.NewToolResultText("ok"), nil
+	})
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"tasks": "short", "items": []interface{}{"a", "b"}}
+	if _, err := handle(context.Background(), req); err != nil || !called {
+		t.Errorf("small input should reach the handler (called=%v, err=%v)", called, err)
+	}
+}
@@ -0,0 +1,196 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Page size bounds for sdd_list_projects.
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// cursorPrefix tags a decoded cursor so arbitrary base64 is rejected.
+const cursorPrefix = "after:"
+
+// ListProjectsTool handles the sdd_list_projects MCP tool.
+// It lists the Hoofy projects under a workspace directory one page at a
+// time, so a monorepo with hundreds of features doesn't blow the
+// response budget.
+//
+// Design: read-only. Pages are keyed by the last path returned rather
+// than an offset, so projects created between calls don't shift or
+// repeat entries on later pages.
+type ListProjectsTool struct {
+	store config.Store
+}
+
+// NewListProjectsTool creates a ListProjectsTool with its dependencies.
+func NewListProjectsTool(store config.Store) *ListProjectsTool {
+	return &ListProjectsTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *ListProjectsTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_list_projects",
+		mcp.WithDescription(
+			"List the Hoofy projects under a directory with their current stage and clarity score, "+
+				"one page at a time. When more projects remain, the response ends with a next cursor — "+
+				"pass it back as 'cursor' to fetch the following page. "+
+				"Paths listed in the root's .sddignore (gitignore-style globs, e.g. 'examples/', 'testdata') "+
+				"are skipped, as are hidden and dependency directories. Read-only.",
+		),
+		mcp.WithString("root",
+			mcp.Description("Directory to search (absolute, or relative to the working directory). "+
+				"Defaults to the current project root."),
+		),
+		mcp.WithNumber("depth",
+			mcp.Description(fmt.Sprintf("How many directory levels to descend. Defaults to %d (max %d).",
+				config.DefaultDiscoveryDepth, config.MaxDiscoveryDepth)),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description(fmt.Sprintf("Projects per page. Defaults to %d (max %d).", defaultListLimit, maxListLimit)),
+		),
+		mcp.WithString("cursor",
+			mcp.Description("Opaque cursor from a previous page's response. Omit for the first page."),
+		),
+	)
+}
+
+// listProjectsParams are the parsed sdd_list_projects arguments.
+type listProjectsParams struct {
+	Root   string
+	Depth  int
+	Limit  int
+	Cursor string
+}
+
+// listedProject is one row of a page.
+type listedProject struct {
+	Path string // slash-separated, relative to the listing root
+	Cfg  *config.ProjectConfig
+}
+
+// Handle processes the sdd_list_projects tool call.
+func (t *ListProjectsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return toolResult(t.process(listProjectsParams{
+		Root:   strings.TrimSpace(req.GetString("root", "")),
+		Depth:  intArgTools(req, "depth", config.DefaultDiscoveryDepth),
+		Limit:  intArgTools(req, "limit", defaultListLimit),
+		Cursor: strings.TrimSpace(req.GetString("cursor", "")),
+	}))
+}
+
+// process discovers the projects under the root and renders one page.
+func (t *ListProjectsTool) process(params listProjectsParams) (string, error) {
+	if params.Depth < 0 {
+		return "", newUserError("'depth' must not be negative")
+	}
+	if params.Limit < 1 {
+		return "", newUserError("'limit' must be at least 1")
+	}
+	depth := min(params.Depth, config.MaxDiscoveryDepth)
+	limit := min(params.Limit, maxListLimit)
+
+	after, err := decodeListCursor(params.Cursor)
+	if err != nil {
+		return "", err
+	}
+
+	root := params.Root
+	if root == "" {
+		if root, err = findProjectRoot(); err != nil {
+			return "", err
+		}
+	}
+	if root, err = filepath.Abs(root); err != nil {
+		return "", fmt.Errorf("resolving root: %w", err)
+	}
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		return "", newUserError(fmt.Sprintf("root %q is not a directory", root))
+	}
+
+	dirs, err := config.ListProjects(t.store, root, depth)
+	if err != nil {
+		return "", err
+	}
+
+	// Pages are ordered and the cursor compared by the relative slash
+	// path, not ListProjects' absolute OS path order — the two differ
+	// on Windows and when names mix separators with '-' or '.'.
+	rels := make(map[string]string, len(dirs))
+	for _, dir := range dirs {
+		if rel, err := filepath.Rel(root, dir); err == nil {
+			rels[filepath.ToSlash(rel)] = dir
+		}
+	}
+	var all []listedProject
+	for _, rel := range slices.Sorted(maps.Keys(rels)) {
+		if after != "" && rel <= after {
+			continue
+		}
+		cfg, err := t.store.Load(rels[rel])
+		if err != nil {
+			continue
+		}
+		all = append(all, listedProject{Path: rel, Cfg: cfg})
+	}
+
+	page := all
+	next := ""
+	if len(page) > limit {
+		page = page[:limit]
+		next = encodeListCursor(page[len(page)-1].Path)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Projects\n\n")
+	fmt.Fprintf(&sb, "**Root:** `%s`\n\n", root)
+	if len(page) == 0 {
+		if after != "" {
+			sb.WriteString("_No more projects._\n")
+		} else {
+			sb.WriteString("_No Hoofy projects found._\n")
+		}
+		return sb.String(), nil
+	}
+
+	sb.WriteString("| Path | Name | Stage | Clarity | Progress |\n|------|------|-------|---------|----------|\n")
+	for _, p := range page {
+		fmt.Fprintf(&sb, "| `%s` | %s | %s | %d/%d | %s |\n",
+			p.Path, p.Cfg.Name, p.Cfg.CurrentStage, p.Cfg.ClarityScore, clarityThresholdFor(p.Cfg), stageProgress(p.Cfg))
+	}
+
+	if next != "" {
+		fmt.Fprintf(&sb, "\n_Showing %d of %d remaining projects._ **Next cursor:** `%s`\n", len(page), len(all), next)
+	}
+	return sb.String(), nil
+}
+
+// encodeListCursor returns the opaque cursor for the page after path.
+func encodeListCursor(path string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + path))
+}
+
+// decodeListCursor returns the path a cursor points after, or "" for
+// an empty cursor.
+func decodeListCursor(cursor string) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(raw), cursorPrefix) {
+		return "", newUserError("'cursor' is invalid — pass the cursor from a previous sdd_list_projects response, or omit it for the first page")
+	}
+	return strings.TrimPrefix(string(raw), cursorPrefix), nil
+}
@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+var nextCursorPattern = regexp.MustCompile("\\*\\*Next cursor:\\*\\* `([^`]+)`")
+
+func TestListProjectsTool_Handle_Paginates(t *testing.T) {
+	root := t.TempDir()
+	const total = 7
+	for i := range total {
+		name := fmt.Sprintf("feature-%02d", i)
+		cfg := config.NewProjectConfig(name, "list test", config.ModeGuided)
+		if err := config.NewFileStore().Save(filepath.Join(root, "services", name), cfg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tool := NewListProjectsTool(config.NewFileStore())
+	var seen []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > total {
+			t.Fatal("pagination did not terminate")
+		}
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"root": root, "limit": float64(3), "cursor": cursor}
+		result, err := tool.Handle(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Handle: %v", err)
+		}
+		text := getResultText(result)
+		if isErrorResult(result) {
+			t.Fatalf("unexpected error: %s", text)
+		}
+		for i := range total {
+			if path := fmt.Sprintf("`services/feature-%02d`", i); strings.Contains(text, path) {
+				seen = append(seen, path)
+			}
+		}
+		m := nextCursorPattern.FindStringSubmatch(text)
+		if m == nil {
+			break
+		}
+		cursor = m[1]
+	}
+
+	if len(seen) != total {
+		t.Fatalf("listed %d projects across pages, want %d each exactly once: %v", len(seen), total, seen)
+	}
+	for i := 1; i < len(seen); i++ {
+		if seen[i] <= seen[i-1] {
+			t.Errorf("projects out of order or repeated: %v", seen)
+		}
+	}
+}
+
+func TestListProjectsTool_Handle_CursorOrder(t *testing.T) {
+	// Names where '-' and '.' sort around the path separator.
+	root := t.TempDir()
+	paths := []string{"a", "a-b", "a.c", "a/b", "a0"}
+	for _, rel := range paths {
+		if err := config.NewFileStore().Save(filepath.Join(root, filepath.FromSlash(rel)), config.NewProjectConfig("p", "order test", config.ModeGuided)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tool := NewListProjectsTool(config.NewFileStore())
+	var seen []string
+	cursor := ""
+	for range len(paths) + 1 {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"root": root, "limit": float64(1), "cursor": cursor}
+		result, err := tool.Handle(context.Background(), req)
+		if err != nil || isErrorResult(result) {
+			t.Fatalf("Handle: %v %s", err, getResultText(result))
+		}
+		text := getResultText(result)
+		for _, rel := range paths {
+			if strings.Contains(text, "| `"+rel+"` |") {
+				seen = append(seen, rel)
+			}
+		}
+		m := nextCursorPattern.FindStringSubmatch(text)
+		if m == nil {
+			break
+		}
+		cursor = m[1]
+	}
+	if want := []string{"a", "a-b", "a.c", "a/b", "a0"}; !slices.Equal(seen, want) {
+		t.Errorf("pages listed %v, want each project once in %v", seen, want)
+	}
+}
+
+func TestListProjectsTool_Handle_InvalidCursor(t *testing.T) {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"root": t.TempDir(), "cursor": "not a cursor!"}
+	result, err := NewListProjectsTool(config.NewFileStore()).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "'cursor' is invalid") {
+		t.Errorf("want invalid cursor error, got: %s", getResultText(result))
+	}
+}
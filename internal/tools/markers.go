@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/clarify"
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// MarkersTool handles the sdd_list_markers MCP tool.
+// It lists the unresolved [NEEDS CLARIFICATION] markers left in the
+// project's stage artifacts, with file:line locations to jump to.
+//
+// Design: read-only. sdd_clarify turns the requirements' markers into
+// questions; this tool is the project-wide view of what's still open.
+type MarkersTool struct {
+	store config.Store
+}
+
+// NewMarkersTool creates a MarkersTool with its dependencies.
+func NewMarkersTool(store config.Store) *MarkersTool {
+	return &MarkersTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *MarkersTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_list_markers",
+		mcp.WithDescription(
+			"List every unresolved [NEEDS CLARIFICATION: ...] marker in the project's artifacts, "+
+				"with its file and line. Authors use these inline markers to flag known gaps; "+
+				"sdd_clarify asks about the ones in the requirements. Read-only.",
+		),
+		mcp.WithString("stage",
+			mcp.Description("Only scan this stage's artifact (e.g. 'specify', 'design'). Omit to scan all."),
+		),
+	)
+}
+
+// Handle processes the sdd_list_markers tool call.
+func (t *MarkersTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, config.Stage(strings.TrimSpace(req.GetString("stage", "")))))
+}
+
+// process scans the stage artifacts and renders the marker table.
+func (t *MarkersTool) process(projectRoot string, stage config.Stage) (string, error) {
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if stage != "" && !cfg.HasStage(stage) {
+		return "", newUserError(fmt.Sprintf("unknown stage %q for this project's pipeline", stage))
+	}
+
+	markers, err := projectMarkers(cfg, projectRoot, stage)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Clarification Markers\n\n")
+	if len(markers) == 0 {
+		sb.WriteString("✅ No `[NEEDS CLARIFICATION]` markers left.\n")
+		return sb.String(), nil
+	}
+
+	fmt.Fprintf(&sb, "**%d unresolved marker(s).**\n\n", len(markers))
+	sb.WriteString("| Location | Stage | Question |\n|----------|-------|----------|\n")
+	for _, m := range markers {
+		fmt.Fprintf(&sb, "| `%s:%d` | %s | %s |\n",
+			filepathRel(projectRoot, m.Path), m.Line, m.Stage,
+			strings.ReplaceAll(m.Question(), "|", "\\|"))
+	}
+	if stage == "" || stage == config.StageSpecify {
+		sb.WriteString("\n_Markers in the requirements become questions in the next `sdd_clarify` round._\n")
+	}
+	return sb.String(), nil
+}
+
+// projectMarkers returns the markers in each stage artifact of cfg's
+// pipeline, in pipeline order and with every file of a split stage, or
+// only in stage's when it is set.
+func projectMarkers(cfg *config.ProjectConfig, projectRoot string, stage config.Stage) ([]clarify.Marker, error) {
+	var markers []clarify.Marker
+	for _, s := range config.StageOrderFor(cfg) {
+		if stage != "" && s != stage {
+			continue
+		}
+		for _, path := range config.StagePaths(projectRoot, cfg, s) {
+			content, err := readStageFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s: %w", s, err)
+			}
+			for _, m := range clarify.ExtractMarkers(content) {
+				m.Stage, m.Path = s, path
+				markers = append(markers, m)
+			}
+		}
+	}
+	return markers, nil
+}
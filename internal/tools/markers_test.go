@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const markedRequirements = "# Requirements\n\n" +
+	"- **FR-001**: Users can sign up\n" +
+	"- **FR-002**: Export reports [NEEDS CLARIFICATION: which formats?]\n"
+
+func TestClarifyTool_Handle_FlaggedQuestions(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), markedRequirements); err != nil {
+		t.Fatalf("write requirements: %v", err)
+	}
+
+	result, err := NewClarifyTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"## Author-Flagged Questions",
+		"1. which formats? (re: FR-002: Export reports) _(line 4)_",
+		"Plus the 1 Author-Flagged Questions above",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("clarify output missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestMarkersTool_Process(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+
+	tool := NewMarkersTool(config.NewFileStore())
+	text, err := tool.process(tmpDir, "")
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	if !strings.Contains(text, "No `[NEEDS CLARIFICATION]` markers left") {
+		t.Errorf("empty project should report no markers:\n%s", text)
+	}
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), markedRequirements); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageDesign), "# Design\n\nCache: [NEEDS CLARIFICATION: Redis | in-memory?]\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	text, err = tool.process(tmpDir, "")
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	for _, want := range []string{
+		"**2 unresolved marker(s).**",
+		"| `docs/requirements.md:4` | specify | which formats? (re: FR-002: Export reports) |",
+		"| `docs/design.md:3` | design | Redis \\| in-memory? (re: Cache) |",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("markers output missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Index(text, "requirements.md") > strings.Index(text, "design.md") {
+		t.Error("markers should be listed in pipeline order")
+	}
+
+	text, err = tool.process(tmpDir, config.StageDesign)
+	if err != nil {
+		t.Fatalf("process(design): %v", err)
+	}
+	if strings.Contains(text, "requirements.md") || !strings.Contains(text, "**1 unresolved marker(s).**") {
+		t.Errorf("stage filter should only scan design:\n%s", text)
+	}
+
+	if _, err := tool.process(tmpDir, "nope"); err == nil {
+		t.Error("unknown stage should be rejected")
+	}
+}
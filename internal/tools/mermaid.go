@@ -0,0 +1,31 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/design"
+)
+
+// mermaidFields are the design fields architects draw diagrams in, in
+// the order their problems are reported.
+var mermaidFields = []string{"architecture_overview", "components"}
+
+// mermaidNote returns a response note listing the Mermaid diagrams in
+// the fields of mermaidFields that would render as broken text, or ""
+// when every diagram passes the basic checks. fields maps parameter
+// names to the values the caller passed. The design is saved either
+// way — the note only warns.
+func mermaidNote(fields map[string]string) string {
+	var sb strings.Builder
+	for _, name := range mermaidFields {
+		for _, err := range design.ValidateMermaid(fields[name]) {
+			fmt.Fprintf(&sb, "- `%s`: %s\n", name, err)
+		}
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	return "\n\n📐 **Diagram check** — these Mermaid blocks may not render on GitHub:\n" + sb.String() +
+		"\n_Line numbers count from the start of the field. The design was saved — fix the diagrams in `docs/design.md`._"
+}
@@ -0,0 +1,192 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// NewIterationTool handles the sdd_new_iteration MCP tool.
+// It starts the next iteration (v1 → v1.1) of a completed project:
+// the current artifacts are copied to docs/history/v<version>/, the
+// version is bumped and the pipeline moves back to a chosen stage.
+//
+// Design: nothing is lost. The archived iteration stays readable through
+// sdd_get_context's 'version' parameter; only the artifacts of the
+// reopened stages are removed from docs/, and they're in the archive.
+type NewIterationTool struct {
+	store config.Store
+}
+
+// NewNewIterationTool creates a NewIterationTool with its dependencies.
+func NewNewIterationTool(store config.Store) *NewIterationTool {
+	return &NewIterationTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *NewIterationTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_new_iteration",
+		mcp.WithDescription(
+			"Start a new iteration of a completed SDD project (e.g. v1.1 after requirements change). "+
+				"Archives the current artifacts to docs/history/v<version>/, bumps the project version, and moves "+
+				"the pipeline back to a stage (the charter by default); that stage and every later one restart "+
+				"as pending. Earlier stages stay completed. Read archived artifacts with sdd_get_context's 'version'.",
+		),
+		mcp.WithString("stage",
+			mcp.Description(fmt.Sprintf("Stage the new iteration starts at. Defaults to '%s'. "+
+				"Starting at or before 'clarify' resets the clarity score.", pipeline.DefaultReopenStage)),
+		),
+		mcp.WithString("version",
+			mcp.Description("Version of the new iteration (MAJOR.MINOR.PATCH), higher than the current one. "+
+				"Defaults to the next minor version, e.g. 1.0.0 → 1.1.0."),
+		),
+	)
+}
+
+// newIterationParams are the parsed sdd_new_iteration arguments.
+type newIterationParams struct {
+	Stage   config.Stage
+	Version string
+}
+
+// Handle processes the sdd_new_iteration tool call.
+func (t *NewIterationTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, newIterationParams{
+		Stage:   config.Stage(strings.TrimSpace(req.GetString("stage", ""))),
+		Version: strings.TrimSpace(req.GetString("version", "")),
+	}))
+}
+
+// process archives the current iteration and reopens the pipeline.
+func (t *NewIterationTool) process(projectRoot string, params newIterationParams) (string, error) {
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	previous := cfg.Version
+	if previous == "" {
+		previous = "0.1.0"
+	}
+
+	if err := pipeline.Reopen(cfg, params.Stage); err != nil {
+		return "", asUserError(err)
+	}
+	if params.Version != "" {
+		if err := checkNextVersion(previous, params.Version); err != nil {
+			return "", err
+		}
+		cfg.Version = params.Version
+	}
+
+	archiveDir := iterationArchivePath(projectRoot, previous)
+	if _, err := os.Stat(archiveDir); err == nil {
+		return "", newUserError(fmt.Sprintf(
+			"`%s` already exists — version %s was archived before; pass a different 'version'",
+			filepathRel(projectRoot, archiveDir), previous))
+	}
+	archived, err := archiveIteration(config.DocsPath(projectRoot), archiveDir)
+	if err != nil {
+		return "", err
+	}
+
+	// The reopened stages start over; their artifacts live on in the archive.
+	order := config.StageOrderFor(cfg)
+	reopened := order[pipeline.StageIndexFor(cfg, cfg.CurrentStage):]
+	for _, stage := range reopened {
+		if err := removeIfExists(config.StagePath(projectRoot, stage)); err != nil {
+			return "", err
+		}
+	}
+	if slices.Contains(reopened, config.StageSpecify) {
+		if err := removeIfExists(config.RequirementsJSONPath(projectRoot)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := t.store.Save(projectRoot, cfg); err != nil {
+		return "", fmt.Errorf("saving config: %w", err)
+	}
+
+	names := make([]string, len(reopened))
+	for i, s := range reopened {
+		names[i] = string(s)
+	}
+	var sb strings.Builder
+	sb.WriteString("# New Iteration Started\n\n")
+	fmt.Fprintf(&sb, "**Version:** %s → %s\n", previous, cfg.Version)
+	fmt.Fprintf(&sb, "**Archived:** `%s/` (%d files)\n", filepathRel(projectRoot, archiveDir), len(archived))
+	fmt.Fprintf(&sb, "**Current stage:** %s (%s)\n\n", config.Stages[cfg.CurrentStage].Name, cfg.CurrentStage)
+	fmt.Fprintf(&sb, "Restarting as pending: %s.\n\n", strings.Join(names, ", "))
+	fmt.Fprintf(&sb, "_Read the previous iteration with `sdd_get_context` (stage=..., version=%s)._\n\n", previous)
+	sb.WriteString("## Next Step\n\n")
+	sb.WriteString(nextStepGuidance(cfg))
+	return sb.String(), nil
+}
+
+// checkNextVersion returns a user error unless next is a valid version
+// above previous.
+func checkNextVersion(previous, next string) error {
+	nextParts, err := pipeline.ParseVersion(next)
+	if err != nil {
+		return newUserError(fmt.Sprintf("'version': %v", err))
+	}
+	prevParts, err := pipeline.ParseVersion(previous)
+	if err == nil && slices.Compare(nextParts[:], prevParts[:]) <= 0 {
+		return newUserError(fmt.Sprintf("'version' %s must be higher than the current version %s", next, previous))
+	}
+	return nil
+}
+
+// iterationArchivePath returns docs/history/v<version>, the archive of
+// the project's iteration at version.
+func iterationArchivePath(projectRoot, version string) string {
+	return filepath.Join(config.DocsPath(projectRoot), "history", "v"+strings.TrimPrefix(version, "v"))
+}
+
+// archiveIteration copies the config, stage artifacts and side
+// artifacts in docsDir to archiveDir and returns the copied names.
+// Unlike init's archive it copies: the earlier stages' artifacts stay
+// in place for the new iteration.
+func archiveIteration(docsDir, archiveDir string) ([]string, error) {
+	var archived []string
+	for _, c := range archiveCandidates(docsDir) {
+		if !fileExists(c.src) {
+			continue
+		}
+		data, err := os.ReadFile(c.src)
+		if err != nil {
+			return archived, fmt.Errorf("archiving %s: %w", c.name, err)
+		}
+		dst := filepath.Join(archiveDir, c.name)
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return archived, fmt.Errorf("archiving %s: %w", c.name, err)
+		}
+		if err := config.WriteFile(dst, data, 0o644); err != nil {
+			return archived, fmt.Errorf("archiving %s: %w", c.name, err)
+		}
+		archived = append(archived, c.name)
+	}
+	return archived, nil
+}
+
+// removeIfExists removes path, ignoring a missing file.
+func removeIfExists(path string) error {
+	if path == "" {
+		return nil
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+)
+
+func TestNewIterationTool_Process(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageValidate)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	tool := NewNewIterationTool(store)
+
+	// Not complete yet.
+	if _, err := tool.process(tmpDir, newIterationParams{}); err == nil || !IsUserError(err) {
+		t.Fatalf("an incomplete project should be rejected with a user error, got %v", err)
+	}
+
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := cfg.StageStatus[config.StageValidate]
+	st.Status = "completed"
+	cfg.StageStatus[config.StageValidate] = st
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	for _, stage := range []config.Stage{config.StageCharter, config.StageSpecify, config.StageDesign} {
+		if err := writeStageFile(config.StagePath(tmpDir, stage), "# v1 "+string(stage)+"\n"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := tool.process(tmpDir, newIterationParams{Version: "0.0.9"}); err == nil {
+		t.Error("a version below the current one should be rejected")
+	}
+
+	text, err := tool.process(tmpDir, newIterationParams{Stage: config.StageSpecify})
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	for _, want := range []string{"**Version:** 0.1.0 → 0.2.0", "`docs/history/v0.1.0/`", "Restarting as pending: specify,"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+
+	archive := filepath.Join(config.DocsPath(tmpDir), "history", "v0.1.0")
+	for _, name := range []string{"hoofy.json", "charter.md", "requirements.md", "design.md"} {
+		if _, err := os.Stat(filepath.Join(archive, name)); err != nil {
+			t.Errorf("archive missing %s: %v", name, err)
+		}
+	}
+	if !fileExists(config.StagePath(tmpDir, config.StageCharter)) {
+		t.Error("charter.md comes before the reopened stage and should stay in place")
+	}
+	if fileExists(config.StagePath(tmpDir, config.StageSpecify)) || fileExists(config.StagePath(tmpDir, config.StageDesign)) {
+		t.Error("artifacts of reopened stages should be removed from docs/")
+	}
+
+	cfg, err = store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Version != "0.2.0" || cfg.CurrentStage != config.StageSpecify || pipeline.IsCompleted(cfg, config.StageDesign) {
+		t.Errorf("config after reopen: version %s, stage %s", cfg.Version, cfg.CurrentStage)
+	}
+
+	// The previous iteration stays readable through sdd_get_context.
+	ctxTool := NewContextTool(store)
+	old, err := ctxTool.process(context.Background(), tmpDir, contextParams{Stage: "design", Format: "markdown", Version: "0.1.0"})
+	if err != nil || !strings.Contains(old, "# v1 design") {
+		t.Errorf("version=0.1.0 design = %q, %v", old, err)
+	}
+	if _, err := ctxTool.process(context.Background(), tmpDir, contextParams{Stage: "design", Format: "markdown", Version: "9.9.9"}); err == nil ||
+		!strings.Contains(err.Error(), "archived versions: 0.1.0") {
+		t.Errorf("unknown version should list the archived ones, got %v", err)
+	}
+}
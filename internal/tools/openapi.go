@@ -0,0 +1,483 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+	"gopkg.in/yaml.v3"
+)
+
+// OpenAPITool handles the sdd_export_openapi MCP tool.
+// It turns the API Contracts section of design.md into a starter
+// OpenAPI 3 skeleton (docs/openapi.yaml).
+//
+// Design: best-effort and deterministic. Endpoints are recognized by
+// "METHOD /path" patterns; anything that looks like an entry but has no
+// method+path is reported as a TODO instead of being guessed at.
+type OpenAPITool struct {
+	store config.Store
+}
+
+// NewOpenAPITool creates an OpenAPITool with its dependencies.
+func NewOpenAPITool(store config.Store) *OpenAPITool {
+	return &OpenAPITool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *OpenAPITool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_export_openapi",
+		mcp.WithDescription(
+			"Generate a starter OpenAPI 3 skeleton in `docs/openapi.yaml` from the API Contracts "+
+				"section of design.md. Endpoints are recognized from 'METHOD /path' patterns "+
+				"(e.g. 'POST /users — Request: CreateUser, Response: 201 User'); named request/response "+
+				"schemas become placeholder components. Best-effort: entries without a method and path "+
+				"are listed as TODOs in the response and at the top of the file. "+
+				"Requires: the design stage must be completed. "+
+				"Does not change pipeline state; call again to regenerate.",
+		),
+	)
+}
+
+// Handle processes the sdd_export_openapi tool call.
+func (t *OpenAPITool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot))
+}
+
+// process extracts the endpoints documented in the design's API contracts.
+func (t *OpenAPITool) process(projectRoot string) (string, error) {
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+
+	if !pipeline.IsCompleted(cfg, config.StageDesign) {
+		return "", newUserError(
+			"the OpenAPI skeleton requires a completed design — run sdd_create_design first",
+		)
+	}
+	if err := pipeline.RequireArtifacts(projectRoot, config.StageDesign); err != nil {
+		return "", asUserError(err)
+	}
+
+	design, err := readStageFile(config.StagePath(projectRoot, config.StageDesign))
+	if err != nil {
+		return "", fmt.Errorf("reading design: %w", err)
+	}
+	contracts := markdownSections(design)["API Contracts"]
+	if isPlaceholderBody(contracts) {
+		return "", newUserError(
+			"design.md declares no API contracts — nothing to export",
+		)
+	}
+
+	endpoints, todos := parseAPIContracts(contracts)
+	if len(endpoints) == 0 && len(todos) == 0 {
+		return "", newUserError(
+			"no endpoints found in the API Contracts section — describe them as 'METHOD /path'",
+		)
+	}
+
+	content, err := renderOpenAPI(cfg, endpoints, todos)
+	if err != nil {
+		return "", fmt.Errorf("rendering OpenAPI skeleton: %w", err)
+	}
+	if err := writeStageFile(config.OpenAPIPath(projectRoot), content); err != nil {
+		return "", fmt.Errorf("writing OpenAPI skeleton: %w", err)
+	}
+
+	return formatOpenAPIResponse(endpoints, todos), nil
+}
+
+// apiEndpoint is one endpoint recognized in the API contracts.
+type apiEndpoint struct {
+	Method         string
+	Path           string
+	Summary        string
+	RequestSchema  string
+	RequestArray   bool
+	ResponseStatus string
+	ResponseSchema string
+	ResponseArray  bool
+}
+
+var (
+	// apiEndpointPattern matches "METHOD /path", optionally in backticks.
+	apiEndpointPattern = regexp.MustCompile("\\b(GET|POST|PUT|PATCH|DELETE|HEAD|OPTIONS)\\b[`\\s:|]+(/[^\\s`|,;)]*)")
+	// apiSchemaRef matches a schema name such as User, `User`, User[] or []User.
+	apiSchemaRef = "`?(\\[\\])?([A-Z][A-Za-z0-9_]*)(\\[\\])?`?"
+	// apiRequestPattern matches "Request: CreateUser" / "Body: CreateUser".
+	apiRequestPattern = regexp.MustCompile(`(?i:request|body|input|payload)(?i:\s+body)?\s*[:=]\s*` + apiSchemaRef)
+	// apiResponsePattern matches "Response: 201 User" / "→ User" / "returns User[]".
+	apiResponsePattern = regexp.MustCompile(`(?:(?i:response|returns)\s*[:=]?|→|->)\s*(?:(\d{3})\s*)?` + apiSchemaRef)
+	// apiStatusPattern matches a bare response status, e.g. "Response: 204".
+	apiStatusPattern = regexp.MustCompile(`(?:(?i:response|returns)\s*[:=]?|→|->)\s*(\d{3})\b`)
+	// apiEntryStart matches a top-level list item.
+	apiEntryStart = regexp.MustCompile(`^(?:[-*+]|\d+[.)])\s+`)
+	// apiColonParam matches Express-style path parameters (":id").
+	apiColonParam = regexp.MustCompile(`:([A-Za-z_][A-Za-z0-9_]*)`)
+	// apiBraceParam matches OpenAPI path parameters ("{id}").
+	apiBraceParam = regexp.MustCompile(`\{([^}/]+)\}`)
+)
+
+// apiEntry is one candidate contract entry: a list item, table row,
+// heading, or code line, with its continuation lines.
+type apiEntry struct {
+	lines   []string
+	heading bool
+}
+
+// hasEndpoint reports whether any line of the entry names an endpoint.
+func (e *apiEntry) hasEndpoint() bool {
+	for _, line := range e.lines {
+		if apiEndpointPattern.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAPIContracts extracts endpoints from the API Contracts section.
+// Entries that look like contract items but carry no "METHOD /path" are
+// returned as TODOs; headings without an endpoint are treated as group
+// titles and skipped.
+func parseAPIContracts(section string) ([]apiEndpoint, []string) {
+	var entries []*apiEntry
+	var current *apiEntry
+	start := func(line string, heading bool) {
+		current = &apiEntry{lines: []string{line}, heading: heading}
+		entries = append(entries, current)
+	}
+
+	lines := strings.Split(section, "\n")
+	for i, raw := range lines {
+		line := strings.TrimRight(raw, " \t")
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "" || strings.HasPrefix(trimmed, "```"):
+			continue
+		case strings.HasPrefix(trimmed, "#"):
+			start(strings.TrimSpace(strings.TrimLeft(trimmed, "#")), true)
+		case strings.HasPrefix(trimmed, "|"):
+			if isTableSeparator(trimmed) || (i+1 < len(lines) && isTableSeparator(strings.TrimSpace(lines[i+1]))) {
+				continue // separator or header row
+			}
+			start(trimmed, false)
+		case apiEntryStart.MatchString(line):
+			start(trimmed, false)
+		case apiEndpointPattern.MatchString(trimmed) && (current == nil || current.hasEndpoint()):
+			// A "METHOD /path" line opens its own entry unless it
+			// completes an entry that has no endpoint yet.
+			start(trimmed, false)
+		case current != nil:
+			current.lines = append(current.lines, trimmed)
+		}
+	}
+
+	var endpoints []apiEndpoint
+	var todos []string
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		text := strings.Join(e.lines, "\n")
+		m := apiEndpointPattern.FindStringSubmatchIndex(text)
+		if m == nil {
+			if !e.heading {
+				todos = append(todos, apiEntryLabel(e.lines[0]))
+			}
+			continue
+		}
+		ep := apiEndpoint{
+			Method: text[m[2]:m[3]],
+			Path:   normalizeAPIPath(text[m[4]:m[5]]),
+		}
+		key := ep.Method + " " + ep.Path
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		firstLine, _, _ := strings.Cut(text[m[1]:], "\n")
+		ep.Summary = apiSummary(firstLine)
+		if r := apiRequestPattern.FindStringSubmatch(text); r != nil {
+			ep.RequestSchema, ep.RequestArray = r[2], r[1] != "" || r[3] != ""
+		}
+		if r := apiResponsePattern.FindStringSubmatch(text); r != nil {
+			ep.ResponseStatus, ep.ResponseSchema, ep.ResponseArray = r[1], r[3], r[2] != "" || r[4] != ""
+		} else if r := apiStatusPattern.FindStringSubmatch(text); r != nil {
+			ep.ResponseStatus = r[1]
+		}
+		if ep.ResponseStatus == "" {
+			ep.ResponseStatus = defaultResponseStatus(ep.Method, ep.ResponseSchema != "")
+		}
+		endpoints = append(endpoints, ep)
+	}
+	return endpoints, todos
+}
+
+// isTableSeparator reports whether a markdown table row is the
+// "|---|---|" line under the header.
+func isTableSeparator(row string) bool {
+	return strings.Trim(row, "|-: \t") == "" && strings.Contains(row, "-")
+}
+
+// apiEntryLabel shortens an entry's first line for the TODO list.
+func apiEntryLabel(line string) string {
+	line = strings.TrimSpace(apiEntryStart.ReplaceAllString(line, ""))
+	line = strings.Trim(line, "| ")
+	if runes := []rune(line); len(runes) > 80 {
+		line = string(runes[:77]) + "..."
+	}
+	return line
+}
+
+// apiSummary extracts a human summary from the text following the path,
+// e.g. "— Create a user" or "| Create a user |".
+func apiSummary(rest string) string {
+	rest = strings.TrimSpace(strings.Trim(rest, "`"))
+	rest = strings.TrimLeft(rest, "—–-:| \t`*")
+	if cut := strings.IndexAny(rest, "|("); cut >= 0 {
+		rest = rest[:cut]
+	}
+	for _, marker := range []string{"Request", "request", "Response", "response", "Body", "body", "→", "->", "returns"} {
+		if cut := strings.Index(rest, marker); cut >= 0 {
+			rest = rest[:cut]
+		}
+	}
+	return strings.TrimRight(strings.TrimSpace(rest), ".,;:—–- *")
+}
+
+// normalizeAPIPath converts ":id" parameters to OpenAPI "{id}" form and
+// drops trailing punctuation and query strings.
+func normalizeAPIPath(path string) string {
+	path = strings.TrimRight(path, ".:")
+	if q := strings.IndexByte(path, '?'); q >= 0 {
+		path = path[:q]
+	}
+	path = apiColonParam.ReplaceAllString(path, "{$1}")
+	if len(path) > 1 {
+		path = strings.TrimRight(path, "/")
+	}
+	return path
+}
+
+// defaultResponseStatus picks the conventional success status.
+func defaultResponseStatus(method string, hasBody bool) string {
+	switch {
+	case method == "POST":
+		return "201"
+	case method == "DELETE" && !hasBody:
+		return "204"
+	default:
+		return "200"
+	}
+}
+
+// --- OpenAPI document ---
+
+type openAPIDoc struct {
+	OpenAPI    string                                 `yaml:"openapi"`
+	Info       openAPIInfo                            `yaml:"info"`
+	Paths      map[string]map[string]openAPIOperation `yaml:"paths"`
+	Components *openAPIComponents                     `yaml:"components,omitempty"`
+}
+
+type openAPIInfo struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description,omitempty"`
+	Version     string `yaml:"version"`
+}
+
+type openAPIOperation struct {
+	Summary     string                     `yaml:"summary,omitempty"`
+	OperationID string                     `yaml:"operationId"`
+	Parameters  []openAPIParameter         `yaml:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `yaml:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `yaml:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string        `yaml:"name"`
+	In       string        `yaml:"in"`
+	Required bool          `yaml:"required"`
+	Schema   openAPISchema `yaml:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `yaml:"required"`
+	Content  map[string]openAPIMediaType `yaml:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `yaml:"description"`
+	Content     map[string]openAPIMediaType `yaml:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema openAPISchema `yaml:"schema"`
+}
+
+type openAPISchema struct {
+	Ref         string         `yaml:"$ref,omitempty"`
+	Type        string         `yaml:"type,omitempty"`
+	Description string         `yaml:"description,omitempty"`
+	Items       *openAPISchema `yaml:"items,omitempty"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]openAPISchema `yaml:"schemas"`
+}
+
+// renderOpenAPI builds the skeleton document, with unparsed entries as
+// TODO comments at the top.
+func renderOpenAPI(cfg *config.ProjectConfig, endpoints []apiEndpoint, todos []string) (string, error) {
+	doc := openAPIDoc{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:       cfg.Name,
+			Description: cfg.Description,
+			Version:     "0.1.0",
+		},
+		Paths: make(map[string]map[string]openAPIOperation),
+	}
+	schemas := make(map[string]openAPISchema)
+	usedIDs := make(map[string]bool)
+
+	for _, ep := range endpoints {
+		op := openAPIOperation{
+			Summary:     ep.Summary,
+			OperationID: uniqueOperationID(operationID(ep), usedIDs),
+			Responses:   make(map[string]openAPIResponse),
+		}
+		for _, m := range apiBraceParam.FindAllStringSubmatch(ep.Path, -1) {
+			op.Parameters = append(op.Parameters, openAPIParameter{
+				Name: m[1], In: "path", Required: true, Schema: openAPISchema{Type: "string"},
+			})
+		}
+		if ep.RequestSchema != "" {
+			schemas[ep.RequestSchema] = placeholderSchema(ep.RequestSchema)
+			op.RequestBody = &openAPIRequestBody{
+				Required: true,
+				Content:  jsonContent(schemaRef(ep.RequestSchema, ep.RequestArray)),
+			}
+		}
+		resp := openAPIResponse{Description: "TODO: describe the response"}
+		if ep.ResponseSchema != "" {
+			schemas[ep.ResponseSchema] = placeholderSchema(ep.ResponseSchema)
+			resp.Content = jsonContent(schemaRef(ep.ResponseSchema, ep.ResponseArray))
+		}
+		op.Responses[ep.ResponseStatus] = resp
+
+		if doc.Paths[ep.Path] == nil {
+			doc.Paths[ep.Path] = make(map[string]openAPIOperation)
+		}
+		doc.Paths[ep.Path][strings.ToLower(ep.Method)] = op
+	}
+	if len(schemas) > 0 {
+		doc.Components = &openAPIComponents{Schemas: schemas}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Starter OpenAPI skeleton for %s, generated by sdd_export_openapi\n", cfg.Name)
+	buf.WriteString("# from the API Contracts section of design.md. Fill in the TODOs.\n")
+	for _, todo := range todos {
+		fmt.Fprintf(&buf, "# TODO: could not parse contract entry: %s\n", todo)
+	}
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// operationID derives a camelCase operation ID, e.g. "POST /users/{id}/roles"
+// → "postUsersIdRoles".
+func operationID(ep apiEndpoint) string {
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(ep.Method))
+	for _, part := range strings.FieldsFunc(ep.Path, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return sb.String()
+}
+
+// uniqueOperationID appends a numeric suffix to id when an earlier
+// operation already uses it, e.g. "getUsersList" → "getUsersList2",
+// since operation IDs must be unique across the document.
+func uniqueOperationID(id string, used map[string]bool) string {
+	unique := id
+	for n := 2; used[unique]; n++ {
+		unique = fmt.Sprintf("%s%d", id, n)
+	}
+	used[unique] = true
+	return unique
+}
+
+// schemaRef references a component schema, wrapped in an array if needed.
+func schemaRef(name string, array bool) openAPISchema {
+	ref := openAPISchema{Ref: "#/components/schemas/" + name}
+	if array {
+		return openAPISchema{Type: "array", Items: &ref}
+	}
+	return ref
+}
+
+// placeholderSchema is the stub emitted for each named schema.
+func placeholderSchema(name string) openAPISchema {
+	return openAPISchema{Type: "object", Description: "TODO: define the fields of " + name}
+}
+
+// jsonContent wraps a schema in an application/json media type.
+func jsonContent(schema openAPISchema) map[string]openAPIMediaType {
+	return map[string]openAPIMediaType{"application/json": {Schema: schema}}
+}
+
+// formatOpenAPIResponse summarizes the exported endpoints and TODOs.
+func formatOpenAPIResponse(endpoints []apiEndpoint, todos []string) string {
+	sorted := append([]apiEndpoint(nil), endpoints...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Path < sorted[j].Path })
+
+	var sb strings.Builder
+	sb.WriteString("# OpenAPI Skeleton Saved\n\n")
+	fmt.Fprintf(&sb, "Saved to `docs/%s`\n\n", config.OpenAPIFile)
+	fmt.Fprintf(&sb, "**Endpoints:** %d | **TODOs:** %d\n\n", len(endpoints), len(todos))
+	if len(sorted) > 0 {
+		sb.WriteString("## Endpoints\n\n| Method | Path | Request | Response |\n|--------|------|---------|----------|\n")
+		for _, ep := range sorted {
+			request := "—"
+			if ep.RequestSchema != "" {
+				request = ep.RequestSchema
+			}
+			response := ep.ResponseStatus
+			if ep.ResponseSchema != "" {
+				response += " " + ep.ResponseSchema
+			}
+			fmt.Fprintf(&sb, "| %s | `%s` | %s | %s |\n", ep.Method, ep.Path, request, response)
+		}
+	}
+	if len(todos) > 0 {
+		sb.WriteString("\n## TODO — Entries Not Parsed\n\n")
+		sb.WriteString("These contract entries have no recognizable 'METHOD /path'. Add them to the skeleton by hand, " +
+			"or rewrite them in design.md and call sdd_export_openapi again:\n\n")
+		for _, todo := range todos {
+			fmt.Fprintf(&sb, "- %s\n", todo)
+		}
+	}
+	sb.WriteString("\nSchemas are placeholders — fill in their fields from the Data Model section.\n")
+	return sb.String()
+}
@@ -0,0 +1,201 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+	"gopkg.in/yaml.v3"
+)
+
+const openAPIContracts = "### Users\n\n" +
+	"- `POST /users` — Create a user. Request: `CreateUserRequest`, Response: 201 `User`\n" +
+	"- `GET /users/:id` — Fetch a user → User\n" +
+	"- `GET /users` — List users, returns User[]\n" +
+	"- `DELETE /users/{id}` — Remove a user\n" +
+	"- Password reset flow via email link\n\n" +
+	"### Orders\n\n" +
+	"| Method | Path | Description |\n" +
+	"|--------|------|-------------|\n" +
+	"| PATCH | /orders/{orderId} | Update an order. Body: OrderPatch |\n" +
+	"| Webhook | provider callback | Receives payment events |\n"
+
+func writeOpenAPIDesign(t *testing.T, dir, contracts string) {
+	t.Helper()
+	design := "# Shop — Technical Design\n\n## Architecture Overview\n\nMonolith.\n\n" +
+		"## API Contracts\n\n" + contracts + "\n\n## Data Model\n\n### User\n| id | UUID |\n"
+	if err := writeStageFile(config.StagePath(dir, config.StageDesign), design); err != nil {
+		t.Fatalf("write design: %v", err)
+	}
+}
+
+func TestParseAPIContracts(t *testing.T) {
+	endpoints, todos := parseAPIContracts(openAPIContracts)
+
+	want := []apiEndpoint{
+		{Method: "POST", Path: "/users", Summary: "Create a user", RequestSchema: "CreateUserRequest", ResponseStatus: "201", ResponseSchema: "User"},
+		{Method: "GET", Path: "/users/{id}", Summary: "Fetch a user", ResponseStatus: "200", ResponseSchema: "User"},
+		{Method: "GET", Path: "/users", Summary: "List users", ResponseStatus: "200", ResponseSchema: "User", ResponseArray: true},
+		{Method: "DELETE", Path: "/users/{id}", Summary: "Remove a user", ResponseStatus: "204"},
+		{Method: "PATCH", Path: "/orders/{orderId}", Summary: "Update an order", RequestSchema: "OrderPatch", ResponseStatus: "200"},
+	}
+	if len(endpoints) != len(want) {
+		t.Fatalf("got %d endpoints, want %d: %+v", len(endpoints), len(want), endpoints)
+	}
+	for i := range want {
+		if endpoints[i] != want[i] {
+			t.Errorf("endpoint %d =\n%+v\nwant\n%+v", i, endpoints[i], want[i])
+		}
+	}
+
+	wantTodos := []string{"Password reset flow via email link", "Webhook | provider callback | Receives payment events"}
+	if strings.Join(todos, "\n") != strings.Join(wantTodos, "\n") {
+		t.Errorf("todos = %q, want %q", todos, wantTodos)
+	}
+}
+
+func TestParseAPIContracts_CodeBlockUnderHeading(t *testing.T) {
+	section := "### Create order\n\n```\nPOST /orders\nRequest: NewOrder\nResponse: 201 Order\n```\n\n" +
+		"### Health\n\n```http\nGET /healthz\n```\n"
+	endpoints, todos := parseAPIContracts(section)
+	if len(todos) != 0 {
+		t.Errorf("unexpected todos: %q", todos)
+	}
+	if len(endpoints) != 2 {
+		t.Fatalf("got %d endpoints, want 2: %+v", len(endpoints), endpoints)
+	}
+	if e := endpoints[0]; e.Path != "/orders" || e.RequestSchema != "NewOrder" || e.ResponseSchema != "Order" || e.ResponseStatus != "201" {
+		t.Errorf("POST /orders parsed as %+v", e)
+	}
+	if e := endpoints[1]; e.Method != "GET" || e.Path != "/healthz" {
+		t.Errorf("GET /healthz parsed as %+v", e)
+	}
+}
+
+func TestApiEntryLabel_TruncatesByRunes(t *testing.T) {
+	label := apiEntryLabel("- " + strings.Repeat("é", 100))
+	if !utf8.ValidString(label) {
+		t.Fatalf("label is not valid UTF-8: %q", label)
+	}
+	if want := strings.Repeat("é", 77) + "..."; label != want {
+		t.Errorf("label = %q, want %q", label, want)
+	}
+}
+
+func TestRenderOpenAPI_UniqueOperationIDs(t *testing.T) {
+	endpoints := []apiEndpoint{
+		{Method: "GET", Path: "/users/list", ResponseStatus: "200"},
+		{Method: "GET", Path: "/users-list", ResponseStatus: "200"},
+		{Method: "GET", Path: "/users_list", ResponseStatus: "200"},
+	}
+	out, err := renderOpenAPI(&config.ProjectConfig{Name: "Shop"}, endpoints, nil)
+	if err != nil {
+		t.Fatalf("renderOpenAPI: %v", err)
+	}
+	var doc openAPIDoc
+	if err := yaml.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("output is not valid YAML: %v", err)
+	}
+	for path, want := range map[string]string{
+		"/users/list": "getUsersList",
+		"/users-list": "getUsersList2",
+		"/users_list": "getUsersList3",
+	} {
+		if got := doc.Paths[path]["get"].OperationID; got != want {
+			t.Errorf("GET %s operationId = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestOpenAPITool_Handle_WritesSkeleton(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageTasks)
+	defer cleanup()
+	writeOpenAPIDesign(t, tmpDir, openAPIContracts)
+
+	result, err := NewOpenAPITool(config.NewFileStore()).Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{"**Endpoints:** 5 | **TODOs:** 2", "| POST | `/users` | CreateUserRequest | 201 User |", "- Password reset flow via email link"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response should contain %q:\n%s", want, text)
+		}
+	}
+
+	data, err := os.ReadFile(config.OpenAPIPath(tmpDir))
+	if err != nil {
+		t.Fatalf("read openapi.yaml: %v", err)
+	}
+	if !strings.Contains(string(data), "# TODO: could not parse contract entry: Password reset flow via email link") {
+		t.Errorf("openapi.yaml should list TODOs as comments:\n%s", data)
+	}
+
+	var doc struct {
+		OpenAPI string `yaml:"openapi"`
+		Info    struct {
+			Title string `yaml:"title"`
+		} `yaml:"info"`
+		Paths      map[string]map[string]map[string]any `yaml:"paths"`
+		Components struct {
+			Schemas map[string]map[string]any `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("openapi.yaml is not valid YAML: %v\n%s", err, data)
+	}
+	if doc.OpenAPI != "3.0.3" {
+		t.Errorf("openapi = %q, want 3.0.3", doc.OpenAPI)
+	}
+	if len(doc.Paths) != 3 {
+		t.Errorf("paths = %v, want /users, /users/{id}, /orders/{orderId}", doc.Paths)
+	}
+	if _, ok := doc.Paths["/users/{id}"]["delete"]; !ok {
+		t.Error("DELETE /users/{id} missing")
+	}
+	if params, ok := doc.Paths["/users/{id}"]["get"]["parameters"].([]any); !ok || len(params) != 1 {
+		t.Errorf("GET /users/{id} should declare the id path parameter, got %v", doc.Paths["/users/{id}"]["get"]["parameters"])
+	}
+	for _, name := range []string{"CreateUserRequest", "User", "OrderPatch"} {
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Errorf("schema %s missing from components", name)
+		}
+	}
+}
+
+func TestOpenAPITool_Handle_RequiresDesign(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageDesign)
+	defer cleanup()
+
+	result, err := NewOpenAPITool(config.NewFileStore()).Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "completed design") {
+		t.Errorf("expected design gate error, got: %s", getResultText(result))
+	}
+}
+
+func TestOpenAPITool_Handle_NoContracts(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageTasks)
+	defer cleanup()
+	writeOpenAPIDesign(t, tmpDir, "_No API contracts defined — this project does not expose an API._")
+
+	result, err := NewOpenAPITool(config.NewFileStore()).Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "no API contracts") {
+		t.Errorf("expected no-contracts error, got: %s", getResultText(result))
+	}
+	if fileExists(config.OpenAPIPath(tmpDir)) {
+		t.Error("openapi.yaml should not be written without contracts")
+	}
+}
@@ -0,0 +1,56 @@
+package tools
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// paramSpec declares the checks on one string argument of a tool.
+// Tools list their specs in check order and run them with
+// validateParams, so every tool reports a bad argument the same way;
+// stage tools get theirs from checkRequiredFields.
+type paramSpec struct {
+	name     string
+	required bool
+	// hint follows "'name' is required — " when the argument is missing.
+	hint string
+	// minLen is the minimum length in characters of a non-empty value.
+	minLen int
+	// enum lists the accepted values of a non-empty value.
+	enum []string
+}
+
+// validateParams checks values, which map argument names to what the
+// caller passed, against specs in order and returns the first failure:
+// a CodeMissingField error for an empty required argument, a
+// CodeInvalidInput error for a value that is too short or not in enum.
+// Values are compared after trimming surrounding whitespace.
+//
+// It works on parsed values rather than the MCP request so a tool's
+// process validates the same way whether it's called through Handle or
+// embedded directly.
+func validateParams(specs []paramSpec, values map[string]string) error {
+	for _, spec := range specs {
+		value := strings.TrimSpace(values[spec.name])
+		if value == "" {
+			if !spec.required {
+				continue
+			}
+			if spec.hint == "" {
+				return toolError(CodeMissingField, fmt.Sprintf("'%s' is required", spec.name))
+			}
+			return toolError(CodeMissingField, fmt.Sprintf("'%s' is required — %s", spec.name, spec.hint))
+		}
+		if n := utf8.RuneCountInString(value); n < spec.minLen {
+			return toolError(CodeInvalidInput, fmt.Sprintf("'%s' is too short (%d characters) — give at least %d",
+				spec.name, n, spec.minLen))
+		}
+		if len(spec.enum) > 0 && !slices.Contains(spec.enum, value) {
+			return toolError(CodeInvalidInput, fmt.Sprintf("unknown %s %q — use one of: %s",
+				spec.name, value, strings.Join(spec.enum, ", ")))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestValidateParams(t *testing.T) {
+	specs := []paramSpec{
+		{name: "title", required: true, hint: "give it a name"},
+		{name: "summary", required: true},
+		{name: "body", minLen: 10},
+		{name: "status", enum: []string{"open", "closed"}},
+	}
+	tests := []struct {
+		name   string
+		values map[string]string
+		want   string
+		code   ErrorCode
+	}{
+		{"valid", map[string]string{"title": "x", "summary": "y", "body": "long enough body", "status": "open"}, "", ""},
+		{"optional fields empty", map[string]string{"title": "x", "summary": "y"}, "", ""},
+		{"missing with hint", map[string]string{"summary": "y"}, "'title' is required — give it a name", CodeMissingField},
+		{"blank is missing", map[string]string{"title": "x", "summary": "  \n"}, "'summary' is required", CodeMissingField},
+		{"too short", map[string]string{"title": "x", "summary": "y", "body": "short"}, "'body' is too short (5 characters) — give at least 10", CodeInvalidInput},
+		{"not in enum", map[string]string{"title": "x", "summary": "y", "status": "pending"}, `unknown status "pending" — use one of: open, closed`, CodeInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateParams(specs, tt.values)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.want {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+			if got := ErrorCodeOf(err); got != tt.code {
+				t.Errorf("code = %s, want %s", got, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,347 @@
+// Package tools — see helpers.go for package doc.
+//
+// precheck.go implements the sdd_precheck advisory scanner.
+// It reads whatever artifacts exist in the docs directory and reports
+// cheap, mechanical consistency problems (missing IDs, dangling task
+// references, components with no requirement coverage, empty sections)
+// before the full AI-driven sdd_validate runs.
+//
+// Design: read-only scanner (like audit.go). It never loads or saves
+// hoofy.json and works at any pipeline stage.
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/design"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Precheck severities, ordered from most to least urgent.
+const (
+	precheckHigh   = "high"
+	precheckMedium = "medium"
+	precheckLow    = "low"
+)
+
+// precheckSeverityRank orders findings so the most urgent come first.
+var precheckSeverityRank = map[string]int{
+	precheckHigh:   0,
+	precheckMedium: 1,
+	precheckLow:    2,
+}
+
+// precheckFinding is a single advisory issue found by the scanner.
+type precheckFinding struct {
+	Severity string
+	Artifact string
+	Message  string
+}
+
+// requiredSections lists the headings each artifact must have non-empty.
+// Only artifacts whose structure is fixed by a template are checked.
+var requiredSections = map[config.Stage][]string{
+	config.StageSpecify: {"Must Have", "Should Have", "Non-Functional Requirements"},
+	config.StageDesign:  {"Architecture Overview", "Tech Stack", "Components", "Data Model"},
+	config.StageTasks:   {"Tasks"},
+}
+
+// requirementListSections are the requirements.md headings whose list
+// items are expected to carry an FR/NFR ID.
+var requirementListSections = []string{
+	"Must Have",
+	"Should Have",
+	"Could Have",
+	"Non-Functional Requirements",
+}
+
+// taskIDPattern matches TASK-NNN identifiers.
+var taskIDPattern = regexp.MustCompile(`\bTASK-\d{3,4}\b`)
+
+// parseTaskIDs parses TASK IDs from markdown content.
+// Returns a deduplicated, sorted list of IDs found.
+func parseTaskIDs(content string) []string {
+	matches := taskIDPattern.FindAllString(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(matches))
+	var unique []string
+	for _, m := range matches {
+		if !seen[m] {
+			seen[m] = true
+			unique = append(unique, m)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// PrecheckTool handles the sdd_precheck MCP tool.
+type PrecheckTool struct{}
+
+// NewPrecheckTool creates a PrecheckTool.
+func NewPrecheckTool() *PrecheckTool {
+	return &PrecheckTool{}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *PrecheckTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_precheck",
+		mcp.WithDescription(
+			"Run a cheap, advisory consistency scan over the SDD artifacts that exist so far. "+
+				"Reports requirements without IDs, tasks referencing nonexistent requirements, "+
+				"design components that cover no requirement, and empty required sections. "+
+				"Callable at ANY stage — it never changes pipeline state. "+
+				"Use it before sdd_validate to catch mechanical issues early.",
+		),
+	)
+}
+
+// Handle processes the sdd_precheck tool call.
+func (t *PrecheckTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot))
+}
+
+// process checks the spec artifacts for conflicts before implementation starts.
+func (t *PrecheckTool) process(projectRoot string) (string, error) {
+	artifacts := make(map[config.Stage]string)
+	for _, stage := range []config.Stage{config.StageSpecify, config.StageDesign, config.StageTasks} {
+		var files []string
+		for _, path := range config.StagePathsIn(projectRoot, stage) {
+			content, err := readStageFile(path)
+			if err != nil {
+				return "", fmt.Errorf("reading %s artifact: %w", stage, err)
+			}
+			if content != "" {
+				files = append(files, content)
+			}
+		}
+		if len(files) > 0 {
+			artifacts[stage] = strings.Join(files, "\n\n")
+		}
+	}
+
+	if len(artifacts) == 0 {
+		return "# Precheck\n\nNo requirements, design, or tasks artifacts found yet — nothing to check.", nil
+	}
+
+	filename := func(stage config.Stage) string { return config.StageFilenameIn(projectRoot, stage) }
+	findings := runPrecheck(artifacts, filename)
+	return formatPrecheckReport(findings, artifacts, filename), nil
+}
+
+// runPrecheck performs all checks against the given artifact contents
+// and returns findings sorted by severity. filename names each stage's
+// artifact in the findings.
+func runPrecheck(artifacts map[config.Stage]string, filename func(config.Stage) string) []precheckFinding {
+	var findings []precheckFinding
+
+	requirements := artifacts[config.StageSpecify]
+	designDoc := artifacts[config.StageDesign]
+	tasks := artifacts[config.StageTasks]
+
+	// Empty required sections.
+	for _, stage := range []config.Stage{config.StageSpecify, config.StageDesign, config.StageTasks} {
+		content, ok := artifacts[stage]
+		if !ok {
+			continue
+		}
+		sections := markdownSections(content)
+		for _, heading := range requiredSections[stage] {
+			body, present := sections[heading]
+			switch {
+			case !present:
+				findings = append(findings, precheckFinding{
+					Severity: precheckHigh,
+					Artifact: filename(stage),
+					Message:  fmt.Sprintf("required section %q is missing", heading),
+				})
+			case isPlaceholderBody(body):
+				findings = append(findings, precheckFinding{
+					Severity: precheckHigh,
+					Artifact: filename(stage),
+					Message:  fmt.Sprintf("required section %q is empty", heading),
+				})
+			}
+		}
+	}
+
+	// Requirement list items without IDs.
+	if requirements != "" {
+		sections := markdownSections(requirements)
+		for _, heading := range requirementListSections {
+			for _, item := range listItems(sections[heading]) {
+				if requirementIDPattern.MatchString(item) {
+					continue
+				}
+				findings = append(findings, precheckFinding{
+					Severity: precheckMedium,
+					Artifact: filename(config.StageSpecify),
+					Message:  fmt.Sprintf("requirement under %q has no FR/NFR ID: %s", heading, truncateReview(item, 80)),
+				})
+			}
+		}
+	}
+
+	// Tasks referencing requirements that don't exist.
+	if tasks != "" && requirements != "" {
+		known := make(map[string]bool)
+		for _, id := range extractRequirementIDs(requirements) {
+			known[id] = true
+		}
+		for _, id := range extractRequirementIDs(tasks) {
+			if !known[id] {
+				findings = append(findings, precheckFinding{
+					Severity: precheckHigh,
+					Artifact: filename(config.StageTasks),
+					Message:  fmt.Sprintf("references %s, which is not defined in %s", id, filename(config.StageSpecify)),
+				})
+			}
+		}
+	}
+
+	// Tasks file without any TASK IDs.
+	if tasks != "" && len(parseTaskIDs(tasks)) == 0 {
+		findings = append(findings, precheckFinding{
+			Severity: precheckMedium,
+			Artifact: filename(config.StageTasks),
+			Message:  "no TASK-XXX identifiers found",
+		})
+	}
+
+	// Design components that don't cover any requirement.
+	if designDoc != "" {
+		for _, comp := range design.Components(designDoc) {
+			if requirementIDPattern.MatchString(comp.Body) {
+				continue
+			}
+			findings = append(findings, precheckFinding{
+				Severity: precheckLow,
+				Artifact: filename(config.StageDesign),
+				Message:  fmt.Sprintf("component %q does not reference any FR/NFR requirement", comp.Name),
+			})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return precheckSeverityRank[findings[i].Severity] < precheckSeverityRank[findings[j].Severity]
+	})
+	return findings
+}
+
+// formatPrecheckReport renders findings as a prioritized markdown list.
+func formatPrecheckReport(findings []precheckFinding, artifacts map[config.Stage]string, filename func(config.Stage) string) string {
+	var sb strings.Builder
+	sb.WriteString("# Precheck\n\n")
+	sb.WriteString("_Advisory only — pipeline state was not changed._\n\n")
+
+	var scanned []string
+	for _, stage := range []config.Stage{config.StageSpecify, config.StageDesign, config.StageTasks} {
+		if _, ok := artifacts[stage]; ok {
+			scanned = append(scanned, "`"+filename(stage)+"`")
+		}
+	}
+	fmt.Fprintf(&sb, "**Scanned:** %s\n\n", strings.Join(scanned, ", "))
+
+	if len(findings) == 0 {
+		sb.WriteString("✅ No issues found.\n")
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, "## Findings (%d)\n\n", len(findings))
+	for i, f := range findings {
+		fmt.Fprintf(&sb, "%d. **[%s]** `%s`: %s\n", i+1, strings.ToUpper(f.Severity), f.Artifact, f.Message)
+	}
+	return sb.String()
+}
+
+// --- Markdown helpers ---
+
+// markdownSections splits markdown into sections keyed by heading text
+// (## and ### levels). A ### section's body is also included in its
+// parent ## section, so "Functional Requirements" contains "Must Have".
+// A repeated heading, as in requirements split by domain and read as one
+// document, merges its bodies, skipping placeholders.
+func markdownSections(content string) map[string]string {
+	sections := make(map[string]string)
+	var h2, h3 string
+	var h2Body, h3Body []string
+
+	add := func(heading string, body []string) {
+		text := strings.Join(body, "\n")
+		if prev, ok := sections[heading]; ok && !isPlaceholderBody(prev) {
+			if isPlaceholderBody(text) {
+				return
+			}
+			text = prev + "\n" + text
+		}
+		sections[heading] = text
+	}
+	flushH3 := func() {
+		if h3 != "" {
+			add(h3, h3Body)
+		}
+		h3, h3Body = "", nil
+	}
+	flushH2 := func() {
+		flushH3()
+		if h2 != "" {
+			add(h2, h2Body)
+		}
+		h2, h2Body = "", nil
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		switch {
+		case strings.HasPrefix(line, "## "):
+			flushH2()
+			h2 = strings.TrimSpace(strings.TrimPrefix(line, "## "))
+		case strings.HasPrefix(line, "### "):
+			flushH3()
+			h3 = strings.TrimSpace(strings.TrimPrefix(line, "### "))
+			h2Body = append(h2Body, line)
+		default:
+			if h3 != "" {
+				h3Body = append(h3Body, line)
+			}
+			if h2 != "" {
+				h2Body = append(h2Body, line)
+			}
+		}
+	}
+	flushH2()
+
+	return sections
+}
+
+// isPlaceholderBody reports whether a section body is blank or only
+// contains an italic placeholder such as "_None defined for this version._".
+func isPlaceholderBody(body string) bool {
+	trimmed := strings.TrimSpace(body)
+	if trimmed == "" {
+		return true
+	}
+	return strings.HasPrefix(trimmed, "_") && strings.HasSuffix(trimmed, "_") && !strings.Contains(trimmed, "\n")
+}
+
+// listItems returns the text of top-level markdown list items in body.
+func listItems(body string) []string {
+	var items []string
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") {
+			items = append(items, strings.TrimSpace(line[2:]))
+		}
+	}
+	return items
+}
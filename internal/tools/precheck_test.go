@@ -0,0 +1,243 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const precheckRequirements = `# demo — Requirements
+
+## Functional Requirements
+
+### Must Have
+
+- **FR-001**: Users can register
+- Users can reset their password
+
+### Should Have
+
+- **FR-002**: Users can export CSV
+
+### Could Have
+
+_None defined for this version._
+
+## Non-Functional Requirements
+
+- **NFR-001**: p95 latency under 200ms
+`
+
+const precheckDesign = `# demo — Technical Design
+
+## Architecture Overview
+
+Modular monolith.
+
+## Tech Stack
+
+- Go
+
+## Components
+
+### AuthModule
+- **Covers**: FR-001
+
+### MetricsModule
+- **Responsibility**: Collect metrics
+
+## Data Model
+
+_Not yet defined._
+`
+
+const precheckTasks = `# demo — Implementation Tasks
+
+## Tasks
+
+### TASK-001: Registration
+**Covers**: FR-001
+
+### TASK-002: Webhooks
+**Covers**: FR-009
+`
+
+func TestParseTaskIDs(t *testing.T) {
+	ids := parseTaskIDs("TASK-002 then TASK-001, TASK-002 again")
+	if len(ids) != 2 || ids[0] != "TASK-001" || ids[1] != "TASK-002" {
+		t.Errorf("got %v, want [TASK-001 TASK-002]", ids)
+	}
+	if parseTaskIDs("no tasks") != nil {
+		t.Error("expected nil for content without task IDs")
+	}
+}
+
+func TestMarkdownSections_NestedHeadings(t *testing.T) {
+	sections := markdownSections(precheckRequirements)
+
+	if !strings.Contains(sections["Must Have"], "FR-001") {
+		t.Errorf("Must Have section should contain FR-001, got %q", sections["Must Have"])
+	}
+	if !strings.Contains(sections["Functional Requirements"], "FR-002") {
+		t.Error("## section should include nested ### bodies")
+	}
+	if strings.Contains(sections["Must Have"], "FR-002") {
+		t.Error("Must Have section should stop at the next heading")
+	}
+}
+
+func TestRunPrecheck_Findings(t *testing.T) {
+	findings := runPrecheck(map[config.Stage]string{
+		config.StageSpecify: precheckRequirements,
+		config.StageDesign:  precheckDesign,
+		config.StageTasks:   precheckTasks,
+	}, config.StageFilename)
+
+	var messages []string
+	for _, f := range findings {
+		messages = append(messages, f.Severity+": "+f.Message)
+	}
+	joined := strings.Join(messages, "\n")
+
+	wants := []string{
+		`high: references FR-009`,
+		`high: required section "Data Model" is empty`,
+		`medium: requirement under "Must Have" has no FR/NFR ID`,
+		`low: component "MetricsModule"`,
+	}
+	for _, want := range wants {
+		if !strings.Contains(joined, want) {
+			t.Errorf("findings missing %q\ngot:\n%s", want, joined)
+		}
+	}
+	if strings.Contains(joined, "AuthModule") {
+		t.Error("AuthModule covers FR-001 and should not be flagged")
+	}
+
+	// Findings must be sorted by severity.
+	for i := 1; i < len(findings); i++ {
+		if precheckSeverityRank[findings[i-1].Severity] > precheckSeverityRank[findings[i].Severity] {
+			t.Fatalf("findings not sorted by severity: %v", messages)
+		}
+	}
+}
+
+func TestRunPrecheck_Clean(t *testing.T) {
+	findings := runPrecheck(map[config.Stage]string{
+		config.StageTasks: "## Tasks\n\n### TASK-001: Setup\n",
+	}, config.StageFilename)
+	if len(findings) != 0 {
+		t.Errorf("expected no findings, got %+v", findings)
+	}
+}
+
+func TestPrecheckTool_Handle_NoArtifacts(t *testing.T) {
+	_, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+
+	result, err := NewPrecheckTool().Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	if !strings.Contains(getResultText(result), "nothing to check") {
+		t.Errorf("expected empty-project message, got: %s", getResultText(result))
+	}
+}
+
+func TestPrecheckTool_Handle_DoesNotChangeState(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), precheckRequirements); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	before, _ := store.Load(tmpDir)
+
+	result, err := NewPrecheckTool().Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "Findings") {
+		t.Errorf("expected findings, got: %s", text)
+	}
+
+	after, _ := store.Load(tmpDir)
+	if after.CurrentStage != before.CurrentStage || after.UpdatedAt != before.UpdatedAt {
+		t.Error("precheck must not modify hoofy.json")
+	}
+}
+
+func TestPrecheckTool_Handle_SplitRequirements(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageSpecify)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), "# Charter\n"); err != nil {
+		t.Fatal(err)
+	}
+	result, err := NewSpecifyTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), splitSpecifyRequest(true))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("split: %v %s", err, getResultText(result))
+	}
+	tasks := "# demo — Implementation Tasks\n\n## Tasks\n\n### TASK-001: Login\n**Covers**: FR-001, FR-004, NFR-001\n"
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks), tasks); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = NewPrecheckTool().Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	for _, unwanted := range []string{
+		"is not defined in",
+		`"Should Have" is empty`,
+		`"Non-Functional Requirements" is empty`,
+	} {
+		if strings.Contains(text, unwanted) {
+			t.Errorf("the domain files hold these requirements, but precheck reported %q:\n%s", unwanted, text)
+		}
+	}
+}
+
+func TestPrecheckTool_Handle_RenamedStageFiles(t *testing.T) {
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	cfg, _ := store.Load(tmpDir)
+	cfg.StageFiles = map[config.Stage]string{config.StageSpecify: "spec.md", config.StageTasks: "plan/tasks.md"}
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	for stage, content := range map[config.Stage]string{
+		config.StageSpecify: precheckRequirements,
+		config.StageTasks:   precheckTasks,
+	} {
+		if err := writeStageFile(config.StagePath(tmpDir, stage), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := NewPrecheckTool().Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"**Scanned:** `spec.md`, `plan/tasks.md`",
+		"`plan/tasks.md`: references FR-009, which is not defined in spec.md",
+		"`spec.md`: requirement under \"Must Have\" has no FR/NFR ID",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("findings should name the renamed files, missing %q:\n%s", want, text)
+		}
+	}
+}
@@ -61,45 +61,56 @@ func (t *PrinciplesTool) Definition() mcp.Tool {
 				"- Prices are always in cents (integer), never floats\\n"+
 				"- All timestamps are UTC'"),
 		),
+		actorParam(),
 	)
 }
 
 // Handle processes the sdd_create_principles tool call.
 func (t *PrinciplesTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	principles := req.GetString("principles", "")
-	codingStandards := req.GetString("coding_standards", "")
-	domainTruths := req.GetString("domain_truths", "")
-
-	// Validate required fields.
-	if principles == "" {
-		return mcp.NewToolResultError("'principles' is required — what rules must NEVER be broken in this project?"), nil
+	data := templates.PrinciplesData{
+		Principles:      req.GetString("principles", ""),
+		CodingStandards: req.GetString("coding_standards", ""),
+		DomainTruths:    req.GetString("domain_truths", ""),
 	}
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return stageToolResult(t.run(projectRoot, data, requestActor(ctx, req)))
+}
 
-	cfg, err := t.store.Load(projectRoot)
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+// Run saves the principles for the project at projectRoot and advances
+// the pipeline. data.Name is ignored — the project's name is used.
+func (t *PrinciplesTool) Run(projectRoot string, data templates.PrinciplesData) (*StageResult, error) {
+	return t.run(projectRoot, data, "")
+}
+
+// run is Run with the completed stage attributed to actor.
+func (t *PrinciplesTool) run(projectRoot string, data templates.PrinciplesData, actor string) (*StageResult, error) {
+	// Validate required fields — before the load error, so a bad call is
+	// reported as such even outside a project.
+	cfg, loadErr := t.store.Load(projectRoot)
+	if err := checkRequiredFields(cfg, config.StagePrinciples, map[string]string{
+		"principles":       data.Principles,
+		"coding_standards": data.CodingStandards,
+		"domain_truths":    data.DomainTruths,
+	}); err != nil {
+		return nil, err
+	}
+	if loadErr != nil {
+		return nil, asUserError(loadErr)
 	}
 
 	// Validate we're at the right stage.
 	if err := pipeline.RequireStage(cfg, config.StagePrinciples); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
 	}
 
 	pipeline.MarkInProgress(cfg)
 
-	// Build principles with REAL content from the AI.
-	data := templates.PrinciplesData{
-		Name:            cfg.Name,
-		Principles:      principles,
-		CodingStandards: codingStandards,
-		DomainTruths:    domainTruths,
-	}
-
+	data.Name = cfg.Name
+	data.Attribution = attributionFor(cfg)
 	content, err := t.renderer.Render(templates.Principles, data)
 	if err != nil {
 		return nil, fmt.Errorf("rendering principles: %w", err)
@@ -112,7 +123,7 @@ func (t *PrinciplesTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*
 	}
 
 	// Advance pipeline to next stage.
-	if err := pipeline.Advance(cfg); err != nil {
+	if err := pipeline.AdvanceBy(cfg, actor); err != nil {
 		return nil, fmt.Errorf("advancing pipeline: %w", err)
 	}
 
@@ -133,6 +144,7 @@ func (t *PrinciplesTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*
 			"Call `sdd_create_charter` with the project's problem statement, target users, and proposed solution.",
 		config.DocsDir, content,
 	)
+	response += wordBudgetNote(cfg, config.StagePrinciples, content)
 
-	return mcp.NewToolResultText(response), nil
+	return &StageResult{Content: content, Config: cfg, Response: response}, nil
 }
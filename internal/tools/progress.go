@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// progressFunc reports that done of total units of work are finished.
+// A nil progressFunc is valid and reports nothing.
+type progressFunc func(done, total int, message string)
+
+// report calls p when it is non-nil.
+func (p progressFunc) report(done, total int, message string) {
+	if p != nil {
+		p(done, total, message)
+	}
+}
+
+// maxProgressUpdates caps the notifications sent for one loop, so a
+// task list with thousands of requirements doesn't flood the client.
+const maxProgressUpdates = 20
+
+// every returns a progressFunc that forwards only about
+// maxProgressUpdates evenly spaced reports of a total-unit loop,
+// always including the last one.
+func (p progressFunc) every(total int) progressFunc {
+	if p == nil {
+		return nil
+	}
+	step := max(1, (total+maxProgressUpdates-1)/maxProgressUpdates)
+	return func(done, total int, message string) {
+		if done%step == 0 || done == total {
+			p(done, total, message)
+		}
+	}
+}
+
+// requestProgress returns a progressFunc that sends MCP
+// notifications/progress for req. It returns nil — reporting nothing —
+// when the client didn't ask for progress (no progress token) or the
+// context carries no server session, e.g. when a tool runs through the
+// sdd package API.
+func requestProgress(ctx context.Context, req mcp.CallToolRequest) progressFunc {
+	if req.Params.Meta == nil || req.Params.Meta.ProgressToken == nil {
+		return nil
+	}
+	srv := server.ServerFromContext(ctx)
+	if srv == nil {
+		return nil
+	}
+	token := req.Params.Meta.ProgressToken
+	return func(done, total int, message string) {
+		// Best-effort: a failed notification must never fail the tool.
+		_ = srv.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+			"progressToken": token,
+			"progress":      done,
+			"total":         total,
+			"message":       message,
+		})
+	}
+}
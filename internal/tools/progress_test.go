@@ -0,0 +1,56 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestAnalyzeCoverage_ReportsProgress(t *testing.T) {
+	const total = 50
+	var reqs strings.Builder
+	for i := 1; i <= total; i++ {
+		fmt.Fprintf(&reqs, "- **FR-%03d**: requirement\n", i)
+	}
+
+	var messages []string
+	lastDone := 0
+	progress := progressFunc(func(done, n int, message string) {
+		if n != total || done <= lastDone {
+			t.Errorf("report %d/%d after %d", done, n, lastDone)
+		}
+		lastDone = done
+		messages = append(messages, message)
+	})
+
+	c, err := analyzeCoverage(context.Background(), reqs.String(), "TASK-001 covers FR-001", progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.FRTotal != total || len(c.FRUncovered) != total-1 {
+		t.Fatalf("coverage = %d total, %d uncovered", c.FRTotal, len(c.FRUncovered))
+	}
+	if len(messages) == 0 || len(messages) > maxProgressUpdates+1 {
+		t.Fatalf("got %d progress reports, want 1-%d", len(messages), maxProgressUpdates+1)
+	}
+	if last := messages[len(messages)-1]; last != "analyzed 50/50 requirements" {
+		t.Errorf("last report = %q", last)
+	}
+}
+
+func TestRequestProgress_NoTokenIsNoop(t *testing.T) {
+	if p := requestProgress(context.Background(), mcp.CallToolRequest{}); p != nil {
+		t.Error("requestProgress without a progress token should be nil")
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Meta = &mcp.Meta{ProgressToken: "tok"}
+	p := requestProgress(context.Background(), req)
+	if p != nil {
+		t.Error("requestProgress without a server in the context should be nil")
+	}
+	p.report(1, 1, "safe on nil") // must not panic
+}
@@ -0,0 +1,204 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ProposeQuestionsTool handles the sdd_propose_questions MCP tool.
+// It returns the interview script for eliciting a project idea before
+// the charter — the project's proposal — is written: the problem, the
+// users, the scope and what success looks like.
+//
+// Design: the charter counterpart of clarify's question round. The
+// script is fixed per mode rather than improvised, so every AI client
+// asks the same things; guided mode explains each question and expert
+// mode lists them tersely. Read-only — nothing is saved.
+type ProposeQuestionsTool struct {
+	store config.Store
+}
+
+// NewProposeQuestionsTool creates a ProposeQuestionsTool with its dependencies.
+func NewProposeQuestionsTool(store config.Store) *ProposeQuestionsTool {
+	return &ProposeQuestionsTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *ProposeQuestionsTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_propose_questions",
+		mcp.WithDescription(
+			"Get the interview script for eliciting the project idea before the charter exists. "+
+				"Covers the problem, the users, the scope and success, and says which sdd_create_charter "+
+				"field each answer feeds. Guided mode returns a step-by-step script with the reason for each "+
+				"question; expert mode returns a terse question list. "+
+				"Requires: the pipeline is at the charter stage and no charter has been saved yet. "+
+				"Read-only — does not change pipeline state.",
+		),
+	)
+}
+
+// Handle processes the sdd_propose_questions tool call.
+func (t *ProposeQuestionsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot))
+}
+
+// interviewTopic is one part of the proposal interview.
+type interviewTopic struct {
+	name   string
+	fields []string // sdd_create_charter fields the answers feed
+	why    string   // guided mode's explanation of the topic
+	guided []string
+	expert []string
+}
+
+// proposeInterview is the proposal interview, in the order to ask it.
+// The guided questions follow the charter stage guide: ask about the
+// user's context rather than a feature wish list.
+var proposeInterview = []interviewTopic{
+	{
+		name:   "Problem",
+		fields: []string{"problem_statement", "domain_context"},
+		why:    "A solution is only as good as the problem it answers. Pin the pain down before talking about features.",
+		guided: []string{
+			"What problem are you trying to solve? Tell me about the last time it happened.",
+			"What do people do about it today, and what's frustrating about that?",
+			"What does it cost them — time, money, mistakes, stress?",
+			"Is there anything about your industry or market I should know, such as rules you must follow?",
+		},
+		expert: []string{
+			"Problem and its current workaround?",
+			"Cost of the problem today?",
+			"Domain or regulatory context?",
+		},
+	},
+	{
+		name:   "Users",
+		fields: []string{"target_users", "stakeholders"},
+		why:    "Specific people make for specific requirements. \"Everyone\" is not a user.",
+		guided: []string{
+			"Describe someone who would use this in their first week — their role, their frustration, their goal.",
+			"What are the top three tasks that person does every day that this should improve?",
+			"Who else cares how this turns out — who pays for it, approves it, or supports it?",
+		},
+		expert: []string{
+			"Primary personas (role, need)?",
+			"Top daily tasks to improve?",
+			"Stakeholders and their interests?",
+		},
+	},
+	{
+		name:   "Scope",
+		fields: []string{"proposed_solution", "boundaries", "existing_systems", "constraints"},
+		why:    "Saying what is out is as important as saying what is in — it keeps the project from growing without anyone deciding it should.",
+		guided: []string{
+			"In a sentence or two, what will it do for those people? Leave out how it's built.",
+			"What should the first version definitely include?",
+			"What is it tempting to add that should wait, or never be built?",
+			"Does it have to work with, or replace, anything that already exists?",
+			"Are there limits on budget, deadline, team or technology?",
+		},
+		expert: []string{
+			"Solution in one or two sentences (no tech)?",
+			"In scope for v1? Explicitly out?",
+			"Systems to integrate with or replace?",
+			"Budget, deadline, team and tech constraints?",
+		},
+	},
+	{
+		name:   "Success",
+		fields: []string{"success_criteria", "vision"},
+		why:    "Measurable outcomes tell everyone when the project is done and whether it worked.",
+		guided: []string{
+			"Imagine it's three months after launch and it went well. What has changed, and how would you measure it?",
+			"What number would tell you it failed?",
+			"Where could this go after the first version?",
+		},
+		expert: []string{
+			"2-4 measurable success criteria?",
+			"Long-term vision beyond v1?",
+		},
+	},
+}
+
+// process checks the pipeline is waiting for a charter and returns the
+// interview script for the project's mode.
+func (t *ProposeQuestionsTool) process(projectRoot string) (string, error) {
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if err := pipeline.RequireStage(cfg, config.StageCharter); err != nil {
+		return "", asUserError(err)
+	}
+	charter, err := readStageFile(config.StagePath(projectRoot, config.StageCharter))
+	if err != nil {
+		return "", fmt.Errorf("reading charter: %w", err)
+	}
+	if strings.TrimSpace(charter) != "" {
+		return "", newUserError(
+			"a charter is already saved — revise it with sdd_create_charter and update=true instead of starting the interview over",
+		)
+	}
+
+	if cfg.Mode == config.ModeExpert {
+		return formatExpertInterview(), nil
+	}
+	return formatGuidedInterview(), nil
+}
+
+// formatGuidedInterview renders the step-by-step script for guided mode.
+func formatGuidedInterview() string {
+	var sb strings.Builder
+	sb.WriteString("# Project Interview\n\n")
+	sb.WriteString("Use this script to help the user describe their idea before writing the charter.\n\n")
+	sb.WriteString("**How to run it:**\n")
+	sb.WriteString("- Ask one question at a time and wait for the answer.\n")
+	sb.WriteString("- Use plain language — the user may not be technical.\n")
+	sb.WriteString("- After each topic, summarize what you heard in two or three sentences and ask if it's right.\n")
+	sb.WriteString("- If an answer is vague (\"fast\", \"easy\", \"everyone\"), ask for an example or a number.\n\n")
+	for i, topic := range proposeInterview {
+		fmt.Fprintf(&sb, "## %d. %s\n\n", i+1, topic.name)
+		fmt.Fprintf(&sb, "_%s_\n\n", topic.why)
+		for j, q := range topic.guided {
+			fmt.Fprintf(&sb, "%d. %s\n", j+1, q)
+		}
+		fmt.Fprintf(&sb, "\nFeeds: %s\n\n", formatFieldList(topic.fields))
+	}
+	sb.WriteString("## Next Step\n\n")
+	sb.WriteString("When every topic has an answer the user agrees with, call `sdd_create_charter` " +
+		"with the fields above filled in from their answers.")
+	return sb.String()
+}
+
+// formatExpertInterview renders the terse question list for expert mode.
+func formatExpertInterview() string {
+	var sb strings.Builder
+	sb.WriteString("# Project Interview\n\n")
+	for _, topic := range proposeInterview {
+		fmt.Fprintf(&sb, "**%s** → %s\n", topic.name, formatFieldList(topic.fields))
+		for _, q := range topic.expert {
+			fmt.Fprintf(&sb, "- %s\n", q)
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("Then call `sdd_create_charter`.")
+	return sb.String()
+}
+
+// formatFieldList renders field names as inline code, comma-separated.
+func formatFieldList(fields []string) string {
+	quoted := make([]string, len(fields))
+	for i, f := range fields {
+		quoted[i] = "`" + f + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+func TestProposeQuestionsTool_Process(t *testing.T) {
+	tests := []struct {
+		mode    config.Mode
+		want    []string
+		notWant []string
+	}{
+		{
+			mode: config.ModeGuided,
+			want: []string{
+				"Ask one question at a time",
+				"## 1. Problem", "## 2. Users", "## 3. Scope", "## 4. Success",
+				"Feeds: `problem_statement`, `domain_context`",
+				"Describe someone who would use this in their first week",
+			},
+		},
+		{
+			mode:    config.ModeExpert,
+			want:    []string{"**Scope** → `proposed_solution`", "- 2-4 measurable success criteria?"},
+			notWant: []string{"Ask one question at a time", "Describe someone"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			tmpDir, cleanup := setupTestProjectAtStage(t, tt.mode, config.StageCharter)
+			defer cleanup()
+
+			text, err := NewProposeQuestionsTool(config.NewFileStore()).process(tmpDir)
+			if err != nil {
+				t.Fatalf("process: %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(text, w) {
+					t.Errorf("script missing %q:\n%s", w, text)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(text, w) {
+					t.Errorf("script should not contain %q:\n%s", w, text)
+				}
+			}
+		})
+	}
+}
+
+func TestProposeQuestionsTool_Process_Rejects(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+	defer cleanup()
+	tool := NewProposeQuestionsTool(config.NewFileStore())
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), "# Charter\n"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tool.process(tmpDir); err == nil || !strings.Contains(err.Error(), "already saved") {
+		t.Errorf("an existing charter should be rejected, got %v", err)
+	}
+
+	specifyDir, cleanup2 := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup2()
+	if _, err := tool.process(specifyDir); err == nil || !IsUserError(err) {
+		t.Errorf("a project past the charter should be rejected with a user error, got %v", err)
+	}
+}
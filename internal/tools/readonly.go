@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ReadOnlyTools are the tools that only read project state, never write
+// it. It is the one list both guards consult: a read-only server accepts
+// no other tool (see RejectMutations), and WithTimeout may abandon only
+// these at the deadline.
+var ReadOnlyTools = []string{
+	"sdd_audit", "sdd_change_status", "sdd_compare_projects", "sdd_context",
+	"sdd_context_check", "sdd_estimate_clarity", "sdd_get_context",
+	"sdd_list_markers", "sdd_list_projects", "sdd_precheck",
+	"sdd_propose_questions", "sdd_reverse_engineer", "sdd_review",
+	"sdd_suggest_context",
+}
+
+// isReadOnly reports whether the tool named name is in ReadOnlyTools.
+func isReadOnly(name string) bool {
+	return slices.Contains(ReadOnlyTools, name)
+}
+
+// RejectMutations is tool handler middleware for a read-only server. A
+// call to any tool outside ReadOnlyTools fails with CodeReadOnly before
+// the tool's Handle runs — a guard in case a mutating tool is ever
+// registered on a server meant for demos or CI.
+func RejectMutations(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !isReadOnly(req.Params.Name) {
+			return toolResult("", toolError(CodeReadOnly, fmt.Sprintf(
+				"server is read-only — '%s' can't run; start it without --read-only to change the project",
+				req.Params.Name)))
+		}
+		return next(ctx, req)
+	}
+}
@@ -0,0 +1,40 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRejectMutations(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StagePrinciples)
+	defer cleanup()
+	store := config.NewFileStore()
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "sdd_create_principles"
+	req.Params.Arguments = map[string]interface{}{"principles": "- Never lose data"}
+	result, err := RejectMutations(NewPrinciplesTool(store, mustRenderer(t)).Handle)(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handler error: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "server is read-only") {
+		t.Errorf("want a read-only error, got: %s", getResultText(result))
+	}
+	if result.Meta == nil || result.Meta.AdditionalFields[ErrorCodeKey] != string(CodeReadOnly) {
+		t.Errorf("want error code %s, got meta %+v", CodeReadOnly, result.Meta)
+	}
+	if cfg, _ := store.Load(tmpDir); cfg.CurrentStage != config.StagePrinciples {
+		t.Errorf("a rejected call must not advance the pipeline, at %s", cfg.CurrentStage)
+	}
+
+	req = mcp.CallToolRequest{}
+	req.Params.Name = "sdd_get_context"
+	result, err = RejectMutations(NewContextTool(store).Handle)(context.Background(), req)
+	if err != nil || isErrorResult(result) {
+		t.Errorf("sdd_get_context should pass through, got %v: %s", err, getResultText(result))
+	}
+}
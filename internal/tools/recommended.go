@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// defaultRecommendedFields lists, per stage, the optional fields that are
+// strategically important enough to question when left empty. Empty
+// optionals are rendered as placeholder text ("_None identified._"),
+// which an AI then reads as "handled" — a gentle nudge in the response
+// catches the ones that were simply forgotten.
+//
+// hoofy.json can replace a stage's list (recommended_fields) or turn the
+// nudges off entirely (skip_recommended_fields).
+var defaultRecommendedFields = map[config.Stage][]string{
+	config.StageCharter:  {"boundaries", "constraints"},
+	config.StageSpecify:  {"wont_have", "assumptions", "dependencies"},
+	config.StageDesign:   {"security", "api_contracts", "quality_analysis"},
+	config.StageTasks:    {"acceptance_criteria", "dependency_graph"},
+	config.StageValidate: {"risk_assessment"},
+}
+
+// recommendedFieldLabels are the human names used in the nudges.
+// Fields without a label are shown with underscores as spaces.
+var recommendedFieldLabels = map[string]string{
+	"wont_have":        "won't-have items",
+	"api_contracts":    "API contracts",
+	"security":         "security design",
+	"quality_analysis": "quality analysis",
+}
+
+// recommendedFieldsNote returns a response note naming the recommended
+// fields of stage left empty, or "" when none were. fields maps each
+// optional parameter name to the value the caller passed; names missing
+// from fields are not optional for the stage and are never reported.
+// The note is response-only — nothing is persisted.
+func recommendedFieldsNote(cfg *config.ProjectConfig, stage config.Stage, fields map[string]string) string {
+	if cfg.SkipRecommendedFields {
+		return ""
+	}
+	names, ok := cfg.RecommendedFields[stage]
+	if !ok {
+		names = defaultRecommendedFields[stage]
+	}
+
+	var empty []string
+	for _, name := range names {
+		if v, known := fields[name]; known && strings.TrimSpace(v) == "" {
+			empty = append(empty, name)
+		}
+	}
+	if len(empty) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n\n💡 **Worth a second look** — these optional fields were left empty and recorded as placeholders:\n")
+	for _, name := range empty {
+		label, ok := recommendedFieldLabels[name]
+		if !ok {
+			label = strings.ReplaceAll(name, "_", " ")
+		}
+		fmt.Fprintf(&sb, "- No %s recorded (`%s`) — are you sure?\n", label, name)
+	}
+	sb.WriteString("\n_If they're intentionally empty, carry on. Set `skip_recommended_fields` in hoofy.json to silence these notes._")
+	return sb.String()
+}
@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+func TestRecommendedFieldsNote(t *testing.T) {
+	fields := map[string]string{
+		"could_have":   "",
+		"wont_have":    "",
+		"assumptions":  "- Users have email",
+		"dependencies": "  ",
+	}
+
+	tests := []struct {
+		name    string
+		cfg     func(*config.ProjectConfig)
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "built-in list",
+			want:    []string{"No won't-have items recorded (`wont_have`)", "No dependencies recorded"},
+			notWant: []string{"`assumptions`", "`could_have`"},
+		},
+		{
+			name: "configured list replaces the built-in one",
+			cfg: func(c *config.ProjectConfig) {
+				c.RecommendedFields = map[config.Stage][]string{config.StageSpecify: {"could_have", "unknown_field"}}
+			},
+			want:    []string{"No could have recorded (`could_have`)"},
+			notWant: []string{"`wont_have`", "unknown_field"},
+		},
+		{
+			name: "empty list silences the stage",
+			cfg: func(c *config.ProjectConfig) {
+				c.RecommendedFields = map[config.Stage][]string{config.StageSpecify: {}}
+			},
+		},
+		{
+			name: "skipped everywhere",
+			cfg:  func(c *config.ProjectConfig) { c.SkipRecommendedFields = true },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewProjectConfig("p", "d", config.ModeGuided)
+			if tt.cfg != nil {
+				tt.cfg(cfg)
+			}
+			note := recommendedFieldsNote(cfg, config.StageSpecify, fields)
+			if len(tt.want) == 0 && note != "" {
+				t.Fatalf("want no note, got:\n%s", note)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(note, w) {
+					t.Errorf("note missing %q:\n%s", w, note)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(note, w) {
+					t.Errorf("note should not mention %q:\n%s", w, note)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package tools
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// referencesParam is the optional "references" argument shared by the
+// charter, specify, design and tasks tools.
+func referencesParam() mcp.ToolOption {
+	return mcp.WithArray("references",
+		mcp.Description("External links for this artifact — RFCs, tickets, prior designs — rendered in its "+
+			"\"References\" section and kept in hoofy.json. Each item is {\"title\": ..., \"url\": ...}; "+
+			"URLs must be absolute http(s) links. "+
+			"Example: [{\"title\": \"RFC 6749 (OAuth 2.0)\", \"url\": \"https://www.rfc-editor.org/rfc/rfc6749\"}]"),
+		mcp.Items(map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"title": map[string]any{"type": "string"},
+				"url":   map[string]any{"type": "string"},
+			},
+			"required": []string{"title", "url"},
+		}),
+	)
+}
+
+// requestReferences reads the "references" argument. Items must be
+// objects; their fields are checked later by validateReferences, so a
+// tool called through sdd.Engine gets the same checks.
+func requestReferences(req mcp.CallToolRequest) ([]templates.Reference, error) {
+	raw, ok := req.GetArguments()["references"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, toolError(CodeInvalidInput, "'references' must be a list of {title, url} objects")
+	}
+	refs := make([]templates.Reference, 0, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, toolError(CodeInvalidInput, fmt.Sprintf("references[%d] must be a {title, url} object", i))
+		}
+		title, _ := obj["title"].(string)
+		link, _ := obj["url"].(string)
+		refs = append(refs, templates.Reference{Title: title, URL: link})
+	}
+	return refs, nil
+}
+
+// validateReferences trims refs in place and checks that each has a
+// one-line title and an absolute http(s) URL. Every bad item is
+// reported at once.
+func validateReferences(refs []templates.Reference) error {
+	var problems []string
+	for i := range refs {
+		r := &refs[i]
+		r.Title = strings.TrimSpace(r.Title)
+		r.URL = strings.TrimSpace(r.URL)
+		switch {
+		case r.Title == "":
+			problems = append(problems, fmt.Sprintf("references[%d]: 'title' is required", i))
+		case strings.ContainsAny(r.Title, "\r\n"):
+			problems = append(problems, fmt.Sprintf("references[%d]: 'title' must be a single line", i))
+		}
+		if r.URL == "" {
+			problems = append(problems, fmt.Sprintf("references[%d]: 'url' is required", i))
+		} else if u, err := url.Parse(r.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") ||
+			u.Host == "" || strings.ContainsAny(r.URL, " \t") {
+			problems = append(problems, fmt.Sprintf("references[%d]: %q is not an absolute http(s) URL", i, r.URL))
+		}
+	}
+	if len(problems) > 0 {
+		return toolError(CodeInvalidInput, "invalid references:\n- "+strings.Join(problems, "\n- "))
+	}
+	return nil
+}
+
+// recordReferences stores refs as stage's references in cfg, replacing
+// the ones from an earlier run of the stage.
+func recordReferences(cfg *config.ProjectConfig, stage config.Stage, refs []templates.Reference) {
+	status, ok := cfg.StageStatus[stage]
+	if !ok && len(refs) == 0 {
+		return
+	}
+	status.References = nil
+	for _, r := range refs {
+		status.References = append(status.References, config.Reference(r))
+	}
+	cfg.StageStatus[stage] = status
+}
+
+// stageReferences returns the references recorded for stage.
+func stageReferences(cfg *config.ProjectConfig, stage config.Stage) []templates.Reference {
+	var refs []templates.Reference
+	for _, r := range cfg.StageStatus[stage].References {
+		refs = append(refs, templates.Reference(r))
+	}
+	return refs
+}
+
+// projectReferences collects the references of every stage in pipeline
+// order, dropping repeats of a URL.
+func projectReferences(cfg *config.ProjectConfig) []templates.Reference {
+	var refs []templates.Reference
+	seen := map[string]bool{}
+	for _, stage := range config.StageOrderFor(cfg) {
+		for _, r := range stageReferences(cfg, stage) {
+			if seen[r.URL] {
+				continue
+			}
+			seen[r.URL] = true
+			refs = append(refs, r)
+		}
+	}
+	return refs
+}
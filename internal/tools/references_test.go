@@ -0,0 +1,145 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestValidateReferences(t *testing.T) {
+	refs := []templates.Reference{{Title: "  RFC 6749 ", URL: " https://www.rfc-editor.org/rfc/rfc6749 "}}
+	if err := validateReferences(refs); err != nil {
+		t.Fatalf("valid reference rejected: %v", err)
+	}
+	if refs[0].Title != "RFC 6749" || refs[0].URL != "https://www.rfc-editor.org/rfc/rfc6749" {
+		t.Errorf("reference not trimmed: %+v", refs[0])
+	}
+
+	bad := []templates.Reference{
+		{Title: "", URL: "https://example.com"},
+		{Title: "Two\nlines", URL: "https://example.com"},
+		{Title: "No scheme", URL: "example.com/rfc"},
+		{Title: "FTP", URL: "ftp://example.com/spec.pdf"},
+		{Title: "Spaces", URL: "https://example.com/a b"},
+		{Title: "No URL"},
+	}
+	err := validateReferences(bad)
+	if err == nil || ErrorCodeOf(err) != CodeInvalidInput {
+		t.Fatalf("validateReferences = %v, want an invalid input error", err)
+	}
+	for i := range bad {
+		if !strings.Contains(err.Error(), fmt.Sprintf("references[%d]", i)) {
+			t.Errorf("error should report references[%d]:\n%s", i, err)
+		}
+	}
+}
+
+func TestDesignTool_Handle_References(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageDesign)
+	defer cleanup()
+	for _, stage := range []config.Stage{config.StageCharter, config.StageSpecify, config.StageClarify} {
+		if err := writeStageFile(config.StagePath(tmpDir, stage), "# "+string(stage)+"\n\n- **FR-001**: Log in\n"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store := config.NewFileStore()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"architecture_overview": "A modular monolith.",
+		"tech_stack":            "- Go",
+		"components":            "### Auth\n- **Covers**: FR-001",
+		"data_model":            "### User",
+		"references": []any{
+			map[string]any{"title": "RFC 6749 (OAuth 2.0)", "url": "https://www.rfc-editor.org/rfc/rfc6749"},
+			map[string]any{"title": "AUTH-42", "url": "https://jira.example.com/browse/AUTH-42"},
+		},
+	}
+	result, err := NewDesignTool(store, mustRenderer(t)).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+
+	doc, _ := readStageFile(config.StagePath(tmpDir, config.StageDesign))
+	if !strings.Contains(doc, "## References\n\n- [RFC 6749 (OAuth 2.0)](https://www.rfc-editor.org/rfc/rfc6749)\n"+
+		"- [AUTH-42](https://jira.example.com/browse/AUTH-42)\n") {
+		t.Errorf("design.md should list the references:\n%s", doc)
+	}
+	cfg, _ := store.Load(tmpDir)
+	if got := cfg.StageStatus[config.StageDesign].References; len(got) != 2 || got[1].Title != "AUTH-42" {
+		t.Errorf("design references in config = %+v", got)
+	}
+}
+
+func TestDesignTool_Handle_BadReferences(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageDesign)
+	defer cleanup()
+
+	tests := []struct {
+		name       string
+		references any
+		want       string
+	}{
+		{"not a list", "https://example.com", "must be a list"},
+		{"not an object", []any{"https://example.com"}, "references[0] must be a {title, url} object"},
+		{"bad url", []any{map[string]any{"title": "Spec", "url": "not a url"}}, "is not an absolute http(s) URL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]any{
+				"architecture_overview": "A modular monolith.",
+				"tech_stack":            "- Go",
+				"components":            "### Auth",
+				"data_model":            "### User",
+				"references":            tt.references,
+			}
+			result, err := NewDesignTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if !isErrorResult(result) || !strings.Contains(getResultText(result), tt.want) {
+				t.Errorf("result = %s, want an error containing %q", getResultText(result), tt.want)
+			}
+		})
+	}
+	if _, err := os.Stat(config.StagePath(tmpDir, config.StageDesign)); !os.IsNotExist(err) {
+		t.Error("design.md must not be written when the references are invalid")
+	}
+}
+
+func TestSummarizeTool_ConsolidatesReferences(t *testing.T) {
+	tmpDir, cleanup := setupValidatedProject(t, "PASS")
+	defer cleanup()
+
+	store := config.NewFileStore()
+	cfg, _ := store.Load(tmpDir)
+	recordReferences(cfg, config.StageCharter, []templates.Reference{{Title: "BILL-12", URL: "https://jira.example.com/BILL-12"}})
+	recordReferences(cfg, config.StageDesign, []templates.Reference{
+		{Title: "RFC 6749", URL: "https://www.rfc-editor.org/rfc/rfc6749"},
+		{Title: "BILL-12 again", URL: "https://jira.example.com/BILL-12"},
+	})
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewSummarizeTool(store, mustRenderer(t)).Handle(context.Background(),
+		summarizeRequest("- **FR-001**: Users can register"))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	data, _ := os.ReadFile(config.SummaryPath(tmpDir))
+	want := "## References\n\n- [BILL-12](https://jira.example.com/BILL-12)\n- [RFC 6749](https://www.rfc-editor.org/rfc/rfc6749)\n"
+	if !strings.Contains(string(data), want) {
+		t.Errorf("summary.md should list each reference once, in pipeline order:\n%s", data)
+	}
+}
@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+// RegenerateResult reports what Regenerate did with one stage artifact.
+type RegenerateResult struct {
+	Stage config.Stage
+	Path  string // absolute path to the artifact
+	// Regenerated is true when the artifact was re-rendered; Reason
+	// says why not otherwise.
+	Regenerated bool
+	Reason      string
+}
+
+// Regenerate re-renders the project's missing stage artifacts from the
+// structured data stored next to them, for when the markdown is lost but
+// the sidecars survive. Artifacts that exist are left as they are.
+//
+// Only requirements.md has a structured source today (requirements.json);
+// it comes back with its requirement lists, but the Constraints,
+// Assumptions and Dependencies sections the sidecar doesn't record are
+// empty. Every other missing artifact is reported as not regenerable.
+func Regenerate(projectRoot string, store config.Store, renderer templates.Renderer) ([]RegenerateResult, error) {
+	cfg, err := store.Load(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []RegenerateResult
+	for _, stage := range config.StageOrderFor(cfg) {
+		path := config.StagePath(projectRoot, stage)
+		if path == "" {
+			continue
+		}
+		res := RegenerateResult{Stage: stage, Path: path}
+		switch {
+		case ArtifactExists(projectRoot, stage):
+			res.Reason = "exists — left as is"
+		case stage == config.StageSpecify:
+			res.Regenerated, res.Reason, err = regenerateRequirements(projectRoot, cfg, renderer)
+			if err != nil {
+				return results, err
+			}
+		default:
+			res.Reason = "no structured source to rebuild it from"
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// regenerateRequirements rebuilds requirements.md from requirements.json.
+// It reports false with a reason when there is no sidecar to read.
+func regenerateRequirements(projectRoot string, cfg *config.ProjectConfig, renderer templates.Renderer) (bool, string, error) {
+	data, err := os.ReadFile(config.RequirementsJSONPath(projectRoot))
+	if os.IsNotExist(err) {
+		return false, fmt.Sprintf("no structured source (%s is missing)", config.RequirementsJSONFile), nil
+	}
+	if err != nil {
+		return false, "", fmt.Errorf("reading %s: %w", config.RequirementsJSONFile, err)
+	}
+	var reqs []requirements.Requirement
+	if err := json.Unmarshal(config.StripBOM(data), &reqs); err != nil {
+		return false, "", newUserError(fmt.Sprintf("%s is not valid: %v", config.RequirementsJSONFile, err))
+	}
+	if len(reqs) == 0 {
+		return false, fmt.Sprintf("%s lists no requirements", config.RequirementsJSONFile), nil
+	}
+
+	rows := make([]importedRow, 0, len(reqs))
+	for _, r := range reqs {
+		if !slices.Contains(requirements.Buckets, r.Bucket) {
+			return false, "", newUserError(fmt.Sprintf("%s: %s has unknown bucket %q", config.RequirementsJSONFile, r.ID, r.Bucket))
+		}
+		rows = append(rows, importedRow{id: r.ID, bucket: r.Bucket, text: r.Text})
+	}
+	rd := importedRequirementsData(rows)
+	for _, field := range []*string{&rd.CouldHave, &rd.WontHave} {
+		if *field == "" {
+			*field = wontHavePlaceholder
+		}
+	}
+	rd.Name = cfg.Name
+	rd.References = stageReferences(cfg, config.StageSpecify)
+	rd.Attribution = attributionFor(cfg)
+	if _, err := RenderAndWriteRequirements(projectRoot, renderer, rd, false); err != nil {
+		return false, "", err
+	}
+	return true, fmt.Sprintf("from %s (%d requirements; constraints, assumptions and dependencies were not stored)",
+		config.RequirementsJSONFile, len(reqs)), nil
+}
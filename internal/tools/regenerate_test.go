@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+func TestRegenerate_RequirementsFromSidecar(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+
+	renderer, err := templates.NewRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := templates.RequirementsData{
+		MustHave:      "- **FR-001**: Users can sign up\n- **FR-002**: Users can log in",
+		ShouldHave:    "- **FR-003**: Users can reset their password",
+		NonFunctional: "- **NFR-001**: Pages load in under 2s",
+	}
+	if _, err := RenderAndWriteRequirements(tmpDir, renderer, data, false); err != nil {
+		t.Fatal(err)
+	}
+	charter := config.StagePath(tmpDir, config.StageCharter)
+	if err := writeStageFile(charter, "# Charter\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(config.StagePath(tmpDir, config.StageSpecify)); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := Regenerate(tmpDir, config.NewFileStore(), renderer)
+	if err != nil {
+		t.Fatalf("Regenerate: %v", err)
+	}
+	byStage := map[config.Stage]RegenerateResult{}
+	for _, r := range results {
+		byStage[r.Stage] = r
+	}
+	if r := byStage[config.StageSpecify]; !r.Regenerated || !strings.Contains(r.Reason, "4 requirements") {
+		t.Errorf("specify = %+v, want regenerated from requirements.json", r)
+	}
+	if r := byStage[config.StageCharter]; r.Regenerated || !strings.Contains(r.Reason, "exists") {
+		t.Errorf("charter = %+v, want left as is", r)
+	}
+	if r := byStage[config.StageDesign]; r.Regenerated || !strings.Contains(r.Reason, "no structured source") {
+		t.Errorf("design = %+v, want no structured source", r)
+	}
+
+	doc, _ := readStageFile(config.StagePath(tmpDir, config.StageSpecify))
+	for _, want := range []string{"**FR-002**: Users can log in", "**FR-003**", "**NFR-001**", wontHavePlaceholder} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("regenerated requirements.md missing %q:\n%s", want, doc)
+		}
+	}
+}
+
+func TestRegenerate_NoSidecar(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+
+	renderer, err := templates.NewRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := Regenerate(tmpDir, config.NewFileStore(), renderer)
+	if err != nil {
+		t.Fatalf("Regenerate: %v", err)
+	}
+	for _, r := range results {
+		if r.Regenerated {
+			t.Errorf("%s regenerated without a structured source", r.Stage)
+		}
+		if r.Stage == config.StageSpecify && !strings.Contains(r.Reason, config.RequirementsJSONFile+" is missing") {
+			t.Errorf("specify reason = %q", r.Reason)
+		}
+	}
+	if ArtifactExists(tmpDir, config.StageSpecify) {
+		t.Error("requirements.md should not be written without a sidecar")
+	}
+}
+
+func TestRegenerate_BadSidecar(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+
+	renderer, err := templates.NewRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sidecar := `[{"id": "FR-001", "bucket": "Maybe", "text": "Users can sign up"}]`
+	if err := writeStageFile(config.RequirementsJSONPath(tmpDir), sidecar); err != nil {
+		t.Fatal(err)
+	}
+	_, err = Regenerate(tmpDir, config.NewFileStore(), renderer)
+	if err == nil || !IsUserError(err) || !strings.Contains(err.Error(), `unknown bucket "Maybe"`) {
+		t.Fatalf("Regenerate error = %v, want an unknown bucket user error", err)
+	}
+}
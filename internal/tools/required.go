@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// requiredSpec is the paramSpec of a stage parameter that must not be
+// empty, with the hint shown when it is.
+func requiredSpec(name, hint string) paramSpec {
+	return paramSpec{name: name, required: true, hint: hint}
+}
+
+// defaultRequiredFields lists, per stage and in check order, the fields a
+// stage tool requires out of the box. hoofy.json can replace a stage's
+// list (required_fields) — e.g. to make 'boundaries' mandatory or
+// 'should_have' optional.
+var defaultRequiredFields = map[config.Stage][]paramSpec{
+	config.StagePrinciples: {
+		requiredSpec("principles", "what rules must NEVER be broken in this project?"),
+	},
+	config.StageCharter: {
+		requiredSpec("problem_statement", "describe the problem this project solves"),
+		requiredSpec("target_users", "who will use this?"),
+		requiredSpec("proposed_solution", "describe what we're building"),
+		requiredSpec("success_criteria", "how do we know this succeeded?"),
+	},
+	config.StageSpecify: {
+		requiredSpec("must_have", "list the non-negotiable requirements"),
+		requiredSpec("should_have", "list the important-but-not-blocking requirements"),
+		requiredSpec("non_functional", "list performance, security, and usability constraints"),
+	},
+	config.StageBusinessRules: {
+		requiredSpec("definitions", "list domain terms with precise definitions (Ubiquitous Language)"),
+		requiredSpec("facts", "list relationships between domain terms that are always true"),
+		requiredSpec("constraints", "list behavioral boundaries using When/Then/Otherwise format"),
+	},
+	config.StageResearch: {
+		requiredSpec("options_evaluated", "list the options considered"),
+		requiredSpec("findings", "what did the research show?"),
+		requiredSpec("recommendation", "which option should the design use?"),
+	},
+	config.StageDesign: {
+		requiredSpec("architecture_overview", "describe the system architecture"),
+		requiredSpec("tech_stack", "list technology choices with rationale"),
+		requiredSpec("components", "break down the system into components with responsibilities"),
+		requiredSpec("data_model", "define the data schema and relationships"),
+	},
+	config.StageTasks: {
+		requiredSpec("total_tasks", "how many tasks in the breakdown?"),
+		requiredSpec("estimated_effort", "what's the estimated effort?"),
+		requiredSpec("tasks", "provide the ordered list of implementation tasks"),
+	},
+	config.StageValidate: {
+		requiredSpec("requirements_coverage", "analyze requirement-to-task traceability"),
+		requiredSpec("component_coverage", "analyze component-to-task coverage"),
+		requiredSpec("consistency_issues", "list cross-artifact inconsistencies (or '_None found._')"),
+		requiredSpec("verdict", "must be 'PASS', 'PASS_WITH_WARNINGS', or 'FAIL'"),
+	},
+}
+
+// checkRequiredFields returns a CodeMissingField error for the first
+// required field of stage left empty in fields, which maps every
+// parameter of the stage to the value the caller passed. The required
+// set comes from cfg's required_fields when it lists the stage, and
+// from defaultRequiredFields otherwise — also when cfg is nil because
+// the project couldn't be loaded, so a missing field is reported before
+// the load error.
+func checkRequiredFields(cfg *config.ProjectConfig, stage config.Stage, fields map[string]string) error {
+	required := defaultRequiredFields[stage]
+	if cfg != nil {
+		if names, ok := cfg.RequiredFields[stage]; ok {
+			required = make([]paramSpec, len(names))
+			for i, name := range names {
+				if _, known := fields[name]; !known {
+					return newUserError(fmt.Sprintf("required_fields in %s lists unknown %s field %q — known fields: %s",
+						config.ConfigName(), stage, name, strings.Join(slices.Sorted(maps.Keys(fields)), ", ")))
+				}
+				hint := defaultFieldHint(stage, name)
+				if hint == "" {
+					hint = "this project's required_fields makes it mandatory"
+				}
+				required[i] = requiredSpec(name, hint)
+			}
+		}
+	}
+	return validateParams(required, fields)
+}
+
+// defaultFieldHint returns the built-in hint of a stage field, or "".
+func defaultFieldHint(stage config.Stage, name string) string {
+	for _, f := range defaultRequiredFields[stage] {
+		if f.name == name {
+			return f.hint
+		}
+	}
+	return ""
+}
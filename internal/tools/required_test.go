@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+func TestDefaultRequiredFields(t *testing.T) {
+	// The defaults must match the fields each stage tool has always
+	// required — changing them is a breaking change for callers.
+	tests := []struct {
+		stage config.Stage
+		want  []string
+	}{
+		{config.StagePrinciples, []string{"principles"}},
+		{config.StageCharter, []string{"problem_statement", "target_users", "proposed_solution", "success_criteria"}},
+		{config.StageSpecify, []string{"must_have", "should_have", "non_functional"}},
+		{config.StageBusinessRules, []string{"definitions", "facts", "constraints"}},
+		{config.StageResearch, []string{"options_evaluated", "findings", "recommendation"}},
+		{config.StageDesign, []string{"architecture_overview", "tech_stack", "components", "data_model"}},
+		{config.StageTasks, []string{"total_tasks", "estimated_effort", "tasks"}},
+		{config.StageValidate, []string{"requirements_coverage", "component_coverage", "consistency_issues", "verdict"}},
+		{config.StageClarify, nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, f := range defaultRequiredFields[tt.stage] {
+			got = append(got, f.name)
+			if f.hint == "" {
+				t.Errorf("%s: '%s' has no hint", tt.stage, f.name)
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: required = %v, want %v", tt.stage, got, tt.want)
+		}
+	}
+}
+
+func TestCheckRequiredFields(t *testing.T) {
+	fields := map[string]string{
+		"must_have":      "- **FR-001**: Sign up",
+		"should_have":    "",
+		"non_functional": "- **NFR-001**: Fast",
+		"assumptions":    "  ",
+	}
+
+	tests := []struct {
+		name     string
+		required map[config.Stage][]string // nil: no config at all
+		want     string
+		userErr  bool
+	}{
+		{name: "defaults without a config", want: "'should_have' is required — list the important-but-not-blocking requirements"},
+		{name: "defaults with an empty override map", required: map[config.Stage][]string{}, want: "'should_have' is required"},
+		{name: "required made optional", required: map[config.Stage][]string{config.StageSpecify: {"must_have", "non_functional"}}},
+		{name: "optional made required", required: map[config.Stage][]string{config.StageSpecify: {"must_have", "assumptions"}}, want: "'assumptions' is required — this project's required_fields makes it mandatory"},
+		{name: "empty list requires nothing", required: map[config.Stage][]string{config.StageSpecify: {}}},
+		{name: "other stage override leaves defaults", required: map[config.Stage][]string{config.StageDesign: {}}, want: "'should_have' is required"},
+		{name: "unknown field", required: map[config.Stage][]string{config.StageSpecify: {"must_haves"}}, want: `unknown specify field "must_haves"`, userErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg *config.ProjectConfig
+			if tt.required != nil {
+				cfg = config.NewProjectConfig("p", "d", config.ModeGuided)
+				cfg.RequiredFields = tt.required
+			}
+			err := checkRequiredFields(cfg, config.StageSpecify, fields)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+			wantCode := CodeMissingField
+			if tt.userErr {
+				wantCode = CodeInvalidInput
+			}
+			if code := ErrorCodeOf(err); code != wantCode {
+				t.Errorf("code = %s, want %s", code, wantCode)
+			}
+		})
+	}
+}
+
+func TestCharterTool_ConfiguredRequiredFields(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageCharter)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.RequiredFields = map[config.Stage][]string{
+		config.StageCharter: {"problem_statement", "target_users", "proposed_solution", "boundaries"},
+	}
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	tool := NewCharterTool(store, mustRenderer(t))
+
+	data := templates.CharterData{
+		ProblemStatement: "Invoices get lost",
+		TargetUsers:      "Freelancers",
+		ProposedSolution: "A tiny invoicing app",
+	}
+	if _, err := tool.Run(tmpDir, data); err == nil || !strings.Contains(err.Error(), "'boundaries' is required") {
+		t.Fatalf("want boundaries required, got %v", err)
+	}
+
+	// success_criteria is no longer required.
+	data.Boundaries = "No payroll"
+	if _, err := tool.Run(tmpDir, data); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+}
@@ -0,0 +1,202 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ResearchTool handles the sdd_record_research MCP tool.
+// It saves the optional research/spike artifact between clarify and
+// design. Only projects initialized with enable_research reach this stage.
+type ResearchTool struct {
+	store    config.Store
+	renderer templates.Renderer
+	bridge   StageObserver
+}
+
+// NewResearchTool creates a ResearchTool with its dependencies.
+func NewResearchTool(store config.Store, renderer templates.Renderer) *ResearchTool {
+	return &ResearchTool{store: store, renderer: renderer}
+}
+
+// SetBridge injects an optional StageObserver that gets notified
+// when the research stage completes. Nil is safe (disables bridge).
+func (t *ResearchTool) SetBridge(obs StageObserver) { t.bridge = obs }
+
+// Definition returns the MCP tool definition for registration.
+func (t *ResearchTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_record_research",
+		mcp.WithDescription(
+			"Save research and spike results before the design stage. "+
+				"This OPTIONAL stage runs after the Clarity Gate only for projects initialized "+
+				"with enable_research=true. "+
+				"IMPORTANT: Before calling this tool, the AI MUST actually evaluate the candidate "+
+				"libraries/approaches (and run spikes where needed) against the clarified requirements. "+
+				"Pass the ACTUAL findings (not placeholders). "+
+				"Requires: the Clarity Gate must have been passed first.",
+		),
+		mcp.WithString("options_evaluated",
+			mcp.Required(),
+			mcp.Description("Libraries, services, or approaches considered, with pros/cons for each. "+
+				"Example: '### PostgreSQL\\n- ✅ ACID, mature\\n- ❌ Ops overhead\\n\\n"+
+				"### SQLite\\n- ✅ Zero-ops\\n- ❌ Single writer'"),
+		),
+		mcp.WithString("findings",
+			mcp.Required(),
+			mcp.Description("What was learned — benchmarks, compatibility results, blockers found. "+
+				"Reference requirements where relevant (FR-XXX/NFR-XXX)."),
+		),
+		mcp.WithString("recommendation",
+			mcp.Required(),
+			mcp.Description("The recommended option and why. This feeds directly into the design stage."),
+		),
+		mcp.WithString("questions",
+			mcp.Description("The research questions this stage set out to answer."),
+		),
+		mcp.WithString("spikes",
+			mcp.Description("Spikes or prototypes run, with links or short results. "+
+				"Example: '- Spike: load-tested SQLite WAL at 500 writes/s — p95 12ms'"),
+		),
+		mcp.WithString("open_risks",
+			mcp.Description("Risks that remain after research and should be addressed in design."),
+		),
+		actorParam(),
+	)
+}
+
+// Handle processes the sdd_record_research tool call.
+func (t *ResearchTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := researchParams{
+		OptionsEvaluated: req.GetString("options_evaluated", ""),
+		Findings:         req.GetString("findings", ""),
+		Recommendation:   req.GetString("recommendation", ""),
+		Questions:        req.GetString("questions", ""),
+		Spikes:           req.GetString("spikes", ""),
+		OpenRisks:        req.GetString("open_risks", ""),
+		Actor:            requestActor(ctx, req),
+	}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// researchParams are the parsed sdd_record_research arguments.
+type researchParams struct {
+	OptionsEvaluated string
+	Findings         string
+	Recommendation   string
+	Questions        string
+	Spikes           string
+	OpenRisks        string
+	Actor            string
+}
+
+// process writes the research report for the research stage.
+func (t *ResearchTool) process(projectRoot string, params researchParams) (string, error) {
+	optionsEvaluated := params.OptionsEvaluated
+	findings := params.Findings
+	recommendation := params.Recommendation
+	questions := params.Questions
+	spikes := params.Spikes
+	openRisks := params.OpenRisks
+
+	// Validate required fields — before the load error, so a bad call is
+	// reported as such even outside a project.
+	cfg, loadErr := t.store.Load(projectRoot)
+	if err := checkRequiredFields(cfg, config.StageResearch, map[string]string{
+		"options_evaluated": optionsEvaluated,
+		"findings":          findings,
+		"recommendation":    recommendation,
+		"questions":         questions,
+		"spikes":            spikes,
+		"open_risks":        openRisks,
+	}); err != nil {
+		return "", err
+	}
+	if loadErr != nil {
+		return "", asUserError(loadErr)
+	}
+
+	if !cfg.HasStage(config.StageResearch) {
+		return "", newUserError(
+			"the research stage is not enabled for this project — initialize with enable_research=true to use it",
+		)
+	}
+
+	// Validate we're at the right stage.
+	if err := pipeline.RequireStage(cfg, config.StageResearch); err != nil {
+		return "", asUserError(err)
+	}
+
+	// Verify prerequisite artifacts exist.
+	if err := pipeline.RequireArtifacts(projectRoot, pipeline.PrerequisiteArtifacts(cfg, config.StageResearch)...); err != nil {
+		return "", asUserError(err)
+	}
+
+	pipeline.MarkInProgress(cfg)
+
+	// Fill optional fields with defaults.
+	if questions == "" {
+		questions = "_Not recorded._"
+	}
+	if spikes == "" {
+		spikes = "_No spikes run._"
+	}
+	if openRisks == "" {
+		openRisks = "_None identified._"
+	}
+
+	data := templates.ResearchData{
+		Name:             cfg.Name,
+		Attribution:      attributionFor(cfg),
+		Questions:        questions,
+		OptionsEvaluated: optionsEvaluated,
+		Spikes:           spikes,
+		Findings:         findings,
+		Recommendation:   recommendation,
+		OpenRisks:        openRisks,
+	}
+
+	content, err := t.renderer.Render(templates.Research, data)
+	if err != nil {
+		return "", fmt.Errorf("rendering research: %w", err)
+	}
+
+	researchPath := config.StagePath(projectRoot, config.StageResearch)
+	if err := writeStageFile(researchPath, content); err != nil {
+		return "", fmt.Errorf("writing research: %w", err)
+	}
+
+	// Advance pipeline to next stage.
+	if err := pipeline.AdvanceBy(cfg, params.Actor); err != nil {
+		return "", fmt.Errorf("advancing pipeline: %w", err)
+	}
+
+	if err := t.store.Save(projectRoot, cfg); err != nil {
+		return "", fmt.Errorf("saving config: %w", err)
+	}
+
+	notifyObserver(t.bridge, cfg.Name, config.StageResearch, content)
+
+	response := fmt.Sprintf(
+		"# Research Recorded\n\n"+
+			"Saved to `%s/research.md`\n\n"+
+			"## Content\n\n%s\n\n"+
+			"---\n\n"+
+			"## Next Step\n\n"+
+			"Pipeline advanced to **Design**.\n\n"+
+			"Use the recommendation above as the basis for the architecture. "+
+			"Call `sdd_create_design` once the design is ready.",
+		config.ResolveDocsDir(projectRoot), content,
+	)
+
+	return response, nil
+}
@@ -0,0 +1,174 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// setupResearchProject creates a project with the research stage enabled
+// and advances it to the research stage.
+func setupResearchProject(t *testing.T) (string, func()) {
+	t.Helper()
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+
+	store := config.NewFileStore()
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		cleanup()
+		t.Fatalf("setup: load config: %v", err)
+	}
+	if err := cfg.EnableOptionalStage(config.StageResearch); err != nil {
+		cleanup()
+		t.Fatalf("setup: enable research: %v", err)
+	}
+	cfg.ClarityScore = 100
+	for cfg.CurrentStage != config.StageResearch {
+		if err := pipeline.Advance(cfg); err != nil {
+			cleanup()
+			t.Fatalf("setup: advance: %v", err)
+		}
+	}
+	if err := store.Save(tmpDir, cfg); err != nil {
+		cleanup()
+		t.Fatalf("setup: save config: %v", err)
+	}
+	for stage, content := range map[config.Stage]string{
+		config.StageSpecify: "# Requirements\n\n- NFR-001: 1k writes/s",
+		config.StageClarify: "# Clarifications\n\nAll clarified.",
+	} {
+		if err := writeStageFile(config.StagePath(tmpDir, stage), content); err != nil {
+			cleanup()
+			t.Fatalf("setup: write %s: %v", stage, err)
+		}
+	}
+	return tmpDir, cleanup
+}
+
+func researchArgs() map[string]interface{} {
+	return map[string]interface{}{
+		"options_evaluated": "### SQLite\n- zero-ops\n\n### PostgreSQL\n- mature",
+		"findings":          "SQLite handles NFR-001 load comfortably.",
+		"recommendation":    "Use SQLite with WAL mode.",
+	}
+}
+
+func TestResearchTool_Handle_Success(t *testing.T) {
+	tmpDir, cleanup := setupResearchProject(t)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	tool := NewResearchTool(store, mustRenderer(t))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = researchArgs()
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+
+	data, err := os.ReadFile(config.StagePath(tmpDir, config.StageResearch))
+	if err != nil {
+		t.Fatalf("research.md not written: %v", err)
+	}
+	for _, want := range []string{"## Options Evaluated", "Use SQLite with WAL mode.", "_No spikes run._"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("research.md missing %q", want)
+		}
+	}
+
+	cfg, _ := store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageDesign {
+		t.Errorf("CurrentStage = %s, want design", cfg.CurrentStage)
+	}
+}
+
+func TestResearchTool_Handle_NotEnabled(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup()
+
+	tool := NewResearchTool(config.NewFileStore(), mustRenderer(t))
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = researchArgs()
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "not enabled") {
+		t.Errorf("expected not-enabled error, got: %s", getResultText(result))
+	}
+}
+
+func TestResearchTool_Handle_MissingRequiredFields(t *testing.T) {
+	_, cleanup := setupResearchProject(t)
+	defer cleanup()
+
+	tool := NewResearchTool(config.NewFileStore(), mustRenderer(t))
+	for _, field := range []string{"options_evaluated", "findings", "recommendation"} {
+		args := researchArgs()
+		delete(args, field)
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+
+		result, err := tool.Handle(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Handle failed: %v", err)
+		}
+		if !isErrorResult(result) {
+			t.Errorf("missing %s should return an error", field)
+		}
+	}
+}
+
+func TestClarifyTool_GatePassed_AdvancesToResearchWhenEnabled(t *testing.T) {
+	tmpDir, cleanup := setupTestProject(t, config.ModeExpert)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	cfg, _ := store.Load(tmpDir)
+	if err := cfg.EnableOptionalStage(config.StageResearch); err != nil {
+		t.Fatal(err)
+	}
+	cfg.ClarityScore = 100
+	for cfg.CurrentStage != config.StageClarify {
+		if err := pipeline.Advance(cfg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg.ClarityScore = 0
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), "- **FR-001**: x"); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := NewClarifyTool(store, mustRenderer(t))
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"answers":          "Q: who? A: admins",
+		"dimension_scores": "target_users:90,core_functionality:90,data_model:90,integrations:90,edge_cases:90,security:90,scale_performance:90,scope_boundaries:90",
+	}
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if !strings.Contains(getResultText(result), "sdd_record_research") {
+		t.Errorf("response should point to sdd_record_research, got: %s", getResultText(result))
+	}
+
+	cfg, _ = store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageResearch {
+		t.Errorf("CurrentStage = %s, want research", cfg.CurrentStage)
+	}
+}
@@ -0,0 +1,140 @@
+package tools
+
+import (
+	"errors"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// StageResult is the outcome of running a pipeline stage outside the MCP
+// request plumbing: the artifact written (empty when the run only
+// produced guidance, e.g. a clarify question round), the project state
+// afterwards, and the response text the MCP tool returns to the AI.
+type StageResult struct {
+	Content  string
+	Config   *config.ProjectConfig
+	Response string
+}
+
+// ErrorCode is a stable, machine-readable identifier for a user error.
+// Error results carry it in their metadata under ErrorCodeKey, so MCP
+// clients can branch on the failure without parsing the message.
+type ErrorCode string
+
+// Error codes reported on error tool results.
+const (
+	CodeInvalidInput    ErrorCode = "SDD_INVALID_INPUT"
+	CodeMissingField    ErrorCode = "SDD_MISSING_FIELD"
+	CodeWrongStage      ErrorCode = "SDD_WRONG_STAGE"
+	CodeGateNotPassed   ErrorCode = "SDD_GATE_NOT_PASSED"
+	CodeMissingArtifact ErrorCode = "SDD_MISSING_ARTIFACT"
+	CodeNotInitialized  ErrorCode = "SDD_NOT_INITIALIZED"
+	CodeConflict        ErrorCode = "SDD_CONFLICT"
+	CodeReadOnly        ErrorCode = "SDD_READ_ONLY"
+	CodeTimeout         ErrorCode = "SDD_TIMEOUT"
+	CodeNotFound        ErrorCode = "SDD_NOT_FOUND"
+)
+
+// ErrorCodeKey is the error result metadata key holding the ErrorCode.
+const ErrorCodeKey = "errorCode"
+
+// userError marks a failure the caller can fix — a missing argument, a
+// stage run out of order — as opposed to an I/O or rendering failure.
+// Handle reports it as an error tool result instead of a protocol error.
+type userError struct {
+	code ErrorCode
+	err  error
+}
+
+func (e *userError) Error() string { return e.err.Error() }
+func (e *userError) Unwrap() error { return e.err }
+
+// toolError returns a userError with the given code and message.
+func toolError(code ErrorCode, msg string) error {
+	return &userError{code: code, err: errors.New(msg)}
+}
+
+// NewToolError is toolError for tool packages outside this one, such as
+// the memory tools, so every tool reports caller errors with the same
+// codes and result metadata.
+func NewToolError(code ErrorCode, msg string) error { return toolError(code, msg) }
+
+// newUserError returns a userError with the given message and the
+// CodeInvalidInput code.
+func newUserError(msg string) error { return toolError(CodeInvalidInput, msg) }
+
+// asUserError marks err as a userError, coded by the pipeline or config
+// sentinel it wraps. Nil stays nil.
+func asUserError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &userError{code: classifyError(err), err: err}
+}
+
+// classifyError maps the sentinel errors of the pipeline and config
+// packages to their codes.
+func classifyError(err error) ErrorCode {
+	switch {
+	case errors.Is(err, pipeline.ErrWrongStage):
+		return CodeWrongStage
+	case errors.Is(err, pipeline.ErrGateNotPassed):
+		return CodeGateNotPassed
+	case errors.Is(err, pipeline.ErrMissingArtifact):
+		return CodeMissingArtifact
+	case errors.Is(err, config.ErrNotInitialized):
+		return CodeNotInitialized
+	case errors.Is(err, config.ErrConflict):
+		return CodeConflict
+	default:
+		return CodeInvalidInput
+	}
+}
+
+// IsUserError reports whether err is a caller-fixable failure (invalid
+// arguments or pipeline state) rather than an internal one. A config
+// save conflict counts: the caller fixes it by retrying.
+func IsUserError(err error) bool {
+	var ue *userError
+	return errors.As(err, &ue) || errors.Is(err, config.ErrConflict)
+}
+
+// ErrorCodeOf returns the code of a user error, or "" when err isn't one.
+func ErrorCodeOf(err error) ErrorCode {
+	var ue *userError
+	if errors.As(err, &ue) {
+		return ue.code
+	}
+	if IsUserError(err) {
+		return classifyError(err)
+	}
+	return ""
+}
+
+// toolResult converts the outcome of a tool's core logic into an MCP
+// result: user errors become error results carrying their ErrorCode in
+// the metadata, other errors propagate.
+func toolResult(text string, err error) (*mcp.CallToolResult, error) {
+	if err != nil {
+		if IsUserError(err) {
+			result := mcp.NewToolResultError(err.Error())
+			result.Meta = mcp.NewMetaFromMap(map[string]any{ErrorCodeKey: string(ErrorCodeOf(err))})
+			return result, nil
+		}
+		return nil, err
+	}
+	return mcp.NewToolResultText(text), nil
+}
+
+// ToolResult is toolResult for tool packages outside this one.
+func ToolResult(text string, err error) (*mcp.CallToolResult, error) { return toolResult(text, err) }
+
+// stageToolResult is toolResult for a pipeline stage's Run.
+func stageToolResult(res *StageResult, err error) (*mcp.CallToolResult, error) {
+	if err != nil {
+		return toolResult("", err)
+	}
+	return toolResult(res.Response, nil)
+}
@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	cfg := config.NewProjectConfig("x", "y", config.ModeGuided)
+	cfg.CurrentStage = config.StageClarify
+
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCode
+	}{
+		{"missing field", toolError(CodeMissingField, "'name' is required"), CodeMissingField},
+		{"plain user error", newUserError("bad input"), CodeInvalidInput},
+		{"wrong stage", asUserError(pipeline.RequireStage(cfg, config.StageDesign)), CodeWrongStage},
+		{"gate", asUserError(pipeline.CanAdvance(cfg)), CodeGateNotPassed},
+		{"missing artifacts", asUserError(pipeline.RequireArtifacts(t.TempDir(), config.StageCharter, config.StageSpecify)), CodeMissingArtifact},
+		{"not initialized", asUserError(fmt.Errorf("project A: %w", config.ErrNotInitialized)), CodeNotInitialized},
+		{"bare conflict", fmt.Errorf("saving: %w", config.ErrConflict), CodeConflict},
+		{"internal error", errors.New("disk on fire"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorCodeOf(tt.err); got != tt.want {
+				t.Errorf("ErrorCodeOf(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToolResult_ErrorCodeMeta(t *testing.T) {
+	result, err := toolResult("", toolError(CodeMissingField, "'name' is required"))
+	if err != nil || !isErrorResult(result) {
+		t.Fatalf("want an error result, got %v", err)
+	}
+	if result.Meta == nil || result.Meta.AdditionalFields[ErrorCodeKey] != string(CodeMissingField) {
+		t.Errorf("meta = %+v, want %s=%s", result.Meta, ErrorCodeKey, CodeMissingField)
+	}
+	if got := getResultText(result); got != "'name' is required" {
+		t.Errorf("message = %q; the code belongs in the metadata only", got)
+	}
+
+	if ok, _ := toolResult("done", nil); ok.Meta != nil {
+		t.Errorf("successful results carry no error code, got %+v", ok.Meta)
+	}
+}
+
+func TestHandle_ReportsNotInitialized(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Chdir(tmpDir)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"principles": "- Keep it simple"}
+	result, err := NewPrinciplesTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), req)
+	if err != nil || !isErrorResult(result) {
+		t.Fatalf("want an error result, got %v", err)
+	}
+	if got := result.Meta.AdditionalFields[ErrorCodeKey]; got != string(CodeNotInitialized) {
+		t.Errorf("errorCode = %v, want %s", got, CodeNotInitialized)
+	}
+}
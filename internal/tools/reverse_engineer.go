@@ -113,7 +113,7 @@ func scanManifests(root, detailLevel string) scanSection {
 // --- Structure scanner ---
 
 // scanStructure builds a directory tree with depth limiting.
-func scanStructure(root, detailLevel string, maxDepth int) scanSection {
+func scanStructure(ctx context.Context, root, detailLevel string, maxDepth int) scanSection {
 	s := scanSection{title: "Directory Structure"}
 	if maxDepth <= 0 {
 		maxDepth = 3
@@ -123,6 +123,9 @@ func scanStructure(root, detailLevel string, maxDepth int) scanSection {
 	lines = append(lines, "```")
 
 	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if err != nil {
 			return nil // graceful degradation
 		}
@@ -596,7 +599,7 @@ var apiRoutePatterns = []string{
 }
 
 // scanAPIDefs detects and reads API definition files.
-func scanAPIDefs(root, detailLevel string) scanSection {
+func scanAPIDefs(ctx context.Context, root, detailLevel string) scanSection {
 	s := scanSection{title: "API Evidence"}
 	var parts []string
 
@@ -657,6 +660,9 @@ func scanAPIDefs(root, detailLevel string) scanSection {
 		"urls.py": true, "api.py": true,
 	}
 	_ = filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if err != nil {
 			return nil
 		}
@@ -720,7 +726,7 @@ var adrDirs = []string{
 }
 
 // scanADRs detects and reads ADR files.
-func scanADRs(root, detailLevel string) scanSection {
+func scanADRs(ctx context.Context, root, detailLevel string) scanSection {
 	s := scanSection{title: "Prior Decisions"}
 	var parts []string
 
@@ -732,6 +738,9 @@ func scanADRs(root, detailLevel string) scanSection {
 
 		// Walk the ADR directory (may have subdirectories for change pipeline).
 		_ = filepath.WalkDir(dirPath, func(path string, d os.DirEntry, err error) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
 			if err != nil || d.IsDir() {
 				return nil
 			}
@@ -828,7 +837,7 @@ var testFilePatterns = map[string]string{
 }
 
 // scanTests detects test directories, frameworks, and approximate file counts.
-func scanTests(root, detailLevel string) scanSection {
+func scanTests(ctx context.Context, root, detailLevel string) scanSection {
 	s := scanSection{title: "Test Evidence"}
 	var parts []string
 
@@ -850,6 +859,9 @@ func scanTests(root, detailLevel string) scanSection {
 	testDirsSeen := map[string]bool{}
 
 	_ = filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if err != nil {
 			return nil
 		}
@@ -1030,28 +1042,50 @@ func (t *ReverseEngineerTool) Definition() mcp.Tool {
 
 // Handle processes the sdd_reverse_engineer tool call.
 func (t *ReverseEngineerTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	detailLevel := memory.ParseDetailLevel(req.GetString("detail_level", ""))
-	maxTokens := int(req.GetFloat("max_tokens", 0))
-	scanPath := req.GetString("scan_path", "")
-	maxDepth := int(req.GetFloat("max_depth", 3))
-
-	if maxDepth <= 0 {
-		maxDepth = 3
+	params := reverseEngineerParams{
+		DetailLevel: memory.ParseDetailLevel(req.GetString("detail_level", "")),
+		MaxTokens:   int(req.GetFloat("max_tokens", 0)),
+		ScanPath:    req.GetString("scan_path", ""),
+		MaxDepth:    int(req.GetFloat("max_depth", 3)),
 	}
 
-	// Resolve scan root.
 	root, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return toolResult(t.process(ctx, root, params))
+}
+
+// reverseEngineerParams are the parsed sdd_reverse_engineer arguments.
+type reverseEngineerParams struct {
+	DetailLevel string
+	MaxTokens   int
+	ScanPath    string
+	MaxDepth    int
+}
+
+// process scans the project and renders the report sdd_bootstrap works
+// from. Once ctx is done the scanners stop walking and it returns ctx's
+// error instead of a partial report.
+func (t *ReverseEngineerTool) process(ctx context.Context, root string, params reverseEngineerParams) (string, error) {
+	detailLevel := params.DetailLevel
+	maxTokens := params.MaxTokens
+	scanPath := params.ScanPath
+	maxDepth := params.MaxDepth
+
+	if maxDepth <= 0 {
+		maxDepth = 3
+	}
+
+	// Resolve scan root.
 	if scanPath != "" {
 		candidate := filepath.Join(root, scanPath)
 		info, err := os.Stat(candidate)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("scan_path '%s' not found: %v", scanPath, err)), nil
+			return "", newUserError(fmt.Sprintf("scan_path '%s' not found: %v", scanPath, err))
 		}
 		if !info.IsDir() {
-			return mcp.NewToolResultError(fmt.Sprintf("scan_path '%s' is not a directory", scanPath)), nil
+			return "", newUserError(fmt.Sprintf("scan_path '%s' is not a directory", scanPath))
 		}
 		root = candidate
 	}
@@ -1061,16 +1095,19 @@ func (t *ReverseEngineerTool) Handle(ctx context.Context, req mcp.CallToolReques
 	// Run all sub-scanners sequentially.
 	sections := []scanSection{
 		scanManifests(root, detailLevel),
-		scanStructure(root, detailLevel, maxDepth),
+		scanStructure(ctx, root, detailLevel, maxDepth),
 		scanConfigs(root, detailLevel),
 		scanEntryPoints(root, detailLevel),
 		scanConventions(root, detailLevel),
 		scanSchemas(root, detailLevel),
-		scanAPIDefs(root, detailLevel),
-		scanADRs(root, detailLevel),
-		scanTests(root, detailLevel),
+		scanAPIDefs(ctx, root, detailLevel),
+		scanADRs(ctx, root, detailLevel),
+		scanTests(ctx, root, detailLevel),
 	}
 
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	duration := time.Since(start)
 
 	// Collect totals.
@@ -1166,7 +1203,7 @@ func (t *ReverseEngineerTool) Handle(ctx context.Context, req mcp.CallToolReques
 	tokens := memory.EstimateTokens(result)
 	result += memory.TokenFooter(tokens)
 
-	return mcp.NewToolResultText(result), nil
+	return result, nil
 }
 
 // truncateReportToTokens truncates the report by removing sections from
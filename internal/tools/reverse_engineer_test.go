@@ -178,7 +178,7 @@ func TestScanManifests_SummaryLevel(t *testing.T) {
 
 func TestScanStructure_GoProject(t *testing.T) {
 	root := setupGoProject(t)
-	s := scanStructure(root, "standard", 3)
+	s := scanStructure(context.Background(), root, "standard", 3)
 
 	if !strings.Contains(s.content, "internal/") {
 		t.Error("should show internal/ directory")
@@ -190,7 +190,7 @@ func TestScanStructure_GoProject(t *testing.T) {
 
 func TestScanStructure_DepthLimit(t *testing.T) {
 	root := setupGoProject(t)
-	s := scanStructure(root, "standard", 1)
+	s := scanStructure(context.Background(), root, "standard", 1)
 
 	// With depth 1, should show top-level dirs but not files inside them.
 	if !strings.Contains(s.content, "internal/") {
@@ -204,7 +204,7 @@ func TestScanStructure_DepthLimit(t *testing.T) {
 
 func TestScanStructure_EmptyProject(t *testing.T) {
 	root := setupEmptyProject(t)
-	s := scanStructure(root, "standard", 3)
+	s := scanStructure(context.Background(), root, "standard", 3)
 
 	if !strings.Contains(s.content, "```") {
 		t.Error("should contain code fence")
@@ -378,7 +378,7 @@ func TestScanAPIDefs_OpenAPI(t *testing.T) {
 	root := setupEmptyProject(t)
 	writeTestFile(t, root, "openapi.yaml", "openapi: 3.0.0\ninfo:\n  title: My API\n  version: 1.0.0\npaths: {}\n")
 
-	s := scanAPIDefs(root, "standard")
+	s := scanAPIDefs(context.Background(), root, "standard")
 	if !strings.Contains(s.content, "openapi.yaml") {
 		t.Error("should detect openapi.yaml")
 	}
@@ -389,7 +389,7 @@ func TestScanAPIDefs_OpenAPI(t *testing.T) {
 
 func TestScanAPIDefs_RouteFiles(t *testing.T) {
 	root := setupGoProject(t)
-	s := scanAPIDefs(root, "standard")
+	s := scanAPIDefs(context.Background(), root, "standard")
 
 	// internal/handler/routes.go should be found by the walker.
 	if !strings.Contains(s.content, "routes.go") {
@@ -399,7 +399,7 @@ func TestScanAPIDefs_RouteFiles(t *testing.T) {
 
 func TestScanAPIDefs_PythonURLs(t *testing.T) {
 	root := setupPythonProject(t)
-	s := scanAPIDefs(root, "standard")
+	s := scanAPIDefs(context.Background(), root, "standard")
 
 	if !strings.Contains(s.content, "urls.py") {
 		t.Error("should detect urls.py")
@@ -408,7 +408,7 @@ func TestScanAPIDefs_PythonURLs(t *testing.T) {
 
 func TestScanAPIDefs_EmptyProject(t *testing.T) {
 	root := setupEmptyProject(t)
-	s := scanAPIDefs(root, "standard")
+	s := scanAPIDefs(context.Background(), root, "standard")
 
 	if !strings.Contains(s.content, "No API definitions") {
 		t.Error("should say no API defs found")
@@ -421,7 +421,7 @@ func TestScanADRs_WithADRDir(t *testing.T) {
 	root := setupEmptyProject(t)
 	writeTestFile(t, root, "docs/adr/ADR-001-use-postgres.md", "# ADR-001: Use PostgreSQL\n\n## Decision\nUse PostgreSQL for the database.\n")
 
-	s := scanADRs(root, "standard")
+	s := scanADRs(context.Background(), root, "standard")
 	if !strings.Contains(s.content, "ADR-001") {
 		t.Error("should detect ADR files")
 	}
@@ -434,7 +434,7 @@ func TestScanADRs_NumberedFiles(t *testing.T) {
 	root := setupEmptyProject(t)
 	writeTestFile(t, root, "adr/0001-initial-architecture.md", "# Initial Architecture\nMonolith first.\n")
 
-	s := scanADRs(root, "standard")
+	s := scanADRs(context.Background(), root, "standard")
 	if !strings.Contains(s.content, "0001-initial-architecture.md") {
 		t.Error("should detect numbered ADR files")
 	}
@@ -442,7 +442,7 @@ func TestScanADRs_NumberedFiles(t *testing.T) {
 
 func TestScanADRs_EmptyProject(t *testing.T) {
 	root := setupEmptyProject(t)
-	s := scanADRs(root, "standard")
+	s := scanADRs(context.Background(), root, "standard")
 
 	if !strings.Contains(s.content, "No ADR files") {
 		t.Error("should say no ADRs found")
@@ -453,7 +453,7 @@ func TestScanADRs_EmptyProject(t *testing.T) {
 
 func TestScanTests_GoProject(t *testing.T) {
 	root := setupGoProject(t)
-	s := scanTests(root, "standard")
+	s := scanTests(context.Background(), root, "standard")
 
 	if !strings.Contains(s.content, "Go testing") {
 		t.Error("should detect Go testing framework")
@@ -465,7 +465,7 @@ func TestScanTests_GoProject(t *testing.T) {
 
 func TestScanTests_NodeProject(t *testing.T) {
 	root := setupNodeProject(t)
-	s := scanTests(root, "standard")
+	s := scanTests(context.Background(), root, "standard")
 
 	if !strings.Contains(s.content, "Jest") {
 		t.Error("should detect Jest from jest.config.js")
@@ -477,7 +477,7 @@ func TestScanTests_NodeProject(t *testing.T) {
 
 func TestScanTests_PythonProject(t *testing.T) {
 	root := setupPythonProject(t)
-	s := scanTests(root, "standard")
+	s := scanTests(context.Background(), root, "standard")
 
 	if !strings.Contains(s.content, "pytest") {
 		t.Error("should detect pytest from conftest.py")
@@ -486,7 +486,7 @@ func TestScanTests_PythonProject(t *testing.T) {
 
 func TestScanTests_EmptyProject(t *testing.T) {
 	root := setupEmptyProject(t)
-	s := scanTests(root, "standard")
+	s := scanTests(context.Background(), root, "standard")
 
 	if !strings.Contains(s.content, "No test files") {
 		t.Error("should say no test files found")
@@ -604,7 +604,7 @@ func TestScanStructure_IgnoresDirs(t *testing.T) {
 	writeTestFile(t, root, "node_modules/lodash/index.js", "module.exports = {};\n")
 	writeTestFile(t, root, ".git/config", "[core]\n")
 
-	s := scanStructure(root, "standard", 3)
+	s := scanStructure(context.Background(), root, "standard", 3)
 	if strings.Contains(s.content, "node_modules") {
 		t.Error("should not include node_modules")
 	}
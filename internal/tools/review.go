@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -70,18 +69,42 @@ const reviewMaxADRs = 5
 
 // Handle processes the sdd_review tool call.
 func (t *ReviewTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	changeDesc := strings.TrimSpace(req.GetString("change_description", ""))
-	projectName := req.GetString("project_name", "")
-	detailLevel := memory.ParseDetailLevel(req.GetString("detail_level", ""))
-	maxTokens := intArgReview(req, "max_tokens", 0)
+	params := reviewParams{
+		ChangeDescription: strings.TrimSpace(req.GetString("change_description", "")),
+		ProjectName:       req.GetString("project_name", ""),
+		DetailLevel:       memory.ParseDetailLevel(req.GetString("detail_level", "")),
+		MaxTokens:         intArgReview(req, "max_tokens", 0),
+	}
+	return toolResult(t.process(params))
+}
 
-	if changeDesc == "" {
-		return mcp.NewToolResultError("'change_description' is required — describe the change to review"), nil
+// reviewParams are the parsed sdd_review arguments.
+type reviewParams struct {
+	ChangeDescription string
+	ProjectName       string
+	DetailLevel       string
+	MaxTokens         int
+}
+
+// reviewParamSpecs declares the sdd_review argument checks.
+var reviewParamSpecs = []paramSpec{
+	{name: "change_description", required: true, hint: "describe the change to review"},
+}
+
+// process builds the review checklist for the change from the project's specs.
+func (t *ReviewTool) process(params reviewParams) (string, error) {
+	changeDesc := params.ChangeDescription
+	projectName := params.ProjectName
+	detailLevel := params.DetailLevel
+	maxTokens := params.MaxTokens
+
+	if err := validateParams(reviewParamSpecs, map[string]string{"change_description": changeDesc}); err != nil {
+		return "", err
 	}
 
 	cwd, err := os.Getwd()
 	if err != nil {
-		return nil, fmt.Errorf("getting working directory: %w", err)
+		return "", fmt.Errorf("getting working directory: %w", err)
 	}
 
 	keywords := extractKeywords(changeDesc)
@@ -193,7 +216,7 @@ func (t *ReviewTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 
 	response += memory.TokenFooter(memory.EstimateTokens(response))
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
 
 // --- Checklist item types ---
@@ -222,10 +245,15 @@ func writeChecklistItem(sb *strings.Builder, item checklistItem, detailLevel str
 // requirementPattern matches lines like "- **FR-001**: description" or "- **NFR-001**: description".
 var requirementPattern = regexp.MustCompile(`^\s*-\s*\*\*([FN](?:FR|R)-\d+)\*\*:\s*(.+)$`)
 
-// parseRequirements reads requirements.md and extracts FR/NFR lines that match keywords.
+// parseRequirements reads the requirements, with every file of a split by
+// domain, and extracts FR/NFR lines that match keywords.
 func (t *ReviewTool) parseRequirements(cwd string, keywords []string) []checklistItem {
-	content := readFileContent(filepath.Join(config.DocsPath(cwd), "requirements.md"))
-	if content == "" {
+	var files []string
+	for _, path := range config.StagePathsIn(cwd, config.StageSpecify) {
+		files = append(files, readFileContent(path))
+	}
+	content := strings.Join(files, "\n")
+	if strings.TrimSpace(content) == "" {
 		return nil
 	}
 
@@ -253,9 +281,9 @@ func (t *ReviewTool) parseRequirements(cwd string, keywords []string) []checklis
 // constraintPattern matches "When ... Then ..." business rule lines.
 var constraintPattern = regexp.MustCompile(`(?i)^\s*-\s*(?:\*\*([^*]+)\*\*:?\s*)?[Ww]hen\s+`)
 
-// parseBusinessRules reads business-rules.md and extracts constraints that match keywords.
+// parseBusinessRules reads the business rules and extracts constraints that match keywords.
 func (t *ReviewTool) parseBusinessRules(cwd string, keywords []string) []checklistItem {
-	content := readFileContent(filepath.Join(config.DocsPath(cwd), "business-rules.md"))
+	content := readFileContent(config.StagePath(cwd, config.StageBusinessRules))
 	if content == "" {
 		return nil
 	}
@@ -306,9 +334,9 @@ func (t *ReviewTool) parseBusinessRules(cwd string, keywords []string) []checkli
 	return items
 }
 
-// parseDesign reads design.md and extracts component sections that match keywords.
+// parseDesign reads the design and extracts component sections that match keywords.
 func (t *ReviewTool) parseDesign(cwd string, keywords []string) []checklistItem {
-	content := readFileContent(filepath.Join(config.DocsPath(cwd), "design.md"))
+	content := readFileContent(config.StagePath(cwd, config.StageDesign))
 	if content == "" {
 		return nil
 	}
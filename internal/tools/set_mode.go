@@ -0,0 +1,234 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SetModeTool handles the sdd_set_mode MCP tool.
+// It switches a project between guided and expert mode mid-pipeline and
+// re-evaluates the Clarity Gate against the new threshold.
+//
+// Design: a mode switch can lower the gate threshold, so every switch is
+// recorded in hoofy.json (mode_changes) and, once clarification has
+// started, in clarifications.md — the bar is never lowered silently.
+type SetModeTool struct {
+	store  config.Store
+	bridge StageObserver
+}
+
+// NewSetModeTool creates a SetModeTool with its dependencies.
+func NewSetModeTool(store config.Store) *SetModeTool {
+	return &SetModeTool{store: store}
+}
+
+// SetBridge sets the optional stage observer notified when the switch
+// lets the pipeline advance past clarify.
+func (t *SetModeTool) SetBridge(obs StageObserver) { t.bridge = obs }
+
+// Definition returns the MCP tool definition for registration.
+func (t *SetModeTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_set_mode",
+		mcp.WithDescription(
+			"Change the project's interaction mode mid-pipeline ('guided' ↔ 'expert'). "+
+				"The Clarity Gate threshold follows the mode (guided 70, expert 50) unless the project "+
+				"pins its own threshold. The response says whether the current clarity score now "+
+				"satisfies the gate. Every change is recorded in hoofy.json and, once clarification has "+
+				"started, in clarifications.md so the history stays auditable. "+
+				"Pass advance=true to move past the Clarity Gate when the new threshold is met.",
+		),
+		mcp.WithString("mode",
+			mcp.Required(),
+			mcp.Description("The new mode: 'guided' or 'expert'."),
+			mcp.Enum("guided", "expert"),
+		),
+		mcp.WithString("reason",
+			mcp.Required(),
+			mcp.Description("Why the mode is changing, e.g. 'Team is familiar with the domain now'. "+
+				"Recorded with the change."),
+		),
+		mcp.WithBoolean("advance",
+			mcp.Description("When the project is at the Clarity Gate and its score meets the new "+
+				"threshold, advance the pipeline. Defaults to false (report only)."),
+		),
+		actorParam(),
+	)
+}
+
+// Handle processes the sdd_set_mode tool call.
+func (t *SetModeTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := setModeParams{
+		Mode:    req.GetString("mode", ""),
+		Reason:  strings.TrimSpace(req.GetString("reason", "")),
+		Advance: req.GetBool("advance", false),
+		Actor:   requestActor(ctx, req),
+	}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// setModeParams are the parsed sdd_set_mode arguments.
+type setModeParams struct {
+	Mode    string
+	Reason  string
+	Advance bool
+	Actor   string
+}
+
+// setModeParamSpecs declares the sdd_set_mode argument checks; the
+// mode itself is parsed by config.ParseMode.
+var setModeParamSpecs = []paramSpec{
+	{name: "reason", required: true, hint: "explain why the mode is changing"},
+}
+
+// process switches the project's mode and records the change.
+func (t *SetModeTool) process(projectRoot string, params setModeParams) (string, error) {
+	mode, err := config.ParseMode(params.Mode)
+	if err != nil {
+		return "", newUserError("'mode': " + err.Error())
+	}
+	reason := params.Reason
+	if err := validateParams(setModeParamSpecs, map[string]string{"reason": reason}); err != nil {
+		return "", err
+	}
+	advance := params.Advance
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if cfg.Mode == mode {
+		if advance {
+			return t.advanceAtGate(projectRoot, cfg, params.Actor)
+		}
+		return "", newUserError(fmt.Sprintf("project is already in %s mode", mode))
+	}
+
+	change := config.ModeChange{
+		From:            cfg.Mode,
+		To:              mode,
+		At:              timeNow().UTC().Format(time.RFC3339),
+		Stage:           cfg.CurrentStage,
+		ThresholdBefore: pipeline.ProjectClarityThreshold(cfg),
+		ClarityScore:    cfg.ClarityScore,
+		Reason:          reason,
+	}
+	cfg.Mode = mode
+	change.ThresholdAfter = pipeline.ProjectClarityThreshold(cfg)
+	cfg.ModeChanges = append(cfg.ModeChanges, change)
+
+	// Record the switch next to the clarification rounds it affects.
+	clarifyPath := config.StagePath(projectRoot, config.StageClarify)
+	clarifications, err := readStageFile(clarifyPath)
+	if err != nil {
+		return "", fmt.Errorf("reading clarifications: %w", err)
+	}
+	noted := clarifications != ""
+	if noted {
+		if err := writeStageFile(clarifyPath, clarifications+formatModeChangeNote(change)); err != nil {
+			return "", fmt.Errorf("writing clarifications: %w", err)
+		}
+	}
+
+	atGate := cfg.CurrentStage == config.StageClarify
+	gateMet := cfg.ClarityScore >= change.ThresholdAfter
+	advanced := false
+	if advance && atGate && gateMet {
+		if err := pipeline.AdvanceBy(cfg, params.Actor); err != nil {
+			return "", fmt.Errorf("advancing pipeline: %w", err)
+		}
+		advanced = true
+	}
+
+	if err := t.store.Save(projectRoot, cfg); err != nil {
+		return "", fmt.Errorf("saving config: %w", err)
+	}
+	if advanced {
+		content, _ := readStageFile(clarifyPath)
+		notifyObserver(t.bridge, cfg.Name, config.StageClarify, content)
+	}
+
+	return formatModeChangeResponse(cfg, change, noted, atGate, gateMet, advanced), nil
+}
+
+// advanceAtGate advances past the Clarity Gate without changing the mode,
+// for a follow-up call after a switch reported that the gate is met.
+func (t *SetModeTool) advanceAtGate(projectRoot string, cfg *config.ProjectConfig, actor string) (string, error) {
+	if cfg.CurrentStage != config.StageClarify {
+		return "", newUserError(fmt.Sprintf(
+			"project is already in %s mode and not at the Clarity Gate (current stage: %s) — nothing to advance",
+			cfg.Mode, cfg.CurrentStage,
+		))
+	}
+	if err := pipeline.AdvanceBy(cfg, actor); err != nil {
+		return "", asUserError(err)
+	}
+	if err := t.store.Save(projectRoot, cfg); err != nil {
+		return "", fmt.Errorf("saving config: %w", err)
+	}
+	content, _ := readStageFile(config.StagePath(projectRoot, config.StageClarify))
+	notifyObserver(t.bridge, cfg.Name, config.StageClarify, content)
+
+	return fmt.Sprintf(
+		"# Clarity Gate PASSED\n\n**Score:** %d/100 (threshold: %d, %s mode)\n\n"+
+			"## Next Step\n\nPipeline advanced to **%s**.\n\n%s",
+		cfg.ClarityScore, pipeline.ProjectClarityThreshold(cfg), cfg.Mode,
+		config.Stages[cfg.CurrentStage].Name, nextStepGuidance(cfg),
+	), nil
+}
+
+// formatModeChangeNote renders the audit entry appended to clarifications.md.
+func formatModeChangeNote(change config.ModeChange) string {
+	return fmt.Sprintf(
+		"\n### Mode Change: %s → %s\n\n"+
+			"_Recorded %s at stage %s._ Threshold %d/100 → %d/100; clarity score at the time: %d/100.\n\n"+
+			"**Reason:** %s\n",
+		change.From, change.To, change.At, change.Stage,
+		change.ThresholdBefore, change.ThresholdAfter, change.ClarityScore, change.Reason,
+	)
+}
+
+// formatModeChangeResponse explains the new mode and the gate's state.
+func formatModeChangeResponse(cfg *config.ProjectConfig, change config.ModeChange, noted, atGate, gateMet, advanced bool) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Mode Changed: %s → %s\n\n", change.From, change.To)
+	fmt.Fprintf(&sb, "**Clarity threshold:** %d/100 → %d/100\n", change.ThresholdBefore, change.ThresholdAfter)
+	if cfg.ClarityThreshold > 0 {
+		sb.WriteString("_The project pins its own threshold, so the mode change does not move the gate._\n")
+	}
+	fmt.Fprintf(&sb, "**Reason:** %s\n\n", change.Reason)
+	sb.WriteString("The change is recorded in hoofy.json (`mode_changes`)")
+	if noted {
+		sb.WriteString(" and in clarifications.md")
+	}
+	sb.WriteString(".\n\n## Clarity Gate\n\n")
+
+	switch {
+	case advanced:
+		fmt.Fprintf(&sb, "✅ Score %d/100 meets the new threshold. Pipeline advanced to **%s**.\n\n%s",
+			cfg.ClarityScore, config.Stages[cfg.CurrentStage].Name, nextStepGuidance(cfg))
+	case atGate && gateMet:
+		fmt.Fprintf(&sb, "✅ Score %d/100 now meets the threshold — **the project can advance**. "+
+			"Call sdd_set_mode again with mode=%s and advance=true to move on.\n", cfg.ClarityScore, cfg.Mode)
+	case atGate:
+		fmt.Fprintf(&sb, "❌ Score %d/100 is still below the threshold (%d more points needed) — "+
+			"**the project cannot advance yet**. Run sdd_clarify to resolve more ambiguities.\n",
+			cfg.ClarityScore, change.ThresholdAfter-cfg.ClarityScore)
+	case pipeline.StageIndexFor(cfg, cfg.CurrentStage) < pipeline.StageIndexFor(cfg, config.StageClarify):
+		fmt.Fprintf(&sb, "Not reached yet — the gate will require %d/100 when the project gets to clarify.\n",
+			change.ThresholdAfter)
+	default:
+		sb.WriteString("Already passed — the new mode only changes the guidance for the remaining stages.\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,217 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// setupClarifyProject returns a guided project at the Clarity Gate with
+// one recorded round and the given clarity score.
+func setupClarifyProject(t *testing.T, score int) (string, func()) {
+	t.Helper()
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+
+	store := config.NewFileStore()
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		cleanup()
+		t.Fatalf("load config: %v", err)
+	}
+	cfg.ClarityScore = score
+	if err := store.Save(tmpDir, cfg); err != nil {
+		cleanup()
+		t.Fatalf("save config: %v", err)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+		t.Fatalf("write requirements: %v", err)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageClarify), "# Clarifications\n\n### Round 1\n\nAnswers.\n"); err != nil {
+		t.Fatalf("write clarifications: %v", err)
+	}
+	return tmpDir, cleanup
+}
+
+func setModeRequest(args map[string]interface{}) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	return req
+}
+
+func TestSetModeTool_Handle_GateNowSatisfied(t *testing.T) {
+	tmpDir, cleanup := setupClarifyProject(t, 60)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	tool := NewSetModeTool(store)
+	result, err := tool.Handle(context.Background(), setModeRequest(map[string]interface{}{
+		"mode":   "expert",
+		"reason": "Team knows the domain well now",
+	}))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{"Mode Changed: guided → expert", "70/100 → 50/100", "the project can advance"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response should contain %q:\n%s", want, text)
+		}
+	}
+
+	cfg, _ := store.Load(tmpDir)
+	if cfg.Mode != config.ModeExpert {
+		t.Errorf("Mode = %s, want expert", cfg.Mode)
+	}
+	if cfg.CurrentStage != config.StageClarify {
+		t.Errorf("without advance the stage must stay clarify, got %s", cfg.CurrentStage)
+	}
+	if len(cfg.ModeChanges) != 1 {
+		t.Fatalf("ModeChanges = %+v, want one entry", cfg.ModeChanges)
+	}
+	if c := cfg.ModeChanges[0]; c.From != config.ModeGuided || c.To != config.ModeExpert ||
+		c.ThresholdBefore != 70 || c.ThresholdAfter != 50 || c.ClarityScore != 60 || c.Reason == "" {
+		t.Errorf("recorded change = %+v", c)
+	}
+
+	clarifications, _ := readStageFile(config.StagePath(tmpDir, config.StageClarify))
+	if !strings.Contains(clarifications, "### Mode Change: guided → expert") ||
+		!strings.Contains(clarifications, "**Reason:** Team knows the domain well now") {
+		t.Errorf("clarifications.md should record the change:\n%s", clarifications)
+	}
+
+	// A follow-up call with the same mode and advance=true moves on.
+	result, err = tool.Handle(context.Background(), setModeRequest(map[string]interface{}{
+		"mode":    "expert",
+		"reason":  "Gate met",
+		"advance": true,
+	}))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) || !strings.Contains(getResultText(result), "Clarity Gate PASSED") {
+		t.Fatalf("expected gate to pass, got: %s", getResultText(result))
+	}
+	cfg, _ = store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageDesign {
+		t.Errorf("stage = %s, want design", cfg.CurrentStage)
+	}
+	if len(cfg.ModeChanges) != 1 {
+		t.Errorf("advancing without a switch must not record a change, got %+v", cfg.ModeChanges)
+	}
+	if pass := cfg.ClarityGatePassed; pass == nil || pass.Score != 60 || pass.Threshold != 50 || pass.Mode != config.ModeExpert {
+		t.Errorf("advancing through set_mode should record the gate pass, got %+v", pass)
+	}
+}
+
+func TestSetModeTool_Handle_AdvanceInOneCall(t *testing.T) {
+	tmpDir, cleanup := setupClarifyProject(t, 55)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	result, err := NewSetModeTool(store).Handle(context.Background(), setModeRequest(map[string]interface{}{
+		"mode":    "expert",
+		"reason":  "Experienced team",
+		"advance": true,
+	}))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !strings.Contains(getResultText(result), "Pipeline advanced to") {
+		t.Errorf("expected advance, got: %s", getResultText(result))
+	}
+	cfg, _ := store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageDesign {
+		t.Errorf("stage = %s, want design", cfg.CurrentStage)
+	}
+	if pass := cfg.ClarityGatePassed; pass == nil || pass.Score != 55 || pass.Mode != config.ModeExpert {
+		t.Errorf("advancing through set_mode should record the gate pass, got %+v", pass)
+	}
+}
+
+func TestSetModeTool_Handle_StillBelowThreshold(t *testing.T) {
+	tmpDir, cleanup := setupClarifyProject(t, 40)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	result, err := NewSetModeTool(store).Handle(context.Background(), setModeRequest(map[string]interface{}{
+		"mode":    "expert",
+		"reason":  "Trying to skip ahead",
+		"advance": true,
+	}))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "cannot advance yet") || !strings.Contains(text, "10 more points") {
+		t.Errorf("expected below-threshold report, got: %s", text)
+	}
+	cfg, _ := store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageClarify {
+		t.Errorf("stage = %s, want clarify", cfg.CurrentStage)
+	}
+	if cfg.Mode != config.ModeExpert || len(cfg.ModeChanges) != 1 {
+		t.Errorf("the switch itself should still be applied and recorded: %+v", cfg)
+	}
+}
+
+func TestSetModeTool_Handle_PinnedThreshold(t *testing.T) {
+	tmpDir, cleanup := setupClarifyProject(t, 60)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	cfg, _ := store.Load(tmpDir)
+	cfg.ClarityThreshold = 80
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewSetModeTool(store).Handle(context.Background(), setModeRequest(map[string]interface{}{
+		"mode":    "expert",
+		"reason":  "Lower the bar",
+		"advance": true,
+	}))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "80/100 → 80/100") || !strings.Contains(text, "pins its own threshold") {
+		t.Errorf("a pinned threshold must not move:\n%s", text)
+	}
+	cfg, _ = store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageClarify {
+		t.Errorf("stage = %s, want clarify", cfg.CurrentStage)
+	}
+}
+
+func TestSetModeTool_Handle_Errors(t *testing.T) {
+	_, cleanup := setupClarifyProject(t, 60)
+	defer cleanup()
+
+	tool := NewSetModeTool(config.NewFileStore())
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want string
+	}{
+		{"invalid mode", map[string]interface{}{"mode": "turbo", "reason": "x"}, "'guided' or 'expert'"},
+		{"missing reason", map[string]interface{}{"mode": "expert"}, "'reason' is required"},
+		{"same mode", map[string]interface{}{"mode": "guided", "reason": "x"}, "already in guided mode"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Handle(context.Background(), setModeRequest(tt.args))
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if !isErrorResult(result) || !strings.Contains(getResultText(result), tt.want) {
+				t.Errorf("expected error containing %q, got: %s", tt.want, getResultText(result))
+			}
+		})
+	}
+}
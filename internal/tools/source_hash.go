@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+)
+
+// recordSourceHash records on stage that its artifact was built from
+// source's artifact as it reads now.
+func recordSourceHash(cfg *config.ProjectConfig, stage, source config.Stage, content string) {
+	st := cfg.StageStatus[stage]
+	if st.SourceHashes == nil {
+		st.SourceHashes = make(map[config.Stage]string)
+	}
+	st.SourceHashes[source] = pipeline.ContentHash(content)
+	cfg.StageStatus[stage] = st
+}
+
+// requirementsChangedSinceDesign returns a warning when requirements.md
+// no longer matches the version design.md was created from, or "" when
+// it does — or when the design predates hash recording, so there is
+// nothing to compare against.
+func requirementsChangedSinceDesign(cfg *config.ProjectConfig, requirements string) string {
+	recorded := cfg.StageStatus[config.StageDesign].SourceHashes[config.StageSpecify]
+	if recorded == "" || recorded == pipeline.ContentHash(requirements) {
+		return ""
+	}
+	return fmt.Sprintf("⚠️ **Requirements changed since design:** `docs/%s` was amended after `docs/%s` "+
+		"was created, so the design — and the tasks built on it — may be stale. Review the design against "+
+		"the amended requirements and re-run `sdd_create_design` if it is affected "+
+		"(once the pipeline completes, `sdd_new_iteration stage=design` reopens it).",
+		cfg.StageFilename(config.StageSpecify), cfg.StageFilename(config.StageDesign))
+}
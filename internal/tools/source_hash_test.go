@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRequirementsChangedSinceDesign(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageDesign)
+	defer cleanup()
+	requirements := "# Requirements\n\n- **FR-001**: Log in\n"
+	for stage, content := range map[config.Stage]string{
+		config.StagePrinciples: "# Principles\n",
+		config.StageCharter:    "# Charter\n",
+		config.StageSpecify:    requirements,
+		config.StageClarify:    "# Clarifications\n",
+	} {
+		if err := writeStageFile(config.StagePath(tmpDir, stage), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store := config.NewFileStore()
+	designReq := mcp.CallToolRequest{}
+	designReq.Params.Arguments = map[string]any{
+		"architecture_overview": "A modular monolith.",
+		"tech_stack":            "- Go",
+		"components":            "### Auth\n- **Covers**: FR-001",
+		"data_model":            "### User",
+	}
+	result, err := NewDesignTool(store, mustRenderer(t)).Handle(context.Background(), designReq)
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("design: %v %s", err, getResultText(result))
+	}
+	cfg, _ := store.Load(tmpDir)
+	if got := cfg.StageStatus[config.StageDesign].SourceHashes[config.StageSpecify]; got != pipeline.ContentHash(requirements) {
+		t.Fatalf("design should record the requirements hash, got %q", got)
+	}
+	if warning := requirementsChangedSinceDesign(cfg, requirements); warning != "" {
+		t.Errorf("unchanged requirements should not warn: %s", warning)
+	}
+
+	// Amend the requirements after design.
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), requirements+"- **FR-002**: Log out\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	tasksReq := mcp.CallToolRequest{}
+	tasksReq.Params.Arguments = map[string]any{
+		"total_tasks":      "1",
+		"estimated_effort": "1 day",
+		"tasks":            "### TASK-001: Login\n- **Covers**: FR-001\n- **Component**: Auth",
+	}
+	result, err = NewTasksTool(store, mustRenderer(t)).Handle(context.Background(), tasksReq)
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("tasks: %v %s", err, getResultText(result))
+	}
+	if text := getResultText(result); !strings.Contains(text, "Requirements changed since design") {
+		t.Errorf("sdd_create_tasks should warn about the amended requirements:\n%s", text)
+	}
+
+	result, err = NewValidateTool(store).Handle(context.Background(), checklistValidateRequest(nil, nil))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("validate: %v %s", err, getResultText(result))
+	}
+	if text := getResultText(result); !strings.Contains(text, "Requirements changed since design") ||
+		!strings.Contains(text, "re-run `sdd_create_design`") {
+		t.Errorf("sdd_validate should warn and suggest re-running design:\n%s", text)
+	}
+}
+
+func TestRequirementsChangedSinceDesign_NoRecordedHash(t *testing.T) {
+	cfg := config.NewProjectConfig("legacy", "", config.ModeGuided)
+	if warning := requirementsChangedSinceDesign(cfg, "# Requirements\n"); warning != "" {
+		t.Errorf("a design without a recorded hash has nothing to compare: %s", warning)
+	}
+}
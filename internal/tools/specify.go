@@ -3,9 +3,11 @@ package tools
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
 	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
 	"github.com/HendryAvila/Hoofy/internal/templates"
 	"github.com/mark3labs/mcp-go/mcp"
 )
@@ -76,96 +78,164 @@ func (t *SpecifyTool) Definition() mcp.Tool {
 		mcp.WithString("dependencies",
 			mcp.Description("External systems, APIs, services, or teams we depend on."),
 		),
+		mcp.WithBoolean("split_by_domain",
+			mcp.Description("For large systems: write each domain's requirements to its own file "+
+				"(docs/requirements/<domain>.md) and make requirements.md their index. Tag each requirement "+
+				"with its domain at the start of its text: '- **FR-001**: [auth] Users can log in'. "+
+				"Untagged requirements, constraints, assumptions and dependencies stay in the index. "+
+				"Validation reads every file. Default false — one requirements.md."),
+		),
+		referencesParam(),
+		actorParam(),
 	)
 }
 
 // Handle processes the sdd_generate_requirements tool call.
 func (t *SpecifyTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	mustHave := req.GetString("must_have", "")
-	shouldHave := req.GetString("should_have", "")
-	couldHave := req.GetString("could_have", "")
-	wontHave := req.GetString("wont_have", "")
-	nonFunctional := req.GetString("non_functional", "")
-	constraints := req.GetString("constraints", "")
-	assumptions := req.GetString("assumptions", "")
-	dependencies := req.GetString("dependencies", "")
-
-	// Validate required fields.
-	if mustHave == "" {
-		return mcp.NewToolResultError("'must_have' is required — list the non-negotiable requirements"), nil
-	}
-	if shouldHave == "" {
-		return mcp.NewToolResultError("'should_have' is required — list the important-but-not-blocking requirements"), nil
+	data := templates.RequirementsData{
+		MustHave:      req.GetString("must_have", ""),
+		ShouldHave:    req.GetString("should_have", ""),
+		CouldHave:     req.GetString("could_have", ""),
+		WontHave:      req.GetString("wont_have", ""),
+		NonFunctional: req.GetString("non_functional", ""),
+		Constraints:   req.GetString("constraints", ""),
+		Assumptions:   req.GetString("assumptions", ""),
+		Dependencies:  req.GetString("dependencies", ""),
 	}
-	if nonFunctional == "" {
-		return mcp.NewToolResultError("'non_functional' is required — list performance, security, and usability constraints"), nil
+	refs, err := requestReferences(req)
+	if err != nil {
+		return toolResult("", err)
 	}
+	data.References = refs
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return stageToolResult(t.run(projectRoot, data, requestActor(ctx, req), req.GetBool("split_by_domain", false)))
+}
 
-	cfg, err := t.store.Load(projectRoot)
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+// Run saves the requirements for the project at projectRoot and advances
+// the pipeline. data.Name is ignored — the project's name is used.
+func (t *SpecifyTool) Run(projectRoot string, data templates.RequirementsData) (*StageResult, error) {
+	return t.run(projectRoot, data, "", false)
+}
+
+// run is Run with the completed stage attributed to actor. With
+// splitByDomain, domain-tagged requirements are written to one file per
+// domain and requirements.md becomes their index.
+func (t *SpecifyTool) run(projectRoot string, data templates.RequirementsData, actor string, splitByDomain bool) (*StageResult, error) {
+	// Validate required fields — before the load error, so a bad call is
+	// reported as such even outside a project.
+	cfg, loadErr := t.store.Load(projectRoot)
+	if err := checkRequiredFields(cfg, config.StageSpecify, map[string]string{
+		"must_have":      data.MustHave,
+		"should_have":    data.ShouldHave,
+		"could_have":     data.CouldHave,
+		"wont_have":      data.WontHave,
+		"non_functional": data.NonFunctional,
+		"constraints":    data.Constraints,
+		"assumptions":    data.Assumptions,
+		"dependencies":   data.Dependencies,
+	}); err != nil {
+		return nil, err
+	}
+	if err := validateReferences(data.References); err != nil {
+		return nil, err
+	}
+	// all is every requirement, domain tags removed; data keeps only the
+	// index's share when splitting.
+	all := data
+	var domains []requirementsDomain
+	if splitByDomain {
+		var err error
+		if data, domains, all, err = splitRequirementsByDomain(data); err != nil {
+			return nil, err
+		}
+	}
+	if loadErr != nil {
+		return nil, asUserError(loadErr)
 	}
 
 	// Validate pipeline stage.
 	if err := pipeline.RequireStage(cfg, config.StageSpecify); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
 	}
 
-	// Verify the charter exists.
-	charterPath := config.StagePath(projectRoot, config.StageCharter)
-	charter, err := readStageFile(charterPath)
-	if err != nil {
-		return nil, fmt.Errorf("reading charter: %w", err)
-	}
-	if charter == "" {
-		return mcp.NewToolResultError("charter.md is empty — run sdd_create_charter first"), nil
+	// Verify prerequisite artifacts exist.
+	if err := pipeline.RequireArtifacts(projectRoot, pipeline.PrerequisiteArtifacts(cfg, config.StageSpecify)...); err != nil {
+		return nil, asUserError(err)
 	}
 
 	pipeline.MarkInProgress(cfg)
 
+	nudge := recommendedFieldsNote(cfg, config.StageSpecify, map[string]string{
+		"could_have":   all.CouldHave,
+		"wont_have":    all.WontHave,
+		"constraints":  data.Constraints,
+		"assumptions":  data.Assumptions,
+		"dependencies": data.Dependencies,
+	})
+
 	// Fill optional fields with "None" if empty.
-	if couldHave == "" {
-		couldHave = "_None defined for this version._"
+	data.Name = cfg.Name
+	data.Attribution = attributionFor(cfg)
+	if all.CouldHave == "" {
+		data.CouldHave = "_None defined for this version._"
 	}
-	if wontHave == "" {
-		wontHave = "_None defined for this version._"
+	if all.WontHave == "" {
+		data.WontHave = "_None defined for this version._"
 	}
-	if constraints == "" {
-		constraints = "_None identified._"
+	if data.Constraints == "" {
+		data.Constraints = "_None identified._"
 	}
-	if assumptions == "" {
-		assumptions = "_None identified._"
+	if data.Assumptions == "" {
+		data.Assumptions = "_None identified._"
 	}
-	if dependencies == "" {
-		dependencies = "_None identified._"
+	if data.Dependencies == "" {
+		data.Dependencies = "_None identified._"
 	}
 
-	// Build requirements with REAL content from the AI.
-	data := templates.RequirementsData{
-		Name:          cfg.Name,
-		MustHave:      mustHave,
-		ShouldHave:    shouldHave,
-		CouldHave:     couldHave,
-		WontHave:      wontHave,
-		NonFunctional: nonFunctional,
-		Constraints:   constraints,
-		Assumptions:   assumptions,
-		Dependencies:  dependencies,
-	}
-
-	// Render and write via shared function (ADR-001).
+	// Render and write via shared function (ADR-001). A split replaces
+	// the domain files of the previous one; a single-file run only stops
+	// reading them, leaving the files where they are.
+	if len(domains) == 0 {
+		delete(cfg.StageParts, config.StageSpecify)
+	} else {
+		if err := clearStageParts(projectRoot, cfg, config.StageSpecify); err != nil {
+			return nil, err
+		}
+		for _, b := range requirementBuckets {
+			if field := b.field(&data); *field == "" {
+				*field = wontHavePlaceholder
+			}
+		}
+		links, err := writeRequirementsDomains(projectRoot, t.renderer, cfg.Name, data.Attribution, domains)
+		if err != nil {
+			return nil, err
+		}
+		data.Domains = links
+		names := make([]string, len(domains))
+		for i, d := range domains {
+			names[i] = d.Name + ".md"
+		}
+		recordStageParts(cfg, config.StageSpecify, names)
+	}
 	content, err := RenderAndWriteRequirements(projectRoot, t.renderer, data, false)
 	if err != nil {
 		return nil, err
 	}
+	if len(domains) > 0 {
+		// The sidecar holds every requirement, not just the index's.
+		if err := writeRequirementsJSON(projectRoot, requirements.Parse(all)); err != nil {
+			return nil, err
+		}
+	}
+
+	recordReferences(cfg, config.StageSpecify, data.References)
 
 	// Advance pipeline.
-	if err := pipeline.Advance(cfg); err != nil {
+	if err := pipeline.AdvanceBy(cfg, actor); err != nil {
 		return nil, fmt.Errorf("advancing pipeline: %w", err)
 	}
 
@@ -178,6 +248,7 @@ func (t *SpecifyTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp
 	response := fmt.Sprintf(
 		"# Requirements Generated\n\n"+
 			"Saved to `docs/requirements.md`\n\n"+
+			"**Counts:** %s\n\n"+
 			"## Content\n\n%s\n\n"+
 			"---\n\n"+
 			"## Next Step\n\n"+
@@ -186,8 +257,23 @@ func (t *SpecifyTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp
 			"these requirements for ambiguities. The pipeline cannot proceed until the clarity "+
 			"score reaches %d/100 (%s mode).\n\n"+
 			"**Why this matters:** Ambiguous requirements are the #1 cause of AI hallucinations.",
-		content, pipeline.ClarityThreshold(cfg.Mode), cfg.Mode,
+		requirements.FormatCounts(requirements.CountByBucket(all)),
+		content, pipeline.ProjectClarityThreshold(cfg), cfg.Mode,
 	)
+	if len(domains) > 0 {
+		response += "\n\n## Domain Files\n\n"
+		for i, d := range domains {
+			response += fmt.Sprintf("- `docs/%s` — %d requirement(s)\n", data.Domains[i].URL, d.Count)
+		}
+		response = strings.TrimSuffix(response, "\n")
+	}
+	if dups := requirements.DuplicateIDs(requirements.Parse(all)); len(dups) > 0 {
+		response += fmt.Sprintf("\n\n⚠️ **Duplicate requirement IDs:** %s — give each requirement a unique ID.",
+			strings.Join(dups, ", "))
+	}
+	response += scopeCreepSection(projectRoot, content)
+	response += nudge
+	response += wordBudgetNote(cfg, config.StageSpecify, content)
 
-	return mcp.NewToolResultText(response), nil
+	return &StageResult{Content: content, Config: cfg, Response: response}, nil
 }
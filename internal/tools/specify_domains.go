@@ -0,0 +1,181 @@
+package tools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+// requirementItemPattern matches a top-level list item that opens with
+// a requirement ID, as requirements.ParseMarkdown reads them.
+const requirementItemPattern = `^(?:[-*+]|\d+\.)\s+\**(?:FR|NFR)-\d{3,4}\b`
+
+// requirementItemLine matches the opening line of a requirement.
+var requirementItemLine = regexp.MustCompile(requirementItemPattern)
+
+// domainTagPattern matches a requirement whose text opens with a domain
+// tag: "- **FR-001**: [auth] Users can log in". Group 1 is everything
+// before the tag, group 2 the domain, group 3 the text.
+var domainTagPattern = regexp.MustCompile(`^(` + requirementItemPattern + `[*:\s—–-]*)\[([^\]]*)\]\s*(.*)$`)
+
+// domainNamePattern is a valid domain name — it becomes a filename.
+var domainNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// requirementBuckets are the RequirementsData fields that hold
+// requirements, by their sdd_generate_requirements parameter name.
+var requirementBuckets = []struct {
+	param string
+	field func(*templates.RequirementsData) *string
+}{
+	{"must_have", func(d *templates.RequirementsData) *string { return &d.MustHave }},
+	{"should_have", func(d *templates.RequirementsData) *string { return &d.ShouldHave }},
+	{"could_have", func(d *templates.RequirementsData) *string { return &d.CouldHave }},
+	{"wont_have", func(d *templates.RequirementsData) *string { return &d.WontHave }},
+	{"non_functional", func(d *templates.RequirementsData) *string { return &d.NonFunctional }},
+}
+
+// requirementsDomain is one domain's share of split requirements.
+type requirementsDomain struct {
+	Name  string
+	Data  templates.RequirementsData
+	Count int // requirements in the domain
+}
+
+// domainSplitter accumulates the domains found while splitting.
+type domainSplitter struct {
+	domains  []requirementsDomain
+	byName   map[string]int
+	problems []string
+}
+
+// splitRequirementsByDomain moves domain-tagged requirements out of data
+// into one RequirementsData per domain, in order of first appearance.
+// Returns data without the tagged items (the shared requirements, for
+// the index), the domains, and data with every tag removed (the whole
+// set, for the requirements.json sidecar). Sub-bullets and other lines
+// stay with the requirement above them. Every bad tag is reported at once.
+func splitRequirementsByDomain(data templates.RequirementsData) (shared templates.RequirementsData, domains []requirementsDomain, untagged templates.RequirementsData, err error) {
+	shared, untagged = data, data
+	sp := &domainSplitter{byName: make(map[string]int)}
+	for _, b := range requirementBuckets {
+		perDomain := sp.splitBucket(b.param, *b.field(&data), b.field(&shared), b.field(&untagged))
+		for i, text := range perDomain {
+			*b.field(&sp.domains[i].Data) = text
+		}
+	}
+
+	if len(sp.problems) > 0 {
+		return shared, nil, untagged, toolError(CodeInvalidInput, "invalid domain tags:\n- "+strings.Join(sp.problems, "\n- "))
+	}
+	if len(sp.domains) == 0 {
+		return shared, nil, untagged, toolError(CodeInvalidInput,
+			"'split_by_domain' needs domain-tagged requirements — start each requirement's text with its domain, "+
+				"e.g. '- **FR-001**: [auth] Users can log in'")
+	}
+	return shared, sp.domains, untagged, nil
+}
+
+// splitBucket splits one bucket's text: the untagged lines go to shared,
+// every line (tags removed) to all, and the tagged requirements to the
+// returned texts, keyed by domain index.
+func (sp *domainSplitter) splitBucket(param, text string, shared, all *string) map[int]string {
+	var sharedLines, allLines []string
+	domainLines := make(map[int][]string)
+	current := -1 // domain of the requirement above; -1 is shared
+	for _, line := range strings.Split(text, "\n") {
+		if m := domainTagPattern.FindStringSubmatch(line); m != nil {
+			name := strings.ToLower(strings.TrimSpace(m[2]))
+			if !domainNamePattern.MatchString(name) {
+				sp.problems = append(sp.problems, fmt.Sprintf(
+					"%s: %q is not a valid domain — use lowercase letters, digits, '-' or '_'", param, m[2]))
+				continue
+			}
+			i, ok := sp.byName[name]
+			if !ok {
+				i = len(sp.domains)
+				sp.byName[name] = i
+				sp.domains = append(sp.domains, requirementsDomain{Name: name})
+			}
+			line = m[1] + m[3]
+			current = i
+			sp.domains[i].Count++
+		} else if requirementItemLine.MatchString(line) {
+			current = -1
+		}
+		allLines = append(allLines, line)
+		if current < 0 {
+			sharedLines = append(sharedLines, line)
+		} else {
+			domainLines[current] = append(domainLines[current], line)
+		}
+	}
+	*shared = strings.TrimSpace(strings.Join(sharedLines, "\n"))
+	*all = strings.Join(allLines, "\n")
+
+	texts := make(map[int]string, len(domainLines))
+	for i, lines := range domainLines {
+		texts[i] = strings.TrimSpace(strings.Join(lines, "\n"))
+	}
+	return texts
+}
+
+// clearStageParts removes the part files cfg records for a stage (see
+// config.StagePaths) and forgets them, so a re-split doesn't leave a
+// stale domain behind. Files it didn't write are left alone.
+func clearStageParts(projectRoot string, cfg *config.ProjectConfig, stage config.Stage) error {
+	for _, path := range config.StagePaths(projectRoot, cfg, stage)[1:] {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing %s: %w", path, err)
+		}
+	}
+	delete(cfg.StageParts, stage)
+	return nil
+}
+
+// recordStageParts records the part files a split wrote for a stage, by
+// name within config.StagePartsDir.
+func recordStageParts(cfg *config.ProjectConfig, stage config.Stage, names []string) {
+	if cfg.StageParts == nil {
+		cfg.StageParts = make(map[config.Stage][]string)
+	}
+	cfg.StageParts[stage] = names
+}
+
+// writeRequirementsDomains renders each domain's requirements file into
+// config.StagePartsDir and returns the index links to them, relative to
+// the docs directory.
+func writeRequirementsDomains(projectRoot string, renderer templates.Renderer, name, attribution string, domains []requirementsDomain) ([]templates.Reference, error) {
+	dir := config.StagePartsDir(projectRoot, config.StageSpecify)
+	index := filepath.Base(config.StagePath(projectRoot, config.StageSpecify))
+	links := make([]templates.Reference, 0, len(domains))
+	for _, d := range domains {
+		data := d.Data
+		data.Name = name + ": " + d.Name
+		data.Attribution = attribution
+		for _, b := range requirementBuckets {
+			if field := b.field(&data); *field == "" {
+				*field = wontHavePlaceholder
+			}
+		}
+		// The shared sections live once, in the index.
+		shared := fmt.Sprintf("_Shared across domains — see [%[1]s](../%[1]s)._", index)
+		data.Constraints, data.Assumptions, data.Dependencies = shared, shared, shared
+
+		content, err := renderer.Render(templates.Requirements, data)
+		if err != nil {
+			return nil, fmt.Errorf("rendering %s requirements: %w", d.Name, err)
+		}
+		path := filepath.Join(dir, d.Name+".md")
+		if err := writeStageFile(path, content); err != nil {
+			return nil, fmt.Errorf("writing %s requirements: %w", d.Name, err)
+		}
+		rel, _ := filepath.Rel(config.DocsPath(projectRoot), path)
+		links = append(links, templates.Reference{Title: d.Name, URL: filepath.ToSlash(rel)})
+	}
+	return links, nil
+}
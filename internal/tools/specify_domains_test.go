@@ -0,0 +1,229 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// splitSpecifyRequest is a sdd_generate_requirements call with
+// domain-tagged requirements.
+func splitSpecifyRequest(split bool) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"must_have": "- **FR-001**: [auth] Users can log in\n  - With email and password\n" +
+			"- **FR-002**: [billing] Customers can pay by card\n" +
+			"- **FR-003**: Every page shows the company logo",
+		"should_have":     "- **FR-004**: [Auth] Users can reset their password",
+		"non_functional":  "- **NFR-001**: [billing] Payments settle within 2 seconds",
+		"constraints":     "- Hosted in the EU",
+		"split_by_domain": split,
+	}
+	return req
+}
+
+func TestSpecifyTool_Handle_SplitByDomain(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageSpecify)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), "# Charter\n"); err != nil {
+		t.Fatal(err)
+	}
+	// A stale domain from an earlier split must not survive; a file the
+	// user keeps beside the domain files is neither removed nor read.
+	store := config.NewFileStore()
+	partsDir := config.StagePartsDir(tmpDir, config.StageSpecify)
+	stale := filepath.Join(partsDir, "legacy.md")
+	notes := filepath.Join(partsDir, "notes.md")
+	for _, path := range []string{stale, notes} {
+		if err := writeStageFile(path, "- **FR-099**: Left over\n"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg, _ := store.Load(tmpDir)
+	cfg.StageParts = map[config.Stage][]string{config.StageSpecify: {"legacy.md"}}
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewSpecifyTool(store, mustRenderer(t)).Handle(context.Background(), splitSpecifyRequest(true))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{"`docs/requirements/auth.md` — 2 requirement(s)", "`docs/requirements/billing.md` — 2 requirement(s)"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+
+	index, _ := readStageFile(config.StagePath(tmpDir, config.StageSpecify))
+	for _, want := range []string{
+		"- **FR-003**: Every page shows the company logo",
+		"- Hosted in the EU",
+		"## Domains",
+		"- [auth](requirements/auth.md)\n- [billing](requirements/billing.md)\n",
+	} {
+		if !strings.Contains(index, want) {
+			t.Errorf("index missing %q:\n%s", want, index)
+		}
+	}
+	if strings.Contains(index, "FR-001") || strings.Contains(index, "NFR-001") {
+		t.Errorf("tagged requirements belong in their domain files, not the index:\n%s", index)
+	}
+
+	auth, _ := readStageFile(filepath.Join(config.StagePartsDir(tmpDir, config.StageSpecify), "auth.md"))
+	for _, want := range []string{
+		"— Requirements", "- **FR-001**: Users can log in\n  - With email and password",
+		"### Should Have\n\n- **FR-004**: Users can reset their password",
+		"_Shared across domains — see [requirements.md](../requirements.md)._",
+	} {
+		if !strings.Contains(auth, want) {
+			t.Errorf("auth.md missing %q:\n%s", want, auth)
+		}
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Error("the stale domain file from the previous run should be removed")
+	}
+	if _, err := os.Stat(notes); err != nil {
+		t.Errorf("a file the tool didn't write should be kept: %v", err)
+	}
+	cfg, _ = store.Load(tmpDir)
+	if got := cfg.StageParts[config.StageSpecify]; !slices.Equal(got, []string{"auth.md", "billing.md"}) {
+		t.Errorf("StageParts = %v, want the two domain files", got)
+	}
+
+	data, _ := os.ReadFile(config.RequirementsJSONPath(tmpDir))
+	var sidecar []requirements.Requirement
+	if err := json.Unmarshal(data, &sidecar); err != nil {
+		t.Fatal(err)
+	}
+	if len(sidecar) != 5 || sidecar[0].Text != "Users can log in" {
+		t.Errorf("requirements.json should hold every requirement, untagged: %+v", sidecar)
+	}
+
+	all, _ := readStageArtifacts(tmpDir, cfg, config.StageSpecify)
+	if got := len(requirements.ParseMarkdown(all)); got != 5 {
+		t.Errorf("index plus domain files parse to %d requirements, want 5 (notes.md not read)", got)
+	}
+
+	// A single-file run stops reading the domain files but keeps them.
+	cfg.CurrentStage = config.StageSpecify
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	result, err = NewSpecifyTool(store, mustRenderer(t)).Handle(context.Background(), splitSpecifyRequest(false))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("single-file re-run: %v %s", err, getResultText(result))
+	}
+	cfg, _ = store.Load(tmpDir)
+	if paths := config.StagePaths(tmpDir, cfg, config.StageSpecify); len(paths) != 1 {
+		t.Errorf("after a single-file run StagePaths = %v, want just requirements.md", paths)
+	}
+	if _, err := os.Stat(filepath.Join(partsDir, "auth.md")); err != nil {
+		t.Errorf("a single-file run must not delete part files: %v", err)
+	}
+}
+
+func TestSpecifyTool_Handle_DefaultKeepsTags(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageSpecify)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), "# Charter\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewSpecifyTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), splitSpecifyRequest(false))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	cfg, _ := config.NewFileStore().Load(tmpDir)
+	if paths := config.StagePaths(tmpDir, cfg, config.StageSpecify); len(paths) != 1 {
+		t.Errorf("single-file output by default, got %v", paths)
+	}
+	index, _ := readStageFile(config.StagePath(tmpDir, config.StageSpecify))
+	if !strings.Contains(index, "- **FR-001**: [auth] Users can log in") || strings.Contains(index, "## Domains") {
+		t.Errorf("without split_by_domain, requirements.md holds everything as written:\n%s", index)
+	}
+}
+
+func TestSplitRequirementsByDomain_Errors(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageSpecify)
+	defer cleanup()
+
+	req := splitSpecifyRequest(true)
+	req.Params.Arguments.(map[string]any)["must_have"] = "- **FR-001**: [Auth Team] Users can log in\n- **FR-002**: [../etc] Escape"
+	result, err := NewSpecifyTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	text := getResultText(result)
+	if !isErrorResult(result) || !strings.Contains(text, `"Auth Team" is not a valid domain`) || !strings.Contains(text, `"../etc"`) {
+		t.Errorf("expected both bad tags reported, got %s", text)
+	}
+
+	req = splitSpecifyRequest(true)
+	args := req.Params.Arguments.(map[string]any)
+	args["must_have"], args["should_have"], args["non_functional"] = "- **FR-001**: Log in", "- **FR-002**: Log out", "- **NFR-001**: Fast"
+	result, _ = NewSpecifyTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(), req)
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "needs domain-tagged requirements") {
+		t.Errorf("split without tags should be rejected, got %s", getResultText(result))
+	}
+}
+
+func TestValidateTool_CoverageReadsDomainFiles(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+	if err := writeStageFile(filepath.Join(config.StagePartsDir(tmpDir, config.StageSpecify), "billing.md"),
+		"# Shop: billing — Requirements\n\n### Must Have\n\n- **FR-010**: Customers can pay by card\n"); err != nil {
+		t.Fatal(err)
+	}
+	store := config.NewFileStore()
+	cfg, _ := store.Load(tmpDir)
+	cfg.StageParts = map[config.Stage][]string{config.StageSpecify: {"billing.md"}}
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewValidateTool(config.NewFileStore()).Handle(context.Background(), checklistValidateRequest(nil, nil))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+	if !strings.Contains(report, "- **Functional (FR):** 0/2 covered") || !strings.Contains(report, "- FR-010\n") {
+		t.Errorf("coverage should include requirements from domain files:\n%s", report)
+	}
+}
+
+func TestDeferRequirementTool_DomainFile(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageSpecify)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), "# Charter\n"); err != nil {
+		t.Fatal(err)
+	}
+	store := config.NewFileStore()
+	if result, err := NewSpecifyTool(store, mustRenderer(t)).Handle(context.Background(), splitSpecifyRequest(true)); err != nil || isErrorResult(result) {
+		t.Fatalf("specify: %v %s", err, getResultText(result))
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"id": "FR-002", "rationale": "Card payments move to v2"}
+	result, err := NewDeferRequirementTool(store, mustRenderer(t)).Handle(context.Background(), req)
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("defer: %v %s", err, getResultText(result))
+	}
+	if text := getResultText(result); !strings.Contains(text, "requirements/billing.md") {
+		t.Errorf("response should name the domain file:\n%s", text)
+	}
+	billing, _ := readStageFile(filepath.Join(config.StagePartsDir(tmpDir, config.StageSpecify), "billing.md"))
+	if !strings.Contains(billing, "### Won't Have (this version)\n\n- **FR-002**: Customers can pay by card") ||
+		!strings.Contains(billing, ": billing — Requirements") {
+		t.Errorf("FR-002 should move to Won't Have in billing.md, keeping its title:\n%s", billing)
+	}
+}
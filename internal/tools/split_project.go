@@ -0,0 +1,282 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SubProjectsDir is the directory, under the umbrella project's root,
+// that sdd_split_project creates sub-projects in.
+const SubProjectsDir = "features"
+
+// unassignedBucket fills a MoSCoW bucket that received no requirement
+// from the umbrella project.
+const unassignedBucket = "_None assigned from the umbrella project._"
+
+// SplitProjectTool handles the sdd_split_project MCP tool.
+// When the Clarity Gate shows one project is really several, it splits
+// the requirements across new sub-projects under features/<name>/:
+// each is initialized like sdd_init_project and seeded with its share
+// of requirements.md, and the original becomes an umbrella that lists
+// them.
+//
+// Design: all-or-nothing validation. Every requirement must go to
+// exactly one sub-project, and nothing is written until the whole
+// mapping checks out. The umbrella's own artifacts are left untouched.
+type SplitProjectTool struct {
+	store    config.Store
+	renderer templates.Renderer
+}
+
+// NewSplitProjectTool creates a SplitProjectTool with its dependencies.
+func NewSplitProjectTool(store config.Store, renderer templates.Renderer) *SplitProjectTool {
+	return &SplitProjectTool{store: store, renderer: renderer}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *SplitProjectTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_split_project",
+		mcp.WithDescription(
+			"Split a project whose scope is too large into sub-projects. Takes a mapping of requirement IDs "+
+				"to sub-project names; every requirement in requirements.md must be assigned to exactly one. "+
+				"Each sub-project is initialized under "+SubProjectsDir+"/<name>/ with the same mode and threshold, "+
+				"and seeded with its requirements; the current project becomes an umbrella listing them. "+
+				"Continue each sub-project's pipeline from its own directory.",
+		),
+		mcp.WithString("mapping",
+			mcp.Required(),
+			mcp.Description("One sub-project per line: 'name: FR-001, FR-002, NFR-001'. "+
+				"At least two sub-projects."),
+		),
+	)
+}
+
+// splitProjectParams are the parsed sdd_split_project arguments.
+type splitProjectParams struct {
+	Mapping string
+}
+
+// splitProjectParamSpecs declares the sdd_split_project argument checks.
+var splitProjectParamSpecs = []paramSpec{
+	{name: "mapping", required: true, hint: "one 'name: FR-001, FR-002' line per sub-project"},
+}
+
+// subProjectPlan is one validated sub-project of a split.
+type subProjectPlan struct {
+	name string
+	root string
+	reqs []requirements.Requirement
+}
+
+// Handle processes the sdd_split_project tool call.
+func (t *SplitProjectTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, splitProjectParams{
+		Mapping: req.GetString("mapping", ""),
+	}))
+}
+
+// process validates the mapping, creates the sub-projects and records
+// them on the umbrella project.
+func (t *SplitProjectTool) process(projectRoot string, params splitProjectParams) (string, error) {
+	if err := validateParams(splitProjectParamSpecs, map[string]string{"mapping": params.Mapping}); err != nil {
+		return "", err
+	}
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if len(cfg.SubProjects) > 0 {
+		return "", newUserError(fmt.Sprintf("%s was already split into %d sub-projects", cfg.Name, len(cfg.SubProjects)))
+	}
+	if err := pipeline.RequireArtifacts(projectRoot, config.StageSpecify); err != nil {
+		return "", asUserError(err)
+	}
+	doc, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return "", fmt.Errorf("reading requirements: %w", err)
+	}
+
+	plans, err := planSplit(projectRoot, params.Mapping, requirements.ParseMarkdown(doc))
+	if err != nil {
+		return "", err
+	}
+
+	initTool := NewInitTool(t.store, t.renderer)
+	for i := range plans {
+		if err := t.createSubProject(initTool, cfg, plans[i], doc); err != nil {
+			return "", fmt.Errorf("creating sub-project %s: %w", plans[i].name, err)
+		}
+	}
+
+	for _, p := range plans {
+		ids := make([]string, len(p.reqs))
+		for i, r := range p.reqs {
+			ids[i] = r.ID
+		}
+		cfg.SubProjects = append(cfg.SubProjects, config.SubProject{
+			Name:         p.name,
+			Path:         filepath.ToSlash(filepathRel(projectRoot, p.root)),
+			Requirements: ids,
+		})
+	}
+	if err := t.store.Save(projectRoot, cfg); err != nil {
+		return "", fmt.Errorf("saving config: %w", err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s Split into %d Sub-Projects\n\n", cfg.Name, len(plans))
+	sb.WriteString("| Sub-project | Directory | Requirements |\n|-------------|-----------|--------------|\n")
+	for _, sp := range cfg.SubProjects {
+		fmt.Fprintf(&sb, "| %s | `%s/` | %s |\n", sp.Name, sp.Path, strings.Join(sp.Requirements, ", "))
+	}
+	fmt.Fprintf(&sb, "\n%s is now an umbrella project; its artifacts are unchanged.\n\n", cfg.Name)
+	sb.WriteString("## Next Step\n\n")
+	fmt.Fprintf(&sb, "Each sub-project starts at the %s stage in %s mode, with its requirements pre-seeded "+
+		"in `docs/requirements.md`. Work on one from its directory: run the pipeline there and refine the seeded "+
+		"requirements when you reach sdd_generate_requirements.",
+		config.Stages[config.StagePrinciples].Name, cfg.Mode)
+	return sb.String(), nil
+}
+
+// planSplit parses the mapping and checks it against the umbrella's
+// requirements: names must be distinct and free to create, IDs must
+// exist, and every requirement must be assigned exactly once.
+func planSplit(projectRoot, mapping string, reqs []requirements.Requirement) ([]subProjectPlan, error) {
+	byID := make(map[string]requirements.Requirement, len(reqs))
+	for _, r := range reqs {
+		byID[r.ID] = r
+	}
+	if len(byID) == 0 {
+		return nil, newUserError("requirements.md has no identified requirements (FR-001, NFR-001, ...) to split")
+	}
+
+	var plans []subProjectPlan
+	owner := make(map[string]string, len(byID))
+	var problems []string
+	for n, line := range strings.Split(mapping, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, ids, ok := strings.Cut(line, ":")
+		name = strings.TrimSpace(name)
+		slug := slugifyTitle(name)
+		if !ok || slug == "" {
+			problems = append(problems, fmt.Sprintf("line %d: expected 'name: FR-001, FR-002', got %q", n+1, line))
+			continue
+		}
+		plan := subProjectPlan{name: name, root: filepath.Join(projectRoot, SubProjectsDir, slug)}
+		if slices.ContainsFunc(plans, func(p subProjectPlan) bool { return p.root == plan.root }) {
+			problems = append(problems, fmt.Sprintf("sub-project %q is listed twice", name))
+			continue
+		}
+		if config.Exists(plan.root) {
+			problems = append(problems, fmt.Sprintf("`%s` already holds a project", filepathRel(projectRoot, plan.root)))
+		}
+		for _, id := range requirementIDPattern.FindAllString(ids, -1) {
+			r, known := byID[id]
+			switch {
+			case !known:
+				problems = append(problems, fmt.Sprintf("%s (for %s) is not in requirements.md", id, name))
+			case owner[id] != "":
+				problems = append(problems, fmt.Sprintf("%s is assigned to both %s and %s", id, owner[id], name))
+			default:
+				owner[id] = name
+				plan.reqs = append(plan.reqs, r)
+			}
+		}
+		if len(plan.reqs) == 0 {
+			problems = append(problems, fmt.Sprintf("sub-project %q has no requirements", name))
+		}
+		plans = append(plans, plan)
+	}
+	if len(plans) < 2 {
+		problems = append(problems, "a split needs at least two sub-projects")
+	}
+
+	var unassigned []string
+	for _, r := range reqs {
+		if owner[r.ID] == "" && !slices.Contains(unassigned, r.ID) {
+			unassigned = append(unassigned, r.ID)
+		}
+	}
+	if len(unassigned) > 0 {
+		problems = append(problems, "not assigned to any sub-project: "+strings.Join(unassigned, ", "))
+	}
+	if len(problems) > 0 {
+		return nil, newUserError("cannot split the project:\n- " + strings.Join(problems, "\n- "))
+	}
+	return plans, nil
+}
+
+// createSubProject initializes plan's project with the umbrella's mode
+// and threshold and seeds its requirements.md with the assigned
+// requirements, each with its sub-bullets from the umbrella's doc.
+func (t *SplitProjectTool) createSubProject(initTool *InitTool, umbrella *config.ProjectConfig, plan subProjectPlan, doc string) error {
+	if err := os.MkdirAll(plan.root, 0o755); err != nil {
+		return err
+	}
+	if _, err := initTool.Run(plan.root, InitParams{
+		Name:             plan.name,
+		Description:      fmt.Sprintf("Split from %s — %s", umbrella.Name, umbrella.Description),
+		Mode:             umbrella.Mode,
+		ClarityThreshold: umbrella.ClarityThreshold,
+	}); err != nil {
+		return err
+	}
+	cfg, err := t.store.Load(plan.root)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(doc, "\n")
+	buckets := make(map[string][]string)
+	for _, r := range plan.reqs {
+		buckets[r.Bucket] = append(buckets[r.Bucket], requirementBlock(lines, r.Line))
+	}
+	field := func(bucket string) string {
+		if items := buckets[bucket]; len(items) > 0 {
+			return strings.Join(items, "\n")
+		}
+		return unassignedBucket
+	}
+	_, err = RenderAndWriteRequirements(plan.root, t.renderer, templates.RequirementsData{
+		Name:          cfg.Name,
+		Attribution:   attributionFor(cfg),
+		MustHave:      field(requirements.BucketMust),
+		ShouldHave:    field(requirements.BucketShould),
+		CouldHave:     field(requirements.BucketCould),
+		WontHave:      field(requirements.BucketWont),
+		NonFunctional: field(requirements.BucketNFR),
+		Constraints:   "_See the umbrella project._",
+		Assumptions:   "_See the umbrella project._",
+		Dependencies:  "_See the umbrella project._",
+	}, false)
+	return err
+}
+
+// requirementBlock returns the list item at 1-based line and the
+// indented lines (sub-bullets, continuations) that follow it.
+func requirementBlock(lines []string, line int) string {
+	end := line
+	for end < len(lines) && strings.TrimSpace(lines[end]) != "" &&
+		(strings.HasPrefix(lines[end], " ") || strings.HasPrefix(lines[end], "\t")) {
+		end++
+	}
+	return strings.Join(lines[line-1:end], "\n")
+}
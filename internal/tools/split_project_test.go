@@ -0,0 +1,92 @@
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+const splitRequirements = `# Requirements
+
+## Must Have
+
+- **FR-001**: Users can sign up
+  - Email and password only
+- **FR-002**: Users can send invoices
+
+## Should Have
+
+- **FR-003**: Invoices can be exported as PDF
+
+## Non-Functional Requirements
+
+- **NFR-001**: Sign-up completes in under 2s
+`
+
+func TestSplitProjectTool_Process(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageClarify)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), splitRequirements); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	tool := NewSplitProjectTool(store, mustRenderer(t))
+
+	rejected := []struct {
+		mapping string
+		want    string
+	}{
+		{"accounts: FR-001, NFR-001", "at least two sub-projects"},
+		{"accounts: FR-001, NFR-001\nbilling: FR-002", "not assigned to any sub-project: FR-003"},
+		{"accounts: FR-001, NFR-001, FR-002\nbilling: FR-002, FR-003", "FR-002 is assigned to both accounts and billing"},
+		{"accounts: FR-001, NFR-001, FR-009\nbilling: FR-002, FR-003", "FR-009 (for accounts) is not in requirements.md"},
+	}
+	for _, tt := range rejected {
+		_, err := tool.process(tmpDir, splitProjectParams{Mapping: tt.mapping})
+		if err == nil || !IsUserError(err) || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("mapping %q: err = %v, want %q", tt.mapping, err, tt.want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, SubProjectsDir)); !os.IsNotExist(err) {
+		t.Fatal("a rejected split must not create anything")
+	}
+
+	text, err := tool.process(tmpDir, splitProjectParams{Mapping: "Accounts: FR-001, NFR-001\nBilling: FR-002, FR-003"})
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	if !strings.Contains(text, "| Billing | `features/billing/` | FR-002, FR-003 |") {
+		t.Errorf("response should list the sub-projects:\n%s", text)
+	}
+
+	umbrella, _ := store.Load(tmpDir)
+	if len(umbrella.SubProjects) != 2 || umbrella.SubProjects[0].Path != "features/accounts" {
+		t.Errorf("umbrella sub-projects = %+v", umbrella.SubProjects)
+	}
+
+	accountsRoot := filepath.Join(tmpDir, SubProjectsDir, "accounts")
+	accounts, err := store.Load(accountsRoot)
+	if err != nil {
+		t.Fatalf("loading sub-project: %v", err)
+	}
+	if accounts.Name != "Accounts" || accounts.Mode != config.ModeExpert || accounts.CurrentStage != config.StagePrinciples {
+		t.Errorf("sub-project config = %s / %s / %s", accounts.Name, accounts.Mode, accounts.CurrentStage)
+	}
+	reqs, _ := readStageFile(config.StagePath(accountsRoot, config.StageSpecify))
+	for _, want := range []string{"- **FR-001**: Users can sign up\n  - Email and password only", "**NFR-001**", unassignedBucket} {
+		if !strings.Contains(reqs, want) {
+			t.Errorf("seeded requirements missing %q:\n%s", want, reqs)
+		}
+	}
+	if strings.Contains(reqs, "FR-002") {
+		t.Errorf("accounts should not get billing's requirements:\n%s", reqs)
+	}
+
+	if _, err := tool.process(tmpDir, splitProjectParams{Mapping: "a: FR-001\nb: FR-002"}); err == nil || !IsUserError(err) {
+		t.Errorf("a second split should be rejected, got %v", err)
+	}
+}
@@ -73,19 +73,43 @@ const suggestMaxSectionLen = 300
 
 // Handle processes the sdd_suggest_context tool call.
 func (t *SuggestContextTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	taskDesc := strings.TrimSpace(req.GetString("task_description", ""))
-	projectName := req.GetString("project_name", "")
-	detailLevel := memory.ParseDetailLevel(req.GetString("detail_level", ""))
-	maxTokens := intArgSuggest(req, "max_tokens", 0)
+	params := suggestContextParams{
+		TaskDescription: strings.TrimSpace(req.GetString("task_description", "")),
+		ProjectName:     req.GetString("project_name", ""),
+		DetailLevel:     memory.ParseDetailLevel(req.GetString("detail_level", "")),
+		MaxTokens:       intArgSuggest(req, "max_tokens", 0),
+	}
+	return toolResult(t.process(params))
+}
+
+// suggestContextParams are the parsed sdd_suggest_context arguments.
+type suggestContextParams struct {
+	TaskDescription string
+	ProjectName     string
+	DetailLevel     string
+	MaxTokens       int
+}
+
+// suggestContextParamSpecs declares the sdd_suggest_context argument checks.
+var suggestContextParamSpecs = []paramSpec{
+	{name: "task_description", required: true, hint: "describe the task you're about to work on"},
+}
+
+// process ranks the artifacts and memories relevant to the task.
+func (t *SuggestContextTool) process(params suggestContextParams) (string, error) {
+	taskDesc := params.TaskDescription
+	projectName := params.ProjectName
+	detailLevel := params.DetailLevel
+	maxTokens := params.MaxTokens
 
-	if taskDesc == "" {
-		return mcp.NewToolResultError("'task_description' is required — describe the task you're about to work on"), nil
+	if err := validateParams(suggestContextParamSpecs, map[string]string{"task_description": taskDesc}); err != nil {
+		return "", err
 	}
 
 	// Use working directory directly — no hoofy.json required (FR-030, FR-031).
 	cwd, err := os.Getwd()
 	if err != nil {
-		return nil, fmt.Errorf("getting working directory: %w", err)
+		return "", fmt.Errorf("getting working directory: %w", err)
 	}
 
 	keywords := extractKeywords(taskDesc)
@@ -208,7 +232,7 @@ func (t *SuggestContextTool) Handle(ctx context.Context, req mcp.CallToolRequest
 
 	response += memory.TokenFooter(memory.EstimateTokens(response))
 
-	return mcp.NewToolResultText(response), nil
+	return response, nil
 }
 
 // --- Private helpers ---
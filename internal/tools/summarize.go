@@ -0,0 +1,275 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxTopRequirements caps the requirements listed in the executive summary.
+const maxTopRequirements = 5
+
+// SummarizeTool handles the sdd_summarize MCP tool.
+// It renders a one-page executive summary (docs/summary.md) for
+// stakeholders once the pipeline has passed validation.
+//
+// The AI supplies the prose; the tool enforces the structure and fills
+// the mechanical facts (task count, effort, verdict) from the artifacts.
+type SummarizeTool struct {
+	store    config.Store
+	renderer templates.Renderer
+}
+
+// NewSummarizeTool creates a SummarizeTool with its dependencies.
+func NewSummarizeTool(store config.Store, renderer templates.Renderer) *SummarizeTool {
+	return &SummarizeTool{store: store, renderer: renderer}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *SummarizeTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_summarize",
+		mcp.WithDescription(
+			"Generate a one-page executive summary for stakeholders in `docs/summary.md`: "+
+				"the problem, the solution, the top 5 requirements, the chosen stack, "+
+				"task count/effort, and the validation verdict. "+
+				"IMPORTANT: Before calling this tool, read all artifacts "+
+				"(use sdd_get_context detail_level=full) and write plain-language prose. "+
+				"Task count, effort, and verdict are filled in from tasks.md and validation.md. "+
+				"Requires: sdd_validate must have completed with PASS or PASS_WITH_WARNINGS. "+
+				"Does not change pipeline state; call again to regenerate.",
+		),
+		mcp.WithString("problem",
+			mcp.Required(),
+			mcp.Description("The problem being solved, in 2-4 sentences a non-technical reader understands."),
+		),
+		mcp.WithString("solution",
+			mcp.Required(),
+			mcp.Description("The proposed solution, in 2-4 sentences a non-technical reader understands."),
+		),
+		mcp.WithString("top_requirements",
+			mcp.Required(),
+			mcp.Description("Markdown list of at most 5 of the most important requirements, each "+
+				"citing its ID. Example: '- **FR-001**: Customers can pay by card\\n"+
+				"- **FR-004**: Orders ship within 24 hours'"),
+		),
+		mcp.WithString("tech_stack",
+			mcp.Description("Short description of the chosen stack. "+
+				"Defaults to the Tech Stack section of design.md."),
+		),
+		mcp.WithString("estimated_effort",
+			mcp.Description("Overall effort estimate. Defaults to the Estimated Effort recorded in tasks.md."),
+		),
+	)
+}
+
+// Handle processes the sdd_summarize tool call.
+func (t *SummarizeTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := summarizeParams{
+		Problem:         strings.TrimSpace(req.GetString("problem", "")),
+		Solution:        strings.TrimSpace(req.GetString("solution", "")),
+		TopRequirements: strings.TrimSpace(req.GetString("top_requirements", "")),
+		TechStack:       strings.TrimSpace(req.GetString("tech_stack", "")),
+		EstimatedEffort: strings.TrimSpace(req.GetString("estimated_effort", "")),
+	}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// summarizeParams are the parsed sdd_summarize arguments.
+type summarizeParams struct {
+	Problem         string
+	Solution        string
+	TopRequirements string
+	TechStack       string
+	EstimatedEffort string
+}
+
+// summarizeParamSpecs declares the sdd_summarize argument checks.
+var summarizeParamSpecs = []paramSpec{
+	{name: "problem", required: true, hint: "describe the problem in plain language"},
+	{name: "solution", required: true, hint: "describe the solution in plain language"},
+	{name: "top_requirements", required: true, hint: "list up to 5 key requirements with their IDs"},
+}
+
+// process renders the stakeholder summary of the pipeline.
+func (t *SummarizeTool) process(projectRoot string, params summarizeParams) (string, error) {
+	problem := params.Problem
+	solution := params.Solution
+	topRequirements := params.TopRequirements
+	techStack := params.TechStack
+	effort := params.EstimatedEffort
+
+	if err := validateParams(summarizeParamSpecs, map[string]string{
+		"problem":          problem,
+		"solution":         solution,
+		"top_requirements": topRequirements,
+	}); err != nil {
+		return "", err
+	}
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+
+	if !pipeline.IsCompleted(cfg, config.StageValidate) {
+		return "", newUserError(
+			"the executive summary requires a completed validation — run sdd_validate first",
+		)
+	}
+	if err := pipeline.RequireArtifacts(projectRoot, config.StageSpecify, config.StageDesign, config.StageTasks, config.StageValidate); err != nil {
+		return "", asUserError(err)
+	}
+
+	validation, err := readStageFile(config.StagePath(projectRoot, config.StageValidate))
+	if err != nil {
+		return "", fmt.Errorf("reading validation report: %w", err)
+	}
+	verdict, ok := pipeline.ParseVerdict(validation)
+	if !ok {
+		return "", newUserError("validation.md has no '## Verdict:' heading — re-run sdd_validate")
+	}
+	if verdict == "FAIL" {
+		return "", newUserError(
+			"validation verdict is FAIL — fix the reported issues and re-run sdd_validate before summarizing",
+		)
+	}
+
+	requirements, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return "", fmt.Errorf("reading requirements: %w", err)
+	}
+	if msg := checkTopRequirements(topRequirements, requirements); msg != "" {
+		return "", newUserError(msg)
+	}
+
+	if techStack == "" {
+		design, err := readStageFile(config.StagePath(projectRoot, config.StageDesign))
+		if err != nil {
+			return "", fmt.Errorf("reading design: %w", err)
+		}
+		techStack = strings.TrimSpace(markdownSections(design)["Tech Stack"])
+		if isPlaceholderBody(techStack) {
+			return "", newUserError(
+				"design.md has no Tech Stack section — pass 'tech_stack' explicitly",
+			)
+		}
+	}
+
+	tasks, err := readStageFile(config.StagePath(projectRoot, config.StageTasks))
+	if err != nil {
+		return "", fmt.Errorf("reading tasks: %w", err)
+	}
+	taskCount := countTasks(tasks)
+	if effort == "" {
+		effort = taskSummaryField(tasks, "Estimated Effort")
+	}
+	if effort == "" {
+		effort = "_Not estimated._"
+	}
+
+	content, err := t.renderer.Render(templates.Summary, templates.SummaryData{
+		Name:            cfg.Name,
+		Attribution:     attributionFor(cfg),
+		Problem:         problem,
+		Solution:        solution,
+		TopRequirements: topRequirements,
+		TechStack:       techStack,
+		TaskCount:       taskCount,
+		EstimatedEffort: effort,
+		Verdict:         verdict,
+		References:      projectReferences(cfg),
+	})
+	if err != nil {
+		return "", fmt.Errorf("rendering summary: %w", err)
+	}
+
+	if err := writeStageFile(config.SummaryPath(projectRoot), content); err != nil {
+		return "", fmt.Errorf("writing summary: %w", err)
+	}
+
+	response := fmt.Sprintf(
+		"# Executive Summary Saved\n\n"+
+			"Saved to `docs/%s`\n\n"+
+			"**Verdict:** %s | **Tasks:** %d | **Effort:** %s\n\n"+
+			"---\n\n%s",
+		config.SummaryFile, verdict, taskCount, effort, content,
+	)
+	return response, nil
+}
+
+// listItemPattern matches bullet and numbered markdown list items.
+var listItemPattern = regexp.MustCompile(`^(?:[-*]|\d+[.)])\s+`)
+
+// checkTopRequirements enforces the top-requirements structure: 1 to
+// maxTopRequirements list items, each citing an ID defined in
+// requirements.md. Returns an error message, or "" when valid.
+func checkTopRequirements(topRequirements, requirements string) string {
+	var items []string
+	for _, line := range strings.Split(topRequirements, "\n") {
+		if listItemPattern.MatchString(line) {
+			items = append(items, line)
+		}
+	}
+	if len(items) == 0 {
+		return "'top_requirements' must be a markdown list, one requirement per item"
+	}
+	if len(items) > maxTopRequirements {
+		return fmt.Sprintf("'top_requirements' lists %d items — pick at most %d", len(items), maxTopRequirements)
+	}
+
+	known := make(map[string]bool)
+	for _, id := range extractRequirementIDs(requirements) {
+		known[id] = true
+	}
+	var unknown []string
+	for _, item := range items {
+		ids := requirementIDPattern.FindAllString(item, -1)
+		if len(ids) == 0 {
+			return fmt.Sprintf("every top requirement must cite its FR/NFR ID — missing in: %s", strings.TrimSpace(item))
+		}
+		for _, id := range ids {
+			if !known[id] {
+				unknown = append(unknown, id)
+			}
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Sprintf("unknown requirement ID(s) in top_requirements: %s", strings.Join(unknown, ", "))
+	}
+	return ""
+}
+
+// countTasks returns the number of distinct TASK IDs in tasks.md,
+// falling back to the recorded "Total Tasks" figure.
+func countTasks(tasks string) int {
+	if n := len(parseTaskIDs(tasks)); n > 0 {
+		return n
+	}
+	n, _ := strconv.Atoi(taskSummaryField(tasks, "Total Tasks"))
+	return n
+}
+
+// taskSummaryField reads a "**Label:** value" line from the tasks.md summary.
+func taskSummaryField(tasks, label string) string {
+	prefix := "**" + label + ":**"
+	for _, line := range strings.Split(tasks, "\n") {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(line), prefix); ok {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
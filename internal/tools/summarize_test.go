@@ -0,0 +1,156 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// setupValidatedProject creates a project whose validate stage completed
+// with the given verdict, with requirements, design and tasks on disk.
+func setupValidatedProject(t *testing.T, verdict string) (string, func()) {
+	t.Helper()
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageValidate)
+
+	store := config.NewFileStore()
+	cfg, _ := store.Load(tmpDir)
+	st := cfg.StageStatus[config.StageValidate]
+	st.Status = "completed"
+	cfg.StageStatus[config.StageValidate] = st
+	if err := store.Save(tmpDir, cfg); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+
+	files := map[config.Stage]string{
+		config.StageSpecify:  acceptanceRequirements,
+		config.StageDesign:   precheckDesign,
+		config.StageTasks:    "# demo — Implementation Tasks\n\n**Total Tasks:** 2\n**Estimated Effort:** 4 days\n\n### TASK-001: Signup\n### TASK-002: Reset\n",
+		config.StageValidate: "# Validation\n\n## Verdict: " + verdict + "\n",
+	}
+	for stage, content := range files {
+		if err := writeStageFile(config.StagePath(tmpDir, stage), content); err != nil {
+			cleanup()
+			t.Fatal(err)
+		}
+	}
+	return tmpDir, cleanup
+}
+
+func summarizeRequest(topRequirements string) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"problem":          "Users forget passwords and abandon signup.",
+		"solution":         "A self-service account portal.",
+		"top_requirements": topRequirements,
+	}
+	return req
+}
+
+func TestSummarizeTool_Handle_Success(t *testing.T) {
+	tmpDir, cleanup := setupValidatedProject(t, "PASS_WITH_WARNINGS")
+	defer cleanup()
+
+	result, err := NewSummarizeTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(),
+		summarizeRequest("- **FR-001**: Users can register\n- **FR-002**: Users can reset their password"))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+
+	data, err := os.ReadFile(config.SummaryPath(tmpDir))
+	if err != nil {
+		t.Fatalf("reading summary.md: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{
+		"Executive Summary",
+		"Users forget passwords",
+		"- Go", // tech stack pulled from design.md
+		"**Tasks:** 2",
+		"**Estimated Effort:** 4 days",
+		"**Verdict:** PASS_WITH_WARNINGS",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("summary.md missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestSummarizeTool_Handle_RequiresPassingValidation(t *testing.T) {
+	_, cleanup := setupValidatedProject(t, "FAIL")
+	defer cleanup()
+
+	result, _ := NewSummarizeTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(),
+		summarizeRequest("- **FR-001**: Users can register"))
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "FAIL") {
+		t.Errorf("expected FAIL verdict to be rejected, got: %s", getResultText(result))
+	}
+}
+
+func TestSummarizeTool_Handle_BeforeValidate(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageTasks)
+	defer cleanup()
+
+	result, _ := NewSummarizeTool(config.NewFileStore(), mustRenderer(t)).Handle(context.Background(),
+		summarizeRequest("- **FR-001**: Users can register"))
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "sdd_validate") {
+		t.Errorf("expected validate prerequisite error, got: %s", getResultText(result))
+	}
+}
+
+func TestCheckTopRequirements(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  string
+	}{
+		"valid":      {"1. FR-001 signup\n2. NFR-001 latency", ""},
+		"not a list": {"FR-001 and FR-002", "markdown list"},
+		"too many":   {strings.Repeat("- FR-001\n", 6), "at most 5"},
+		"no id":      {"- Users can register", "cite its FR/NFR ID"},
+		"unknown id": {"- FR-042: Reports", "FR-042"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := checkTopRequirements(tt.input, acceptanceRequirements)
+			if tt.want == "" && got != "" {
+				t.Errorf("expected valid, got %q", got)
+			}
+			if tt.want != "" && !strings.Contains(got, tt.want) {
+				t.Errorf("got %q, want it to mention %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContextTool_Handle_ListsSideArtifacts(t *testing.T) {
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+
+	if err := writeStageFile(config.SummaryPath(tmpDir), "# demo — Executive Summary\n"); err != nil {
+		t.Fatal(err)
+	}
+	tool := NewContextTool(config.NewFileStore())
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"detail_level": "standard"}
+	result, _ := tool.Handle(context.Background(), req)
+	if text := getResultText(result); !strings.Contains(text, "Executive Summary** (`docs/summary.md`)") {
+		t.Errorf("overview should list summary.md, got: %s", text)
+	}
+	if strings.Contains(getResultText(result), "acceptance.md") {
+		t.Error("missing side artifacts should not be listed")
+	}
+
+	req.Params.Arguments = map[string]interface{}{"stage": "summary"}
+	result, _ = tool.Handle(context.Background(), req)
+	if !strings.Contains(getResultText(result), "# demo — Executive Summary") {
+		t.Errorf("stage=summary should return the artifact, got: %s", getResultText(result))
+	}
+}
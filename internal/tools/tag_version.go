@@ -0,0 +1,139 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TagVersionTool handles the sdd_tag_version MCP tool.
+// It snapshots a validated spec under a name ("v1.0-approved"): the
+// config and every artifact are copied to docs/versions/<tag>/ and the
+// tag is recorded in hoofy.json with its timestamp.
+//
+// Design: tags are immutable milestones. A tag is never reused or
+// overwritten, and sdd_get_context reads a snapshot through its
+// 'version' parameter, just like an archived iteration.
+type TagVersionTool struct {
+	store config.Store
+}
+
+// NewTagVersionTool creates a TagVersionTool with its dependencies.
+func NewTagVersionTool(store config.Store) *TagVersionTool {
+	return &TagVersionTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *TagVersionTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_tag_version",
+		mcp.WithDescription(
+			"Tag the validated spec as a named, immutable milestone (e.g. 'v1.0-approved'). "+
+				"Copies hoofy.json and every artifact to docs/versions/<tag>/ and records the tag with a timestamp. "+
+				"Read a tagged artifact later with sdd_get_context (stage=..., version=<tag>). "+
+				"Requires: sdd_validate must have completed with PASS or PASS_WITH_WARNINGS. "+
+				"A tag can't be reused. Does not change pipeline state.",
+		),
+		mcp.WithString("tag",
+			mcp.Required(),
+			mcp.Description("Name of the snapshot: letters, digits, '.', '-' or '_'. Example: 'v1.0-approved'"),
+		),
+	)
+}
+
+// Handle processes the sdd_tag_version tool call.
+func (t *TagVersionTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	tag := strings.TrimSpace(req.GetString("tag", ""))
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, tag))
+}
+
+// tagVersionParamSpecs declares the sdd_tag_version argument checks.
+var tagVersionParamSpecs = []paramSpec{
+	{name: "tag", required: true, hint: "e.g. 'v1.0-approved'"},
+}
+
+// process snapshots the spec and records the tag.
+func (t *TagVersionTool) process(projectRoot, tag string) (string, error) {
+	if err := validateParams(tagVersionParamSpecs, map[string]string{"tag": tag}); err != nil {
+		return "", err
+	}
+	if err := config.ValidateVersionTag(tag); err != nil {
+		return "", toolError(CodeInvalidInput, err.Error())
+	}
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if !pipeline.IsCompleted(cfg, config.StageValidate) {
+		return "", newUserError("only a validated spec can be tagged — run sdd_validate first")
+	}
+	verdict, err := lastVerdict(projectRoot, cfg)
+	if err != nil {
+		return "", err
+	}
+	if verdict == "FAIL" {
+		return "", newUserError(
+			"validation verdict is FAIL — fix the reported issues and re-run sdd_validate before tagging",
+		)
+	}
+
+	snapshotDir := versionTagPath(projectRoot, tag)
+	if existing, ok := cfg.FindTag(tag); ok {
+		return "", newUserError(fmt.Sprintf("tag %s already exists (taken %s) — tags are immutable; choose a new name",
+			tag, formatTimestamp(existing.At)))
+	}
+	if _, err := os.Stat(snapshotDir); err == nil {
+		return "", newUserError(fmt.Sprintf("`%s` already exists — choose a new tag name",
+			filepathRel(projectRoot, snapshotDir)))
+	}
+
+	copied, err := archiveIteration(config.DocsPath(projectRoot), snapshotDir)
+	if err != nil {
+		return "", err
+	}
+
+	version := cfg.Version
+	if version == "" {
+		version = "0.1.0"
+	}
+	cfg.Tags = append(cfg.Tags, config.VersionTag{
+		Tag:     tag,
+		At:      pipeline.Now(),
+		Version: version,
+		Verdict: verdict,
+	})
+	if err := t.store.Save(projectRoot, cfg); err != nil {
+		return "", fmt.Errorf("saving config: %w", err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Spec Tagged: %s\n\n", tag)
+	fmt.Fprintf(&sb, "**Version:** %s | **Verdict:** %s\n", version, verdict)
+	fmt.Fprintf(&sb, "**Snapshot:** `%s/` (%d files)\n\n", filepathRel(projectRoot, snapshotDir), len(copied))
+	fmt.Fprintf(&sb, "_Read it later with `sdd_get_context` (stage=..., version=%s)._\n", tag)
+	if len(cfg.Tags) > 1 {
+		names := make([]string, len(cfg.Tags))
+		for i, t := range cfg.Tags {
+			names[i] = t.Tag
+		}
+		fmt.Fprintf(&sb, "\n**All tags:** %s\n", strings.Join(names, ", "))
+	}
+	return sb.String(), nil
+}
+
+// versionTagPath returns docs/versions/<tag>, the snapshot taken by
+// sdd_tag_version.
+func versionTagPath(projectRoot, tag string) string {
+	return filepath.Join(config.DocsPath(projectRoot), "versions", tag)
+}
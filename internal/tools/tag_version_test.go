@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+func TestTagVersionTool_Process(t *testing.T) {
+	tmpDir, cleanup := setupValidatedProject(t, "PASS")
+	defer cleanup()
+	store := config.NewFileStore()
+	tool := NewTagVersionTool(store)
+
+	if _, err := tool.process(tmpDir, "../escape"); err == nil || !strings.Contains(err.Error(), "must be 1-64 letters") {
+		t.Errorf("a path-like tag should be rejected, got %v", err)
+	}
+
+	text, err := tool.process(tmpDir, "v1.0-approved")
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	for _, want := range []string{"# Spec Tagged: v1.0-approved", "**Verdict:** PASS", "`docs/versions/v1.0-approved/`"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+	snapshot := filepath.Join(config.DocsPath(tmpDir), "versions", "v1.0-approved")
+	for _, name := range []string{"hoofy.json", "requirements.md", "design.md", "tasks.md", "validation.md"} {
+		if _, err := os.Stat(filepath.Join(snapshot, name)); err != nil {
+			t.Errorf("snapshot missing %s: %v", name, err)
+		}
+	}
+	cfg, _ := store.Load(tmpDir)
+	if tag, ok := cfg.FindTag("v1.0-approved"); !ok || tag.At == "" || tag.Verdict != "PASS" {
+		t.Errorf("tag not recorded: %+v", cfg.Tags)
+	}
+
+	if _, err := tool.process(tmpDir, "v1.0-approved"); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("a duplicate tag should be rejected, got %v", err)
+	}
+
+	// The snapshot is read through sdd_get_context, even after docs/ moves on.
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks), "# rewritten tasks\n"); err != nil {
+		t.Fatal(err)
+	}
+	old, err := NewContextTool(store).process(context.Background(), tmpDir, contextParams{Stage: "tasks", Format: "markdown", Version: "v1.0-approved"})
+	if err != nil || !strings.Contains(old, "### TASK-001: Signup") {
+		t.Errorf("version=v1.0-approved tasks = %q, %v", old, err)
+	}
+}
+
+func TestTagVersionTool_Process_RequiresPassingValidation(t *testing.T) {
+	tmpDir, cleanup := setupValidatedProject(t, "FAIL")
+	defer cleanup()
+	tool := NewTagVersionTool(config.NewFileStore())
+	if _, err := tool.process(tmpDir, "v1"); err == nil || !strings.Contains(err.Error(), "verdict is FAIL") {
+		t.Errorf("a failed validation should block tagging, got %v", err)
+	}
+
+	tmpDir2, cleanup2 := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
+	defer cleanup2()
+	if _, err := tool.process(tmpDir2, "v1"); err == nil || !strings.Contains(err.Error(), "run sdd_validate first") {
+		t.Errorf("an unvalidated project should be rejected, got %v", err)
+	}
+}
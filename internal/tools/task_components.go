@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/design"
+)
+
+// taskComponentLine matches the component field of a task, e.g.
+// "**Component**: AuthModule" or "- **Components:** AuthModule, Mailer".
+var taskComponentLine = regexp.MustCompile(`(?i)^\s*[-*]?\s*\*\*components?\s*:?\*\*\s*:?(.*)$`)
+
+// componentListSeparators split a task's component field into names.
+var componentListSeparators = strings.NewReplacer(";", ",", "+", ",", " and ", ",", " & ", ",")
+
+// taskComponentRef is a component named by a task.
+type taskComponentRef struct {
+	task string
+	name string
+}
+
+// unknownTaskComponents returns the components tasks name that the
+// design's Components section doesn't define, in task order — typos
+// that would otherwise create phantom components. It returns nil when
+// the design defines no components, since there is nothing to check
+// against.
+func unknownTaskComponents(designDoc, tasks string) []taskComponentRef {
+	known := make(map[string]bool)
+	for _, name := range design.ExtractComponents(designDoc) {
+		known[design.ComponentKey(name)] = true
+	}
+	if len(known) == 0 {
+		return nil
+	}
+
+	var unknown []taskComponentRef
+	current := ""
+	for _, line := range strings.Split(tasks, "\n") {
+		if m := taskHeadingPattern.FindStringSubmatch(line); m != nil {
+			current = m[1]
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			current = ""
+			continue
+		}
+		m := taskComponentLine.FindStringSubmatch(line)
+		if current == "" || m == nil {
+			continue
+		}
+		for _, ref := range strings.Split(componentListSeparators.Replace(m[1]), ",") {
+			name := design.ComponentName(ref)
+			switch strings.ToLower(name) {
+			case "", "none", "n/a", "-", "—":
+				continue
+			}
+			if !known[design.ComponentKey(name)] {
+				unknown = append(unknown, taskComponentRef{task: current, name: name})
+			}
+		}
+	}
+	return unknown
+}
+
+// formatUnknownComponents lists unknown component references along with
+// the components the design does define.
+func formatUnknownComponents(refs []taskComponentRef, designDoc string) string {
+	var sb strings.Builder
+	for _, r := range refs {
+		fmt.Fprintf(&sb, "- %s: `%s`\n", r.task, r.name)
+	}
+	fmt.Fprintf(&sb, "\n_Components in design.md: %s._\n", strings.Join(design.ExtractComponents(designDoc), ", "))
+	return sb.String()
+}
@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+)
+
+const componentsDesign = "# Design\n\n## Components\n\n### AuthModule\n- **Covers**: FR-001\n\n### Billing Service\n- **Covers**: FR-002\n"
+
+func TestUnknownTaskComponents(t *testing.T) {
+	tasks := "### TASK-001: Login\n**Component**: AuthModule\n\n" +
+		"### TASK-002: Invoices\n- **Components:** billing-service, AuthModul\n\n" +
+		"### TASK-003: Emails\n**Component**: Mailer + AuthModule\n\n" +
+		"### TASK-004: Docs\n**Component**: None\n"
+
+	got := unknownTaskComponents(componentsDesign, tasks)
+	want := []taskComponentRef{{"TASK-002", "AuthModul"}, {"TASK-003", "Mailer"}}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("unknownTaskComponents = %+v, want %+v", got, want)
+	}
+
+	if got := unknownTaskComponents("# Design\n\nFree-form components.", tasks); got != nil {
+		t.Errorf("a design without component headings has nothing to check against, got %+v", got)
+	}
+}
+
+func TestTasksTool_FlagsUnknownComponent(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageTasks)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageDesign), componentsDesign); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := NewTasksTool(config.NewFileStore(), mustRenderer(t)).Run(tmpDir, templates.TasksData{
+		TotalTasks:      "1",
+		EstimatedEffort: "1 day",
+		Tasks:           "### TASK-001: Login\n**Component**: AuthServce\n**Covers**: FR-001",
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	for _, want := range []string{"Unknown components", "- TASK-001: `AuthServce`", "Components in design.md: AuthModule, Billing Service."} {
+		if !strings.Contains(res.Response, want) {
+			t.Errorf("response missing %q:\n%s", want, res.Response)
+		}
+	}
+	if res.Config.CurrentStage != config.StageValidate {
+		t.Errorf("the check is advisory — the stage should still advance, at %s", res.Config.CurrentStage)
+	}
+}
+
+func TestValidateTool_ReportsUnknownComponents(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageDesign), componentsDesign); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks),
+		"# Tasks\n\n### TASK-001: Setup project\n**Component**: Billing Svc\n**Covers**: FR-001\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := NewValidateTool(config.NewFileStore()).Run(tmpDir, ValidateParams{
+		RequirementsCoverage: "All covered.",
+		ComponentCoverage:    "All covered.",
+		ConsistencyIssues:    "_None found._",
+		Verdict:              "PASS_WITH_WARNINGS",
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if !strings.Contains(res.Content, "### Unknown Components") || !strings.Contains(res.Content, "- TASK-001: `Billing Svc`") {
+		t.Errorf("validation report should list the unknown component:\n%s", res.Content)
+	}
+}
@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// taskHeadingPattern matches a task heading such as "### TASK-003: Build API".
+var taskHeadingPattern = regexp.MustCompile(`^#{2,4}\s+(TASK-\d{3,4})\b`)
+
+// taskDependencyLine matches the dependency field of a task, e.g.
+// "**Dependencies**: TASK-001, TASK-002" or "**Depends on:** TASK-004".
+var taskDependencyLine = regexp.MustCompile(`(?i)^\s*[-*]?\s*\*\*(?:dependencies|depends on)\s*:?\*\*\s*:?(.*)$`)
+
+// parseTaskDependencies reads tasks.md and returns each task's direct
+// dependencies, keyed by task ID. Tasks without a dependency field map
+// to an empty list, so every declared task appears in the result.
+func parseTaskDependencies(tasks string) map[string][]string {
+	deps := make(map[string][]string)
+	current := ""
+	for _, line := range strings.Split(tasks, "\n") {
+		if m := taskHeadingPattern.FindStringSubmatch(line); m != nil {
+			current = m[1]
+			if _, ok := deps[current]; !ok {
+				deps[current] = nil
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			current = ""
+			continue
+		}
+		if current == "" {
+			continue
+		}
+		if m := taskDependencyLine.FindStringSubmatch(line); m != nil {
+			for _, id := range parseTaskIDs(m[1]) {
+				if id != current {
+					deps[current] = append(deps[current], id)
+				}
+			}
+		}
+	}
+	return deps
+}
+
+// findDependencyCycle returns one dependency cycle as a path that starts
+// and ends with the same task (e.g. [TASK-001 TASK-002 TASK-001]), or nil
+// when the graph is acyclic. Dependencies on undeclared tasks are ignored.
+// Traversal order is sorted, so the reported cycle is deterministic.
+func findDependencyCycle(deps map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(deps))
+	var stack []string
+
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		state[id] = visiting
+		stack = append(stack, id)
+
+		next := append([]string(nil), deps[id]...)
+		sort.Strings(next)
+		for _, dep := range next {
+			if _, declared := deps[dep]; !declared {
+				continue
+			}
+			switch state[dep] {
+			case visiting:
+				for i, s := range stack {
+					if s == dep {
+						return append(append([]string(nil), stack[i:]...), dep)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[id] = done
+		return nil
+	}
+
+	ids := make([]string, 0, len(deps))
+	for id := range deps {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if state[id] == unvisited {
+			if cycle := visit(id); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,315 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Task graph export formats.
+const (
+	graphFormatMermaid = "mermaid"
+	graphFormatDOT     = "dot"
+	graphFormatBoth    = "both"
+)
+
+// TaskGraphTool handles the sdd_export_task_graph MCP tool.
+// It draws the task dependencies of tasks.md as a Mermaid flowchart
+// (docs/tasks.mmd) and/or a Graphviz DOT file (docs/tasks.dot), so the
+// plan can be shown to stakeholders.
+//
+// Design: edges come from each task's **Dependencies** line and from
+// arrow chains in the Dependency Graph section; a dependency cycle found
+// by the validate check is drawn in red rather than rejected.
+type TaskGraphTool struct {
+	store config.Store
+}
+
+// NewTaskGraphTool creates a TaskGraphTool with its dependencies.
+func NewTaskGraphTool(store config.Store) *TaskGraphTool {
+	return &TaskGraphTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *TaskGraphTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_export_task_graph",
+		mcp.WithDescription(
+			"Export the task dependency graph of tasks.md as a Mermaid flowchart (`docs/tasks.mmd`) "+
+				"and/or a Graphviz DOT file (`docs/tasks.dot`). Edges come from each task's "+
+				"'**Dependencies**:' line and from arrow chains ('TASK-001 → TASK-002') in the "+
+				"Dependency Graph section; an arrow points from a task to the tasks that depend on it. "+
+				"A dependency cycle is highlighted in red. "+
+				"Requires: the tasks stage must be completed. "+
+				"Does not change pipeline state; call again to regenerate.",
+		),
+		mcp.WithString("format",
+			mcp.Description("Which file to write: 'mermaid' (default), 'dot', or 'both'."),
+			mcp.Enum(graphFormatMermaid, graphFormatDOT, graphFormatBoth),
+		),
+	)
+}
+
+// Handle processes the sdd_export_task_graph tool call.
+func (t *TaskGraphTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	format := strings.ToLower(strings.TrimSpace(req.GetString("format", graphFormatMermaid)))
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, format))
+}
+
+// taskGraphParamSpecs declares the sdd_export_task_graph argument checks.
+var taskGraphParamSpecs = []paramSpec{
+	{name: "format", required: true, enum: []string{graphFormatMermaid, graphFormatDOT, graphFormatBoth}},
+}
+
+// process builds the graph from tasks.md and writes the requested files.
+func (t *TaskGraphTool) process(projectRoot, format string) (string, error) {
+	if err := validateParams(taskGraphParamSpecs, map[string]string{"format": format}); err != nil {
+		return "", err
+	}
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if !pipeline.IsCompleted(cfg, config.StageTasks) {
+		return "", newUserError(
+			"the task graph requires a completed task breakdown — run sdd_create_tasks first",
+		)
+	}
+	if err := pipeline.RequireArtifacts(projectRoot, config.StageTasks); err != nil {
+		return "", asUserError(err)
+	}
+
+	tasks, err := readStageFile(config.StagePath(projectRoot, config.StageTasks))
+	if err != nil {
+		return "", fmt.Errorf("reading tasks: %w", err)
+	}
+	g := buildTaskGraph(tasks)
+	if len(g.ids) == 0 {
+		return "", newUserError(
+			"tasks.md declares no tasks — headings like '### TASK-001: Title' are needed to draw the graph",
+		)
+	}
+
+	var written []string
+	if format != graphFormatDOT {
+		if err := writeStageFile(config.TaskGraphPath(projectRoot), g.mermaid()); err != nil {
+			return "", fmt.Errorf("writing Mermaid graph: %w", err)
+		}
+		written = append(written, filepathRel(projectRoot, config.TaskGraphPath(projectRoot)))
+	}
+	if format != graphFormatMermaid {
+		if err := writeStageFile(config.TaskGraphDOTPath(projectRoot), g.dot()); err != nil {
+			return "", fmt.Errorf("writing DOT graph: %w", err)
+		}
+		written = append(written, filepathRel(projectRoot, config.TaskGraphDOTPath(projectRoot)))
+	}
+
+	return formatTaskGraphResponse(g, format, written), nil
+}
+
+// taskGraph is the dependency graph of tasks.md. Edges point from a
+// dependency to the task that depends on it.
+type taskGraph struct {
+	ids    []string            // declared tasks, in document order
+	titles map[string]string   // task ID → heading title
+	deps   map[string][]string // task ID → dependencies, declared only
+	// undeclared lists dependencies on task IDs with no heading.
+	undeclared []string
+	// cycle is one dependency cycle, first task repeated at the end.
+	cycle []string
+}
+
+// graphArrow matches the arrows of a dependency chain.
+var graphArrow = regexp.MustCompile(`\s*(?:-+>|→|⟶|=>)\s*`)
+
+// buildTaskGraph reads the tasks, their dependency lines, and the arrow
+// chains of the Dependency Graph section.
+func buildTaskGraph(tasks string) taskGraph {
+	g := taskGraph{
+		ids:    taskHeadingIDs(tasks),
+		titles: taskTitles(tasks),
+		deps:   make(map[string][]string),
+	}
+	declared := func(id string) bool { return slices.Contains(g.ids, id) }
+	addEdge := func(from, to string) {
+		if from == to {
+			return
+		}
+		missing := false
+		for _, id := range []string{from, to} {
+			if !declared(id) {
+				missing = true
+				if !slices.Contains(g.undeclared, id) {
+					g.undeclared = append(g.undeclared, id)
+				}
+			}
+		}
+		if missing {
+			return
+		}
+		if !slices.Contains(g.deps[to], from) {
+			g.deps[to] = append(g.deps[to], from)
+		}
+	}
+
+	for id, deps := range parseTaskDependencies(tasks) {
+		for _, dep := range deps {
+			addEdge(dep, id)
+		}
+	}
+	for _, line := range strings.Split(markdownSections(tasks)["Dependency Graph"], "\n") {
+		steps := graphArrow.Split(line, -1)
+		for i := 1; i < len(steps); i++ {
+			for _, from := range parseTaskIDs(steps[i-1]) {
+				for _, to := range parseTaskIDs(steps[i]) {
+					addEdge(from, to)
+				}
+			}
+		}
+	}
+
+	for _, id := range g.ids {
+		slices.Sort(g.deps[id])
+	}
+	slices.Sort(g.undeclared)
+	full := make(map[string][]string, len(g.ids))
+	for _, id := range g.ids {
+		full[id] = g.deps[id]
+	}
+	g.cycle = findDependencyCycle(full)
+	return g
+}
+
+// taskTitles maps each task ID to the rest of its heading, without the
+// separator ("### TASK-001: Signup" → "Signup").
+func taskTitles(tasks string) map[string]string {
+	titles := make(map[string]string)
+	for _, line := range strings.Split(tasks, "\n") {
+		loc := taskHeadingPattern.FindStringSubmatchIndex(line)
+		if loc == nil {
+			continue
+		}
+		id := line[loc[2]:loc[3]]
+		if _, ok := titles[id]; !ok {
+			titles[id] = strings.TrimSpace(strings.TrimLeft(line[loc[1]:], " :—–-"))
+		}
+	}
+	return titles
+}
+
+// edges returns the graph's edges in document order of the dependent
+// task, each as [from, to].
+func (g taskGraph) edges() [][2]string {
+	var edges [][2]string
+	for _, id := range g.ids {
+		for _, dep := range g.deps[id] {
+			edges = append(edges, [2]string{dep, id})
+		}
+	}
+	return edges
+}
+
+// inCycle reports whether the edge from → to is part of the cycle.
+func (g taskGraph) inCycle(from, to string) bool {
+	for i := 1; i < len(g.cycle); i++ {
+		// The cycle lists each task before its dependency.
+		if g.cycle[i] == from && g.cycle[i-1] == to {
+			return true
+		}
+	}
+	return false
+}
+
+// mermaid renders the graph as a Mermaid flowchart.
+func (g taskGraph) mermaid() string {
+	var sb strings.Builder
+	sb.WriteString("graph TD\n")
+	for _, id := range g.ids {
+		label := id
+		if title := g.titles[id]; title != "" {
+			label += ": " + title
+		}
+		fmt.Fprintf(&sb, "    %s[\"%s\"]\n", mermaidID(id), strings.ReplaceAll(label, `"`, "#quot;"))
+	}
+	var red []string
+	for i, e := range g.edges() {
+		fmt.Fprintf(&sb, "    %s --> %s\n", mermaidID(e[0]), mermaidID(e[1]))
+		if g.inCycle(e[0], e[1]) {
+			red = append(red, fmt.Sprint(i))
+		}
+	}
+	if len(g.cycle) > 0 {
+		sb.WriteString("    classDef cycle stroke:#d00,stroke-width:2px,color:#d00\n")
+		nodes := make([]string, 0, len(g.cycle)-1)
+		for _, id := range g.cycle[:len(g.cycle)-1] {
+			nodes = append(nodes, mermaidID(id))
+		}
+		fmt.Fprintf(&sb, "    class %s cycle\n", strings.Join(nodes, ","))
+		fmt.Fprintf(&sb, "    linkStyle %s stroke:#d00,stroke-width:2px\n", strings.Join(red, ","))
+	}
+	return sb.String()
+}
+
+// mermaidID turns a task ID into a Mermaid node ID (no dashes).
+func mermaidID(id string) string {
+	return strings.ReplaceAll(id, "-", "_")
+}
+
+// dot renders the graph as a Graphviz digraph.
+func (g taskGraph) dot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph tasks {\n    rankdir=TB;\n    node [shape=box];\n")
+	for _, id := range g.ids {
+		label := id
+		if title := g.titles[id]; title != "" {
+			label += `\n` + strings.ReplaceAll(title, `"`, `\"`)
+		}
+		attrs := fmt.Sprintf(`label="%s"`, label)
+		if slices.Contains(g.cycle, id) {
+			attrs += ", color=red, fontcolor=red"
+		}
+		fmt.Fprintf(&sb, "    %q [%s];\n", id, attrs)
+	}
+	for _, e := range g.edges() {
+		attrs := ""
+		if g.inCycle(e[0], e[1]) {
+			attrs = " [color=red, penwidth=2]"
+		}
+		fmt.Fprintf(&sb, "    %q -> %q%s;\n", e[0], e[1], attrs)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// formatTaskGraphResponse summarizes the export, inlining the Mermaid
+// graph so clients that render Mermaid show it right away.
+func formatTaskGraphResponse(g taskGraph, format string, written []string) string {
+	var sb strings.Builder
+	sb.WriteString("# Task Graph Exported\n\n")
+	fmt.Fprintf(&sb, "**Tasks:** %d | **Dependencies:** %d\n", len(g.ids), len(g.edges()))
+	for _, path := range written {
+		fmt.Fprintf(&sb, "- `%s`\n", path)
+	}
+	if len(g.cycle) > 0 {
+		fmt.Fprintf(&sb, "\n⚠️ **Dependency cycle** (drawn in red): %s\n", strings.Join(g.cycle, " → "))
+	}
+	if len(g.undeclared) > 0 {
+		fmt.Fprintf(&sb, "\n⚠️ Dependencies on tasks with no heading in tasks.md were left out: %s\n",
+			strings.Join(g.undeclared, ", "))
+	}
+	if format != graphFormatDOT {
+		fmt.Fprintf(&sb, "\n```mermaid\n%s```\n", g.mermaid())
+	}
+	return sb.String()
+}
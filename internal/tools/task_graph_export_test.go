@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const graphTasks = `# demo — Implementation Tasks
+
+### TASK-001: Schema
+- **Dependencies**: None
+
+### TASK-002: "Signup" API
+- **Dependencies**: TASK-001
+
+### TASK-003: UI
+- **Dependencies**: TASK-002
+
+## Dependency Graph
+
+TASK-001 → TASK-003
+TASK-002 -> TASK-009
+`
+
+func TestBuildTaskGraph(t *testing.T) {
+	g := buildTaskGraph(graphTasks)
+	if !slices.Equal(g.ids, []string{"TASK-001", "TASK-002", "TASK-003"}) {
+		t.Errorf("ids = %v", g.ids)
+	}
+	if got := g.deps["TASK-003"]; !slices.Equal(got, []string{"TASK-001", "TASK-002"}) {
+		t.Errorf("TASK-003 deps = %v, want the dependency line and the graph chain merged", got)
+	}
+	if g.titles["TASK-002"] != `"Signup" API` {
+		t.Errorf("TASK-002 title = %q", g.titles["TASK-002"])
+	}
+	if !slices.Equal(g.undeclared, []string{"TASK-009"}) {
+		t.Errorf("undeclared = %v, want [TASK-009]", g.undeclared)
+	}
+	if g.cycle != nil {
+		t.Errorf("cycle = %v, want none", g.cycle)
+	}
+}
+
+func TestTaskGraph_CycleHighlighted(t *testing.T) {
+	tasks := strings.Replace(graphTasks, "- **Dependencies**: None", "- **Dependencies**: TASK-003", 1)
+	g := buildTaskGraph(tasks)
+	if len(g.cycle) == 0 {
+		t.Fatal("expected a cycle")
+	}
+
+	mermaid := g.mermaid()
+	for _, want := range []string{
+		"class TASK_001,TASK_003 cycle",
+		"linkStyle 0,2 stroke:#d00",
+	} {
+		if !strings.Contains(mermaid, want) {
+			t.Errorf("mermaid missing %q:\n%s", want, mermaid)
+		}
+	}
+	dot := g.dot()
+	for _, want := range []string{
+		`"TASK-003" -> "TASK-001" [color=red, penwidth=2];`,
+		`"TASK-001" -> "TASK-003" [color=red, penwidth=2];`,
+		`"TASK-002" -> "TASK-003";`, // not part of the cycle
+		`"TASK-002" [label="TASK-002\n\"Signup\" API"];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("dot missing %q:\n%s", want, dot)
+		}
+	}
+}
+
+func TestTaskGraphTool_Handle_Success(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageValidate)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks), graphTasks); err != nil {
+		t.Fatal(err)
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"format": "both"}
+	result, err := NewTaskGraphTool(config.NewFileStore()).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{"**Tasks:** 3 | **Dependencies:** 3", "left out: TASK-009", "```mermaid\ngraph TD"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+
+	mmd, err := os.ReadFile(config.TaskGraphPath(tmpDir))
+	if err != nil {
+		t.Fatalf("reading tasks.mmd: %v", err)
+	}
+	for _, want := range []string{"graph TD\n", `TASK_002["TASK-002: #quot;Signup#quot; API"]`, "TASK_001 --> TASK_003"} {
+		if !strings.Contains(string(mmd), want) {
+			t.Errorf("tasks.mmd missing %q:\n%s", want, mmd)
+		}
+	}
+	if _, err := os.Stat(config.TaskGraphDOTPath(tmpDir)); err != nil {
+		t.Errorf("tasks.dot should be written with format=both: %v", err)
+	}
+}
+
+func TestTaskGraphTool_Handle_BeforeTasks(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageTasks)
+	defer cleanup()
+
+	result, _ := NewTaskGraphTool(config.NewFileStore()).Handle(context.Background(), mcp.CallToolRequest{})
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "run sdd_create_tasks first") {
+		t.Errorf("expected an error before the tasks stage completes, got: %s", getResultText(result))
+	}
+}
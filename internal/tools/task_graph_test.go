@@ -0,0 +1,39 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTaskDependencies(t *testing.T) {
+	tasks := "## Tasks\n\n" +
+		"### TASK-001: Setup\n**Dependencies**: None\n\n" +
+		"### TASK-002: API\n**Depends on:** TASK-001\n\n" +
+		"### TASK-003: UI\n- **Dependencies**: TASK-001, TASK-002\n\n" +
+		"## Dependency Graph\n\nTASK-003 → TASK-009\n"
+
+	got := parseTaskDependencies(tasks)
+	want := map[string][]string{
+		"TASK-001": nil,
+		"TASK-002": {"TASK-001"},
+		"TASK-003": {"TASK-001", "TASK-002"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTaskDependencies = %v, want %v", got, want)
+	}
+	if cycle := findDependencyCycle(got); cycle != nil {
+		t.Errorf("expected acyclic graph, got cycle %v", cycle)
+	}
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	deps := map[string][]string{
+		"TASK-001": {"TASK-003"},
+		"TASK-002": {"TASK-001"},
+		"TASK-003": {"TASK-002", "TASK-099"}, // TASK-099 is undeclared and ignored
+	}
+	want := []string{"TASK-001", "TASK-003", "TASK-002", "TASK-001"}
+	if got := findDependencyCycle(deps); !reflect.DeepEqual(got, want) {
+		t.Errorf("findDependencyCycle = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,255 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TaskStatusTool handles the sdd_update_task_status MCP tool.
+// It tracks implementation once the spec has passed validation: each
+// task's status is kept in hoofy.json and mirrored as a checkbox line
+// under the task's heading in tasks.md.
+//
+// Design: post-pipeline bookkeeping. It never advances the pipeline;
+// hoofy.json is the source of truth and the checkbox follows it.
+type TaskStatusTool struct {
+	store config.Store
+}
+
+// NewTaskStatusTool creates a TaskStatusTool with its dependencies.
+func NewTaskStatusTool(store config.Store) *TaskStatusTool {
+	return &TaskStatusTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *TaskStatusTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_update_task_status",
+		mcp.WithDescription(
+			"Record the implementation status of a task from tasks.md once the spec is validated. "+
+				"The status is stored in hoofy.json and shown as a checkbox line under the task's heading "+
+				"('- [x] **Status:** done'). The response and sdd_get_context report overall progress (done/total). "+
+				"The task ID must exist in tasks.md. "+
+				"Requires: sdd_validate must have completed with PASS or PASS_WITH_WARNINGS. "+
+				"Does not change pipeline state.",
+		),
+		mcp.WithString("task_id",
+			mcp.Required(),
+			mcp.Description("The task to update, as written in tasks.md. Example: 'TASK-003'"),
+		),
+		mcp.WithString("status",
+			mcp.Required(),
+			mcp.Description("The task's implementation status."),
+			mcp.Enum(config.TaskStatuses...),
+		),
+	)
+}
+
+// Handle processes the sdd_update_task_status tool call.
+func (t *TaskStatusTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params := taskStatusParams{
+		TaskID: strings.ToUpper(strings.TrimSpace(req.GetString("task_id", ""))),
+		Status: normalizeTaskStatus(req.GetString("status", "")),
+	}
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot, params))
+}
+
+// taskStatusParams are the parsed sdd_update_task_status arguments.
+type taskStatusParams struct {
+	TaskID string
+	Status string
+}
+
+// taskStatusParamSpecs declares the sdd_update_task_status argument checks.
+var taskStatusParamSpecs = []paramSpec{
+	{name: "task_id", required: true, hint: "e.g. 'TASK-003'"},
+	{name: "status", required: true, hint: "one of: " + strings.Join(config.TaskStatuses, ", "), enum: config.TaskStatuses},
+}
+
+// normalizeTaskStatus lower-cases a status and accepts "in_progress"
+// and "in progress" for "in-progress".
+func normalizeTaskStatus(s string) string {
+	return strings.NewReplacer("_", "-", " ", "-").Replace(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// process records the status and updates the task's checkbox.
+func (t *TaskStatusTool) process(projectRoot string, params taskStatusParams) (string, error) {
+	if err := validateParams(taskStatusParamSpecs, map[string]string{
+		"task_id": params.TaskID,
+		"status":  params.Status,
+	}); err != nil {
+		return "", err
+	}
+
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if !pipeline.IsCompleted(cfg, config.StageValidate) {
+		return "", newUserError(
+			"task status is tracked once the spec is validated — run sdd_validate first",
+		)
+	}
+	verdict, err := lastVerdict(projectRoot, cfg)
+	if err != nil {
+		return "", err
+	}
+	if verdict == "FAIL" {
+		return "", newUserError(
+			"validation verdict is FAIL — fix the reported issues and re-run sdd_validate before tracking implementation",
+		)
+	}
+
+	path := config.StagePath(projectRoot, config.StageTasks)
+	tasks, err := readStageFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading tasks: %w", err)
+	}
+	ids := taskHeadingIDs(tasks)
+	if !slices.Contains(ids, params.TaskID) {
+		return "", newUserError(fmt.Sprintf("unknown task %s — tasks.md defines: %s",
+			params.TaskID, strings.Join(ids, ", ")))
+	}
+
+	previous := cfg.TaskStatus[params.TaskID]
+	if previous == "" {
+		previous = config.TaskTodo
+	}
+	if cfg.TaskStatus == nil {
+		cfg.TaskStatus = make(map[string]string)
+	}
+	cfg.TaskStatus[params.TaskID] = params.Status
+
+	if err := writeStageFile(path, setTaskStatusLine(tasks, params.TaskID, params.Status)); err != nil {
+		return "", fmt.Errorf("writing tasks: %w", err)
+	}
+	if err := t.store.Save(projectRoot, cfg); err != nil {
+		return "", fmt.Errorf("saving config: %w", err)
+	}
+
+	p := implementationProgressOf(cfg, ids)
+	var sb strings.Builder
+	sb.WriteString("# Task Status Updated\n\n")
+	fmt.Fprintf(&sb, "**%s:** %s → %s\n\n", params.TaskID, previous, params.Status)
+	fmt.Fprintf(&sb, "**Implementation:** %s\n", p)
+	if p.Done == p.Total {
+		sb.WriteString("\n✅ Every task is done.\n")
+	}
+	return sb.String(), nil
+}
+
+// taskHeadingIDs returns the IDs of the tasks defined by headings in
+// tasks.md, in document order, without duplicates.
+func taskHeadingIDs(tasks string) []string {
+	var ids []string
+	for _, line := range strings.Split(tasks, "\n") {
+		if m := taskHeadingPattern.FindStringSubmatch(line); m != nil && !slices.Contains(ids, m[1]) {
+			ids = append(ids, m[1])
+		}
+	}
+	return ids
+}
+
+// taskStatusLine matches the checkbox line sdd_update_task_status keeps
+// under a task heading.
+var taskStatusLine = regexp.MustCompile(`^- \[[ xX]\] \*\*Status:\*\* `)
+
+// setTaskStatusLine returns tasks with the status line of taskID set
+// to status: replaced if the task has one, otherwise inserted right
+// below its heading. Only done is ticked.
+func setTaskStatusLine(tasks, taskID, status string) string {
+	box := "[ ]"
+	if status == config.TaskDone {
+		box = "[x]"
+	}
+	line := fmt.Sprintf("- %s **Status:** %s", box, status)
+
+	lines := strings.Split(tasks, "\n")
+	for i := 0; i < len(lines); i++ {
+		m := taskHeadingPattern.FindStringSubmatch(lines[i])
+		if m == nil || m[1] != taskID {
+			continue
+		}
+		// The status line, if any, is the first non-blank line after the heading.
+		j := i + 1
+		for j < len(lines) && strings.TrimSpace(lines[j]) == "" {
+			j++
+		}
+		if j < len(lines) && taskStatusLine.MatchString(lines[j]) {
+			lines[j] = line
+		} else {
+			lines = slices.Insert(lines, i+1, "", line)
+		}
+		break
+	}
+	return strings.Join(lines, "\n")
+}
+
+// implementationProgress counts task statuses against the tasks defined
+// in tasks.md. Statuses of tasks no longer in tasks.md are ignored.
+type implementationProgress struct {
+	Done       int `json:"done"`
+	InProgress int `json:"in_progress"`
+	Blocked    int `json:"blocked"`
+	Total      int `json:"total"`
+}
+
+// implementationProgressOf counts cfg's task statuses for ids.
+func implementationProgressOf(cfg *config.ProjectConfig, ids []string) implementationProgress {
+	p := implementationProgress{Total: len(ids)}
+	for _, id := range ids {
+		switch cfg.TaskStatus[id] {
+		case config.TaskDone:
+			p.Done++
+		case config.TaskInProgress:
+			p.InProgress++
+		case config.TaskBlocked:
+			p.Blocked++
+		}
+	}
+	return p
+}
+
+// String renders the progress as "3/10 tasks done (1 in progress, 1 blocked)".
+func (p implementationProgress) String() string {
+	s := fmt.Sprintf("%d/%d tasks done", p.Done, p.Total)
+	var extra []string
+	if p.InProgress > 0 {
+		extra = append(extra, fmt.Sprintf("%d in progress", p.InProgress))
+	}
+	if p.Blocked > 0 {
+		extra = append(extra, fmt.Sprintf("%d blocked", p.Blocked))
+	}
+	if len(extra) > 0 {
+		s += " (" + strings.Join(extra, ", ") + ")"
+	}
+	return s
+}
+
+// projectImplementationProgress returns the implementation progress of
+// the project, or false when no task status has been recorded yet.
+func projectImplementationProgress(cfg *config.ProjectConfig, projectRoot string) (implementationProgress, bool) {
+	if len(cfg.TaskStatus) == 0 {
+		return implementationProgress{}, false
+	}
+	tasks, err := readStageFile(config.StagePath(projectRoot, config.StageTasks))
+	if err != nil {
+		return implementationProgress{}, false
+	}
+	ids := taskHeadingIDs(tasks)
+	if len(ids) == 0 {
+		return implementationProgress{}, false
+	}
+	return implementationProgressOf(cfg, ids), true
+}
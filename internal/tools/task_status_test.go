@@ -0,0 +1,139 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func taskStatusRequest(taskID, status string) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"task_id": taskID, "status": status}
+	return req
+}
+
+func TestSetTaskStatusLine(t *testing.T) {
+	tasks := "### TASK-001: Signup\n- **Covers**: FR-001\n\n### TASK-002: Reset\n"
+
+	got := setTaskStatusLine(tasks, "TASK-001", config.TaskInProgress)
+	want := "### TASK-001: Signup\n\n- [ ] **Status:** in-progress\n- **Covers**: FR-001\n\n### TASK-002: Reset\n"
+	if got != want {
+		t.Fatalf("insert:\ngot  %q\nwant %q", got, want)
+	}
+
+	got = setTaskStatusLine(got, "TASK-001", config.TaskDone)
+	want = "### TASK-001: Signup\n\n- [x] **Status:** done\n- **Covers**: FR-001\n\n### TASK-002: Reset\n"
+	if got != want {
+		t.Errorf("update:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestTaskStatusTool_Handle_Success(t *testing.T) {
+	tmpDir, cleanup := setupValidatedProject(t, "PASS")
+	defer cleanup()
+	tool := NewTaskStatusTool(config.NewFileStore())
+
+	result, err := tool.Handle(context.Background(), taskStatusRequest("task-002", "in_progress"))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{"**TASK-002:** todo → in-progress", "**Implementation:** 0/2 tasks done (1 in progress)"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+
+	result, _ = tool.Handle(context.Background(), taskStatusRequest("TASK-002", "done"))
+	if text := getResultText(result); !strings.Contains(text, "**Implementation:** 1/2 tasks done") {
+		t.Errorf("response should count the done task:\n%s", text)
+	}
+
+	tasks, _ := readStageFile(config.StagePath(tmpDir, config.StageTasks))
+	if !strings.Contains(tasks, "### TASK-002: Reset\n\n- [x] **Status:** done") {
+		t.Errorf("tasks.md should tick TASK-002:\n%s", tasks)
+	}
+	if strings.Count(tasks, "**Status:**") != 1 {
+		t.Errorf("the status line should be updated in place:\n%s", tasks)
+	}
+	cfg, _ := config.NewFileStore().Load(tmpDir)
+	if got := cfg.TaskStatus["TASK-002"]; got != config.TaskDone {
+		t.Errorf("stored status = %q, want done", got)
+	}
+}
+
+func TestTaskStatusTool_Handle_UnknownTask(t *testing.T) {
+	_, cleanup := setupValidatedProject(t, "PASS")
+	defer cleanup()
+
+	result, _ := NewTaskStatusTool(config.NewFileStore()).Handle(context.Background(), taskStatusRequest("TASK-009", "done"))
+	if !isErrorResult(result) {
+		t.Fatal("expected an error for a task not in tasks.md")
+	}
+	if text := getResultText(result); !strings.Contains(text, "tasks.md defines: TASK-001, TASK-002") {
+		t.Errorf("error should list the known tasks, got: %s", text)
+	}
+}
+
+func TestTaskStatusTool_Handle_InvalidStatus(t *testing.T) {
+	_, cleanup := setupValidatedProject(t, "PASS")
+	defer cleanup()
+
+	result, _ := NewTaskStatusTool(config.NewFileStore()).Handle(context.Background(), taskStatusRequest("TASK-001", "finished"))
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), `unknown status "finished"`) {
+		t.Errorf("expected an unknown status error, got: %s", getResultText(result))
+	}
+}
+
+func TestTaskStatusTool_Handle_RequiresPassingValidation(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageValidate)
+	defer cleanup()
+
+	result, _ := NewTaskStatusTool(config.NewFileStore()).Handle(context.Background(), taskStatusRequest("TASK-001", "done"))
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "run sdd_validate first") {
+		t.Errorf("expected an error before validation, got: %s", getResultText(result))
+	}
+
+	_, cleanupFail := setupValidatedProject(t, "FAIL")
+	defer cleanupFail()
+	result, _ = NewTaskStatusTool(config.NewFileStore()).Handle(context.Background(), taskStatusRequest("TASK-001", "done"))
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "verdict is FAIL") {
+		t.Errorf("expected an error after a FAIL verdict, got: %s", getResultText(result))
+	}
+}
+
+func TestContextTool_Handle_ImplementationProgress(t *testing.T) {
+	_, cleanup := setupValidatedProject(t, "PASS")
+	defer cleanup()
+	store := config.NewFileStore()
+
+	overview := func(detail string) string {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"detail_level": detail}
+		result, err := NewContextTool(store).Handle(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Handle: %v", err)
+		}
+		return getResultText(result)
+	}
+	if text := overview("standard"); strings.Contains(text, "## Implementation") {
+		t.Errorf("no progress should be shown before any status is recorded:\n%s", text)
+	}
+
+	tool := NewTaskStatusTool(store)
+	_, _ = tool.Handle(context.Background(), taskStatusRequest("TASK-001", "done"))
+	_, _ = tool.Handle(context.Background(), taskStatusRequest("TASK-002", "blocked"))
+
+	if text := overview("standard"); !strings.Contains(text, "## Implementation\n\n1/2 tasks done (1 blocked)") {
+		t.Errorf("standard overview should show progress:\n%s", text)
+	}
+	if text := overview("summary"); !strings.Contains(text, "Implementation: 1/2 done") {
+		t.Errorf("summary overview should show progress:\n%s", text)
+	}
+}
@@ -95,73 +95,97 @@ func (t *TasksTool) Definition() mcp.Tool {
 				"- Test coverage must be ≥ 80%\\n"+
 				"- All API endpoints must have integration tests'"),
 		),
+		referencesParam(),
+		actorParam(),
 	)
 }
 
 // Handle processes the sdd_create_tasks tool call.
 func (t *TasksTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	totalTasks := req.GetString("total_tasks", "")
-	estimatedEffort := req.GetString("estimated_effort", "")
-	tasks := req.GetString("tasks", "")
-	dependencyGraph := req.GetString("dependency_graph", "")
-	waveAssignments := req.GetString("wave_assignments", "")
-	acceptanceCriteria := req.GetString("acceptance_criteria", "")
-
-	// Validate required fields.
-	if totalTasks == "" {
-		return mcp.NewToolResultError("'total_tasks' is required — how many tasks in the breakdown?"), nil
-	}
-	if estimatedEffort == "" {
-		return mcp.NewToolResultError("'estimated_effort' is required — what's the estimated effort?"), nil
+	data := templates.TasksData{
+		TotalTasks:         req.GetString("total_tasks", ""),
+		EstimatedEffort:    req.GetString("estimated_effort", ""),
+		Tasks:              req.GetString("tasks", ""),
+		DependencyGraph:    req.GetString("dependency_graph", ""),
+		WaveAssignments:    req.GetString("wave_assignments", ""),
+		AcceptanceCriteria: req.GetString("acceptance_criteria", ""),
 	}
-	if tasks == "" {
-		return mcp.NewToolResultError("'tasks' is required — provide the ordered list of implementation tasks"), nil
+	refs, err := requestReferences(req)
+	if err != nil {
+		return toolResult("", err)
 	}
+	data.References = refs
 
 	projectRoot, err := findProjectRoot()
 	if err != nil {
 		return nil, fmt.Errorf("finding project root: %w", err)
 	}
+	return stageToolResult(t.run(projectRoot, data, requestActor(ctx, req)))
+}
 
-	cfg, err := t.store.Load(projectRoot)
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+// Run saves the task breakdown for the project at projectRoot and
+// advances the pipeline. data.Name is ignored — the project's name is used.
+func (t *TasksTool) Run(projectRoot string, data templates.TasksData) (*StageResult, error) {
+	return t.run(projectRoot, data, "")
+}
+
+// run is Run with the completed stage attributed to actor.
+func (t *TasksTool) run(projectRoot string, data templates.TasksData, actor string) (*StageResult, error) {
+	// Validate required fields — before the load error, so a bad call is
+	// reported as such even outside a project.
+	cfg, loadErr := t.store.Load(projectRoot)
+	if err := checkRequiredFields(cfg, config.StageTasks, map[string]string{
+		"total_tasks":         data.TotalTasks,
+		"estimated_effort":    data.EstimatedEffort,
+		"tasks":               data.Tasks,
+		"dependency_graph":    data.DependencyGraph,
+		"wave_assignments":    data.WaveAssignments,
+		"acceptance_criteria": data.AcceptanceCriteria,
+	}); err != nil {
+		return nil, err
+	}
+	if err := validateReferences(data.References); err != nil {
+		return nil, err
+	}
+	if loadErr != nil {
+		return nil, asUserError(loadErr)
 	}
 
 	// Validate we're at the right stage.
 	if err := pipeline.RequireStage(cfg, config.StageTasks); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, asUserError(err)
 	}
 
-	// Verify the design document exists.
-	designPath := config.StagePath(projectRoot, config.StageDesign)
-	design, err := readStageFile(designPath)
+	// Verify prerequisite artifacts exist.
+	if err := pipeline.RequireArtifacts(projectRoot, pipeline.PrerequisiteArtifacts(cfg, config.StageTasks)...); err != nil {
+		return nil, asUserError(err)
+	}
+
+	designDoc, err := readStageFile(config.StagePath(projectRoot, config.StageDesign))
 	if err != nil {
 		return nil, fmt.Errorf("reading design: %w", err)
 	}
-	if design == "" {
-		return mcp.NewToolResultError("design.md is empty — run sdd_create_design first"), nil
+	requirements, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return nil, fmt.Errorf("reading requirements: %w", err)
 	}
 
 	pipeline.MarkInProgress(cfg)
 
+	nudge := recommendedFieldsNote(cfg, config.StageTasks, map[string]string{
+		"dependency_graph":    data.DependencyGraph,
+		"wave_assignments":    data.WaveAssignments,
+		"acceptance_criteria": data.AcceptanceCriteria,
+	})
+
 	// Fill optional fields with defaults.
-	if dependencyGraph == "" {
-		dependencyGraph = "_No explicit dependency graph defined. Tasks should be executed in order._"
-	}
-	if acceptanceCriteria == "" {
-		acceptanceCriteria = "_No global acceptance criteria defined. See individual task criteria._"
+	data.Name = cfg.Name
+	data.Attribution = attributionFor(cfg)
+	if data.DependencyGraph == "" {
+		data.DependencyGraph = "_No explicit dependency graph defined. Tasks should be executed in order._"
 	}
-
-	// Build tasks document with REAL content from the AI.
-	data := templates.TasksData{
-		Name:               cfg.Name,
-		TotalTasks:         totalTasks,
-		EstimatedEffort:    estimatedEffort,
-		Tasks:              tasks,
-		DependencyGraph:    dependencyGraph,
-		WaveAssignments:    waveAssignments,
-		AcceptanceCriteria: acceptanceCriteria,
+	if data.AcceptanceCriteria == "" {
+		data.AcceptanceCriteria = "_No global acceptance criteria defined. See individual task criteria._"
 	}
 
 	content, err := t.renderer.Render(templates.Tasks, data)
@@ -175,8 +199,10 @@ func (t *TasksTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.C
 		return nil, fmt.Errorf("writing tasks: %w", err)
 	}
 
+	recordReferences(cfg, config.StageTasks, data.References)
+
 	// Advance pipeline to next stage.
-	if err := pipeline.Advance(cfg); err != nil {
+	if err := pipeline.AdvanceBy(cfg, actor); err != nil {
 		return nil, fmt.Errorf("advancing pipeline: %w", err)
 	}
 
@@ -201,6 +227,16 @@ func (t *TasksTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.C
 			"Call `sdd_validate` with your validation analysis.",
 		content,
 	)
+	if stale := requirementsChangedSinceDesign(cfg, requirements); stale != "" {
+		response += "\n\n" + stale
+	}
+	if unknown := unknownTaskComponents(designDoc, data.Tasks); len(unknown) > 0 {
+		response += "\n\n⚠️ **Unknown components** — these tasks name components design.md doesn't define " +
+			"(a typo, or a component missing from the design). Advisory only; the tasks were saved.\n\n" +
+			formatUnknownComponents(unknown, designDoc)
+	}
+	response += nudge
+	response += wordBudgetNote(cfg, config.StageTasks, content)
 
-	return mcp.NewToolResultText(response), nil
+	return &StageResult{Content: content, Config: cfg, Response: response}, nil
 }
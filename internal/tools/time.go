@@ -0,0 +1,30 @@
+package tools
+
+import (
+	"time"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+)
+
+// timeNow is a package-level variable for testability.
+// Tests can replace this to control relative-time output.
+var timeNow = time.Now
+
+// humanizeTime renders an RFC 3339 timestamp relative to now
+// ("3 days ago"). Returns "" for empty or unparseable input.
+func humanizeTime(rfc3339 string) string {
+	return config.HumanizeSince(rfc3339, timeNow())
+}
+
+// formatTimestamp shows the absolute timestamp followed by its relative
+// form, e.g. "2024-01-10T12:00:00Z (3 days ago)". Unparseable values are
+// shown as-is; empty values render as "—".
+func formatTimestamp(rfc3339 string) string {
+	if rfc3339 == "" {
+		return "—"
+	}
+	if rel := humanizeTime(rfc3339); rel != "" {
+		return rfc3339 + " (" + rel + ")"
+	}
+	return rfc3339
+}
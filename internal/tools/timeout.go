@@ -0,0 +1,67 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultToolTimeout is how long `hoofy serve` lets one tool call run
+// before answering with a timeout error.
+const DefaultToolTimeout = 30 * time.Second
+
+// WithTimeout returns tool handler middleware that bounds every tool
+// call to d. The handler's ctx carries the deadline, so operations that
+// honor it (git, network) stop on their own. A read-only handler that
+// doesn't — one of ReadOnlyTools, which can't change the project after
+// the client was told the call stopped — is abandoned and the client gets a
+// CodeTimeout error result instead of hanging; any other handler is
+// waited for, since it may still write artifacts or save the config.
+// A d of zero or less disables the limit.
+func WithTimeout(d time.Duration) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		if d <= 0 {
+			return next
+		}
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			if !isReadOnly(req.Params.Name) {
+				return next(ctx, req)
+			}
+
+			type outcome struct {
+				result *mcp.CallToolResult
+				err    error
+			}
+			done := make(chan outcome, 1)
+			go func() {
+				// The server's recovery middleware can't see a panic on
+				// this goroutine, so report it the same way here.
+				defer func() {
+					if r := recover(); r != nil {
+						done <- outcome{err: fmt.Errorf("panic recovered in %s tool handler: %v", req.Params.Name, r)}
+					}
+				}()
+				result, err := next(ctx, req)
+				done <- outcome{result, err}
+			}()
+
+			select {
+			case o := <-done:
+				return o.result, o.err
+			case <-ctx.Done():
+				if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return nil, ctx.Err() // the client canceled the call
+				}
+				return toolResult("", toolError(CodeTimeout, fmt.Sprintf(
+					"'%s' did not finish within %s and was stopped — try again, or start the server with a longer --tool-timeout",
+					req.Params.Name, d)))
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	// A dependency that hangs and ignores ctx, like a stuck webhook.
+	slow := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		<-release
+		return mcp.NewToolResultText("too late"), nil
+	}
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "sdd_audit"
+
+	start := time.Now()
+	result, err := WithTimeout(20*time.Millisecond)(slow)(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handler error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the call should return at the deadline, took %s", elapsed)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "'sdd_audit' did not finish within 20ms") {
+		t.Errorf("want a timeout error result, got: %s", getResultText(result))
+	}
+	if got := result.Meta.AdditionalFields[ErrorCodeKey]; got != string(CodeTimeout) {
+		t.Errorf("error code = %v, want %s", got, CodeTimeout)
+	}
+}
+
+func TestWithTimeout_WaitsForMutatingTools(t *testing.T) {
+	// A tool that writes is never abandoned: it runs to the end, with
+	// the deadline in its ctx, and its own result is returned.
+	var wrote bool
+	slowWriter := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		time.Sleep(50 * time.Millisecond)
+		wrote = true
+		return mcp.NewToolResultText("saved"), nil
+	}
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "sdd_create_charter"
+	result, err := WithTimeout(10*time.Millisecond)(slowWriter)(context.Background(), req)
+	if err != nil || isErrorResult(result) || getResultText(result) != "saved" || !wrote {
+		t.Errorf("a mutating tool should finish and return its result, got %q, %v (wrote=%v)", getResultText(result), err, wrote)
+	}
+}
+
+func TestWithTimeout_PassesDeadlineAndResult(t *testing.T) {
+	var deadline bool
+	fast := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		_, deadline = ctx.Deadline()
+		return mcp.NewToolResultText("done"), nil
+	}
+	result, err := WithTimeout(time.Minute)(fast)(context.Background(), mcp.CallToolRequest{})
+	if err != nil || getResultText(result) != "done" {
+		t.Fatalf("result = %q, %v; want the handler's result", getResultText(result), err)
+	}
+	if !deadline {
+		t.Error("the handler's ctx should carry the deadline")
+	}
+
+	panicky := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		panic("boom")
+	}
+	// A stoppable tool runs on its own goroutine, out of reach of the
+	// server's recovery middleware.
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "sdd_audit"
+	if _, err := WithTimeout(time.Minute)(panicky)(context.Background(), req); err == nil ||
+		!strings.Contains(err.Error(), "boom") {
+		t.Errorf("a panic should come back as an error, got %v", err)
+	}
+
+	// Zero disables the limit: the handler runs as is.
+	deadline = false
+	if _, err := WithTimeout(0)(fast)(context.Background(), mcp.CallToolRequest{}); err != nil || deadline {
+		t.Errorf("WithTimeout(0) should not set a deadline (deadline=%v, err=%v)", deadline, err)
+	}
+}
+
+func TestRunGit_HonorsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := runGit(ctx, t.TempDir(), "status"); err == nil {
+		t.Error("git should not run on a canceled context")
+	}
+}
+
+func TestScans_HonorContext(t *testing.T) {
+	root := setupGoProject(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := NewReverseEngineerTool().process(ctx, root, reverseEngineerParams{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("reverse engineer on a canceled context: err = %v, want context.Canceled", err)
+	}
+	if files := scanSourceFiles(ctx, root, filepath.Join(root, "docs"), ""); len(files) != 0 {
+		t.Errorf("a canceled source scan should stop before any file, got %d", len(files))
+	}
+	if _, err := NewAuditTool().process(ctx, root, auditParams{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("audit on a canceled context: err = %v, want context.Canceled", err)
+	}
+	if _, err := analyzeCoverage(ctx, "- **FR-001**: requirement\n", "", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("coverage on a canceled context: err = %v, want context.Canceled", err)
+	}
+}
+
+func TestValidateTool_Handle_CanceledWritesNothing(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"requirements_coverage": "All covered",
+		"component_coverage":    "All covered",
+		"consistency_issues":    "_None found._",
+		"verdict":               "PASS",
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := NewValidateTool(store).Handle(ctx, req); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if _, err := os.Stat(config.StagePath(tmpDir, config.StageValidate)); !os.IsNotExist(err) {
+		t.Error("a canceled validate must not write its report")
+	}
+	if cfg, _ := store.Load(tmpDir); cfg.StageStatus[config.StageValidate].Status == "completed" {
+		t.Error("a canceled validate must not complete the stage")
+	}
+}
@@ -2,13 +2,17 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/i18n"
 	"github.com/HendryAvila/Hoofy/internal/memory"
 	"github.com/HendryAvila/Hoofy/internal/pipeline"
 	"github.com/HendryAvila/Hoofy/internal/templates"
@@ -259,6 +263,192 @@ func TestInitTool_Handle_AlreadyExists(t *testing.T) {
 	_ = tmpDir
 }
 
+func TestInitTool_Handle_ForceArchives(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+
+	principlesPath := config.StagePath(tmpDir, config.StagePrinciples)
+	if err := writeStageFile(principlesPath, "# Old principles\n"); err != nil {
+		t.Fatal(err)
+	}
+	adrDir := config.ADRsPath(tmpDir)
+	if err := os.MkdirAll(adrDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	origNow := timeNow
+	timeNow = func() time.Time { return time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC) }
+	defer func() { timeNow = origNow }()
+
+	store := config.NewFileStore()
+	tool := NewInitTool(store, mustRenderer(t))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "fresh-app",
+		"description": "Starting over",
+		"force":       true,
+	}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "Previous Project Archived") || !strings.Contains(text, "`principles.md`") {
+		t.Errorf("response should summarize archived files: %s", text)
+	}
+
+	archiveDir := filepath.Join(config.DocsPath(tmpDir), "history", "archive-20240110T120000Z")
+	for _, name := range []string{config.ConfigFile, "principles.md"} {
+		if _, err := os.Stat(filepath.Join(archiveDir, name)); err != nil {
+			t.Errorf("%s should be archived: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(principlesPath); !os.IsNotExist(err) {
+		t.Error("principles.md should have been moved out of the docs directory")
+	}
+	if _, err := os.Stat(adrDir); err != nil {
+		t.Error("adrs/ should be left in place")
+	}
+
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Name != "fresh-app" || cfg.CurrentStage != config.StagePrinciples {
+		t.Errorf("expected fresh project at principles, got %s at %s", cfg.Name, cfg.CurrentStage)
+	}
+}
+
+func TestInitTool_Handle_ForceArchivesOnlyItsConfig(t *testing.T) {
+	t.Cleanup(func() { _ = config.SetConfigName("") })
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+	store := config.NewFileStore()
+
+	for _, name := range []string{"api.json", "web.json"} {
+		if err := config.SetConfigName(name); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Save(tmpDir, config.NewProjectConfig(name, "test", config.ModeGuided)); err != nil {
+			t.Fatal(err)
+		}
+		if err := writeStageFile(config.StagePath(tmpDir, config.StagePrinciples), "# "+name+"\n"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// web.json is still active: re-initialize it.
+	tool := NewInitTool(store, mustRenderer(t))
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "fresh-web",
+		"description": "Starting over",
+		"force":       true,
+	}
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+
+	if _, err := os.Stat(config.StagePath(tmpDir, config.StagePrinciples)); !os.IsNotExist(err) {
+		t.Error("web principles.md should have been archived")
+	}
+
+	_ = config.SetConfigName("api.json")
+	cfg, err := store.Load(tmpDir)
+	if err != nil || cfg.Name != "api.json" {
+		t.Fatalf("api project should be untouched, got %v, %v", cfg, err)
+	}
+	data, err := os.ReadFile(config.StagePath(tmpDir, config.StagePrinciples))
+	if err != nil || !strings.Contains(string(data), "api.json") {
+		t.Errorf("api principles.md should be untouched, got %q, %v", data, err)
+	}
+}
+
+func TestInitTool_Handle_OrphanedArtifacts(t *testing.T) {
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("chdir to tmpDir: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+
+	artifacts := map[config.Stage]string{
+		config.StagePrinciples: "# Principles\n",
+		config.StageCharter:    "# Charter\n",
+		config.StageSpecify:    "# Requirements\n\n- FR-001: Sign up\n",
+		config.StageClarify:    "# Clarifications\n\n## Clarity Score: 85/100\n",
+		config.StageDesign:     "# Design\n",
+	}
+	for stage, content := range artifacts {
+		if err := writeStageFile(config.StagePath(tmpDir, stage), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store := config.NewFileStore()
+	tool := NewInitTool(store, mustRenderer(t))
+	args := map[string]interface{}{
+		"name":        "orphan-app",
+		"description": "Lost its config",
+	}
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+
+	// Without reconstruct or force: warn, don't initialize.
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if !isErrorResult(result) {
+		t.Fatal("init over orphaned artifacts should be refused")
+	}
+	if text := getResultText(result); !strings.Contains(text, "reconstruct=true") || !strings.Contains(text, "`design.md`") {
+		t.Errorf("warning should list artifacts and options: %s", text)
+	}
+	if config.Exists(tmpDir) {
+		t.Fatal("hoofy.json must not be written when refusing")
+	}
+
+	// With reconstruct: resume after the furthest artifact.
+	args["reconstruct"] = true
+	result, err = tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("reconstruct failed: %s", getResultText(result))
+	}
+	if !strings.Contains(getResultText(result), "Reconstructed") {
+		t.Errorf("unexpected response: %s", getResultText(result))
+	}
+
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.CurrentStage != config.StageTasks {
+		t.Errorf("CurrentStage = %s, want tasks", cfg.CurrentStage)
+	}
+	if cfg.ClarityScore != 85 {
+		t.Errorf("ClarityScore = %d, want 85 (parsed from clarifications.md)", cfg.ClarityScore)
+	}
+	if !pipeline.IsCompleted(cfg, config.StageDesign) {
+		t.Error("design should be marked completed")
+	}
+	if content, _ := readStageFile(config.StagePath(tmpDir, config.StageDesign)); content != "# Design\n" {
+		t.Error("artifacts must be left unchanged")
+	}
+}
+
 func TestInitTool_Handle_ExpertMode(t *testing.T) {
 	tmpDir := t.TempDir()
 	origDir, _ := os.Getwd()
@@ -288,229 +478,826 @@ func TestInitTool_Handle_ExpertMode(t *testing.T) {
 	}
 }
 
-func TestInitTool_Handle_CreatesAgentsFile(t *testing.T) {
+func TestInitTool_Handle_EnvDefaults(t *testing.T) {
 	tmpDir := t.TempDir()
 	origDir, _ := os.Getwd()
 	if err := os.Chdir(tmpDir); err != nil {
-		t.Fatalf("chdir: %v", err)
+		t.Fatalf("chdir to tmpDir: %v", err)
 	}
 	defer func() { _ = os.Chdir(origDir) }()
 
 	store := config.NewFileStore()
 	tool := NewInitTool(store, mustRenderer(t))
+	tool.SetDefaults(config.Defaults{Mode: config.ModeExpert, ClarityThreshold: 85})
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"name":        "fresh-project",
-		"description": "A brand new project",
+		"name":        "my-app",
+		"description": "A cool app",
 	}
 
-	_, err := tool.Handle(context.Background(), req)
+	result, err := tool.Handle(context.Background(), req)
 	if err != nil {
 		t.Fatalf("Handle failed: %v", err)
 	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
 
-	// When neither CLAUDE.md nor AGENTS.md exists, AGENTS.md should be created.
-	agentsPath := filepath.Join(tmpDir, "AGENTS.md")
-	data, err := os.ReadFile(agentsPath)
+	cfg, err := store.Load(tmpDir)
 	if err != nil {
-		t.Fatalf("AGENTS.md should exist: %v", err)
-	}
-	content := string(data)
-	if !strings.Contains(content, "## Hoofy SDD Project") {
-		t.Error("AGENTS.md should contain '## Hoofy SDD Project' marker")
+		t.Fatalf("load config: %v", err)
 	}
-	if !strings.Contains(content, "fresh-project") {
-		t.Error("AGENTS.md should contain project name")
+	if cfg.Mode != config.ModeExpert {
+		t.Errorf("Mode = %s, want expert from defaults", cfg.Mode)
 	}
-	if !strings.Contains(content, "docs/") {
-		t.Error("AGENTS.md should reference docs/ directory")
+	if cfg.ClarityThreshold != 85 {
+		t.Errorf("ClarityThreshold = %d, want 85 from defaults", cfg.ClarityThreshold)
 	}
 }
 
-func TestInitTool_Handle_AppendsToClaudeFile(t *testing.T) {
+func TestInitTool_Handle_ExplicitParamsBeatDefaults(t *testing.T) {
 	tmpDir := t.TempDir()
 	origDir, _ := os.Getwd()
 	if err := os.Chdir(tmpDir); err != nil {
-		t.Fatalf("chdir: %v", err)
+		t.Fatalf("chdir to tmpDir: %v", err)
 	}
 	defer func() { _ = os.Chdir(origDir) }()
 
-	// Pre-create CLAUDE.md with existing content.
-	claudePath := filepath.Join(tmpDir, "CLAUDE.md")
-	existingContent := "# My Project Rules\n\nExisting instructions here.\n"
-	if err := os.WriteFile(claudePath, []byte(existingContent), 0o644); err != nil {
-		t.Fatalf("create CLAUDE.md: %v", err)
-	}
-
 	store := config.NewFileStore()
 	tool := NewInitTool(store, mustRenderer(t))
+	tool.SetDefaults(config.Defaults{Mode: config.ModeExpert, ClarityThreshold: 85})
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"name":        "claude-project",
-		"description": "Uses CLAUDE.md",
+		"name":              "my-app",
+		"description":       "A cool app",
+		"mode":              "guided",
+		"clarity_threshold": float64(60),
 	}
 
-	_, err := tool.Handle(context.Background(), req)
-	if err != nil {
+	if _, err := tool.Handle(context.Background(), req); err != nil {
 		t.Fatalf("Handle failed: %v", err)
 	}
 
-	data, err := os.ReadFile(claudePath)
+	cfg, err := store.Load(tmpDir)
 	if err != nil {
-		t.Fatalf("read CLAUDE.md: %v", err)
-	}
-	content := string(data)
-
-	// Existing content should be preserved.
-	if !strings.Contains(content, "Existing instructions here") {
-		t.Error("CLAUDE.md should preserve existing content")
+		t.Fatalf("load config: %v", err)
 	}
-	// Hoofy section should be appended.
-	if !strings.Contains(content, "## Hoofy SDD Project") {
-		t.Error("CLAUDE.md should have Hoofy section appended")
+	if cfg.Mode != config.ModeGuided {
+		t.Errorf("Mode = %s, want explicit guided", cfg.Mode)
 	}
-	// AGENTS.md should NOT be created.
-	agentsPath := filepath.Join(tmpDir, "AGENTS.md")
-	if _, err := os.Stat(agentsPath); !os.IsNotExist(err) {
-		t.Error("AGENTS.md should NOT be created when CLAUDE.md exists")
+	if cfg.ClarityThreshold != 60 {
+		t.Errorf("ClarityThreshold = %d, want explicit 60", cfg.ClarityThreshold)
 	}
 }
 
-func TestInitTool_Handle_AppendsToAgentsFile(t *testing.T) {
+func TestInitTool_Handle_StageFiles(t *testing.T) {
 	tmpDir := t.TempDir()
 	origDir, _ := os.Getwd()
 	if err := os.Chdir(tmpDir); err != nil {
-		t.Fatalf("chdir: %v", err)
+		t.Fatalf("chdir to tmpDir: %v", err)
 	}
 	defer func() { _ = os.Chdir(origDir) }()
 
-	// Pre-create AGENTS.md with existing content (no CLAUDE.md).
-	agentsPath := filepath.Join(tmpDir, "AGENTS.md")
-	existingContent := "# Agents Guide\n\nExisting agent instructions.\n"
-	if err := os.WriteFile(agentsPath, []byte(existingContent), 0o644); err != nil {
-		t.Fatalf("create AGENTS.md: %v", err)
-	}
-
 	store := config.NewFileStore()
 	tool := NewInitTool(store, mustRenderer(t))
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"name":        "agents-project",
-		"description": "Uses AGENTS.md",
+		"name":        "my-app",
+		"description": "A cool app",
+		"stage_files": "specify=spec.md,design=architecture/design.md",
 	}
-
-	_, err := tool.Handle(context.Background(), req)
+	result, err := tool.Handle(context.Background(), req)
 	if err != nil {
 		t.Fatalf("Handle failed: %v", err)
 	}
+	if isErrorResult(result) {
+		t.Fatalf("expected success, got error: %s", getResultText(result))
+	}
 
-	data, err := os.ReadFile(agentsPath)
+	cfg, err := store.Load(tmpDir)
 	if err != nil {
-		t.Fatalf("read AGENTS.md: %v", err)
+		t.Fatalf("load config: %v", err)
+	}
+	if got := cfg.StageFiles[config.StageSpecify]; got != "spec.md" {
+		t.Errorf("StageFiles[specify] = %q, want spec.md", got)
+	}
+	if got, want := config.StagePath(tmpDir, config.StageDesign), filepath.Join(tmpDir, "docs", "architecture", "design.md"); got != want {
+		t.Errorf("StagePath(design) = %s, want %s", got, want)
 	}
-	content := string(data)
 
-	// Existing content should be preserved.
-	if !strings.Contains(content, "Existing agent instructions") {
-		t.Error("AGENTS.md should preserve existing content")
+	// Invalid overrides are rejected before anything is written.
+	other := t.TempDir()
+	if err := os.Chdir(other); err != nil {
+		t.Fatalf("chdir: %v", err)
 	}
-	// Hoofy section should be appended.
-	if !strings.Contains(content, "## Hoofy SDD Project") {
-		t.Error("AGENTS.md should have Hoofy section appended")
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "my-app",
+		"description": "A cool app",
+		"stage_files": "specify=../spec.md",
+	}
+	result, err = tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "inside the docs directory") {
+		t.Errorf("expected stage_files error, got: %s", getResultText(result))
+	}
+	if config.Exists(other) {
+		t.Error("project should not be created with invalid stage_files")
 	}
 }
 
-func TestInitTool_Handle_AgentInstructions_Idempotent(t *testing.T) {
+func TestInitTool_Handle_InvalidClarityThreshold(t *testing.T) {
 	tmpDir := t.TempDir()
 	origDir, _ := os.Getwd()
 	if err := os.Chdir(tmpDir); err != nil {
-		t.Fatalf("chdir: %v", err)
+		t.Fatalf("chdir to tmpDir: %v", err)
 	}
 	defer func() { _ = os.Chdir(origDir) }()
 
-	// Pre-create AGENTS.md with a Hoofy section already present.
-	agentsPath := filepath.Join(tmpDir, "AGENTS.md")
-	existingContent := "# Agents Guide\n\n## Hoofy SDD Project\n\nAlready here.\n"
-	if err := os.WriteFile(agentsPath, []byte(existingContent), 0o644); err != nil {
-		t.Fatalf("create AGENTS.md: %v", err)
-	}
-
-	store := config.NewFileStore()
-	tool := NewInitTool(store, mustRenderer(t))
+	tool := NewInitTool(config.NewFileStore(), mustRenderer(t))
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"name":        "idempotent-project",
-		"description": "Tests idempotency",
+		"name":              "my-app",
+		"description":       "A cool app",
+		"clarity_threshold": float64(150),
 	}
 
-	_, err := tool.Handle(context.Background(), req)
+	result, err := tool.Handle(context.Background(), req)
 	if err != nil {
 		t.Fatalf("Handle failed: %v", err)
 	}
-
-	data, err := os.ReadFile(agentsPath)
-	if err != nil {
-		t.Fatalf("read AGENTS.md: %v", err)
-	}
-	content := string(data)
-
-	// Should NOT have duplicated the section.
-	count := strings.Count(content, "## Hoofy SDD Project")
-	if count != 1 {
-		t.Errorf("Hoofy section should appear exactly once, found %d times", count)
+	if !isErrorResult(result) {
+		t.Error("should reject clarity_threshold > 100")
 	}
 }
 
-// --- CharterTool ---
-
-func TestCharterTool_Handle_Success(t *testing.T) {
-	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
-	defer cleanup()
+func TestInitTool_Handle_Preset(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Chdir(tmpDir)
 
 	store := config.NewFileStore()
-	renderer, _ := templates.NewRenderer()
-	tool := NewCharterTool(store, renderer)
+	tool := NewInitTool(store, mustRenderer(t))
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"problem_statement": "Freelancers waste 30+ minutes daily tracking hours across spreadsheets",
-		"target_users":      "- **Freelance designers** who need simple time tracking\n- **Small agency owners** who need team visibility",
-		"proposed_solution": "A web app where freelancers log hours per project and see weekly reports",
-		"success_criteria":  "- Users can log time in under 10 seconds\n- 80% complete onboarding without help",
+		"name":        "my-lib",
+		"description": "A parsing library",
+		"preset":      "library",
 	}
-
 	result, err := tool.Handle(context.Background(), req)
 	if err != nil {
 		t.Fatalf("Handle failed: %v", err)
 	}
-
+	text := getResultText(result)
 	if isErrorResult(result) {
-		t.Fatalf("expected success, got error: %s", getResultText(result))
+		t.Fatalf("expected success, got error: %s", text)
+	}
+	for _, want := range []string{"## Preset: library", "scale_performance 3/10", "public API surface"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
 	}
 
-	text := getResultText(result)
-	if !strings.Contains(text, "Charter Created") {
-		t.Error("result should contain 'Charter Created'")
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
 	}
-	if !strings.Contains(text, "Freelancers waste") {
+	if cfg.Preset != "library" || cfg.DimensionWeights["scale_performance"] != 3 {
+		t.Errorf("preset = %q, weights = %v", cfg.Preset, cfg.DimensionWeights)
+	}
+
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "my-lib",
+		"description": "A parsing library",
+		"preset":      "mobile",
+		"force":       true,
+	}
+	result, err = tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	text = getResultText(result)
+	if !isErrorResult(result) || !strings.Contains(text, `unknown preset "mobile"`) || !strings.Contains(text, "web-api") {
+		t.Errorf("unknown preset should error with the valid list, got: %s", text)
+	}
+}
+
+func TestInitTool_Handle_Lang(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Chdir(tmpDir)
+
+	store := config.NewFileStore()
+	renderer := mustRenderer(t)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "tracker",
+		"description": "Time tracking",
+		"lang":        "es",
+	}
+	result, err := NewInitTool(store, renderer).Handle(context.Background(), req)
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("init failed: %v %s", err, getResultText(result))
+	}
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Lang != i18n.Spanish {
+		t.Fatalf("lang = %q, want es", cfg.Lang)
+	}
+	cfg.CurrentStage = config.StageCharter
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	req.Params.Arguments = map[string]interface{}{
+		"problem_statement": "Freelancers lose billable hours",
+		"target_users":      "Freelancers",
+		"proposed_solution": "A web time tracker",
+		"success_criteria":  "- 90% of hours logged",
+	}
+	result, err = NewCharterTool(store, renderer).Handle(context.Background(), req)
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("charter failed: %v %s", err, getResultText(result))
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "# Acta creada") || !strings.Contains(text, "Freelancers lose billable hours") {
+		t.Errorf("charter response should be in Spanish with the charter content:\n%s", text)
+	}
+
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "tracker",
+		"description": "Time tracking",
+		"lang":        "fr",
+		"force":       true,
+	}
+	result, _ = NewInitTool(store, renderer).Handle(context.Background(), req)
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "'lang'") {
+		t.Errorf("unsupported lang should be rejected, got: %s", getResultText(result))
+	}
+}
+
+func TestInitTool_Handle_CreatesAgentsFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+
+	store := config.NewFileStore()
+	tool := NewInitTool(store, mustRenderer(t))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "fresh-project",
+		"description": "A brand new project",
+	}
+
+	_, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	// When neither CLAUDE.md nor AGENTS.md exists, AGENTS.md should be created.
+	agentsPath := filepath.Join(tmpDir, "AGENTS.md")
+	data, err := os.ReadFile(agentsPath)
+	if err != nil {
+		t.Fatalf("AGENTS.md should exist: %v", err)
+	}
+	content := string(data)
+	if !strings.Contains(content, "## Hoofy SDD Project") {
+		t.Error("AGENTS.md should contain '## Hoofy SDD Project' marker")
+	}
+	if !strings.Contains(content, "fresh-project") {
+		t.Error("AGENTS.md should contain project name")
+	}
+	if !strings.Contains(content, "docs/") {
+		t.Error("AGENTS.md should reference docs/ directory")
+	}
+}
+
+func TestInitTool_Handle_AppendsToClaudeFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+
+	// Pre-create CLAUDE.md with existing content.
+	claudePath := filepath.Join(tmpDir, "CLAUDE.md")
+	existingContent := "# My Project Rules\n\nExisting instructions here.\n"
+	if err := os.WriteFile(claudePath, []byte(existingContent), 0o644); err != nil {
+		t.Fatalf("create CLAUDE.md: %v", err)
+	}
+
+	store := config.NewFileStore()
+	tool := NewInitTool(store, mustRenderer(t))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "claude-project",
+		"description": "Uses CLAUDE.md",
+	}
+
+	_, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	data, err := os.ReadFile(claudePath)
+	if err != nil {
+		t.Fatalf("read CLAUDE.md: %v", err)
+	}
+	content := string(data)
+
+	// Existing content should be preserved.
+	if !strings.Contains(content, "Existing instructions here") {
+		t.Error("CLAUDE.md should preserve existing content")
+	}
+	// Hoofy section should be appended.
+	if !strings.Contains(content, "## Hoofy SDD Project") {
+		t.Error("CLAUDE.md should have Hoofy section appended")
+	}
+	// AGENTS.md should NOT be created.
+	agentsPath := filepath.Join(tmpDir, "AGENTS.md")
+	if _, err := os.Stat(agentsPath); !os.IsNotExist(err) {
+		t.Error("AGENTS.md should NOT be created when CLAUDE.md exists")
+	}
+}
+
+func TestInitTool_Handle_AppendsToAgentsFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+
+	// Pre-create AGENTS.md with existing content (no CLAUDE.md).
+	agentsPath := filepath.Join(tmpDir, "AGENTS.md")
+	existingContent := "# Agents Guide\n\nExisting agent instructions.\n"
+	if err := os.WriteFile(agentsPath, []byte(existingContent), 0o644); err != nil {
+		t.Fatalf("create AGENTS.md: %v", err)
+	}
+
+	store := config.NewFileStore()
+	tool := NewInitTool(store, mustRenderer(t))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "agents-project",
+		"description": "Uses AGENTS.md",
+	}
+
+	_, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	data, err := os.ReadFile(agentsPath)
+	if err != nil {
+		t.Fatalf("read AGENTS.md: %v", err)
+	}
+	content := string(data)
+
+	// Existing content should be preserved.
+	if !strings.Contains(content, "Existing agent instructions") {
+		t.Error("AGENTS.md should preserve existing content")
+	}
+	// Hoofy section should be appended.
+	if !strings.Contains(content, "## Hoofy SDD Project") {
+		t.Error("AGENTS.md should have Hoofy section appended")
+	}
+}
+
+func TestInitTool_Handle_AgentInstructions_Idempotent(t *testing.T) {
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+
+	// Pre-create AGENTS.md with a Hoofy section already present.
+	agentsPath := filepath.Join(tmpDir, "AGENTS.md")
+	existingContent := "# Agents Guide\n\n## Hoofy SDD Project\n\nAlready here.\n"
+	if err := os.WriteFile(agentsPath, []byte(existingContent), 0o644); err != nil {
+		t.Fatalf("create AGENTS.md: %v", err)
+	}
+
+	store := config.NewFileStore()
+	tool := NewInitTool(store, mustRenderer(t))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"name":        "idempotent-project",
+		"description": "Tests idempotency",
+	}
+
+	_, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	data, err := os.ReadFile(agentsPath)
+	if err != nil {
+		t.Fatalf("read AGENTS.md: %v", err)
+	}
+	content := string(data)
+
+	// Should NOT have duplicated the section.
+	count := strings.Count(content, "## Hoofy SDD Project")
+	if count != 1 {
+		t.Errorf("Hoofy section should appear exactly once, found %d times", count)
+	}
+}
+
+// --- CharterTool ---
+
+func TestCharterTool_Handle_Success(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewCharterTool(store, renderer)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"problem_statement": "Freelancers waste 30+ minutes daily tracking hours across spreadsheets",
+		"target_users":      "- **Freelance designers** who need simple time tracking\n- **Small agency owners** who need team visibility",
+		"proposed_solution": "A web app where freelancers log hours per project and see weekly reports",
+		"success_criteria":  "- Users can log time in under 10 seconds\n- 80% complete onboarding without help",
+	}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	if isErrorResult(result) {
+		t.Fatalf("expected success, got error: %s", getResultText(result))
+	}
+
+	text := getResultText(result)
+	if !strings.Contains(text, "Charter Created") {
+		t.Error("result should contain 'Charter Created'")
+	}
+	if !strings.Contains(text, "Freelancers waste") {
 		t.Error("result should contain the problem statement content")
 	}
-	if !strings.Contains(text, "Freelance designers") {
-		t.Error("result should contain the target users content")
+	if !strings.Contains(text, "Freelance designers") {
+		t.Error("result should contain the target users content")
+	}
+}
+
+func TestCharterTool_Handle_Update(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewCharterTool(store, renderer)
+
+	call := func(args map[string]interface{}) (string, bool) {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := tool.Handle(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Handle failed: %v", err)
+		}
+		return getResultText(result), isErrorResult(result)
+	}
+
+	if text, isErr := call(map[string]interface{}{"update": true, "vision": "Big"}); !isErr || !strings.Contains(text, "no saved charter") {
+		t.Errorf("update before any charter should fail, got: %s", text)
+	}
+
+	if text, isErr := call(map[string]interface{}{
+		"problem_statement": "Freelancers waste time tracking hours",
+		"target_users":      "- **Freelance designers**",
+		"proposed_solution": "A web app for logging hours",
+		"success_criteria":  "- Log time in under 10 seconds",
+		"boundaries":        "### Out of Scope\n- Invoicing",
+	}); isErr {
+		t.Fatalf("charter failed: %s", text)
+	}
+
+	text, isErr := call(map[string]interface{}{
+		"update":           true,
+		"success_criteria": "- Log time in under 5 seconds",
+	})
+	if isErr {
+		t.Fatalf("update failed: %s", text)
+	}
+	if !strings.Contains(text, "Charter Updated") || !strings.Contains(text, "`success_criteria`") {
+		t.Errorf("unexpected update response:\n%s", text)
+	}
+
+	charter, err := readStageFile(config.StagePath(tmpDir, config.StageCharter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"under 5 seconds", "Freelancers waste time", "### Out of Scope"} {
+		if !strings.Contains(charter, want) {
+			t.Errorf("charter.md missing %q:\n%s", want, charter)
+		}
+	}
+	if strings.Contains(charter, "under 10 seconds") {
+		t.Errorf("old success criteria should be replaced:\n%s", charter)
+	}
+	cfg, _ := store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageSpecify {
+		t.Errorf("update moved the pipeline to %s", cfg.CurrentStage)
+	}
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), "# Requirements\n"); err != nil {
+		t.Fatal(err)
+	}
+	if text, isErr := call(map[string]interface{}{"update": true, "vision": "Big"}); !isErr || !strings.Contains(text, "can only be updated before") {
+		t.Errorf("update after requirements exist should fail, got: %s", text)
+	}
+}
+
+func TestCharterTool_Handle_MissingRequiredFields(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewCharterTool(store, renderer)
+
+	tests := []struct {
+		name   string
+		args   map[string]interface{}
+		errMsg string
+	}{
+		{
+			name:   "missing problem_statement",
+			args:   map[string]interface{}{"target_users": "devs", "proposed_solution": "app", "success_criteria": "works"},
+			errMsg: "problem_statement",
+		},
+		{
+			name:   "missing target_users",
+			args:   map[string]interface{}{"problem_statement": "problem", "proposed_solution": "app", "success_criteria": "works"},
+			errMsg: "target_users",
+		},
+		{
+			name:   "missing proposed_solution",
+			args:   map[string]interface{}{"problem_statement": "problem", "target_users": "devs", "success_criteria": "works"},
+			errMsg: "proposed_solution",
+		},
+		{
+			name:   "missing success_criteria",
+			args:   map[string]interface{}{"problem_statement": "problem", "target_users": "devs", "proposed_solution": "app"},
+			errMsg: "success_criteria",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tt.args
+
+			result, err := tool.Handle(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Handle failed: %v", err)
+			}
+			if !isErrorResult(result) {
+				t.Error("should return error when required field is missing")
+			}
+			text := getResultText(result)
+			if !strings.Contains(text, tt.errMsg) {
+				t.Errorf("error should mention '%s': %s", tt.errMsg, text)
+			}
+		})
+	}
+}
+
+func TestCharterTool_Handle_AutoInit(t *testing.T) {
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("chdir to tmpDir: %v", err)
+	}
+	defer func() { _ = os.Chdir(origDir) }()
+
+	store := config.NewFileStore()
+	renderer := mustRenderer(t)
+	tool := NewCharterTool(store, renderer)
+	tool.SetAutoInit(NewInitTool(store, renderer), NewPrinciplesTool(store, renderer))
+
+	args := map[string]interface{}{
+		"problem_statement": "Freelancers waste time tracking hours",
+		"target_users":      "- Freelancers",
+		"proposed_solution": "A simple time tracker",
+		"success_criteria":  "- Log time in 10 seconds",
+		"auto_init":         true,
+		"name":              "quick-app",
+	}
+
+	// Missing mode: nothing is created.
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "'mode'") {
+		t.Fatalf("expected mode error, got: %s", getResultText(result))
+	}
+	if config.Exists(tmpDir) {
+		t.Fatal("project must not be created when auto_init arguments are incomplete")
+	}
+
+	args["mode"] = "expert"
+	args["principles"] = "- All timestamps are UTC"
+	result, err = tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("expected success, got error: %s", getResultText(result))
+	}
+	if !strings.Contains(getResultText(result), "Auto-initialized") {
+		t.Errorf("response should mention auto-init: %s", getResultText(result))
+	}
+
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Name != "quick-app" || cfg.Mode != config.ModeExpert {
+		t.Errorf("got project %q in %s mode", cfg.Name, cfg.Mode)
+	}
+	if cfg.Description != "A simple time tracker" {
+		t.Errorf("description should default to proposed_solution, got %q", cfg.Description)
+	}
+	if cfg.CurrentStage != config.StageSpecify {
+		t.Errorf("CurrentStage = %s, want specify", cfg.CurrentStage)
+	}
+}
+
+func TestCharterTool_Handle_AutoInitIgnoredForExistingProject(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	renderer := mustRenderer(t)
+	tool := NewCharterTool(store, renderer)
+	tool.SetAutoInit(NewInitTool(store, renderer), NewPrinciplesTool(store, renderer))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"problem_statement": "Problem",
+		"target_users":      "- Users",
+		"proposed_solution": "Solution",
+		"success_criteria":  "- Criteria",
+		"auto_init":         true,
+		"name":              "ignored",
+		"mode":              "expert",
+	}
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("expected success, got error: %s", getResultText(result))
+	}
+
+	cfg, _ := store.Load(tmpDir)
+	if cfg.Name != "test-project" {
+		t.Errorf("existing project should be untouched, got name %q", cfg.Name)
+	}
+}
+
+func TestCharterTool_Handle_WrongStage(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewCharterTool(store, renderer)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"problem_statement": "problem",
+		"target_users":      "devs",
+		"proposed_solution": "app",
+		"success_criteria":  "works",
+	}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if !isErrorResult(result) {
+		t.Error("should return error when at wrong stage")
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "wrong pipeline stage") {
+		t.Errorf("error should mention wrong stage: %s", text)
+	}
+}
+
+func TestCharterTool_Handle_AdvancesPipeline(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewCharterTool(store, renderer)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"problem_statement": "Users need a chat app",
+		"target_users":      "Remote teams",
+		"proposed_solution": "Real-time messaging platform",
+		"success_criteria":  "Sub-second message delivery",
+	}
+
+	_, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	// Verify pipeline advanced.
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.CurrentStage != config.StageSpecify {
+		t.Errorf("stage should be specify after charter, got: %s", cfg.CurrentStage)
+	}
+}
+
+// --- SpecifyTool ---
+
+func TestSpecifyTool_Handle_Success(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+
+	// Write a charter file (required by specify).
+	charterPath := config.StagePath(tmpDir, config.StageCharter)
+	if err := writeStageFile(charterPath, "# Test Charter\n\nThis is a test charter with some requirements."); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewSpecifyTool(store, renderer)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"must_have":      "- **FR-001**: Users can create an account\n- **FR-002**: Users can log time entries",
+		"should_have":    "- **FR-005**: Users can export time entries as CSV",
+		"non_functional": "- **NFR-001**: Page load time must be under 2 seconds",
+	}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	if isErrorResult(result) {
+		t.Fatalf("expected success, got error: %s", getResultText(result))
+	}
+
+	text := getResultText(result)
+	if !strings.Contains(text, "Requirements Generated") {
+		t.Error("result should contain 'Requirements Generated'")
+	}
+	if !strings.Contains(text, "FR-001") {
+		t.Error("result should contain requirement IDs")
+	}
+	if !strings.Contains(text, "Users can create an account") {
+		t.Error("result should contain the actual requirement content")
+	}
+	if !strings.Contains(text, "**Counts:** Must: 2, Should: 1, Could: 0, Won't: 0, NFR: 1") {
+		t.Errorf("result should summarize MoSCoW counts: %s", text)
 	}
 }
 
-func TestCharterTool_Handle_MissingRequiredFields(t *testing.T) {
-	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+func TestSpecifyTool_Handle_MissingRequiredFields(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
 	defer cleanup()
 
+	charterPath := config.StagePath(tmpDir, config.StageCharter)
+	if err := writeStageFile(charterPath, "# Test Charter\n\nSome content here."); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+
 	store := config.NewFileStore()
 	renderer, _ := templates.NewRenderer()
-	tool := NewCharterTool(store, renderer)
+	tool := NewSpecifyTool(store, renderer)
 
 	tests := []struct {
 		name   string
@@ -518,24 +1305,19 @@ func TestCharterTool_Handle_MissingRequiredFields(t *testing.T) {
 		errMsg string
 	}{
 		{
-			name:   "missing problem_statement",
-			args:   map[string]interface{}{"target_users": "devs", "proposed_solution": "app", "success_criteria": "works"},
-			errMsg: "problem_statement",
-		},
-		{
-			name:   "missing target_users",
-			args:   map[string]interface{}{"problem_statement": "problem", "proposed_solution": "app", "success_criteria": "works"},
-			errMsg: "target_users",
+			name:   "missing must_have",
+			args:   map[string]interface{}{"should_have": "something", "non_functional": "something"},
+			errMsg: "must_have",
 		},
 		{
-			name:   "missing proposed_solution",
-			args:   map[string]interface{}{"problem_statement": "problem", "target_users": "devs", "success_criteria": "works"},
-			errMsg: "proposed_solution",
+			name:   "missing should_have",
+			args:   map[string]interface{}{"must_have": "something", "non_functional": "something"},
+			errMsg: "should_have",
 		},
 		{
-			name:   "missing success_criteria",
-			args:   map[string]interface{}{"problem_statement": "problem", "target_users": "devs", "proposed_solution": "app"},
-			errMsg: "success_criteria",
+			name:   "missing non_functional",
+			args:   map[string]interface{}{"must_have": "something", "should_have": "something"},
+			errMsg: "non_functional",
 		},
 	}
 
@@ -559,20 +1341,19 @@ func TestCharterTool_Handle_MissingRequiredFields(t *testing.T) {
 	}
 }
 
-func TestCharterTool_Handle_WrongStage(t *testing.T) {
-	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+func TestSpecifyTool_Handle_EmptyProposal(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
 	defer cleanup()
 
 	store := config.NewFileStore()
 	renderer, _ := templates.NewRenderer()
-	tool := NewCharterTool(store, renderer)
+	tool := NewSpecifyTool(store, renderer)
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"problem_statement": "problem",
-		"target_users":      "devs",
-		"proposed_solution": "app",
-		"success_criteria":  "works",
+		"must_have":      "- FR-001: Something",
+		"should_have":    "- FR-002: Something",
+		"non_functional": "- NFR-001: Something",
 	}
 
 	result, err := tool.Handle(context.Background(), req)
@@ -580,66 +1361,334 @@ func TestCharterTool_Handle_WrongStage(t *testing.T) {
 		t.Fatalf("Handle failed: %v", err)
 	}
 	if !isErrorResult(result) {
-		t.Error("should return error when at wrong stage")
+		t.Error("should return error when charter is empty")
+	}
+}
+
+func TestSpecifyTool_Handle_AdvancesPipeline(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+
+	charterPath := config.StagePath(tmpDir, config.StageCharter)
+	if err := writeStageFile(charterPath, "# Test Charter\n\nSome content here."); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewSpecifyTool(store, renderer)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"must_have":      "- **FR-001**: Users can sign up",
+		"should_have":    "- **FR-003**: Users can export data",
+		"non_functional": "- **NFR-001**: Load time < 2s",
+	}
+
+	_, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	cfg, _ := store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageBusinessRules {
+		t.Errorf("stage should be business-rules after specify, got: %s", cfg.CurrentStage)
+	}
+}
+
+func TestSpecifyTool_Handle_RecordsActor(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+
+	charterPath := config.StagePath(tmpDir, config.StageCharter)
+	if err := writeStageFile(charterPath, "# Test Charter\n\nSome content here."); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewSpecifyTool(store, renderer)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"must_have":      "- **FR-001**: Users can sign up",
+		"should_have":    "- **FR-003**: Users can export data",
+		"non_functional": "- **NFR-001**: Load time < 2s",
+		"actor":          "reviewer-bot",
+	}
+	if _, err := tool.Handle(context.Background(), req); err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	cfg, _ := store.Load(tmpDir)
+	if got := cfg.StageStatus[config.StageSpecify].CompletedBy; got != "reviewer-bot" {
+		t.Errorf("specify CompletedBy = %q, want reviewer-bot", got)
+	}
+	// Stages advanced without an actor are attributed to "unknown".
+	if got := cfg.StageStatus[config.StageCharter].CompletedBy; got != config.UnknownActor {
+		t.Errorf("charter CompletedBy = %q, want %q", got, config.UnknownActor)
+	}
+
+	ctxReq := mcp.CallToolRequest{}
+	ctxReq.Params.Arguments = map[string]interface{}{"detail_level": "standard"}
+	result, err := NewContextTool(store).Handle(context.Background(), ctxReq)
+	if err != nil {
+		t.Fatalf("context Handle failed: %v", err)
+	}
+	if text := getResultText(result); !strings.Contains(text, "| reviewer-bot |") {
+		t.Errorf("overview should show who completed specify:\n%s", text)
+	}
+}
+
+func TestSpecifyTool_Handle_OptionalFieldsDefault(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+
+	charterPath := config.StagePath(tmpDir, config.StageCharter)
+	if err := writeStageFile(charterPath, "# Test Charter\n\nSome content here."); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewSpecifyTool(store, renderer)
+
+	// Only required fields — optional should get defaults.
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"must_have":      "- **FR-001**: Users can sign up",
+		"should_have":    "- **FR-003**: Users can export data",
+		"non_functional": "- **NFR-001**: Load time < 2s",
+	}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	text := getResultText(result)
+	if !strings.Contains(text, "None defined for this version") {
+		t.Error("optional empty fields should show default text")
+	}
+}
+
+// --- ClarifyTool ---
+
+func TestClarifyTool_Handle_GenerateQuestions(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+
+	// Write requirements.
+	reqPath := config.StagePath(tmpDir, config.StageSpecify)
+	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+		t.Fatalf("write requirements: %v", err)
+	}
+
+	store := config.NewFileStore()
+	renderer, _ := templates.NewRenderer()
+	tool := NewClarifyTool(store, renderer)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+
+	if isErrorResult(result) {
+		t.Fatalf("expected success, got error: %s", getResultText(result))
+	}
+
+	text := getResultText(result)
+	if !strings.Contains(text, "Clarity Gate Analysis") {
+		t.Error("result should contain 'Clarity Gate Analysis'")
+	}
+	if !strings.Contains(text, "target_users") {
+		t.Error("result should contain dimension names")
+	}
+}
+
+func TestClarifyTool_Handle_GenerateQuestions_QuestionPlan(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+
+	reqPath := config.StagePath(tmpDir, config.StageSpecify)
+	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+		t.Fatalf("write requirements: %v", err)
+	}
+
+	tool := NewClarifyTool(config.NewFileStore(), mustRenderer(t))
+
+	// First round: no scores yet, so the generic 3-5 guidance applies.
+	result, err := tool.Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	text := getResultText(result)
+	if strings.Contains(text, "## Question Plan") {
+		t.Error("first round has no scores and should not include a question plan")
+	}
+	if !strings.Contains(text, "Generate 3-5 total questions") {
+		t.Error("first round should ask for 3-5 questions")
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"answers": "Some answers",
+		"dimension_scores": "target_users:95,core_functionality:90,data_model:80,integrations:70," +
+			"edge_cases:40,security:85,scale_performance:90,scope_boundaries:20",
+	}
+	if _, err := tool.Handle(context.Background(), req); err != nil {
+		t.Fatalf("Handle answers failed: %v", err)
+	}
+
+	result, err = tool.Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	text = getResultText(result)
+	for _, want := range []string{
+		"## Question Plan",
+		"ask **3 questions** this round",
+		"- Ask 2 questions about **scope_boundaries** (score 20, weight 9/10)",
+		"- Ask 1 question about **edge_cases** (score 40, weight 8/10)",
+		"Generate exactly 3 questions",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("second round should contain %q", want)
+		}
+	}
+	if strings.Contains(text, "about **target_users**") {
+		t.Error("clear dimensions should not get questions")
+	}
+}
+
+func TestClarifyTool_Handle_GenerateQuestions_PlanFollowsPersistedScores(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+
+	reqPath := config.StagePath(tmpDir, config.StageSpecify)
+	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+		t.Fatalf("write requirements: %v", err)
+	}
+
+	store := config.NewFileStore()
+	tool := NewClarifyTool(store, mustRenderer(t))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"answers": "Some answers",
+		"dimension_scores": "target_users:95,core_functionality:90,data_model:80,integrations:70," +
+			"edge_cases:40,security:85,scale_performance:90,scope_boundaries:20",
+	}
+	if _, err := tool.Handle(context.Background(), req); err != nil {
+		t.Fatalf("Handle answers failed: %v", err)
+	}
+
+	// A hand edit drops the score table from clarifications.md; the
+	// scores persisted in hoofy.json still drive the plan.
+	clarifyPath := config.StagePath(tmpDir, config.StageClarify)
+	if err := writeStageFile(clarifyPath, "# Clarifications\n\nEdited by hand.\n"); err != nil {
+		t.Fatalf("write clarifications: %v", err)
+	}
+
+	result, err := tool.Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
 	}
 	text := getResultText(result)
-	if !strings.Contains(text, "wrong pipeline stage") {
-		t.Errorf("error should mention wrong stage: %s", text)
+	for _, want := range []string{
+		"## Question Plan",
+		"- Ask 2 questions about **scope_boundaries** (score 20, weight 9/10)",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("plan should follow the persisted scores, missing %q", want)
+		}
 	}
 }
 
-func TestCharterTool_Handle_AdvancesPipeline(t *testing.T) {
-	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageCharter)
+func TestClarifyTool_Handle_Focus(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
 	defer cleanup()
 
+	reqPath := config.StagePath(tmpDir, config.StageSpecify)
+	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+		t.Fatalf("write requirements: %v", err)
+	}
+
 	store := config.NewFileStore()
-	renderer, _ := templates.NewRenderer()
-	tool := NewCharterTool(store, renderer)
+	tool := NewClarifyTool(store, mustRenderer(t))
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"problem_statement": "Users need a chat app",
-		"target_users":      "Remote teams",
-		"proposed_solution": "Real-time messaging platform",
-		"success_criteria":  "Sub-second message delivery",
+		"answers": "Round one",
+		"dimension_scores": "target_users:95,core_functionality:90,data_model:80,integrations:70," +
+			"edge_cases:40,security:30,scale_performance:90,scope_boundaries:20",
+	}
+	if _, err := tool.Handle(context.Background(), req); err != nil {
+		t.Fatalf("Handle answers failed: %v", err)
 	}
 
-	_, err := tool.Handle(context.Background(), req)
+	// Questions for a focused round only present the focused dimensions.
+	req.Params.Arguments = map[string]interface{}{"focus": "security, scope_boundaries"}
+	result, err := tool.Handle(context.Background(), req)
 	if err != nil {
-		t.Fatalf("Handle failed: %v", err)
+		t.Fatalf("Handle focus questions failed: %v", err)
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "### security") || !strings.Contains(text, "### scope_boundaries") {
+		t.Errorf("focused dimensions should be presented:\n%s", text)
+	}
+	if strings.Contains(text, "### target_users") || strings.Contains(text, "about **edge_cases**") {
+		t.Errorf("unfocused dimensions should not be presented:\n%s", text)
 	}
 
-	// Verify pipeline advanced.
-	cfg, err := store.Load(tmpDir)
+	// Answers in a focused round merge only the focused scores.
+	req.Params.Arguments = map[string]interface{}{
+		"answers":          "Round two",
+		"dimension_scores": "security:90,scope_boundaries:85,target_users:0",
+		"focus":            "security,scope_boundaries",
+	}
+	if _, err := tool.Handle(context.Background(), req); err != nil {
+		t.Fatalf("Handle focused answers failed: %v", err)
+	}
+	cfg, _ := store.Load(tmpDir)
+	want := map[string]int{"security": 90, "scope_boundaries": 85, "target_users": 95, "edge_cases": 40}
+	for name, score := range want {
+		if got := cfg.DimensionScores[name]; got != score {
+			t.Errorf("DimensionScores[%s] = %d, want %d", name, got, score)
+		}
+	}
+
+	// Unknown dimension names are rejected.
+	req.Params.Arguments = map[string]interface{}{"focus": "security,vibes"}
+	result, err = tool.Handle(context.Background(), req)
 	if err != nil {
-		t.Fatalf("Load failed: %v", err)
+		t.Fatalf("Handle failed: %v", err)
 	}
-	if cfg.CurrentStage != config.StageSpecify {
-		t.Errorf("stage should be specify after charter, got: %s", cfg.CurrentStage)
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), `unknown dimension "vibes"`) {
+		t.Errorf("expected unknown-dimension error, got: %s", getResultText(result))
 	}
 }
 
-// --- SpecifyTool ---
-
-func TestSpecifyTool_Handle_Success(t *testing.T) {
-	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+func TestClarifyTool_Handle_ProcessAnswers_GatePassed(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageClarify)
 	defer cleanup()
 
-	// Write a charter file (required by specify).
-	charterPath := config.StagePath(tmpDir, config.StageCharter)
-	if err := writeStageFile(charterPath, "# Test Charter\n\nThis is a test charter with some requirements."); err != nil {
-		t.Fatalf("write charter: %v", err)
+	reqPath := config.StagePath(tmpDir, config.StageSpecify)
+	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+		t.Fatalf("write requirements: %v", err)
 	}
 
 	store := config.NewFileStore()
 	renderer, _ := templates.NewRenderer()
-	tool := NewSpecifyTool(store, renderer)
+	tool := NewClarifyTool(store, renderer)
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"must_have":      "- **FR-001**: Users can create an account\n- **FR-002**: Users can log time entries",
-		"should_have":    "- **FR-005**: Users can export time entries as CSV",
-		"non_functional": "- **NFR-001**: Page load time must be under 2 seconds",
+		"answers":          "The target users are developers.\nCore functionality is task management.",
+		"dimension_scores": "target_users:80,core_functionality:90,data_model:60,integrations:50,edge_cases:55,security:70,scale_performance:60,scope_boundaries:85",
 	}
 
 	result, err := tool.Handle(context.Background(), req)
@@ -652,237 +1701,298 @@ func TestSpecifyTool_Handle_Success(t *testing.T) {
 	}
 
 	text := getResultText(result)
-	if !strings.Contains(text, "Requirements Generated") {
-		t.Error("result should contain 'Requirements Generated'")
-	}
-	if !strings.Contains(text, "FR-001") {
-		t.Error("result should contain requirement IDs")
-	}
-	if !strings.Contains(text, "Users can create an account") {
-		t.Error("result should contain the actual requirement content")
+	if !strings.Contains(text, "Clarity Gate PASSED") {
+		t.Errorf("expected gate to pass with high scores, got: %s", text[:min(200, len(text))])
 	}
-}
-
-func TestSpecifyTool_Handle_MissingRequiredFields(t *testing.T) {
-	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
-	defer cleanup()
 
-	charterPath := config.StagePath(tmpDir, config.StageCharter)
-	if err := writeStageFile(charterPath, "# Test Charter\n\nSome content here."); err != nil {
-		t.Fatalf("write charter: %v", err)
+	// Verify pipeline advanced.
+	cfg, _ := store.Load(tmpDir)
+	if cfg.CurrentStage != config.StageDesign {
+		t.Errorf("stage should be design after passing clarity gate, got: %s", cfg.CurrentStage)
 	}
 
-	store := config.NewFileStore()
-	renderer, _ := templates.NewRenderer()
-	tool := NewSpecifyTool(store, renderer)
+	// The passing round is recorded for the validation report.
+	pass := cfg.ClarityGatePassed
+	if pass == nil || pass.At == "" || pass.Mode != config.ModeExpert || pass.Score != cfg.ClarityScore || len(pass.Dimensions) != 8 {
+		t.Fatalf("ClarityGatePassed = %+v", pass)
+	}
+	if pass.Dimensions[0] != (config.DimensionScore{Name: "target_users", Score: 80}) {
+		t.Errorf("first dimension = %+v", pass.Dimensions[0])
+	}
+}
 
+func TestClarifyTool_Handle_MarginalPass(t *testing.T) {
 	tests := []struct {
-		name   string
-		args   map[string]interface{}
-		errMsg string
+		name     string
+		score    int
+		margin   int
+		marginal bool
 	}{
-		{
-			name:   "missing must_have",
-			args:   map[string]interface{}{"should_have": "something", "non_functional": "something"},
-			errMsg: "must_have",
-		},
-		{
-			name:   "missing should_have",
-			args:   map[string]interface{}{"must_have": "something", "non_functional": "something"},
-			errMsg: "should_have",
-		},
-		{
-			name:   "missing non_functional",
-			args:   map[string]interface{}{"must_have": "something", "should_have": "something"},
-			errMsg: "non_functional",
-		},
+		{"exactly threshold", 70, 0, true},
+		{"threshold+10", 80, 0, false},
+		{"within a custom margin", 78, 10, true},
+		{"margin turned off", 70, -1, false},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			req := mcp.CallToolRequest{}
-			req.Params.Arguments = tt.args
+			tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+			defer cleanup()
+			if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+				t.Fatal(err)
+			}
+			store := config.NewFileStore()
+			cfg, _ := store.Load(tmpDir)
+			cfg.ClarityMargin = tt.margin
+			if err := store.Save(tmpDir, cfg); err != nil {
+				t.Fatal(err)
+			}
 
-			result, err := tool.Handle(context.Background(), req)
-			if err != nil {
-				t.Fatalf("Handle failed: %v", err)
+			var scores []string
+			for _, d := range pipeline.DefaultDimensions() {
+				scores = append(scores, fmt.Sprintf("%s:%d", d.Name, tt.score))
 			}
-			if !isErrorResult(result) {
-				t.Error("should return error when required field is missing")
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{
+				"answers":          "Developers manage tasks.",
+				"dimension_scores": strings.Join(scores, ","),
+			}
+			result, err := NewClarifyTool(store, mustRenderer(t)).Handle(context.Background(), req)
+			if err != nil || isErrorResult(result) {
+				t.Fatalf("Handle: %v %s", err, getResultText(result))
 			}
+
 			text := getResultText(result)
-			if !strings.Contains(text, tt.errMsg) {
-				t.Errorf("error should mention '%s': %s", tt.errMsg, text)
+			if !strings.Contains(text, "Clarity Gate PASSED") {
+				t.Fatalf("a passing score must still advance:\n%s", text)
+			}
+			want := fmt.Sprintf("Score %d/70 is the bare minimum", tt.score)
+			if got := strings.Contains(text, want); got != tt.marginal {
+				t.Errorf("marginal note shown = %v, want %v:\n%s", got, tt.marginal, text)
+			}
+			if cfg, _ := store.Load(tmpDir); cfg.CurrentStage == config.StageClarify {
+				t.Error("the pipeline should advance past clarify")
 			}
 		})
 	}
 }
 
-func TestSpecifyTool_Handle_EmptyProposal(t *testing.T) {
-	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
-	defer cleanup()
+func TestClarifyTool_Handle_PenalizedScoring(t *testing.T) {
+	// Every dimension at 90 but core_functionality left at 0: the mean
+	// (75) clears the guided threshold, the penalized score (35) doesn't.
+	var scores []string
+	for _, d := range pipeline.DefaultDimensions() {
+		score := 90
+		if d.Name == "core_functionality" {
+			score = 0
+		}
+		scores = append(scores, fmt.Sprintf("%s:%d", d.Name, score))
+	}
 
-	store := config.NewFileStore()
-	renderer, _ := templates.NewRenderer()
-	tool := NewSpecifyTool(store, renderer)
+	for _, scheme := range []string{config.ScoringMean, config.ScoringPenalized} {
+		t.Run(scheme, func(t *testing.T) {
+			tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+			defer cleanup()
+			if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+				t.Fatal(err)
+			}
+			store := config.NewFileStore()
+			cfg, _ := store.Load(tmpDir)
+			cfg.ScoringScheme = scheme
+			if err := store.Save(tmpDir, cfg); err != nil {
+				t.Fatal(err)
+			}
 
-	req := mcp.CallToolRequest{}
-	req.Params.Arguments = map[string]interface{}{
-		"must_have":      "- FR-001: Something",
-		"should_have":    "- FR-002: Something",
-		"non_functional": "- NFR-001: Something",
-	}
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{
+				"answers":          "Developers manage tasks.",
+				"dimension_scores": strings.Join(scores, ","),
+			}
+			result, err := NewClarifyTool(store, mustRenderer(t)).Handle(context.Background(), req)
+			if err != nil || isErrorResult(result) {
+				t.Fatalf("Handle: %v %s", err, getResultText(result))
+			}
 
-	result, err := tool.Handle(context.Background(), req)
-	if err != nil {
-		t.Fatalf("Handle failed: %v", err)
-	}
-	if !isErrorResult(result) {
-		t.Error("should return error when charter is empty")
+			cfg, _ = store.Load(tmpDir)
+			wantScore, wantPassed := 75, true
+			if scheme == config.ScoringPenalized {
+				wantScore, wantPassed = 35, false
+			}
+			if cfg.ClarityScore != wantScore {
+				t.Errorf("clarity score = %d, want %d", cfg.ClarityScore, wantScore)
+			}
+			if passed := cfg.CurrentStage != config.StageClarify; passed != wantPassed {
+				t.Errorf("gate passed = %v, want %v:\n%s", passed, wantPassed, getResultText(result))
+			}
+		})
 	}
 }
 
-func TestSpecifyTool_Handle_AdvancesPipeline(t *testing.T) {
-	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+func TestClarifyTool_Handle_ProcessAnswers_GateNotPassed(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
 	defer cleanup()
 
-	charterPath := config.StagePath(tmpDir, config.StageCharter)
-	if err := writeStageFile(charterPath, "# Test Charter\n\nSome content here."); err != nil {
-		t.Fatalf("write charter: %v", err)
+	reqPath := config.StagePath(tmpDir, config.StageSpecify)
+	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+		t.Fatalf("write requirements: %v", err)
 	}
 
 	store := config.NewFileStore()
 	renderer, _ := templates.NewRenderer()
-	tool := NewSpecifyTool(store, renderer)
+	tool := NewClarifyTool(store, renderer)
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]interface{}{
-		"must_have":      "- **FR-001**: Users can sign up",
-		"should_have":    "- **FR-003**: Users can export data",
-		"non_functional": "- **NFR-001**: Load time < 2s",
+		"answers":          "Some vague answers",
+		"dimension_scores": "target_users:30,core_functionality:40,data_model:20",
 	}
 
-	_, err := tool.Handle(context.Background(), req)
+	result, err := tool.Handle(context.Background(), req)
 	if err != nil {
 		t.Fatalf("Handle failed: %v", err)
 	}
 
+	text := getResultText(result)
+	if !strings.Contains(text, "More Clarification Needed") {
+		t.Errorf("expected gate to not pass with low scores, got: %s", text[:min(200, len(text))])
+	}
+
+	// Pipeline should NOT have advanced.
 	cfg, _ := store.Load(tmpDir)
-	if cfg.CurrentStage != config.StageBusinessRules {
-		t.Errorf("stage should be business-rules after specify, got: %s", cfg.CurrentStage)
+	if cfg.CurrentStage != config.StageClarify {
+		t.Errorf("stage should still be clarify, got: %s", cfg.CurrentStage)
 	}
 }
 
-func TestSpecifyTool_Handle_OptionalFieldsDefault(t *testing.T) {
-	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
-	defer cleanup()
+// racingStore is a config.Store whose first Save is preceded by another
+// writer saving the project, as when a retried call overlaps the
+// original.
+type racingStore struct {
+	config.Store
+	raced bool
+}
 
-	charterPath := config.StagePath(tmpDir, config.StageCharter)
-	if err := writeStageFile(charterPath, "# Test Charter\n\nSome content here."); err != nil {
-		t.Fatalf("write charter: %v", err)
+func (s *racingStore) Save(projectRoot string, cfg *config.ProjectConfig) error {
+	if !s.raced {
+		s.raced = true
+		other, err := s.Store.Load(projectRoot)
+		if err != nil {
+			return err
+		}
+		if err := s.Store.Save(projectRoot, other); err != nil {
+			return err
+		}
 	}
+	return s.Store.Save(projectRoot, cfg)
+}
 
-	store := config.NewFileStore()
-	renderer, _ := templates.NewRenderer()
-	tool := NewSpecifyTool(store, renderer)
+func TestClarifyTool_Run_RetriesStaleSave(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
 
-	// Only required fields — optional should get defaults.
-	req := mcp.CallToolRequest{}
-	req.Params.Arguments = map[string]interface{}{
-		"must_have":      "- **FR-001**: Users can sign up",
-		"should_have":    "- **FR-003**: Users can export data",
-		"non_functional": "- **NFR-001**: Load time < 2s",
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+		t.Fatalf("write requirements: %v", err)
 	}
 
-	result, err := tool.Handle(context.Background(), req)
+	store := &racingStore{Store: config.NewFileStore()}
+	_, err := NewClarifyTool(store, mustRenderer(t)).Run(tmpDir, ClarifyParams{
+		Answers:         "Q: Who? A: Freelancers",
+		DimensionScores: "target_users:30,core_functionality:40",
+	})
 	if err != nil {
-		t.Fatalf("Handle failed: %v", err)
+		t.Fatalf("Run should retry past the conflict, got: %v", err)
 	}
 
-	text := getResultText(result)
-	if !strings.Contains(text, "None defined for this version") {
-		t.Error("optional empty fields should show default text")
+	cfg, err := config.NewFileStore().Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ClarityScore == 0 || cfg.DimensionScores["target_users"] != 30 {
+		t.Errorf("retried round's scores were not saved: score %d, dims %v", cfg.ClarityScore, cfg.DimensionScores)
+	}
+	doc, err := readStageFile(config.StagePath(tmpDir, config.StageClarify))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(doc, "Freelancers"); n != 1 {
+		t.Errorf("the round should be recorded once, found %d times:\n%s", n, doc)
 	}
 }
 
-// --- ClarifyTool ---
-
-func TestClarifyTool_Handle_GenerateQuestions(t *testing.T) {
+func TestClarifyTool_Handle_RecordsJustifications(t *testing.T) {
 	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
 	defer cleanup()
 
-	// Write requirements.
-	reqPath := config.StagePath(tmpDir, config.StageSpecify)
-	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), "# Requirements\n\n- FR-009: Secure login"); err != nil {
 		t.Fatalf("write requirements: %v", err)
 	}
 
-	store := config.NewFileStore()
-	renderer, _ := templates.NewRenderer()
-	tool := NewClarifyTool(store, renderer)
-
+	tool := NewClarifyTool(config.NewFileStore(), mustRenderer(t))
 	req := mcp.CallToolRequest{}
-	req.Params.Arguments = map[string]interface{}{}
-
-	result, err := tool.Handle(context.Background(), req)
-	if err != nil {
-		t.Fatalf("Handle failed: %v", err)
+	req.Params.Arguments = map[string]interface{}{
+		"answers":          "Auth uses JWT.",
+		"dimension_scores": "security:70|JWT+bcrypt specified in FR-009,target_users:40",
 	}
-
-	if isErrorResult(result) {
-		t.Fatalf("expected success, got error: %s", getResultText(result))
+	if _, err := tool.Handle(context.Background(), req); err != nil {
+		t.Fatalf("Handle failed: %v", err)
 	}
 
-	text := getResultText(result)
-	if !strings.Contains(text, "Clarity Gate Analysis") {
-		t.Error("result should contain 'Clarity Gate Analysis'")
-	}
-	if !strings.Contains(text, "target_users") {
-		t.Error("result should contain dimension names")
+	content, _ := readStageFile(config.StagePath(tmpDir, config.StageClarify))
+	for _, want := range []string{
+		"#### Dimension Scores",
+		"| security | 70 | JWT+bcrypt specified in FR-009 |",
+		"| target_users | 40 | — |",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("clarifications.md missing %q:\n%s", want, content)
+		}
 	}
 }
 
-func TestClarifyTool_Handle_ProcessAnswers_GatePassed(t *testing.T) {
-	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeExpert, config.StageClarify)
+func TestClarifyTool_Handle_RecordsThresholdPerRound(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
 	defer cleanup()
 
-	reqPath := config.StagePath(tmpDir, config.StageSpecify)
-	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
 		t.Fatalf("write requirements: %v", err)
 	}
 
 	store := config.NewFileStore()
-	renderer, _ := templates.NewRenderer()
-	tool := NewClarifyTool(store, renderer)
-
-	req := mcp.CallToolRequest{}
-	req.Params.Arguments = map[string]interface{}{
-		"answers":          "The target users are developers.\nCore functionality is task management.",
-		"dimension_scores": "target_users:80,core_functionality:90,data_model:60,integrations:50,edge_cases:55,security:70,scale_performance:60,scope_boundaries:85",
+	tool := NewClarifyTool(store, mustRenderer(t))
+	answer := func() {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"answers":          "Some answers",
+			"dimension_scores": "target_users:30,core_functionality:30",
+		}
+		if _, err := tool.Handle(context.Background(), req); err != nil {
+			t.Fatalf("Handle failed: %v", err)
+		}
 	}
 
-	result, err := tool.Handle(context.Background(), req)
+	answer()
+
+	// Switch to expert mode between rounds.
+	cfg, err := store.Load(tmpDir)
 	if err != nil {
-		t.Fatalf("Handle failed: %v", err)
+		t.Fatalf("load config: %v", err)
 	}
-
-	if isErrorResult(result) {
-		t.Fatalf("expected success, got error: %s", getResultText(result))
+	cfg.Mode = config.ModeExpert
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatalf("save config: %v", err)
 	}
 
-	text := getResultText(result)
-	if !strings.Contains(text, "Clarity Gate PASSED") {
-		t.Errorf("expected gate to pass with high scores, got: %s", text[:min(200, len(text))])
-	}
+	answer()
 
-	// Verify pipeline advanced.
-	cfg, _ := store.Load(tmpDir)
-	if cfg.CurrentStage != config.StageDesign {
-		t.Errorf("stage should be design after passing clarity gate, got: %s", cfg.CurrentStage)
+	content, _ := readStageFile(config.StagePath(tmpDir, config.StageClarify))
+	first := strings.Index(content, "_Threshold for this round: 70/100 (guided mode)_")
+	second := strings.Index(content, "_Threshold for this round: 50/100 (expert mode)_")
+	if first < 0 || second < 0 || first > second {
+		t.Errorf("each round should record its own threshold in order:\n%s", content)
 	}
 }
 
-func TestClarifyTool_Handle_ProcessAnswers_GateNotPassed(t *testing.T) {
+func TestClarifyTool_Handle_AnswersWithoutScores(t *testing.T) {
 	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
 	defer cleanup()
 
@@ -892,29 +2002,34 @@ func TestClarifyTool_Handle_ProcessAnswers_GateNotPassed(t *testing.T) {
 	}
 
 	store := config.NewFileStore()
-	renderer, _ := templates.NewRenderer()
-	tool := NewClarifyTool(store, renderer)
-
-	req := mcp.CallToolRequest{}
-	req.Params.Arguments = map[string]interface{}{
-		"answers":          "Some vague answers",
-		"dimension_scores": "target_users:30,core_functionality:40,data_model:20",
-	}
-
-	result, err := tool.Handle(context.Background(), req)
-	if err != nil {
-		t.Fatalf("Handle failed: %v", err)
-	}
+	tool := NewClarifyTool(store, mustRenderer(t))
+	before, _ := store.Load(tmpDir)
+
+	for _, scores := range []string{"", "garbage without pairs", "unknown_dimension:90"} {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"answers":          "Target users are developers.",
+			"dimension_scores": scores,
+		}
 
-	text := getResultText(result)
-	if !strings.Contains(text, "More Clarification Needed") {
-		t.Errorf("expected gate to not pass with low scores, got: %s", text[:min(200, len(text))])
+		result, err := tool.Handle(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Handle(%q) failed: %v", scores, err)
+		}
+		if !isErrorResult(result) {
+			t.Fatalf("dimension_scores=%q: expected targeted error, got: %s", scores, getResultText(result))
+		}
+		if text := getResultText(result); !strings.Contains(text, "dimension_scores") || !strings.Contains(text, "target_users") {
+			t.Errorf("error should ask for scores and list dimensions: %s", text)
+		}
 	}
 
-	// Pipeline should NOT have advanced.
 	cfg, _ := store.Load(tmpDir)
-	if cfg.CurrentStage != config.StageClarify {
-		t.Errorf("stage should still be clarify, got: %s", cfg.CurrentStage)
+	if cfg.ClarityScore != before.ClarityScore || cfg.StageStatus[config.StageClarify].Iterations != before.StageStatus[config.StageClarify].Iterations {
+		t.Error("rejected calls must not record a score or a clarify round")
+	}
+	if content, _ := readStageFile(config.StagePath(tmpDir, config.StageClarify)); content != "" {
+		t.Error("rejected calls must not write clarifications.md")
 	}
 }
 
@@ -1042,6 +2157,125 @@ func TestContextTool_Handle_UnknownStage(t *testing.T) {
 	}
 }
 
+func TestContextTool_Handle_InitStage(t *testing.T) {
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+
+	tool := NewContextTool(config.NewFileStore())
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"stage": "init",
+	}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("init is a known stage, not an error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	for _, want := range []string{"# Stage: Initialize", "**Status:** completed", "no markdown artifact", "hoofy.json"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+
+	// Reading an archived iteration's init stage has nothing to return.
+	req.Params.Arguments = map[string]interface{}{"stage": "init", "version": "0.0.1"}
+	if err := os.MkdirAll(iterationArchivePath(tmpDir, "0.0.1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	result, _ = tool.Handle(context.Background(), req)
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "no markdown artifact") {
+		t.Errorf("archived init should be rejected as having no artifact, got: %s", getResultText(result))
+	}
+}
+
+func TestContextTool_Handle_LineRange(t *testing.T) {
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+
+	var lines []string
+	for i := 1; i <= 10; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageDesign), strings.Join(lines, "\n")+"\n"); err != nil {
+		t.Fatalf("write design: %v", err)
+	}
+
+	tool := NewContextTool(config.NewFileStore())
+	tests := []struct {
+		name     string
+		from, to float64
+		header   string
+		first    string
+		last     string
+	}{
+		{"middle slice", 3, 5, "_Lines 3-5 of 10 (`docs/design.md`)._", "line 3", "line 5"},
+		{"to end", 9, 0, "_Lines 9-10 of 10", "line 9", "line 10"},
+		{"clamped to end", 8, 99, "_Lines 8-10 of 10", "line 8", "line 10"},
+		{"clamped start", -4, 2, "_Lines 1-2 of 10", "line 1", "line 2"},
+		{"past the end", 50, 0, "_Lines 10-10 of 10", "line 10", "line 10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]interface{}{"stage": "design", "from_line": tt.from}
+			if tt.to != 0 {
+				args["to_line"] = tt.to
+			}
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = args
+			result, err := tool.Handle(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Handle failed: %v", err)
+			}
+			text := getResultText(result)
+			if !strings.HasPrefix(text, tt.header) {
+				t.Errorf("header mismatch, want prefix %q:\n%s", tt.header, text)
+			}
+			body := strings.SplitN(text, "\n\n", 2)[1]
+			body = body[:strings.Index(body, "\n📏")] // drop the token footer
+			got := strings.Split(body, "\n")
+			if got[0] != tt.first || got[len(got)-1] != tt.last {
+				t.Errorf("slice = %q, want %s..%s", got, tt.first, tt.last)
+			}
+		})
+	}
+}
+
+func TestContextTool_Handle_LineRangeErrors(t *testing.T) {
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), "# Charter\n\nBody\n"); err != nil {
+		t.Fatalf("write charter: %v", err)
+	}
+
+	tool := NewContextTool(config.NewFileStore())
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want string
+	}{
+		{"inverted", map[string]interface{}{"stage": "charter", "from_line": float64(5), "to_line": float64(2)}, "must not be greater"},
+		{"no stage", map[string]interface{}{"from_line": float64(1)}, "require 'stage'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tt.args
+			result, err := tool.Handle(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Handle failed: %v", err)
+			}
+			if !isErrorResult(result) || !strings.Contains(getResultText(result), tt.want) {
+				t.Errorf("expected error containing %q, got: %s", tt.want, getResultText(result))
+			}
+		})
+	}
+}
+
 func TestContextTool_Handle_SummaryDetailLevel(t *testing.T) {
 	_, cleanup := setupTestProject(t, config.ModeGuided)
 	defer cleanup()
@@ -1127,6 +2361,61 @@ func TestContextTool_Handle_SummaryAtClarifyShowsScore(t *testing.T) {
 	}
 }
 
+func TestContextTool_Handle_Blockers(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageClarify)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	cfg, _ := store.Load(tmpDir)
+	cfg.ClarityThreshold = 70
+	cfg.ClarityScore = 64
+	cfg.DimensionScores = map[string]int{
+		"target_users": 90, "core_functionality": 85, "data_model": 75, "integrations": 70,
+		"edge_cases": 40, "security": 50, "scale_performance": 30, "scope_boundaries": 80,
+	}
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	tool := NewContextTool(store)
+
+	text, err := tool.process(context.Background(), tmpDir, contextParams{Format: "markdown", Blockers: true})
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	for _, want := range []string{
+		"**Score:** 64/100 — need 70 for guided mode (6 short)",
+		"| edge_cases | 40 | 8 | +30 |",
+		"| scale_performance | 30 | 5 | +40 |",
+		"focus='edge_cases,scale_performance,security'",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("blockers missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "| integrations |") {
+		t.Errorf("a dimension at the threshold is not a blocker:\n%s", text)
+	}
+
+	// The standard overview lists the same blockers.
+	overview, _ := tool.process(context.Background(), tmpDir, contextParams{Format: "markdown", DetailLevel: "standard"})
+	if !strings.Contains(overview, "| security | 50 | 7 | +20 |") {
+		t.Errorf("overview should list the blockers:\n%s", overview)
+	}
+
+	if _, err := tool.process(context.Background(), tmpDir, contextParams{Format: "markdown", Blockers: true, Stage: "charter"}); err == nil || !IsUserError(err) {
+		t.Errorf("blockers with stage should be rejected, got %v", err)
+	}
+
+	cfg.ClarityScore = 75
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	text, _ = tool.process(context.Background(), tmpDir, contextParams{Format: "markdown", Blockers: true})
+	if !strings.Contains(text, "Nothing blocks the Clarity Gate") {
+		t.Errorf("a passing score has no blockers:\n%s", text)
+	}
+}
+
 func TestContextTool_Handle_StandardDetailLevel(t *testing.T) {
 	_, cleanup := setupTestProject(t, config.ModeGuided)
 	defer cleanup()
@@ -1297,6 +2586,130 @@ func TestContextTool_Handle_DefaultDetailLevel(t *testing.T) {
 
 // --- parseDimensionScores ---
 
+func TestContextTool_Handle_JSONFormat(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), "# Charter\nline two\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := NewContextTool(config.NewFileStore())
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"format":     "json",
+		"max_tokens": float64(10),
+	}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+
+	var got contextJSON
+	if err := json.Unmarshal([]byte(getResultText(result)), &got); err != nil {
+		t.Fatalf("response is not valid JSON (max_tokens must not truncate): %v", err)
+	}
+	if got.Project == nil || got.Project.Name != "test-project" {
+		t.Errorf("project = %+v, want test-project", got.Project)
+	}
+	if got.ClarityThreshold != 70 {
+		t.Errorf("clarity_threshold = %d, want 70", got.ClarityThreshold)
+	}
+	// init, principles, charter completed out of 9 stages.
+	if got.ProgressPercent != 3*100/len(config.StageOrder) {
+		t.Errorf("progress_percent = %d", got.ProgressPercent)
+	}
+	if len(got.Stages) != len(config.StageOrder) {
+		t.Fatalf("stages = %d, want %d", len(got.Stages), len(config.StageOrder))
+	}
+	if len(got.Transitions) == 0 || got.Transitions[0].Op != pipeline.TransitionSubmit ||
+		got.Transitions[0].Tool != "sdd_generate_requirements" {
+		t.Errorf("transitions = %+v, want submit via sdd_generate_requirements first", got.Transitions)
+	}
+
+	for _, st := range got.Stages {
+		switch st.Stage {
+		case config.StageInit:
+			if st.Artifact != nil {
+				t.Error("init stage has no artifact")
+			}
+			if st.DurationSeconds == nil {
+				t.Error("completed init stage should report a duration")
+			}
+		case config.StageCharter:
+			if st.Artifact == nil || !st.Artifact.Exists || st.Artifact.Lines != 2 {
+				t.Errorf("charter artifact = %+v, want exists with 2 lines", st.Artifact)
+			}
+			if st.Artifact != nil && st.Artifact.Path != "docs/charter.md" {
+				t.Errorf("charter path = %q, want docs/charter.md", st.Artifact.Path)
+			}
+		case config.StageSpecify:
+			if !st.Current {
+				t.Error("specify should be marked current")
+			}
+			if st.DurationSeconds != nil {
+				t.Error("in-progress stage should not report a duration")
+			}
+		}
+	}
+}
+
+func TestContextTool_Handle_InvalidFormat(t *testing.T) {
+	_, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+
+	tool := NewContextTool(config.NewFileStore())
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"format": "yaml"}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if !isErrorResult(result) {
+		t.Error("should reject unknown format")
+	}
+}
+
+func TestContextTool_Handle_PlainFormat(t *testing.T) {
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+	charter := "# Charter\n\n## Problem Statement\n\n**Freelancers** lose _billable_ hours.\n\n* Track time\n"
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageCharter), charter); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := NewContextTool(config.NewFileStore())
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"stage": "charter", "format": "plain"}
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	text := getResultText(result)
+	for _, want := range []string{"CHARTER\n", "PROBLEM STATEMENT\n", "Freelancers lose billable hours.", "- Track time"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("plain artifact missing %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "**") || strings.Contains(text, "# ") {
+		t.Errorf("plain output should carry no markdown:\n%s", text)
+	}
+
+	req.Params.Arguments = map[string]interface{}{"detail_level": "standard", "format": "plain"}
+	result, err = tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if text := getResultText(result); !strings.Contains(text, "SDD PROJECT: ") || strings.Contains(text, "**Mode:**") {
+		t.Errorf("plain overview should normalize headings and bold:\n%s", text)
+	}
+}
+
 func TestParseDimensionScores_ValidInput(t *testing.T) {
 	dims := pipeline.DefaultDimensions()
 	parseDimensionScores("target_users:80,core_functionality:90", dims)
@@ -1342,7 +2755,9 @@ func TestParseDimensionScores_ClampsValues(t *testing.T) {
 
 func TestParseDimensionScores_InvalidFormat(t *testing.T) {
 	dims := pipeline.DefaultDimensions()
-	parseDimensionScores("garbage input without colons", dims)
+	if n := parseDimensionScores("garbage input without colons", dims); n != 0 {
+		t.Errorf("matched = %d, want 0", n)
+	}
 
 	// All should remain at 0.
 	for _, d := range dims {
@@ -1381,6 +2796,48 @@ func TestParseDimensionScores_CoveredThreshold(t *testing.T) {
 	}
 }
 
+func TestParseDimensionScores_Justifications(t *testing.T) {
+	dims := pipeline.DefaultDimensions()
+	n := parseDimensionScores(
+		"security:70|JWT+bcrypt specified in FR-009, FR-010,target_users:80, edge_cases:40 | NFR-001: 5 retries",
+		dims,
+	)
+	if n != 3 {
+		t.Fatalf("matched = %d, want 3", n)
+	}
+
+	want := map[string]struct {
+		score         int
+		justification string
+	}{
+		"security":     {70, "JWT+bcrypt specified in FR-009, FR-010"},
+		"target_users": {80, ""},
+		"edge_cases":   {40, "NFR-001: 5 retries"},
+	}
+	for _, d := range dims {
+		w, ok := want[d.Name]
+		if !ok {
+			continue
+		}
+		if d.Score != w.score || d.Justification != w.justification {
+			t.Errorf("%s = %d %q, want %d %q", d.Name, d.Score, d.Justification, w.score, w.justification)
+		}
+	}
+}
+
+func TestFormatDimensionScores(t *testing.T) {
+	dims := pipeline.DefaultDimensions()
+	parseDimensionScores("security:70|JWT | bcrypt,target_users:80", dims)
+	table := formatDimensionScores(dims)
+
+	if !strings.Contains(table, "| security | 70 | JWT \\| bcrypt |") {
+		t.Errorf("expected escaped justification next to security score:\n%s", table)
+	}
+	if !strings.Contains(table, "| target_users | 80 | — |") {
+		t.Errorf("expected placeholder for unjustified score:\n%s", table)
+	}
+}
+
 // --- statusIndicator ---
 
 func TestStatusIndicator(t *testing.T) {
@@ -1405,6 +2862,59 @@ func TestStatusIndicator(t *testing.T) {
 	}
 }
 
+func TestASCIIStatusIndicator(t *testing.T) {
+	for status, want := range map[string]string{
+		"completed": "[x]", "in_progress": "[~]", "skipped": "[>]", "pending": "[ ]", "unknown": "[ ]",
+	} {
+		if got := asciiStatusIndicator(status); got != want {
+			t.Errorf("asciiStatusIndicator(%s) = %s, want %s", status, got, want)
+		}
+	}
+}
+
+func TestContextTool_Handle_ASCII(t *testing.T) {
+	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageSpecify)
+	defer cleanup()
+	if err := os.WriteFile(filepath.Join(tmpDir, "docs", "charter.md"), []byte("# Charter\n\nPlain text.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := NewContextTool(config.NewFileStore())
+	for _, level := range []string{"summary", "standard", "full"} {
+		t.Run(level, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{"detail_level": level, "ascii": true}
+			result, err := tool.Handle(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			text := getResultText(result)
+			for i, r := range text {
+				if r >= utf8.RuneSelf {
+					t.Fatalf("multibyte rune %q at byte %d in ASCII output:\n%s", r, i, text)
+				}
+			}
+			if !strings.Contains(text, "[x]") || !strings.Contains(text, "[~]") {
+				t.Errorf("expected ASCII markers in output:\n%s", text)
+			}
+		})
+	}
+
+	// SetASCII changes the default; an explicit 'ascii' still wins.
+	tool.SetASCII(true)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"detail_level": "summary"}
+	result, _ := tool.Handle(context.Background(), req)
+	if !strings.Contains(getResultText(result), "[~]") {
+		t.Error("SetASCII(true) should make ASCII the default")
+	}
+	req.Params.Arguments = map[string]interface{}{"detail_level": "summary", "ascii": false}
+	result, _ = tool.Handle(context.Background(), req)
+	if !strings.Contains(getResultText(result), "🔄") {
+		t.Error("ascii=false should restore emoji markers")
+	}
+}
+
 // --- nextStepGuidance ---
 
 func TestNextStepGuidance(t *testing.T) {
@@ -1457,15 +2967,23 @@ func min(a, b int) int {
 
 // --- DesignTool ---
 
+// writeDesignPrerequisites writes the artifacts sdd_create_design requires.
+func writeDesignPrerequisites(t *testing.T, tmpDir, requirements string) {
+	t.Helper()
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), requirements); err != nil {
+		t.Fatalf("write requirements: %v", err)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageClarify), "# Clarifications\n\nAll clarified."); err != nil {
+		t.Fatalf("write clarifications: %v", err)
+	}
+}
+
 func TestDesignTool_Handle_Success(t *testing.T) {
 	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
 	defer cleanup()
 
-	// Write requirements (required by design).
-	reqPath := config.StagePath(tmpDir, config.StageSpecify)
-	if err := writeStageFile(reqPath, "# Requirements\n\n- FR-001: Users can sign up"); err != nil {
-		t.Fatalf("write requirements: %v", err)
-	}
+	// Write requirements and clarifications (required by design).
+	writeDesignPrerequisites(t, tmpDir, "# Requirements\n\n- FR-001: Users can sign up")
 
 	store := config.NewFileStore()
 	renderer, _ := templates.NewRenderer()
@@ -1504,10 +3022,7 @@ func TestDesignTool_Handle_MissingRequiredFields(t *testing.T) {
 	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
 	defer cleanup()
 
-	reqPath := config.StagePath(tmpDir, config.StageSpecify)
-	if err := writeStageFile(reqPath, "# Requirements\n\nSome content."); err != nil {
-		t.Fatalf("write requirements: %v", err)
-	}
+	writeDesignPrerequisites(t, tmpDir, "# Requirements\n\nSome content.")
 
 	store := config.NewFileStore()
 	renderer, _ := templates.NewRenderer()
@@ -1616,12 +3131,9 @@ func TestDesignTool_Handle_EmptyRequirements(t *testing.T) {
 
 func TestDesignTool_Handle_AdvancesPipeline(t *testing.T) {
 	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
-	defer cleanup()
-
-	reqPath := config.StagePath(tmpDir, config.StageSpecify)
-	if err := writeStageFile(reqPath, "# Requirements\n\nSome content."); err != nil {
-		t.Fatalf("write requirements: %v", err)
-	}
+	defer cleanup()
+
+	writeDesignPrerequisites(t, tmpDir, "# Requirements\n\nSome content.")
 
 	store := config.NewFileStore()
 	renderer, _ := templates.NewRenderer()
@@ -1650,10 +3162,7 @@ func TestDesignTool_Handle_OptionalFieldsDefault(t *testing.T) {
 	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageDesign)
 	defer cleanup()
 
-	reqPath := config.StagePath(tmpDir, config.StageSpecify)
-	if err := writeStageFile(reqPath, "# Requirements\n\nSome content."); err != nil {
-		t.Fatalf("write requirements: %v", err)
-	}
+	writeDesignPrerequisites(t, tmpDir, "# Requirements\n\nSome content.")
 
 	store := config.NewFileStore()
 	renderer, _ := templates.NewRenderer()
@@ -1719,6 +3228,52 @@ func TestTasksTool_Handle_Success(t *testing.T) {
 	}
 }
 
+func TestTasksTool_Handle_AttributionFooter(t *testing.T) {
+	custom, removed := "Acme platform team", ""
+	for _, tc := range []struct {
+		name   string
+		footer *string
+		want   string
+	}{
+		{"default", nil, "> " + templates.DefaultAttribution + " | Stage 5: Tasks"},
+		{"custom", &custom, "> Acme platform team | Stage 5: Tasks"},
+		{"removed", &removed, "\n> Stage 5: Tasks\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageTasks)
+			defer cleanup()
+
+			store := config.NewFileStore()
+			cfg, err := store.Load(tmpDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg.AttributionFooter = tc.footer
+			if err := store.Save(tmpDir, cfg); err != nil {
+				t.Fatal(err)
+			}
+			if err := writeStageFile(config.StagePath(tmpDir, config.StageDesign), "# Design\n\nMonolith"); err != nil {
+				t.Fatalf("write design: %v", err)
+			}
+
+			if _, err := NewTasksTool(store, mustRenderer(t)).Run(tmpDir, templates.TasksData{
+				TotalTasks:      "1",
+				EstimatedEffort: "1 day",
+				Tasks:           "### TASK-001: Build it",
+			}); err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+			content, err := readStageFile(config.StagePath(tmpDir, config.StageTasks))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(content, tc.want) {
+				t.Errorf("tasks.md missing %q:\n%s", tc.want, content)
+			}
+		})
+	}
+}
+
 func TestTasksTool_Handle_MissingRequiredFields(t *testing.T) {
 	tmpDir, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageTasks)
 	defer cleanup()
@@ -2007,6 +3562,128 @@ func TestValidateTool_Handle_Pass(t *testing.T) {
 	}
 }
 
+func TestValidateTool_Handle_ClarityGateProvenance(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	args := map[string]interface{}{
+		"requirements_coverage": "All covered",
+		"component_coverage":    "All covered",
+		"consistency_issues":    "_None found._",
+		"verdict":               "PASS",
+	}
+	validate := func() string {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := NewValidateTool(store).Handle(context.Background(), req)
+		if err != nil || isErrorResult(result) {
+			t.Fatalf("Handle: %v %s", err, getResultText(result))
+		}
+		report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+		return report
+	}
+
+	// A clarity score edited into hoofy.json by hand leaves no record.
+	cfg, _ := store.Load(tmpDir)
+	cfg.ClarityGatePassed = nil
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if report := validate(); !strings.Contains(report, "No record of the Clarity Gate being passed through the pipeline") {
+		t.Errorf("report should flag the missing gate record:\n%s", report)
+	}
+
+	cfg, _ = store.Load(tmpDir)
+	cfg.ClarityGatePassed = &config.ClarityGatePass{
+		At: "2026-01-02T03:04:05Z", Mode: config.ModeExpert, Threshold: 50, Score: 72, Round: 2,
+		Dimensions: []config.DimensionScore{
+			{Name: "target_users", Score: 80, Evidence: "Personas in charter"},
+			{Name: "security", Score: 60},
+		},
+	}
+	st := cfg.StageStatus[config.StageValidate]
+	st.Status = "in_progress"
+	cfg.StageStatus[config.StageValidate] = st
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+	report := validate()
+	for _, want := range []string{
+		"## Clarity Gate Provenance",
+		"Passed 2026-01-02T03:04:05Z in round 2 with **72/100** (threshold 50, expert mode).",
+		"| target_users | 80 | Personas in charter |",
+		"_1 of 2 dimensions were scored without evidence._",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report missing %q:\n%s", want, report)
+		}
+	}
+}
+
+func TestAnalyzeCoverage_SeparatesNFR(t *testing.T) {
+	requirements := "- **FR-001**: Sign up\n- **FR-002**: Export\n- **NFR-001**: p95 < 200ms\n- **NFR-002**: Encrypt at rest"
+	tasks := "### TASK-001\n**Covers**: FR-001, NFR-001"
+
+	c, err := analyzeCoverage(context.Background(), requirements, tasks, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.FRTotal != 2 || c.NFRTotal != 2 {
+		t.Fatalf("totals = FR %d, NFR %d; want 2, 2", c.FRTotal, c.NFRTotal)
+	}
+	if len(c.FRUncovered) != 1 || c.FRUncovered[0] != "FR-002" {
+		t.Errorf("FRUncovered = %v, want [FR-002]", c.FRUncovered)
+	}
+	if len(c.NFRUncovered) != 1 || c.NFRUncovered[0] != "NFR-002" {
+		t.Errorf("NFRUncovered = %v, want [NFR-002]", c.NFRUncovered)
+	}
+
+	report := c.format()
+	for _, want := range []string{"**Functional (FR):** 1/2", "**Non-Functional (NFR):** 1/2", "### NFR Uncovered", "- NFR-002"} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report missing %q:\n%s", want, report)
+		}
+	}
+}
+
+func TestValidateTool_Handle_ReportsUncoveredNFR(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify),
+		"# Requirements\n\n- FR-001: Sign up\n- NFR-001: p95 < 200ms"); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks),
+		"# Tasks\n\n### TASK-001: Sign up\n**Covers**: FR-001"); err != nil {
+		t.Fatal(err)
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"requirements_coverage": "**Covered (1/1)**:\n- FR-001 → TASK-001",
+		"component_coverage":    "_All covered._",
+		"consistency_issues":    "_None found._",
+		"verdict":               "PASS",
+	}
+
+	result, err := NewValidateTool(config.NewFileStore()).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "1 NFR(s) have no task:** NFR-001") {
+		t.Errorf("response should warn about uncovered NFR: %s", text)
+	}
+
+	report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+	if !strings.Contains(report, "### NFR Uncovered\n\n- NFR-001") {
+		t.Errorf("validation.md should list NFR-001 as uncovered:\n%s", report)
+	}
+}
+
 func TestValidateTool_Handle_PassWithWarnings(t *testing.T) {
 	_, cleanup := setupValidateProject(t)
 	defer cleanup()
@@ -2198,6 +3875,49 @@ func TestValidateTool_Handle_MissingArtifacts(t *testing.T) {
 	}
 }
 
+func TestValidateTool_Handle_SkippedDesign(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+	if err := os.Remove(config.StagePath(tmpDir, config.StageDesign)); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	tool := NewValidateTool(store)
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"requirements_coverage": "**Covered (1/1)**:\n- FR-001 → TASK-001",
+		"component_coverage":    "_No design — library without an API._",
+		"consistency_issues":    "_None found._",
+		"verdict":               "PASS",
+	}
+
+	result, _ := tool.Handle(context.Background(), req)
+	if !isErrorResult(result) || !strings.Contains(getResultText(result), "design.md") {
+		t.Fatalf("a missing design that wasn't skipped should be reported, got: %s", getResultText(result))
+	}
+
+	cfg, _ := store.Load(tmpDir)
+	st := cfg.StageStatus[config.StageDesign]
+	st.Status = "skipped"
+	cfg.StageStatus[config.StageDesign] = st
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := tool.Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("a skipped design should satisfy validate, got: %s", getResultText(result))
+	}
+	report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+	if !strings.Contains(report, "_Skipped stages, validated without an artifact: Design._") {
+		t.Errorf("report should name the skipped stage:\n%s", report)
+	}
+}
+
 func TestValidateTool_Handle_CompletesStage(t *testing.T) {
 	tmpDir, cleanup := setupValidateProject(t)
 	defer cleanup()
@@ -2226,6 +3946,238 @@ func TestValidateTool_Handle_CompletesStage(t *testing.T) {
 	}
 }
 
+func TestValidateTool_Handle_TechStackMismatch(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	design := "# Design\n\n## Tech Stack\n\n- **Database**: PostgreSQL\n\n## Components\n\n- API"
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageDesign), design); err != nil {
+		t.Fatalf("write design: %v", err)
+	}
+	tasks := "# Tasks\n\n### TASK-001: Setup project\n\n### TASK-005: Set up MongoDB collections"
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks), tasks); err != nil {
+		t.Fatalf("write tasks: %v", err)
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"requirements_coverage": "All covered",
+		"component_coverage":    "All covered",
+		"consistency_issues":    "_None found._",
+		"verdict":               "PASS",
+	}
+	result, err := NewValidateTool(config.NewFileStore()).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	text := getResultText(result)
+	for _, want := range []string{
+		"### Tech Stack Check (automated)",
+		"database: design uses PostgreSQL, but MongoDB appears",
+		"1 tech stack mismatch(es)",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("response missing %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestValidateTool_Handle_RerunKeepsVerdictHistory(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	tool := NewValidateTool(store)
+	run := func(verdict string) string {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"requirements_coverage": "All covered",
+			"component_coverage":    "All covered",
+			"consistency_issues":    "_None found._",
+			"verdict":               verdict,
+			"recommendations":       "Run " + verdict,
+		}
+		result, err := tool.Handle(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Handle(%s) failed: %v", verdict, err)
+		}
+		if isErrorResult(result) {
+			t.Fatalf("Handle(%s) error: %s", verdict, getResultText(result))
+		}
+		return getResultText(result)
+	}
+
+	if text := run("FAIL"); strings.Contains(text, "Previous verdict") {
+		t.Errorf("first run has no previous verdict:\n%s", text)
+	}
+	if text := run("PASS_WITH_WARNINGS"); !strings.Contains(text, "**Previous verdict:** FAIL (last run) → now PASS_WITH_WARNINGS") {
+		t.Errorf("second run should compare with the FAIL run:\n%s", text)
+	}
+	text := run("PASS_WITH_WARNINGS")
+	if !strings.Contains(text, "**Previous verdict:** FAIL (2 runs ago) → now PASS_WITH_WARNINGS") {
+		t.Errorf("third run should report the FAIL two runs back:\n%s", text)
+	}
+
+	cfg, _ := store.Load(tmpDir)
+	if len(cfg.ValidationRuns) != 3 {
+		t.Fatalf("ValidationRuns = %d, want 3", len(cfg.ValidationRuns))
+	}
+	var archives []string
+	for i, r := range cfg.ValidationRuns[:2] {
+		if r.Report == "" {
+			t.Fatalf("run %d report was not archived", i+1)
+		}
+		archives = append(archives, r.Report)
+	}
+	if archives[0] == archives[1] {
+		t.Fatalf("runs share an archive path %q", archives[0])
+	}
+	first, err := os.ReadFile(filepath.Join(config.DocsPath(tmpDir), filepath.FromSlash(archives[0])))
+	if err != nil {
+		t.Fatalf("reading archived report: %v", err)
+	}
+	if !strings.Contains(string(first), "## Verdict: FAIL") {
+		t.Errorf("first archive should hold the FAIL report:\n%s", first)
+	}
+	if cfg.ValidationRuns[2].Report != "" {
+		t.Error("the current report should not be marked archived")
+	}
+}
+
+func TestValidateTool_Handle_ArchivePathUsesDocsDir(t *testing.T) {
+	if err := config.SetDocsDir("specs"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = config.SetDocsDir("") })
+	_, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	tool := NewValidateTool(config.NewFileStore())
+	var text string
+	for range 2 {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{
+			"requirements_coverage": "All covered",
+			"component_coverage":    "All covered",
+			"consistency_issues":    "_None found._",
+			"verdict":               "PASS",
+		}
+		result, err := tool.Handle(context.Background(), req)
+		if err != nil || isErrorResult(result) {
+			t.Fatalf("Handle: %v %s", err, getResultText(result))
+		}
+		text = getResultText(result)
+	}
+	if !strings.Contains(text, "Previous report archived to `specs/history/validation-") {
+		t.Errorf("the archive path should start at the configured docs dir:\n%s", text)
+	}
+}
+
+func TestValidateTool_Handle_StrictBlocksUncoveredFR(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify),
+		"# Requirements\n\n- FR-001: Sign up\n- FR-002: Export CSV"); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks),
+		"# Tasks\n\n### TASK-001: Sign up\n**Covers**: FR-001"); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"requirements_coverage": "All covered",
+		"component_coverage":    "All covered",
+		"consistency_issues":    "_None found._",
+		"verdict":               "PASS",
+		"strict":                true,
+	}
+
+	result, err := NewValidateTool(store).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "Validation Blocked") || !strings.Contains(text, "FR-002") {
+		t.Errorf("strict mode should block on FR-002, got: %s", text)
+	}
+
+	cfg, _ := store.Load(tmpDir)
+	if status := cfg.StageStatus[config.StageValidate].Status; status != "in_progress" {
+		t.Errorf("validate stage should stay in_progress under strict mode, got: %s", status)
+	}
+	report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+	if !strings.Contains(report, "## Strict Gate: BLOCKED") {
+		t.Errorf("validation.md should record the blocked gate:\n%s", report)
+	}
+}
+
+func TestValidateTool_Handle_StrictBlocksDependencyCycle(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks),
+		"# Tasks\n\n### TASK-001: Sign up\n**Covers**: FR-001\n**Dependencies**: TASK-002\n\n"+
+			"### TASK-002: Schema\n**Dependencies**: TASK-001\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"requirements_coverage": "All covered",
+		"component_coverage":    "All covered",
+		"consistency_issues":    "_None found._",
+		"verdict":               "PASS",
+		"strict":                true,
+	}
+
+	result, _ := NewValidateTool(store).Handle(context.Background(), req)
+	if text := getResultText(result); !strings.Contains(text, "TASK-001 → TASK-002 → TASK-001") {
+		t.Errorf("expected cycle in blockers, got: %s", text)
+	}
+	cfg, _ := store.Load(tmpDir)
+	if pipeline.IsCompleted(cfg, config.StageValidate) {
+		t.Error("validate must not complete with a dependency cycle under strict mode")
+	}
+}
+
+func TestValidateTool_Handle_StrictPasses(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks),
+		"# Tasks\n\n### TASK-001: Sign up\n**Covers**: FR-001\n**Dependencies**: None"); err != nil {
+		t.Fatal(err)
+	}
+
+	store := config.NewFileStore()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"requirements_coverage": "All covered",
+		"component_coverage":    "All covered",
+		"consistency_issues":    "_None found._",
+		"verdict":               "FAIL",
+		"strict":                true,
+	}
+
+	if _, err := NewValidateTool(store).Handle(context.Background(), req); err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	cfg, _ := store.Load(tmpDir)
+	if !pipeline.IsCompleted(cfg, config.StageValidate) {
+		t.Error("strict mode with full coverage should complete like non-strict mode")
+	}
+	report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+	if !strings.Contains(report, "## Strict Gate: PASSED") {
+		t.Errorf("validation.md should record the passed gate:\n%s", report)
+	}
+}
+
 func TestValidateTool_Handle_VerdictCaseInsensitive(t *testing.T) {
 	_, cleanup := setupValidateProject(t)
 	defer cleanup()
@@ -2531,3 +4483,59 @@ func TestCharterTool_Handle_NilBridge_NoError(t *testing.T) {
 		t.Fatalf("unexpected error with nil bridge: %s", getResultText(result))
 	}
 }
+
+func TestContextTool_Handle_RelativeTimes(t *testing.T) {
+	tmpDir, cleanup := setupTestProject(t, config.ModeGuided)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	cfg, err := store.Load(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.CreatedAt = "2024-01-10T12:00:00Z"
+	status := cfg.StageStatus[config.StageInit]
+	status.CompletedAt = "2024-01-13T10:00:00Z"
+	cfg.StageStatus[config.StageInit] = status
+	if err := store.Save(tmpDir, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	origNow := timeNow
+	timeNow = func() time.Time { return time.Date(2024, 1, 13, 12, 0, 0, 0, time.UTC) }
+	defer func() { timeNow = origNow }()
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"detail_level": "standard"}
+	result, err := NewContextTool(store).Handle(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Handle failed: %v", err)
+	}
+	text := getResultText(result)
+
+	for _, want := range []string{
+		"**Created:** 2024-01-10T12:00:00Z (3 days ago)",
+		"2024-01-13T10:00:00Z (2 hours ago)",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("overview missing %q\ngot:\n%s", want, text)
+		}
+	}
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	origNow := timeNow
+	timeNow = func() time.Time { return time.Date(2024, 1, 13, 12, 0, 0, 0, time.UTC) }
+	defer func() { timeNow = origNow }()
+
+	tests := map[string]string{
+		"":                     "—",
+		"not-a-timestamp":      "not-a-timestamp",
+		"2024-01-13T11:00:00Z": "2024-01-13T11:00:00Z (1 hour ago)",
+	}
+	for in, want := range tests {
+		if got := formatTimestamp(in); got != want {
+			t.Errorf("formatTimestamp(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/design"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// traceGap marks a missing link in the traceability matrix.
+const traceGap = "⚠️ gap"
+
+// TraceabilityMatrixTool handles the sdd_traceability_matrix MCP tool.
+// It joins requirements, design components, tasks and acceptance
+// scenarios into one table (docs/traceability.md) for audits: every
+// requirement, what implements it, and what proves it.
+//
+// Design: side artifact, derived entirely from the other artifacts. It
+// never advances the pipeline; call it again to regenerate.
+type TraceabilityMatrixTool struct {
+	store config.Store
+}
+
+// NewTraceabilityMatrixTool creates a TraceabilityMatrixTool with its dependencies.
+func NewTraceabilityMatrixTool(store config.Store) *TraceabilityMatrixTool {
+	return &TraceabilityMatrixTool{store: store}
+}
+
+// Definition returns the MCP tool definition for registration.
+func (t *TraceabilityMatrixTool) Definition() mcp.Tool {
+	return mcp.NewTool("sdd_traceability_matrix",
+		mcp.WithDescription(
+			"Generate a requirements traceability matrix in `docs/traceability.md`: one row per "+
+				"FR/NFR mapping it to the design components that cover it (their **Covers** line), "+
+				"the tasks that reference it, its acceptance scenarios, and its validation status. "+
+				"Missing links are marked as gaps and listed after the table. "+
+				"Requires: sdd_create_tasks must have been run (the pipeline has reached validate). "+
+				"Does not change pipeline state; call again to regenerate.",
+		),
+	)
+}
+
+// Handle processes the sdd_traceability_matrix tool call.
+func (t *TraceabilityMatrixTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
+	}
+	return toolResult(t.process(projectRoot))
+}
+
+// process builds the matrix, writes it and renders the response.
+func (t *TraceabilityMatrixTool) process(projectRoot string) (string, error) {
+	cfg, err := t.store.Load(projectRoot)
+	if err != nil {
+		return "", asUserError(err)
+	}
+	if pipeline.StageIndexFor(cfg, cfg.CurrentStage) < pipeline.StageIndexFor(cfg, config.StageValidate) {
+		return "", newUserError(fmt.Sprintf(
+			"the traceability matrix needs the pipeline to reach validate — currently at '%s'; run sdd_create_tasks first",
+			cfg.CurrentStage,
+		))
+	}
+	if err := pipeline.RequireArtifacts(projectRoot, config.StageSpecify, config.StageDesign, config.StageTasks); err != nil {
+		return "", asUserError(err)
+	}
+
+	requirementsDoc, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return "", fmt.Errorf("reading requirements: %w", err)
+	}
+	designDoc, err := readStageFile(config.StagePath(projectRoot, config.StageDesign))
+	if err != nil {
+		return "", fmt.Errorf("reading design: %w", err)
+	}
+	tasks, err := readStageFile(config.StagePath(projectRoot, config.StageTasks))
+	if err != nil {
+		return "", fmt.Errorf("reading tasks: %w", err)
+	}
+	acceptance, err := readStageFile(config.AcceptancePath(projectRoot))
+	if err != nil {
+		return "", fmt.Errorf("reading acceptance tests: %w", err)
+	}
+	verdict, err := lastVerdict(projectRoot, cfg)
+	if err != nil {
+		return "", err
+	}
+
+	rows := buildTraceability(requirementsDoc, designDoc, tasks, parseAcceptanceFile(acceptance), verdict)
+	if len(rows) == 0 {
+		return "", newUserError("requirements.md has no FR/NFR IDs — nothing to trace")
+	}
+
+	content := renderTraceability(cfg.Name, verdict, rows)
+	if err := writeStageFile(config.TraceabilityPath(projectRoot), content); err != nil {
+		return "", fmt.Errorf("writing traceability matrix: %w", err)
+	}
+
+	gaps := 0
+	for _, r := range rows {
+		if len(r.gaps()) > 0 {
+			gaps++
+		}
+	}
+	response := fmt.Sprintf(
+		"# Traceability Matrix Saved\n\n"+
+			"Saved to `docs/%s`\n\n"+
+			"**Requirements:** %d | **With gaps:** %d\n\n"+
+			"---\n\n%s",
+		config.TraceabilityFile, len(rows), gaps, content,
+	)
+	return response, nil
+}
+
+// lastVerdict returns the verdict of the most recent sdd_validate run,
+// falling back to validation.md for projects validated before runs were
+// recorded. Returns "" when validation hasn't run.
+func lastVerdict(projectRoot string, cfg *config.ProjectConfig) (string, error) {
+	if n := len(cfg.ValidationRuns); n > 0 {
+		return cfg.ValidationRuns[n-1].Verdict, nil
+	}
+	report, err := readStageFile(config.StagePath(projectRoot, config.StageValidate))
+	if err != nil {
+		return "", fmt.Errorf("reading validation report: %w", err)
+	}
+	verdict, _ := pipeline.ParseVerdict(report)
+	return verdict, nil
+}
+
+// traceRow is one requirement's line of the traceability matrix.
+type traceRow struct {
+	ID         string
+	Components []string
+	Tasks      []string
+	Acceptance bool
+	Deferred   bool
+	Verdict    string // last validation verdict; "" if not validated
+}
+
+// gaps names the links the requirement is missing. Deferred
+// requirements have none, and an NFR without a scenario is not a gap —
+// acceptance scenarios are expected of functional requirements.
+func (r traceRow) gaps() []string {
+	if r.Deferred {
+		return nil
+	}
+	var gaps []string
+	if len(r.Components) == 0 {
+		gaps = append(gaps, "no design component")
+	}
+	if len(r.Tasks) == 0 {
+		gaps = append(gaps, "no task")
+	}
+	if !r.Acceptance && !isNFR(r.ID) {
+		gaps = append(gaps, "no acceptance test")
+	}
+	return gaps
+}
+
+// validation is the row's validation cell. A requirement no task
+// references is reported uncovered, as sdd_validate's coverage check
+// would.
+func (r traceRow) validation() string {
+	switch {
+	case r.Deferred:
+		return "⏸ deferred"
+	case len(r.Tasks) == 0:
+		return "❌ uncovered"
+	case r.Verdict == "":
+		return "⏳ not validated"
+	case r.Verdict == "FAIL":
+		return "❌ FAIL"
+	default:
+		return "✅ " + r.Verdict
+	}
+}
+
+// buildTraceability joins the artifacts into one row per requirement
+// ID, sorted by ID.
+func buildTraceability(requirementsDoc, designDoc, tasks string, scenarios map[string]string, verdict string) []traceRow {
+	components := make(map[string][]string)
+	for _, c := range design.Components(designDoc) {
+		name := design.ComponentName(c.Name)
+		for _, id := range extractRequirementIDs(c.Body) {
+			components[id] = append(components[id], name)
+		}
+	}
+	byTask := taskRequirementRefs(tasks)
+	deferred := requirements.DeferredIDs(requirementsDoc)
+
+	var rows []traceRow
+	for _, id := range extractRequirementIDs(requirementsDoc) {
+		_, hasScenario := scenarios[id]
+		rows = append(rows, traceRow{
+			ID:         id,
+			Components: components[id],
+			Tasks:      byTask[id],
+			Acceptance: hasScenario,
+			Deferred:   deferred[id],
+			Verdict:    verdict,
+		})
+	}
+	return rows
+}
+
+// taskRequirementRefs maps each requirement ID to the tasks whose
+// section of tasks.md mentions it, in task order. A task's section runs
+// from its heading to the next heading.
+func taskRequirementRefs(tasks string) map[string][]string {
+	refs := make(map[string][]string)
+	current := ""
+	for _, line := range strings.Split(tasks, "\n") {
+		if m := taskHeadingPattern.FindStringSubmatch(line); m != nil {
+			current = m[1]
+		} else if strings.HasPrefix(line, "#") {
+			current = ""
+		}
+		if current == "" {
+			continue
+		}
+		for _, id := range requirementIDPattern.FindAllString(line, -1) {
+			if n := len(refs[id]); n == 0 || refs[id][n-1] != current {
+				refs[id] = append(refs[id], current)
+			}
+		}
+	}
+	return refs
+}
+
+// renderTraceability renders the matrix and its gap list as markdown.
+func renderTraceability(name, verdict string, rows []traceRow) string {
+	if verdict == "" {
+		verdict = "not run"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s — Traceability Matrix\n\n", name)
+	sb.WriteString("_Requirement → design component → task → acceptance test → validation. " +
+		"Generated from the artifacts by sdd_traceability_matrix; regenerate after changing them._\n\n")
+	fmt.Fprintf(&sb, "**Last validation:** %s\n\n", verdict)
+
+	sb.WriteString("| Requirement | Components | Tasks | Acceptance | Validation |\n")
+	sb.WriteString("|-------------|------------|-------|------------|------------|\n")
+	for _, r := range rows {
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s |\n",
+			r.ID, traceCell(r, r.Components), traceCell(r, r.Tasks), acceptanceCell(r), r.validation())
+	}
+
+	var gapLines []string
+	for _, r := range rows {
+		if gaps := r.gaps(); len(gaps) > 0 {
+			gapLines = append(gapLines, fmt.Sprintf("- **%s**: %s", r.ID, strings.Join(gaps, ", ")))
+		}
+	}
+	sb.WriteString("\n## Gaps\n\n")
+	if len(gapLines) == 0 {
+		sb.WriteString("✅ Every requirement traces to a component, a task and (for FRs) an acceptance test.\n")
+	} else {
+		sb.WriteString(strings.Join(gapLines, "\n") + "\n")
+	}
+	return sb.String()
+}
+
+// traceCell renders a list of links, or the gap marker when there are
+// none and the requirement isn't deferred.
+func traceCell(r traceRow, links []string) string {
+	if len(links) > 0 {
+		return tableCell(strings.Join(links, ", "))
+	}
+	if r.Deferred {
+		return "—"
+	}
+	return traceGap
+}
+
+// acceptanceCell renders whether the requirement has a scenario.
+func acceptanceCell(r traceRow) string {
+	switch {
+	case r.Acceptance:
+		return "✅"
+	case r.Deferred, isNFR(r.ID):
+		return "—"
+	default:
+		return traceGap
+	}
+}
@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestTaskRequirementRefs(t *testing.T) {
+	tasks := "# Tasks\n\nFR-009 in the intro is not a task reference.\n\n" +
+		"### TASK-001: Signup\n- **Covers**: FR-001, FR-002\n- Also FR-001 again\n\n" +
+		"### TASK-002: Reset\n- **Covers**: FR-002\n\n" +
+		"## Dependency Graph\n\nFR-003 here is outside any task.\n"
+
+	refs := taskRequirementRefs(tasks)
+	if got := refs["FR-001"]; !slices.Equal(got, []string{"TASK-001"}) {
+		t.Errorf("FR-001 = %v, want [TASK-001]", got)
+	}
+	if got := refs["FR-002"]; !slices.Equal(got, []string{"TASK-001", "TASK-002"}) {
+		t.Errorf("FR-002 = %v, want [TASK-001 TASK-002]", got)
+	}
+	for _, id := range []string{"FR-003", "FR-009"} {
+		if got, ok := refs[id]; ok {
+			t.Errorf("%s = %v, want no reference", id, got)
+		}
+	}
+}
+
+func TestTraceabilityMatrixTool_Handle_Success(t *testing.T) {
+	tmpDir, cleanup := setupValidatedProject(t, "PASS_WITH_WARNINGS")
+	defer cleanup()
+
+	tasks := "# demo — Implementation Tasks\n\n### TASK-001: Signup\n- **Covers**: FR-001, NFR-001\n"
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageTasks), tasks); err != nil {
+		t.Fatal(err)
+	}
+	acceptance := renderAcceptance("demo", map[string]string{"FR-001": "Given a visitor\nWhen they register\nThen an account exists"})
+	if err := writeStageFile(config.AcceptancePath(tmpDir), acceptance); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewTraceabilityMatrixTool(config.NewFileStore()).Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	if text := getResultText(result); !strings.Contains(text, "**Requirements:** 3 | **With gaps:** 2") {
+		t.Errorf("response should count rows and gaps, got:\n%s", text)
+	}
+
+	data, err := os.ReadFile(config.TraceabilityPath(tmpDir))
+	if err != nil {
+		t.Fatalf("reading traceability.md: %v", err)
+	}
+	content := string(data)
+	for _, want := range []string{
+		"**Last validation:** PASS_WITH_WARNINGS",
+		"| FR-001 | AuthModule | TASK-001 | ✅ | ✅ PASS_WITH_WARNINGS |",
+		"| FR-002 | ⚠️ gap | ⚠️ gap | ⚠️ gap | ❌ uncovered |",
+		"| NFR-001 | ⚠️ gap | TASK-001 | — | ✅ PASS_WITH_WARNINGS |", // no scenario needed for an NFR
+		"- **FR-002**: no design component, no task, no acceptance test",
+		"- **NFR-001**: no design component",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("traceability.md missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestTraceabilityMatrixTool_Handle_DeferredHasNoGaps(t *testing.T) {
+	tmpDir, cleanup := setupValidatedProject(t, "PASS")
+	defer cleanup()
+
+	doc := acceptanceRequirements + "\n### Won't Have (this version)\n\n" +
+		"- **FR-002**: Users can reset their password" + requirements.DeferredNote("needs an email provider") + "\n"
+	doc = strings.Replace(doc, "- **FR-002**: Users can reset their password\n", "", 1)
+	if err := writeStageFile(config.StagePath(tmpDir, config.StageSpecify), doc); err != nil {
+		t.Fatal(err)
+	}
+
+	result, _ := NewTraceabilityMatrixTool(config.NewFileStore()).Handle(context.Background(), mcp.CallToolRequest{})
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+	text := getResultText(result)
+	if !strings.Contains(text, "| FR-002 | — | — | — | ⏸ deferred |") {
+		t.Errorf("deferred FR-002 should have no gaps:\n%s", text)
+	}
+	if strings.Contains(text, "- **FR-002**:") {
+		t.Errorf("deferred FR-002 should not be listed under Gaps:\n%s", text)
+	}
+}
+
+func TestTraceabilityMatrixTool_Handle_BeforeValidate(t *testing.T) {
+	_, cleanup := setupTestProjectAtStage(t, config.ModeGuided, config.StageTasks)
+	defer cleanup()
+
+	result, err := NewTraceabilityMatrixTool(config.NewFileStore()).Handle(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if !isErrorResult(result) {
+		t.Fatal("expected an error before the pipeline reaches validate")
+	}
+	if text := getResultText(result); !strings.Contains(text, "currently at 'tasks'") {
+		t.Errorf("error should name the current stage, got: %s", text)
+	}
+}
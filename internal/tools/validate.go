@@ -3,10 +3,15 @@ package tools
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/HendryAvila/Hoofy/internal/config"
 	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/internal/requirements"
+	"github.com/HendryAvila/Hoofy/internal/techstack"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
@@ -39,6 +44,8 @@ func (t *ValidateTool) Definition() mcp.Tool {
 				"The AI should check: requirement coverage, component coverage, task traceability, "+
 				"dependency validity, and identify any gaps or inconsistencies. "+
 				"Pass the ACTUAL validation results (not placeholders). "+
+				"Re-running is safe: the previous report is archived under history/ and the response "+
+				"compares the new verdict with earlier runs. "+
 				"Requires: sdd_create_tasks must have been run first.",
 		),
 		mcp.WithString("requirements_coverage",
@@ -95,79 +102,165 @@ func (t *ValidateTool) Definition() mcp.Tool {
 				"(3) Smell propagation — do the tasks mitigate or amplify the smells identified in the design? "+
 				"Reference Martin Fowler's Refactoring catalog for smell definitions."),
 		),
+		mcp.WithBoolean("strict",
+			mcp.Description("Gate mode. When true, the validate stage is NOT marked completed "+
+				"(it stays in_progress) if the automated check finds requirements no task covers "+
+				"or a circular task dependency — regardless of the verdict. "+
+				"Fix the gaps and re-run. Defaults to false (the report is advisory)."),
+		),
+		checklistParam(),
+		mcp.WithBoolean("save_checklist",
+			mcp.Description("Save this run's checklist items (text and mandatory flag) as the project's checklist "+
+				"in hoofy.json, so later runs ask the same questions; copy it into other projects to reuse it. "+
+				"Default false."),
+		),
+		actorParam(),
 	)
 }
 
+// ValidateParams are the AI's cross-artifact analysis for sdd_validate.
+type ValidateParams struct {
+	RequirementsCoverage string
+	ComponentCoverage    string
+	ConsistencyIssues    string
+	RiskAssessment       string
+	Verdict              string // PASS, PASS_WITH_WARNINGS, or FAIL (case-insensitive)
+	Recommendations      string
+	DesignQuality        string
+	Strict               bool
+	Checklist            []ChecklistResult // answers to the release checklist
+	SaveChecklist        bool              // store Checklist as the project's checklist
+	Actor                string            // recorded as the stage's completed_by; "" is unknown
+}
+
 // Handle processes the sdd_validate tool call.
 func (t *ValidateTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	reqCoverage := req.GetString("requirements_coverage", "")
-	compCoverage := req.GetString("component_coverage", "")
-	consistencyIssues := req.GetString("consistency_issues", "")
-	riskAssessment := req.GetString("risk_assessment", "")
-	verdict := req.GetString("verdict", "")
-	recommendations := req.GetString("recommendations", "")
-	designQuality := req.GetString("design_quality", "")
-
-	// Validate required fields.
-	if reqCoverage == "" {
-		return mcp.NewToolResultError("'requirements_coverage' is required — analyze requirement-to-task traceability"), nil
+	params := ValidateParams{
+		RequirementsCoverage: req.GetString("requirements_coverage", ""),
+		ComponentCoverage:    req.GetString("component_coverage", ""),
+		ConsistencyIssues:    req.GetString("consistency_issues", ""),
+		RiskAssessment:       req.GetString("risk_assessment", ""),
+		Verdict:              req.GetString("verdict", ""),
+		Recommendations:      req.GetString("recommendations", ""),
+		DesignQuality:        req.GetString("design_quality", ""),
+		Strict:               req.GetBool("strict", false),
+		SaveChecklist:        req.GetBool("save_checklist", false),
+		Actor:                requestActor(ctx, req),
 	}
-	if compCoverage == "" {
-		return mcp.NewToolResultError("'component_coverage' is required — analyze component-to-task coverage"), nil
+	checklist, err := requestChecklist(req)
+	if err != nil {
+		return toolResult("", err)
 	}
-	if consistencyIssues == "" {
-		return mcp.NewToolResultError("'consistency_issues' is required — list cross-artifact inconsistencies (or '_None found._')"), nil
+	params.Checklist = checklist
+
+	projectRoot, err := findProjectRoot()
+	if err != nil {
+		return nil, fmt.Errorf("finding project root: %w", err)
 	}
-	if verdict == "" {
-		return mcp.NewToolResultError("'verdict' is required — must be 'PASS', 'PASS_WITH_WARNINGS', or 'FAIL'"), nil
+	return stageToolResult(t.run(ctx, projectRoot, params, requestProgress(ctx, req)))
+}
+
+// Run writes the validation report for the project at projectRoot and
+// completes the pipeline — unless strict mode finds blocking gaps, in
+// which case the report is written but the stage stays in progress.
+func (t *ValidateTool) Run(projectRoot string, params ValidateParams) (*StageResult, error) {
+	return t.run(context.Background(), projectRoot, params, nil)
+}
+
+// run is Run with the coverage analysis reported through progress and
+// abandoned, before anything is written, once ctx is done.
+func (t *ValidateTool) run(ctx context.Context, projectRoot string, params ValidateParams, progress progressFunc) (*StageResult, error) {
+	reqCoverage := params.RequirementsCoverage
+	compCoverage := params.ComponentCoverage
+	consistencyIssues := params.ConsistencyIssues
+	riskAssessment := params.RiskAssessment
+	verdict := params.Verdict
+	recommendations := params.Recommendations
+	designQuality := params.DesignQuality
+	strict := params.Strict
+
+	// Validate required fields — before the load error, so a bad call is
+	// reported as such even outside a project.
+	cfg, loadErr := t.store.Load(projectRoot)
+	if err := checkRequiredFields(cfg, config.StageValidate, map[string]string{
+		"requirements_coverage": reqCoverage,
+		"component_coverage":    compCoverage,
+		"consistency_issues":    consistencyIssues,
+		"risk_assessment":       riskAssessment,
+		"verdict":               verdict,
+		"recommendations":       recommendations,
+		"design_quality":        designQuality,
+	}); err != nil {
+		return nil, err
 	}
 
 	// Validate verdict value.
 	verdictUpper := strings.ToUpper(strings.TrimSpace(verdict))
 	if verdictUpper != "PASS" && verdictUpper != "PASS_WITH_WARNINGS" && verdictUpper != "FAIL" {
-		return mcp.NewToolResultError(
-			"'verdict' must be 'PASS', 'PASS_WITH_WARNINGS', or 'FAIL' — got: " + verdict,
-		), nil
+		return nil, newUserError("'verdict' must be 'PASS', 'PASS_WITH_WARNINGS', or 'FAIL' — got: " + verdict)
 	}
 
-	projectRoot, err := findProjectRoot()
-	if err != nil {
-		return nil, fmt.Errorf("finding project root: %w", err)
+	if loadErr != nil {
+		return nil, asUserError(loadErr)
 	}
 
-	cfg, err := t.store.Load(projectRoot)
+	checklist, err := resolveChecklist(cfg.Checklist, params.Checklist)
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return nil, err
 	}
 
 	// Validate we're at the right stage.
 	if err := pipeline.RequireStage(cfg, config.StageValidate); err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
-	}
-
-	// Verify all previous artifacts exist.
-	for _, stage := range []config.Stage{
-		config.StagePrinciples,
-		config.StageCharter,
-		config.StageSpecify,
-		config.StageClarify,
-		config.StageDesign,
-		config.StageTasks,
-	} {
-		path := config.StagePath(projectRoot, stage)
-		content, err := readStageFile(path)
-		if err != nil {
-			return nil, fmt.Errorf("reading %s artifact: %w", stage, err)
-		}
-		if content == "" {
-			return mcp.NewToolResultError(
-				fmt.Sprintf("%s is empty — all previous stages must be completed before validation", config.StageFilename(stage)),
-			), nil
-		}
+		return nil, asUserError(err)
+	}
+
+	// Verify all previous artifacts exist (including enabled optional stages).
+	if err := pipeline.RequireArtifacts(projectRoot, pipeline.PrerequisiteArtifacts(cfg, config.StageValidate)...); err != nil {
+		return nil, asUserError(err)
+	}
+
+	requirements, err := readStageArtifacts(projectRoot, cfg, config.StageSpecify)
+	if err != nil {
+		return nil, fmt.Errorf("reading requirements: %w", err)
+	}
+	tasks, err := readStageFile(config.StagePath(projectRoot, config.StageTasks))
+	if err != nil {
+		return nil, fmt.Errorf("reading tasks: %w", err)
+	}
+
+	design, err := readStageFile(config.StagePath(projectRoot, config.StageDesign))
+	if err != nil {
+		return nil, fmt.Errorf("reading design: %w", err)
+	}
+
+	coverage, err := analyzeCoverage(ctx, requirements, tasks, progress)
+	if err != nil {
+		return nil, err
+	}
+	techConflicts := techstack.Detect(markdownSections(design)["Tech Stack"], tasks)
+	cycle := findDependencyCycle(parseTaskDependencies(tasks))
+	var blockers []string
+	if strict {
+		blockers = strictBlockers(coverage, cycle)
+	}
+
+	// A failed mandatory checklist item caps the verdict at PASS_WITH_WARNINGS.
+	checklistFailed := checklistFailures(checklist)
+	downgraded := ""
+	if verdictUpper == "PASS" && len(checklistFailed) > 0 {
+		verdictUpper = "PASS_WITH_WARNINGS"
+		downgraded = fmt.Sprintf("⚠️ **Verdict lowered from PASS:** mandatory checklist item(s) failed or unanswered: %s\n\n",
+			strings.Join(checklistFailed, "; "))
 	}
 
 	pipeline.MarkInProgress(cfg)
 
+	nudge := recommendedFieldsNote(cfg, config.StageValidate, map[string]string{
+		"risk_assessment": riskAssessment,
+		"recommendations": recommendations,
+		"design_quality":  designQuality,
+	})
+
 	// Fill optional fields with defaults.
 	if riskAssessment == "" {
 		riskAssessment = "_No specific risks identified._"
@@ -182,15 +275,44 @@ func (t *ValidateTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mc
 	// Build the validation report.
 	var sb strings.Builder
 	fmt.Fprintf(&sb, "# %s — Validation Report\n\n", cfg.Name)
-	sb.WriteString("> Generated by [SDD-Hoffy](https://github.com/HendryAvila/Hoofy) | Stage 6: Validate\n\n")
+	if attribution := attributionFor(cfg); attribution != "" {
+		fmt.Fprintf(&sb, "> %s | Stage 6: Validate\n\n", attribution)
+	} else {
+		sb.WriteString("> Stage 6: Validate\n\n")
+	}
 	fmt.Fprintf(&sb, "## Verdict: %s\n\n", verdictUpper)
+	var skipped []string
+	for _, stage := range config.StageOrderFor(cfg) {
+		if pipeline.IsSkipped(cfg, stage) {
+			skipped = append(skipped, config.Stages[stage].Name)
+		}
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintf(&sb, "_Skipped stages, validated without an artifact: %s._\n\n", strings.Join(skipped, ", "))
+	}
 	sb.WriteString("---\n\n")
 	sb.WriteString("## Requirements Coverage\n\n")
 	sb.WriteString(reqCoverage)
-	sb.WriteString("\n\n## Component Coverage\n\n")
+	sb.WriteString("\n\n")
+	sb.WriteString(coverage.format())
+	if len(cycle) > 0 {
+		fmt.Fprintf(&sb, "\n### Dependency Cycle\n\n- %s\n", strings.Join(cycle, " → "))
+	}
+	if strict {
+		sb.WriteString(formatStrictGate(blockers))
+	}
+	sb.WriteString("\n## Component Coverage\n\n")
 	sb.WriteString(compCoverage)
 	sb.WriteString("\n\n## Consistency Issues\n\n")
 	sb.WriteString(consistencyIssues)
+	sb.WriteString("\n")
+	if unknown := unknownTaskComponents(design, tasks); len(unknown) > 0 {
+		sb.WriteString("\n### Unknown Components\n\nTasks name components design.md doesn't define:\n\n")
+		sb.WriteString(formatUnknownComponents(unknown, design))
+	}
+	sb.WriteString(formatTechStackCheck(techConflicts))
+	sb.WriteString(formatGateProvenance(cfg))
+	sb.WriteString(formatChecklist(checklist))
 	sb.WriteString("\n\n## Risk Assessment\n\n")
 	sb.WriteString(riskAssessment)
 	sb.WriteString("\n\n## Design Quality\n\n")
@@ -200,16 +322,55 @@ func (t *ValidateTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mc
 
 	content := sb.String()
 
-	// Write the validation report.
+	// Archive the previous report before overwriting it, and record this
+	// run's verdict so the trend stays readable from hoofy.json.
 	validatePath := config.StagePath(projectRoot, config.StageValidate)
+	archived, err := archiveValidationReport(projectRoot, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if archived != "" {
+		// The archive path is relative to the docs directory, which may
+		// not be docs/.
+		archived = filepathRel(projectRoot, filepath.Join(config.DocsPath(projectRoot), filepath.FromSlash(archived)))
+	}
+	trend := formatVerdictTrend(cfg.ValidationRuns, verdictUpper, archived)
+	if params.SaveChecklist {
+		cfg.Checklist = checklistTemplate(checklist)
+	}
+	cfg.ValidationRuns = append(cfg.ValidationRuns, config.ValidationRun{
+		Verdict: verdictUpper,
+		At:      pipeline.Now(),
+		Blocked: len(blockers) > 0,
+	})
+
+	// Write the validation report.
 	if err := writeStageFile(validatePath, content); err != nil {
 		return nil, fmt.Errorf("writing validation report: %w", err)
 	}
 
+	// Strict mode: the report is written, but the stage stays in progress.
+	if len(blockers) > 0 {
+		if err := t.store.Save(projectRoot, cfg); err != nil {
+			return nil, fmt.Errorf("saving config: %w", err)
+		}
+		return &StageResult{Content: content, Config: cfg, Response: fmt.Sprintf(
+			"# Validation Blocked (strict mode)\n\n"+
+				"**Verdict submitted:** %s — overridden by the automated check.\n\n"+
+				"%s"+
+				"The validate stage stays **in_progress** until these are fixed:\n\n- %s\n\n"+
+				"Report saved to `docs/validation.md`.\n\n"+
+				"**Next:** Update tasks with sdd_create_tasks (or requirements) to close the gaps, "+
+				"then re-run sdd_validate with strict=true.",
+			verdictUpper, trend, strings.Join(blockers, "\n- "),
+		)}, nil
+	}
+
 	// Mark the final stage as completed (no Advance — this IS the last stage).
 	st := cfg.StageStatus[config.StageValidate]
 	st.Status = "completed"
 	st.CompletedAt = pipeline.Now()
+	st.CompletedBy = pipeline.ActorOrUnknown(params.Actor)
 	cfg.StageStatus[config.StageValidate] = st
 
 	if err := t.store.Save(projectRoot, cfg); err != nil {
@@ -222,43 +383,252 @@ func (t *ValidateTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mc
 	var nextStep string
 	switch verdictUpper {
 	case "PASS":
-		nextStep = "## 🎉 SDD Pipeline Complete!\n\n" +
-			"All specifications are consistent and ready for implementation.\n\n" +
-			"**Your SDD artifacts:**\n" +
-			"- `docs/principles.md` — Golden invariants and coding standards\n" +
-			"- `docs/charter.md` — What we're building and why\n" +
-			"- `docs/requirements.md` — Formal requirements (MoSCoW)\n" +
-			"- `docs/clarifications.md` — Resolved ambiguities\n" +
-			"- `docs/design.md` — Technical architecture\n" +
-			"- `docs/tasks.md` — Implementation task breakdown\n" +
-			"- `docs/validation.md` — This consistency report\n\n" +
-			"**Next:** Use these specs with your AI coding tool's `/plan mode` to start implementation. " +
-			"The specs will dramatically reduce hallucinations because every requirement is clear, " +
-			"traced to a task, and architecturally grounded."
+		nextStep = msg(cfg, "validate.pass")
 	case "PASS_WITH_WARNINGS":
-		nextStep = "## ⚠️ SDD Pipeline Complete (with warnings)\n\n" +
-			"Specifications are usable but have minor gaps. " +
-			"Track the warnings during implementation.\n\n" +
-			"**Recommendations:**\n\n" + recommendations + "\n\n" +
-			"**Next:** You can proceed to implementation, but keep an eye on the flagged issues."
+		nextStep = msg(cfg, "validate.pass_with_warnings", recommendations)
 	case "FAIL":
-		nextStep = "## ❌ Validation Failed\n\n" +
-			"Critical gaps or inconsistencies were found. " +
-			"Implementation would likely produce incorrect results.\n\n" +
-			"**Required actions:**\n\n" + recommendations + "\n\n" +
-			"**Next:** Revisit the stages mentioned above to fix the issues, " +
-			"then re-run validation."
-	}
-
-	response := fmt.Sprintf(
-		"# Validation Report\n\n"+
-			"**Verdict:** %s\n\n"+
-			"Saved to `docs/validation.md`\n\n"+
-			"## Summary\n\n%s\n\n"+
-			"---\n\n"+
-			"%s",
-		verdictUpper, content, nextStep,
-	)
+		nextStep = msg(cfg, "validate.fail", recommendations)
+	}
 
-	return mcp.NewToolResultText(response), nil
+	warnings := downgraded
+	if stale := requirementsChangedSinceDesign(cfg, requirements); stale != "" {
+		warnings += stale + "\n\n"
+	}
+	if len(coverage.NFRUncovered) > 0 {
+		warnings += fmt.Sprintf("⚠️ **%d NFR(s) have no task:** %s\n\n",
+			len(coverage.NFRUncovered), strings.Join(coverage.NFRUncovered, ", "))
+	}
+	if len(techConflicts) > 0 {
+		warnings += fmt.Sprintf("⚠️ **%d tech stack mismatch(es)** between design and tasks — see Consistency Issues.\n\n",
+			len(techConflicts))
+	}
+
+	response := msg(cfg, "validate.report", verdictUpper, trend, warnings, content, nextStep)
+	response += nudge
+
+	return &StageResult{Content: content, Config: cfg, Response: response}, nil
+}
+
+// archiveValidationReport copies the current validation report, if any,
+// to history/validation-<timestamp>.md under the docs directory, stamped
+// with the run that produced it, and records the copy on that run.
+// Returns the archive path relative to the docs directory ("" when there
+// was no report).
+func archiveValidationReport(projectRoot string, cfg *config.ProjectConfig) (string, error) {
+	previous, err := readStageFile(config.StagePath(projectRoot, config.StageValidate))
+	if err != nil {
+		return "", fmt.Errorf("reading previous validation report: %w", err)
+	}
+	if previous == "" {
+		return "", nil
+	}
+
+	stamp := timeNow().UTC()
+	var last *config.ValidationRun
+	if n := len(cfg.ValidationRuns); n > 0 {
+		last = &cfg.ValidationRuns[n-1]
+		if at, err := time.Parse(time.RFC3339, last.At); err == nil {
+			stamp = at
+		}
+	}
+	// Runs within the same second share a stamp; never overwrite an
+	// earlier archive.
+	base := "history/validation-" + stamp.Format("20060102T150405Z")
+	rel := base + ".md"
+	for n := 2; fileExists(filepath.Join(config.DocsPath(projectRoot), filepath.FromSlash(rel))); n++ {
+		rel = fmt.Sprintf("%s-%d.md", base, n)
+	}
+	if err := writeStageFile(filepath.Join(config.DocsPath(projectRoot), filepath.FromSlash(rel)), previous); err != nil {
+		return "", fmt.Errorf("archiving validation report: %w", err)
+	}
+	if last != nil {
+		last.Report = rel
+	}
+	return rel, nil
+}
+
+// formatVerdictTrend compares verdict with the recorded runs, e.g.
+// "Previous verdict: FAIL (2 runs ago) → now PASS". It reports the most
+// recent run whose verdict differs, or that the verdict is unchanged.
+// archived is where the previous report was moved, relative to the
+// project root, if anywhere.
+func formatVerdictTrend(runs []config.ValidationRun, verdict, archived string) string {
+	if len(runs) == 0 {
+		return ""
+	}
+	line := fmt.Sprintf("**Previous verdict:** %s (last run) — unchanged\n\n", verdict)
+	for i := len(runs) - 1; i >= 0; i-- {
+		if runs[i].Verdict != verdict {
+			line = fmt.Sprintf("**Previous verdict:** %s (%s) → now %s\n\n",
+				runs[i].Verdict, runsAgo(len(runs)-i), verdict)
+			break
+		}
+	}
+	if archived != "" {
+		line += fmt.Sprintf("Previous report archived to `%s`.\n\n", archived)
+	}
+	return line
+}
+
+// runsAgo renders how many validate runs back a run was.
+func runsAgo(n int) string {
+	if n == 1 {
+		return "last run"
+	}
+	return fmt.Sprintf("%d runs ago", n)
+}
+
+// formatTechStackCheck renders the automated design-vs-tasks technology
+// comparison for the report.
+func formatTechStackCheck(conflicts []techstack.Conflict) string {
+	var sb strings.Builder
+	sb.WriteString("\n### Tech Stack Check (automated)\n\n")
+	if len(conflicts) == 0 {
+		sb.WriteString("_No task mentions a technology that competes with the design's tech stack._\n")
+		return sb.String()
+	}
+	for _, c := range conflicts {
+		fmt.Fprintf(&sb, "- %s\n", c)
+	}
+	return sb.String()
+}
+
+// formatGateProvenance reports how the Clarity Gate was passed, from the
+// audit record saved when the pipeline left clarify, so a reviewer can
+// see the scores and evidence behind it — or that there is no record at
+// all.
+func formatGateProvenance(cfg *config.ProjectConfig) string {
+	var sb strings.Builder
+	sb.WriteString("\n## Clarity Gate Provenance\n\n")
+	pass := cfg.ClarityGatePassed
+	if pass == nil {
+		sb.WriteString("⚠️ No record of the Clarity Gate being passed through the pipeline — " +
+			"the clarity score was set outside it.\n")
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, "Passed %s in round %d with **%d/100** (threshold %d, %s mode).\n\n",
+		pass.At, pass.Round, pass.Score, pass.Threshold, pass.Mode)
+	dimensions := make([]pipeline.ClarityDimension, len(pass.Dimensions))
+	noEvidence := 0
+	for i, d := range pass.Dimensions {
+		dimensions[i] = pipeline.ClarityDimension{Name: d.Name, Score: d.Score, Justification: d.Evidence}
+		if d.Evidence == "" {
+			noEvidence++
+		}
+	}
+	if len(dimensions) == 0 {
+		sb.WriteString("_No dimension scores were recorded with the pass._\n")
+		return sb.String()
+	}
+	sb.WriteString(formatDimensionScores(dimensions))
+	if noEvidence > 0 {
+		fmt.Fprintf(&sb, "\n_%d of %d dimensions were scored without evidence._\n", noEvidence, len(dimensions))
+	}
+	return sb.String()
+}
+
+// strictBlockers lists the automated-check failures that stop strict
+// mode from completing the validate stage.
+func strictBlockers(coverage requirementCoverage, cycle []string) []string {
+	var blockers []string
+	if len(coverage.FRUncovered) > 0 {
+		blockers = append(blockers, fmt.Sprintf("%d FR(s) have no task: %s",
+			len(coverage.FRUncovered), strings.Join(coverage.FRUncovered, ", ")))
+	}
+	if len(coverage.NFRUncovered) > 0 {
+		blockers = append(blockers, fmt.Sprintf("%d NFR(s) have no task: %s",
+			len(coverage.NFRUncovered), strings.Join(coverage.NFRUncovered, ", ")))
+	}
+	if len(cycle) > 0 {
+		blockers = append(blockers, "circular task dependency: "+strings.Join(cycle, " → "))
+	}
+	return blockers
+}
+
+// formatStrictGate renders the strict-mode outcome for the report.
+func formatStrictGate(blockers []string) string {
+	if len(blockers) == 0 {
+		return "\n## Strict Gate: PASSED\n\n_Every requirement is covered and task dependencies are acyclic._\n"
+	}
+	var sb strings.Builder
+	sb.WriteString("\n## Strict Gate: BLOCKED\n\n")
+	for _, b := range blockers {
+		fmt.Fprintf(&sb, "- %s\n", b)
+	}
+	return sb.String()
+}
+
+// requirementCoverage is the mechanical requirement-to-task coverage
+// computed from requirements.md and tasks.md, split by FR and NFR so an
+// uncovered NFR can't hide behind a healthy overall percentage.
+type requirementCoverage struct {
+	FRTotal      int
+	NFRTotal     int
+	FRUncovered  []string
+	NFRUncovered []string
+}
+
+// analyzeCoverage reports which requirement IDs defined in requirements
+// are never referenced in tasks, reporting "analyzed N/M requirements"
+// through progress as it goes. Deferred requirements (see
+// sdd_defer_requirement) need no task and are left out. It stops with
+// ctx's error once ctx is done.
+func analyzeCoverage(ctx context.Context, requirementsDoc, tasks string, progress progressFunc) (requirementCoverage, error) {
+	referenced := make(map[string]bool)
+	for _, id := range extractRequirementIDs(tasks) {
+		referenced[id] = true
+	}
+
+	var c requirementCoverage
+	deferred := requirements.DeferredIDs(requirementsDoc)
+	ids := slices.DeleteFunc(extractRequirementIDs(requirementsDoc), func(id string) bool { return deferred[id] })
+	progress = progress.every(len(ids))
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return requirementCoverage{}, err
+		}
+		if isNFR(id) {
+			c.NFRTotal++
+			if !referenced[id] {
+				c.NFRUncovered = append(c.NFRUncovered, id)
+			}
+		} else {
+			c.FRTotal++
+			if !referenced[id] {
+				c.FRUncovered = append(c.FRUncovered, id)
+			}
+		}
+		progress.report(i+1, len(ids), fmt.Sprintf("analyzed %d/%d requirements", i+1, len(ids)))
+	}
+	return c, nil
+}
+
+// format renders the coverage as the report's automated check section.
+func (c requirementCoverage) format() string {
+	var sb strings.Builder
+	sb.WriteString("## Automated Coverage Check\n\n")
+	sb.WriteString("_Computed from requirement IDs referenced in tasks.md._\n\n")
+	fmt.Fprintf(&sb, "- **Functional (FR):** %d/%d covered\n",
+		c.FRTotal-len(c.FRUncovered), c.FRTotal)
+	fmt.Fprintf(&sb, "- **Non-Functional (NFR):** %d/%d covered\n\n",
+		c.NFRTotal-len(c.NFRUncovered), c.NFRTotal)
+
+	if len(c.FRUncovered) > 0 {
+		sb.WriteString("### FR Uncovered\n\n")
+		for _, id := range c.FRUncovered {
+			fmt.Fprintf(&sb, "- %s\n", id)
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("### NFR Uncovered\n\n")
+	if len(c.NFRUncovered) == 0 {
+		sb.WriteString("_Every NFR is referenced by at least one task._\n")
+	} else {
+		for _, id := range c.NFRUncovered {
+			fmt.Fprintf(&sb, "- %s — no task addresses this non-functional requirement\n", id)
+		}
+	}
+	return sb.String()
 }
@@ -0,0 +1,183 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Checklist statuses accepted by sdd_validate.
+const (
+	ChecklistPass = "pass"
+	ChecklistFail = "fail"
+	ChecklistNA   = "na"
+)
+
+// ChecklistResult is the answer to one release checklist item in a
+// validate run. Status is ChecklistPass, ChecklistFail or ChecklistNA;
+// it is empty only for a project checklist item the run left unanswered.
+type ChecklistResult struct {
+	Item      string
+	Status    string
+	Mandatory bool
+	Note      string
+}
+
+// checklistParam is the sdd_validate "checklist" argument.
+func checklistParam() mcp.ToolOption {
+	return mcp.WithArray("checklist",
+		mcp.Description("Answers to your organization's release checklist — gates such as "+
+			"'Security review done' or 'Load test planned'. Each item is "+
+			"{\"item\": ..., \"status\": \"pass\"|\"fail\"|\"na\", \"mandatory\": bool, \"note\": ...}. "+
+			"Answer every item of the project's saved checklist (hoofy.json 'checklist'); extra items are allowed. "+
+			"A failed or unanswered mandatory item turns a PASS verdict into PASS_WITH_WARNINGS. "+
+			"The checklist is rendered into the validation report."),
+		mcp.Items(map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"item":      map[string]any{"type": "string"},
+				"status":    map[string]any{"type": "string", "enum": []string{ChecklistPass, ChecklistFail, ChecklistNA}},
+				"mandatory": map[string]any{"type": "boolean"},
+				"note":      map[string]any{"type": "string"},
+			},
+			"required": []string{"item", "status"},
+		}),
+	)
+}
+
+// requestChecklist reads the "checklist" argument. The values are
+// checked later by resolveChecklist, so sdd.Engine gets the same checks.
+func requestChecklist(req mcp.CallToolRequest) ([]ChecklistResult, error) {
+	raw, ok := req.GetArguments()["checklist"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, toolError(CodeInvalidInput, "'checklist' must be a list of {item, status} objects")
+	}
+	results := make([]ChecklistResult, 0, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, toolError(CodeInvalidInput, fmt.Sprintf("checklist[%d] must be an {item, status} object", i))
+		}
+		var r ChecklistResult
+		r.Item, _ = obj["item"].(string)
+		r.Status, _ = obj["status"].(string)
+		r.Mandatory, _ = obj["mandatory"].(bool)
+		r.Note, _ = obj["note"].(string)
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// resolveChecklist checks a run's answers and merges them with the
+// project's checklist: project items first, in their order and with
+// their mandatory flag, then the run's extra items. Project items the
+// run didn't answer are kept with an empty Status. Every bad answer is
+// reported at once.
+func resolveChecklist(project []config.ChecklistItem, answers []ChecklistResult) ([]ChecklistResult, error) {
+	var problems []string
+	byKey := make(map[string]int, len(answers))
+	for i := range answers {
+		a := &answers[i]
+		a.Item = strings.TrimSpace(a.Item)
+		a.Note = strings.TrimSpace(a.Note)
+		a.Status = strings.ToLower(strings.TrimSpace(a.Status))
+		if a.Status == "n/a" {
+			a.Status = ChecklistNA
+		}
+		key := strings.ToLower(a.Item)
+		switch {
+		case a.Item == "":
+			problems = append(problems, fmt.Sprintf("checklist[%d]: 'item' is required", i))
+		case byKey[key] > 0:
+			problems = append(problems, fmt.Sprintf("checklist[%d]: %q is answered twice", i, a.Item))
+		default:
+			byKey[key] = i + 1
+		}
+		if a.Status != ChecklistPass && a.Status != ChecklistFail && a.Status != ChecklistNA {
+			problems = append(problems, fmt.Sprintf("checklist[%d]: 'status' must be pass, fail or na — got %q", i, a.Status))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, toolError(CodeInvalidInput, "invalid checklist:\n- "+strings.Join(problems, "\n- "))
+	}
+
+	merged := make([]ChecklistResult, 0, len(project)+len(answers))
+	used := make([]bool, len(answers))
+	for _, item := range project {
+		r := ChecklistResult{Item: strings.TrimSpace(item.Item), Mandatory: item.Mandatory}
+		if i := byKey[strings.ToLower(r.Item)]; i > 0 {
+			a := answers[i-1]
+			used[i-1] = true
+			r.Status, r.Note = a.Status, a.Note
+			r.Mandatory = r.Mandatory || a.Mandatory
+		}
+		merged = append(merged, r)
+	}
+	for i, a := range answers {
+		if !used[i] {
+			merged = append(merged, a)
+		}
+	}
+	return merged, nil
+}
+
+// checklistFailures returns the mandatory items that failed or went
+// unanswered — the ones that keep a verdict from being PASS.
+func checklistFailures(results []ChecklistResult) []string {
+	var failed []string
+	for _, r := range results {
+		if r.Mandatory && (r.Status == ChecklistFail || r.Status == "") {
+			failed = append(failed, r.Item)
+		}
+	}
+	return failed
+}
+
+// checklistTemplate returns results as a project checklist, for
+// save_checklist.
+func checklistTemplate(results []ChecklistResult) []config.ChecklistItem {
+	items := make([]config.ChecklistItem, 0, len(results))
+	for _, r := range results {
+		items = append(items, config.ChecklistItem{Item: r.Item, Mandatory: r.Mandatory})
+	}
+	return items
+}
+
+// formatChecklist renders the report's "Release Checklist" section, or
+// "" when there is no checklist.
+func formatChecklist(results []ChecklistResult) string {
+	if len(results) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("\n\n## Release Checklist\n\n")
+	sb.WriteString("| Item | Mandatory | Status | Note |\n|------|-----------|--------|------|\n")
+	for _, r := range results {
+		mandatory := "no"
+		if r.Mandatory {
+			mandatory = "yes"
+		}
+		status := map[string]string{
+			ChecklistPass: "PASS",
+			ChecklistFail: "FAIL",
+			ChecklistNA:   "N/A",
+		}[r.Status]
+		if status == "" {
+			status = "not answered"
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n",
+			tableCell(r.Item), mandatory, status, tableCell(r.Note))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// tableCell makes s safe inside a markdown table cell.
+func tableCell(s string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(s), " "), "|", `\|`)
+}
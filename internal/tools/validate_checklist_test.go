@@ -0,0 +1,140 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// checklistValidateRequest is a PASS validate call with the given checklist.
+func checklistValidateRequest(checklist []any, extra map[string]any) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"requirements_coverage": "**Covered (1/1)**:\n- FR-001 → TASK-001",
+		"component_coverage":    "**Covered**:\n- AuthModule → TASK-001",
+		"consistency_issues":    "_None found._",
+		"verdict":               "PASS",
+		"checklist":             checklist,
+	}
+	for k, v := range extra {
+		req.Params.Arguments.(map[string]any)[k] = v
+	}
+	return req
+}
+
+func TestValidateTool_Handle_FailedMandatoryChecklistDowngradesPass(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	result, err := NewValidateTool(store).Handle(context.Background(), checklistValidateRequest([]any{
+		map[string]any{"item": "Security review done", "status": "fail", "mandatory": true, "note": "Booked for | Friday"},
+		map[string]any{"item": "Load test planned", "status": "pass"},
+	}, nil))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if isErrorResult(result) {
+		t.Fatalf("unexpected error: %s", getResultText(result))
+	}
+
+	text := getResultText(result)
+	if !strings.Contains(text, "Verdict lowered from PASS") || !strings.Contains(text, "Security review done") {
+		t.Errorf("response should explain the downgrade:\n%s", text)
+	}
+	report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+	for _, want := range []string{
+		"## Verdict: PASS_WITH_WARNINGS",
+		"## Release Checklist",
+		"| Security review done | yes | FAIL | Booked for \\| Friday |",
+		"| Load test planned | no | PASS |  |",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report missing %q:\n%s", want, report)
+		}
+	}
+	cfg, _ := store.Load(tmpDir)
+	if got := cfg.ValidationRuns[len(cfg.ValidationRuns)-1].Verdict; got != "PASS_WITH_WARNINGS" {
+		t.Errorf("recorded verdict = %s, want PASS_WITH_WARNINGS", got)
+	}
+}
+
+func TestValidateTool_Handle_ChecklistNonMandatoryFailKeepsPass(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	result, err := NewValidateTool(config.NewFileStore()).Handle(context.Background(), checklistValidateRequest([]any{
+		map[string]any{"item": "Docs site updated", "status": "fail"},
+		map[string]any{"item": "Security review done", "status": "N/A", "mandatory": true},
+	}, nil))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+	if !strings.Contains(report, "## Verdict: PASS\n") || !strings.Contains(report, "| Security review done | yes | N/A |") {
+		t.Errorf("optional failures and N/A items should keep PASS:\n%s", report)
+	}
+}
+
+func TestValidateTool_Handle_SavedChecklist(t *testing.T) {
+	tmpDir, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	store := config.NewFileStore()
+	result, err := NewValidateTool(store).Handle(context.Background(), checklistValidateRequest([]any{
+		map[string]any{"item": "Security review done", "status": "pass", "mandatory": true},
+		map[string]any{"item": "Load test planned", "status": "pass"},
+	}, map[string]any{"save_checklist": true}))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("Handle: %v %s", err, getResultText(result))
+	}
+	cfg, _ := store.Load(tmpDir)
+	want := []config.ChecklistItem{{Item: "Security review done", Mandatory: true}, {Item: "Load test planned"}}
+	if len(cfg.Checklist) != 2 || cfg.Checklist[0] != want[0] || cfg.Checklist[1] != want[1] {
+		t.Fatalf("saved checklist = %+v, want %+v", cfg.Checklist, want)
+	}
+
+	// A re-run that skips the saved mandatory item can't PASS.
+	result, err = NewValidateTool(store).Handle(context.Background(), checklistValidateRequest([]any{
+		map[string]any{"item": "load test planned", "status": "pass"},
+	}, nil))
+	if err != nil || isErrorResult(result) {
+		t.Fatalf("re-run: %v %s", err, getResultText(result))
+	}
+	report, _ := readStageFile(config.StagePath(tmpDir, config.StageValidate))
+	for _, want := range []string{
+		"## Verdict: PASS_WITH_WARNINGS",
+		"| Security review done | yes | not answered |",
+		"| Load test planned | no | PASS |",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report missing %q:\n%s", want, report)
+		}
+	}
+}
+
+func TestValidateTool_Handle_InvalidChecklist(t *testing.T) {
+	_, cleanup := setupValidateProject(t)
+	defer cleanup()
+
+	result, err := NewValidateTool(config.NewFileStore()).Handle(context.Background(), checklistValidateRequest([]any{
+		map[string]any{"item": "Security review done", "status": "maybe"},
+		map[string]any{"item": "security review done", "status": "pass"},
+		map[string]any{"status": "pass"},
+	}, nil))
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	text := getResultText(result)
+	if !isErrorResult(result) {
+		t.Fatalf("expected an error, got %s", text)
+	}
+	for _, want := range []string{`got "maybe"`, "answered twice", "checklist[2]: 'item' is required"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("error missing %q:\n%s", want, text)
+		}
+	}
+}
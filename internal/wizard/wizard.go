@@ -0,0 +1,368 @@
+// Package wizard walks the SDD pipeline interactively on a terminal, so
+// specs can be authored by hand without an AI client.
+//
+// Every answer goes through sdd.Engine — the same stage logic the sdd_*
+// MCP tools run — so the wizard writes identical artifacts and is held
+// to the same validation, stage order and Clarity Gate.
+package wizard
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/pipeline"
+	"github.com/HendryAvila/Hoofy/sdd"
+)
+
+// endOfAnswer is the line that ends a multi-line answer.
+const endOfAnswer = "."
+
+// field is one prompt of a stage: its answer is stored in dst.
+type field struct {
+	label    string
+	required bool
+	multi    bool // multi-line answer, ended by a "." line
+	dst      *string
+}
+
+// Wizard prompts for each pipeline stage on in and writes to out.
+type Wizard struct {
+	eng *sdd.Engine
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// New creates a Wizard for eng's project.
+func New(eng *sdd.Engine, in io.Reader, out io.Writer) *Wizard {
+	return &Wizard{eng: eng, in: bufio.NewReader(in), out: out}
+}
+
+// Run initializes the project if needed, then prompts through every
+// remaining stage until the pipeline is complete. A stage rejected by
+// the pipeline (a missing field, a failed Clarity Gate) is asked again;
+// Run returns on any other error, including the input ending early.
+func (w *Wizard) Run() error {
+	cfg, err := w.eng.State()
+	if errors.Is(err, config.ErrNotInitialized) {
+		cfg, err = w.initProject()
+	}
+	if err != nil {
+		return err
+	}
+
+	for {
+		order := config.StageOrderFor(cfg)
+		if pipeline.IsCompleted(cfg, order[len(order)-1]) {
+			fmt.Fprintf(w.out, "\n🎉 The pipeline is complete. Artifacts are in %s/.\n", w.rel(config.DocsPath(w.eng.Root())))
+			return nil
+		}
+
+		stage := cfg.CurrentStage
+		step, ok := w.steps()[stage]
+		if !ok {
+			return fmt.Errorf("the %s stage is not supported by the wizard — "+
+				"complete it with its sdd_* tool from an MCP client, then run the wizard again", stage)
+		}
+		fmt.Fprintf(w.out, "\n== %s (%s) ==\n", config.Stages[stage].Name, stage)
+
+		res, err := step()
+		if sdd.IsUserError(err) {
+			fmt.Fprintf(w.out, "\n⚠️  %v\nLet's go through this stage again.\n", err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if path := config.StagePath(w.eng.Root(), stage); res.Content != "" && path != "" {
+			fmt.Fprintf(w.out, "✅ Saved %s\n", w.rel(path))
+		}
+		cfg = res.Config
+	}
+}
+
+// initProject prompts for sdd_init_project's fields and creates the project.
+func (w *Wizard) initProject() (*sdd.ProjectConfig, error) {
+	fmt.Fprintf(w.out, "No SDD project in %s yet — let's create one.\n", w.eng.Root())
+	for {
+		var p sdd.InitParams
+		var mode string
+		if err := w.ask(
+			field{label: "Project name", required: true, dst: &p.Name},
+			field{label: "One-line description", required: true, dst: &p.Description},
+			field{label: "Mode (guided or expert, default guided)", dst: &mode},
+		); err != nil {
+			return nil, err
+		}
+		p.Mode = sdd.Mode(strings.ToLower(mode))
+
+		res, err := w.eng.Init(p)
+		if sdd.IsUserError(err) {
+			fmt.Fprintf(w.out, "\n⚠️  %v\n", err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return res.Config, nil
+	}
+}
+
+// steps maps each stage the wizard can author to the function that
+// prompts for it and runs it through the engine.
+func (w *Wizard) steps() map[config.Stage]func() (*sdd.Result, error) {
+	return map[config.Stage]func() (*sdd.Result, error){
+		config.StagePrinciples: func() (*sdd.Result, error) {
+			var d sdd.PrinciplesData
+			if err := w.ask(
+				field{label: "Principles — rules that must never be broken", required: true, multi: true, dst: &d.Principles},
+				field{label: "Coding standards", multi: true, dst: &d.CodingStandards},
+				field{label: "Domain truths", multi: true, dst: &d.DomainTruths},
+			); err != nil {
+				return nil, err
+			}
+			return w.eng.Principles(d)
+		},
+		config.StageCharter: func() (*sdd.Result, error) {
+			var d sdd.CharterData
+			if err := w.ask(
+				field{label: "Problem statement", required: true, multi: true, dst: &d.ProblemStatement},
+				field{label: "Target users", required: true, multi: true, dst: &d.TargetUsers},
+				field{label: "Proposed solution", required: true, multi: true, dst: &d.ProposedSolution},
+				field{label: "Success criteria", required: true, multi: true, dst: &d.SuccessCriteria},
+				field{label: "Domain context", multi: true, dst: &d.DomainContext},
+				field{label: "Stakeholders", multi: true, dst: &d.Stakeholders},
+				field{label: "Vision", multi: true, dst: &d.Vision},
+				field{label: "Boundaries — what is out of scope", multi: true, dst: &d.Boundaries},
+				field{label: "Existing systems", multi: true, dst: &d.ExistingSystems},
+				field{label: "Constraints", multi: true, dst: &d.Constraints},
+			); err != nil {
+				return nil, err
+			}
+			return w.eng.Propose(d)
+		},
+		config.StageSpecify: func() (*sdd.Result, error) {
+			var d sdd.RequirementsData
+			if err := w.ask(
+				field{label: "Must-have requirements (e.g. - **FR-001**: ...)", required: true, multi: true, dst: &d.MustHave},
+				field{label: "Should-have requirements", required: true, multi: true, dst: &d.ShouldHave},
+				field{label: "Could-have requirements", multi: true, dst: &d.CouldHave},
+				field{label: "Won't-have requirements", multi: true, dst: &d.WontHave},
+				field{label: "Non-functional requirements (e.g. - **NFR-001**: ...)", required: true, multi: true, dst: &d.NonFunctional},
+				field{label: "Constraints", multi: true, dst: &d.Constraints},
+				field{label: "Assumptions", multi: true, dst: &d.Assumptions},
+				field{label: "Dependencies", multi: true, dst: &d.Dependencies},
+			); err != nil {
+				return nil, err
+			}
+			return w.eng.Specify(d)
+		},
+		config.StageBusinessRules: func() (*sdd.Result, error) {
+			var d sdd.BusinessRulesData
+			if err := w.ask(
+				field{label: "Definitions — domain terms", required: true, multi: true, dst: &d.Definitions},
+				field{label: "Facts — relationships that are always true", required: true, multi: true, dst: &d.Facts},
+				field{label: "Constraints (When ... Then ... Otherwise ...)", required: true, multi: true, dst: &d.Constraints},
+				field{label: "Derivations", multi: true, dst: &d.Derivations},
+				field{label: "Glossary", multi: true, dst: &d.Glossary},
+			); err != nil {
+				return nil, err
+			}
+			return w.eng.BusinessRules(d)
+		},
+		config.StageClarify: w.clarify,
+		config.StageDesign: func() (*sdd.Result, error) {
+			var d sdd.DesignData
+			if err := w.ask(
+				field{label: "Architecture overview", required: true, multi: true, dst: &d.ArchitectureOverview},
+				field{label: "Tech stack", required: true, multi: true, dst: &d.TechStack},
+				field{label: "Components (name the requirements each covers)", required: true, multi: true, dst: &d.Components},
+				field{label: "Data model", required: true, multi: true, dst: &d.DataModel},
+				field{label: "API contracts", multi: true, dst: &d.APIContracts},
+				field{label: "Infrastructure", multi: true, dst: &d.Infrastructure},
+				field{label: "Security", multi: true, dst: &d.Security},
+				field{label: "Design decisions (### ADR-001: Title blocks)", multi: true, dst: &d.DesignDecisions},
+				field{label: "Quality analysis", multi: true, dst: &d.QualityAnalysis},
+			); err != nil {
+				return nil, err
+			}
+			return w.eng.Design(d)
+		},
+		config.StageTasks: func() (*sdd.Result, error) {
+			var d sdd.TasksData
+			if err := w.ask(
+				field{label: "Total number of tasks", required: true, dst: &d.TotalTasks},
+				field{label: "Estimated effort", required: true, dst: &d.EstimatedEffort},
+				field{label: "Tasks (### TASK-001: ... with **Covers**: FR-001)", required: true, multi: true, dst: &d.Tasks},
+				field{label: "Dependency graph", multi: true, dst: &d.DependencyGraph},
+				field{label: "Wave assignments", multi: true, dst: &d.WaveAssignments},
+				field{label: "Acceptance criteria", multi: true, dst: &d.AcceptanceCriteria},
+			); err != nil {
+				return nil, err
+			}
+			return w.eng.Tasks(d)
+		},
+		config.StageValidate: func() (*sdd.Result, error) {
+			var p sdd.ValidateParams
+			if err := w.ask(
+				field{label: "Requirements coverage", required: true, multi: true, dst: &p.RequirementsCoverage},
+				field{label: "Component coverage", required: true, multi: true, dst: &p.ComponentCoverage},
+				field{label: "Consistency issues (or _None found._)", required: true, multi: true, dst: &p.ConsistencyIssues},
+				field{label: "Risk assessment", multi: true, dst: &p.RiskAssessment},
+				field{label: "Recommendations", multi: true, dst: &p.Recommendations},
+				field{label: "Design quality", multi: true, dst: &p.DesignQuality},
+				field{label: "Verdict — PASS, PASS_WITH_WARNINGS or FAIL", required: true, dst: &p.Verdict},
+			); err != nil {
+				return nil, err
+			}
+			return w.eng.Validate(p)
+		},
+	}
+}
+
+// clarify runs one Clarity Gate round: it shows the questions, takes the
+// answers, and asks for a self-assessed score per clarity dimension.
+// The engine scores the round; below the threshold the stage stays at
+// clarify and Run asks again.
+func (w *Wizard) clarify() (*sdd.Result, error) {
+	framework, err := w.eng.Clarify(sdd.ClarifyParams{})
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(w.out, "\n%s\n", strings.TrimSpace(framework.Response))
+
+	var answers string
+	if err := w.ask(field{label: "Your answers to the questions above", required: true, multi: true, dst: &answers}); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(w.out, "\nRate how clearly the spec covers each dimension (0-100). "+
+		"The gate needs %d overall.\n", pipeline.ProjectClarityThreshold(framework.Config))
+	var scores []string
+	for _, dim := range pipeline.DefaultDimensions() {
+		score, err := w.askScore(dim)
+		if err != nil {
+			return nil, err
+		}
+		var evidence string
+		if err := w.ask(field{label: "  Evidence (optional)", dst: &evidence}); err != nil {
+			return nil, err
+		}
+		entry := fmt.Sprintf("%s:%d", dim.Name, score)
+		if evidence != "" {
+			entry += "|" + evidence
+		}
+		scores = append(scores, entry)
+	}
+
+	res, err := w.eng.Clarify(sdd.ClarifyParams{Answers: answers, DimensionScores: strings.Join(scores, ",")})
+	if err != nil {
+		return nil, err
+	}
+	if res.Config.CurrentStage == config.StageClarify {
+		fmt.Fprintf(w.out, "\n%s\n\n❌ Clarity score %d is below the gate — let's run another round.\n",
+			strings.TrimSpace(res.Response), res.Config.ClarityScore)
+	} else {
+		fmt.Fprintf(w.out, "\n✅ Clarity Gate passed with %d/100.\n", res.Config.ClarityScore)
+	}
+	return res, nil
+}
+
+// askScore prompts for dim's score until it gets a number from 0 to 100.
+func (w *Wizard) askScore(dim pipeline.ClarityDimension) (int, error) {
+	for {
+		var answer string
+		label := fmt.Sprintf("%s — %s", dim.Name, dim.Description)
+		if err := w.ask(field{label: label, required: true, dst: &answer}); err != nil {
+			return 0, err
+		}
+		score, err := strconv.Atoi(answer)
+		if err == nil && score >= 0 && score <= 100 {
+			return score, nil
+		}
+		fmt.Fprintln(w.out, "  Enter a whole number from 0 to 100.")
+	}
+}
+
+// ask prompts for each field in turn, repeating a required field until
+// it gets an answer.
+func (w *Wizard) ask(fields ...field) error {
+	for _, f := range fields {
+		hint := ""
+		if f.multi {
+			hint = fmt.Sprintf(" — end with a line containing only %q", endOfAnswer)
+			if !f.required {
+				hint += "; blank line to skip"
+			}
+		} else if !f.required {
+			hint = " — blank to skip"
+		}
+		for {
+			fmt.Fprintf(w.out, "%s%s:\n> ", f.label, hint)
+			answer, err := w.readAnswer(f.multi, f.required)
+			if err != nil {
+				return err
+			}
+			if answer != "" || !f.required {
+				*f.dst = answer
+				break
+			}
+			fmt.Fprintln(w.out, "  This field is required.")
+		}
+	}
+	return nil
+}
+
+// readAnswer reads one line, or for a multi-line answer every line up to
+// the endOfAnswer line. An optional multi-line answer whose first line is
+// blank is empty.
+func (w *Wizard) readAnswer(multi, required bool) (string, error) {
+	first, err := w.readLine()
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case first == endOfAnswer:
+		return "", nil
+	case !multi, !required && first == "":
+		return strings.TrimSpace(first), nil
+	}
+
+	lines := []string{first}
+	for {
+		line, err := w.readLine()
+		if err != nil {
+			return "", err
+		}
+		if line == endOfAnswer {
+			return strings.TrimSpace(strings.Join(lines, "\n")), nil
+		}
+		lines = append(lines, line)
+	}
+}
+
+// readLine reads one line of input without its line ending. The input
+// ending before a line is complete is an error: the wizard can't finish.
+func (w *Wizard) readLine() (string, error) {
+	line, err := w.in.ReadString('\n')
+	if errors.Is(err, io.EOF) && line == "" {
+		return "", fmt.Errorf("input ended before the wizard finished: %w", io.ErrUnexpectedEOF)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("reading input: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// rel returns path relative to the project root, for display.
+func (w *Wizard) rel(path string) string {
+	if rel, err := filepath.Rel(w.eng.Root(), path); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return path
+}
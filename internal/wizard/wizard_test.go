@@ -0,0 +1,111 @@
+package wizard
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/HendryAvila/Hoofy/sdd"
+)
+
+// skip answers n optional fields with blank lines.
+func skip(n int) []string { return make([]string, n) }
+
+// clarifyRound answers the questions and scores every dimension.
+func clarifyRound(score string) []string {
+	lines := []string{"Q: Offline? A: No.", "."}
+	for range 8 {
+		lines = append(lines, score, "")
+	}
+	return lines
+}
+
+func script(parts ...[]string) io.Reader {
+	var lines []string
+	for _, p := range parts {
+		lines = append(lines, p...)
+	}
+	return strings.NewReader(strings.Join(lines, "\n") + "\n")
+}
+
+func TestWizard_FullPipeline(t *testing.T) {
+	root := t.TempDir()
+	eng, err := sdd.New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := script(
+		[]string{"tracker", "Time tracking", "expert"},
+		[]string{"- Never lose a time entry", "."}, skip(2),
+		[]string{
+			"Freelancers lose billable hours", ".",
+			"Freelancers", ".",
+			"A web time tracker", ".",
+			"- 90% of hours logged", ".",
+		}, skip(6),
+		[]string{"- **FR-001**: Users can log time entries", ".", "- **FR-002**: Users can export CSV", "."},
+		skip(2),
+		[]string{"- **NFR-001**: Pages load in under 2s", "."}, skip(3),
+		[]string{
+			"- **Entry**: a logged block of time", ".",
+			"- Each Entry belongs to one User", ".",
+			"- When an Entry overlaps another, Then reject it", ".",
+		}, skip(2),
+		// The first round fails the gate (with one invalid score re-asked),
+		// the second passes it.
+		[]string{"Q: Offline? A: No.", ".", "abc"}, clarifyRound("10")[2:],
+		clarifyRound("90"),
+		[]string{"Monolith", ".", "- Go", ".", "### Tracker", "- Covers: FR-001, FR-002", ".", "### Entry", "| id | UUID |", "."},
+		skip(5),
+		[]string{"1", "1 day", "### TASK-001: Build tracker", "**Covers**: FR-001, FR-002, NFR-001", "."}, skip(3),
+		[]string{"All covered", ".", "All covered", ".", "_None found._", "."}, skip(3), []string{"pass"},
+	)
+	var out strings.Builder
+	if err := New(eng, in, &out).Run(); err != nil {
+		t.Fatalf("Run: %v\n%s", err, out.String())
+	}
+
+	text := out.String()
+	for _, want := range []string{
+		"Enter a whole number from 0 to 100.",
+		"is below the gate — let's run another round.",
+		"✅ Clarity Gate passed with 90/100.",
+		"✅ Saved docs/design.md",
+		"🎉 The pipeline is complete.",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("output missing %q", want)
+		}
+	}
+
+	state, err := eng.State()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.StageStatus["validate"].Status != "completed" {
+		t.Errorf("validate status = %q, want completed", state.StageStatus["validate"].Status)
+	}
+	reqs, err := os.ReadFile(filepath.Join(root, "docs", "requirements.md"))
+	if err != nil || !strings.Contains(string(reqs), "FR-002**: Users can export CSV") {
+		t.Errorf("requirements.md = %q, %v", reqs, err)
+	}
+}
+
+func TestWizard_InputEndsEarly(t *testing.T) {
+	eng, err := sdd.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := script([]string{"tracker", "Time tracking", "", "- Never lose a time entry"})
+	err = New(eng, in, io.Discard).Run()
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Run = %v, want an unexpected EOF", err)
+	}
+	if state, err := eng.State(); err != nil || state.Name != "tracker" {
+		t.Errorf("the project should be initialized before the input ran out: %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+// Package sdd embeds the Hoofy Spec-Driven Development pipeline in a Go
+// program, without going through MCP.
+//
+// An Engine drives one project directory through the same stages — and
+// the same validation, templates, and stage gates — as the sdd_* MCP
+// tools:
+//
+//	eng, err := sdd.New("/path/to/project")
+//	res, err := eng.Init(sdd.InitParams{Name: "tracker", Description: "Time tracking"})
+//	res, err = eng.Principles(sdd.PrinciplesData{Principles: "- Never lose a time entry"})
+//	res, err = eng.Propose(sdd.CharterData{ProblemStatement: "...", ...})
+//	...
+//
+// Every stage method returns the rendered artifact and the project state
+// after the run. Errors for invalid input or out-of-order stages satisfy
+// IsUserError; any other error is an I/O or rendering failure.
+package sdd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/HendryAvila/Hoofy/internal/config"
+	"github.com/HendryAvila/Hoofy/internal/templates"
+	"github.com/HendryAvila/Hoofy/internal/tools"
+)
+
+// Pipeline state and stage results.
+type (
+	// ProjectConfig is the persisted project state (hoofy.json).
+	ProjectConfig = config.ProjectConfig
+	// Mode is the project's interaction mode.
+	Mode = config.Mode
+	// Stage identifies a pipeline stage.
+	Stage = config.Stage
+	// Result is the outcome of a stage: Content is the artifact written,
+	// Config the state afterwards, and Response the guidance text the
+	// MCP tool would return.
+	Result = tools.StageResult
+)
+
+// Interaction modes.
+const (
+	ModeGuided = config.ModeGuided
+	ModeExpert = config.ModeExpert
+)
+
+// Stage inputs. The Name field of the *Data types is ignored; the
+// project's name is used.
+type (
+	InitParams        = tools.InitParams
+	PrinciplesData    = templates.PrinciplesData
+	CharterData       = templates.CharterData
+	RequirementsData  = templates.RequirementsData
+	BusinessRulesData = templates.BusinessRulesData
+	ClarifyParams     = tools.ClarifyParams
+	DesignData        = templates.DesignData
+	TasksData         = templates.TasksData
+	ValidateParams    = tools.ValidateParams
+	// ChecklistResult answers one release checklist item in
+	// ValidateParams.Checklist; Status is "pass", "fail" or "na".
+	ChecklistResult = tools.ChecklistResult
+	// Reference is an external link for the References field of
+	// CharterData, RequirementsData, DesignData and TasksData.
+	Reference = templates.Reference
+)
+
+// Engine runs the SDD pipeline for a single project directory.
+// It is not safe for concurrent use on the same project.
+type Engine struct {
+	root  string
+	store config.Store
+
+	init          *tools.InitTool
+	principles    *tools.PrinciplesTool
+	charter       *tools.CharterTool
+	specify       *tools.SpecifyTool
+	businessRules *tools.BusinessRulesTool
+	clarify       *tools.ClarifyTool
+	design        *tools.DesignTool
+	tasks         *tools.TasksTool
+	validate      *tools.ValidateTool
+}
+
+// New creates an Engine for the project rooted at projectRoot. The
+// directory must exist; the project itself is created by Init.
+func New(projectRoot string) (*Engine, error) {
+	root, err := filepath.Abs(projectRoot)
+	if err != nil {
+		return nil, fmt.Errorf("resolving project root: %w", err)
+	}
+	renderer, err := templates.NewRenderer()
+	if err != nil {
+		return nil, fmt.Errorf("creating template renderer: %w", err)
+	}
+	store := config.NewFileStore()
+
+	return &Engine{
+		root:          root,
+		store:         store,
+		init:          tools.NewInitTool(store, renderer),
+		principles:    tools.NewPrinciplesTool(store, renderer),
+		charter:       tools.NewCharterTool(store, renderer),
+		specify:       tools.NewSpecifyTool(store, renderer),
+		businessRules: tools.NewBusinessRulesTool(store, renderer),
+		clarify:       tools.NewClarifyTool(store, renderer),
+		design:        tools.NewDesignTool(store, renderer),
+		tasks:         tools.NewTasksTool(store, renderer),
+		validate:      tools.NewValidateTool(store),
+	}, nil
+}
+
+// Root returns the absolute project root.
+func (e *Engine) Root() string { return e.root }
+
+// State loads the current project state.
+func (e *Engine) State() (*ProjectConfig, error) { return e.store.Load(e.root) }
+
+// Batch runs fn with the project state saved once, when fn returns nil,
+// instead of after every stage fn runs. Stages inside fn see each
+// other's state as usual; artifacts are still written as each stage
+// runs. If fn fails, the state changes it made are discarded — the
+// state on disk stays as it was before Batch — and its error returned.
+// The save that creates a project (Init) is never deferred.
+func (e *Engine) Batch(fn func() error) error {
+	return config.InTransaction(e.store, e.root, fn)
+}
+
+// Init creates the project (sdd_init_project).
+func (e *Engine) Init(p InitParams) (*Result, error) { return e.init.Run(e.root, p) }
+
+// Principles records the project's golden invariants (sdd_create_principles).
+func (e *Engine) Principles(d PrinciplesData) (*Result, error) { return e.principles.Run(e.root, d) }
+
+// Propose saves the project charter (sdd_create_charter).
+func (e *Engine) Propose(d CharterData) (*Result, error) { return e.charter.Run(e.root, d) }
+
+// UpdateProposal replaces only the non-empty fields of d in the saved
+// charter, before requirements are generated (sdd_create_charter with
+// update=true).
+func (e *Engine) UpdateProposal(d CharterData) (*Result, error) { return e.charter.Update(e.root, d) }
+
+// Specify saves the MoSCoW requirements (sdd_generate_requirements).
+func (e *Engine) Specify(d RequirementsData) (*Result, error) { return e.specify.Run(e.root, d) }
+
+// BusinessRules saves the declarative business rules (sdd_create_business_rules).
+func (e *Engine) BusinessRules(d BusinessRulesData) (*Result, error) {
+	return e.businessRules.Run(e.root, d)
+}
+
+// Clarify runs a Clarity Gate round (sdd_clarify). Without answers it
+// only returns the question framework in Result.Response.
+func (e *Engine) Clarify(p ClarifyParams) (*Result, error) { return e.clarify.Run(e.root, p) }
+
+// Design saves the technical design (sdd_create_design).
+func (e *Engine) Design(d DesignData) (*Result, error) { return e.design.Run(e.root, d) }
+
+// Tasks saves the implementation task breakdown (sdd_create_tasks).
+func (e *Engine) Tasks(d TasksData) (*Result, error) { return e.tasks.Run(e.root, d) }
+
+// Validate writes the validation report and completes the pipeline (sdd_validate).
+func (e *Engine) Validate(p ValidateParams) (*Result, error) { return e.validate.Run(e.root, p) }
+
+// IsUserError reports whether err was caused by invalid input or by
+// running a stage out of order, as opposed to an I/O or rendering failure.
+func IsUserError(err error) bool { return tools.IsUserError(err) }
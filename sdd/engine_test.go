@@ -0,0 +1,198 @@
+package sdd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEngine_FullPipeline(t *testing.T) {
+	root := t.TempDir()
+	eng, err := New(root)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	steps := []struct {
+		name  string
+		run   func() (*Result, error)
+		after Stage
+		file  string // artifact written, relative to docs/
+	}{
+		{"init", func() (*Result, error) {
+			return eng.Init(InitParams{Name: "tracker", Description: "Time tracking", Mode: ModeExpert})
+		}, "principles", ""},
+		{"principles", func() (*Result, error) {
+			return eng.Principles(PrinciplesData{Principles: "- Never lose a time entry"})
+		}, "charter", "principles.md"},
+		{"propose", func() (*Result, error) {
+			return eng.Propose(CharterData{
+				ProblemStatement: "Freelancers lose billable hours",
+				TargetUsers:      "Freelancers",
+				ProposedSolution: "A web time tracker",
+				SuccessCriteria:  "- 90% of hours logged",
+			})
+		}, "specify", "charter.md"},
+		{"specify", func() (*Result, error) {
+			return eng.Specify(RequirementsData{
+				MustHave:      "- **FR-001**: Users can log time entries",
+				ShouldHave:    "- **FR-002**: Users can export CSV",
+				NonFunctional: "- **NFR-001**: Pages load in under 2s",
+			})
+		}, "business-rules", "requirements.md"},
+		{"business rules", func() (*Result, error) {
+			return eng.BusinessRules(BusinessRulesData{
+				Definitions: "- **Entry**: a logged block of time",
+				Facts:       "- Each Entry belongs to one User",
+				Constraints: "- When an Entry overlaps another, Then reject it",
+			})
+		}, "clarify", "business-rules.md"},
+		{"clarify", func() (*Result, error) {
+			return eng.Clarify(ClarifyParams{
+				Answers: "Q: Offline? A: No.",
+				DimensionScores: "target_users:90,core_functionality:90,data_model:90,integrations:90," +
+					"edge_cases:90,security:90,scale_performance:90,scope_boundaries:90",
+			})
+		}, "design", "clarifications.md"},
+		{"design", func() (*Result, error) {
+			return eng.Design(DesignData{
+				ArchitectureOverview: "Monolith",
+				TechStack:            "- Go",
+				Components:           "### Tracker\n- Covers: FR-001, FR-002",
+				DataModel:            "### Entry\n| id | UUID |",
+			})
+		}, "tasks", "design.md"},
+		{"tasks", func() (*Result, error) {
+			return eng.Tasks(TasksData{
+				TotalTasks:      "1",
+				EstimatedEffort: "1 day",
+				Tasks:           "### TASK-001: Build tracker\n**Covers**: FR-001, FR-002, NFR-001",
+			})
+		}, "validate", "tasks.md"},
+		{"validate", func() (*Result, error) {
+			return eng.Validate(ValidateParams{
+				RequirementsCoverage: "All covered",
+				ComponentCoverage:    "All covered",
+				ConsistencyIssues:    "_None found._",
+				Verdict:              "pass",
+			})
+		}, "validate", "validation.md"},
+	}
+
+	for _, step := range steps {
+		res, err := step.run()
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if res.Config.CurrentStage != step.after {
+			t.Fatalf("%s: stage = %s, want %s", step.name, res.Config.CurrentStage, step.after)
+		}
+		if res.Response == "" {
+			t.Errorf("%s: empty response", step.name)
+		}
+		if step.file == "" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(root, "docs", step.file))
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if res.Content == "" || !strings.Contains(string(data), strings.TrimSpace(res.Content)[:20]) {
+			t.Errorf("%s: Result.Content should be the artifact written to %s", step.name, step.file)
+		}
+	}
+
+	state, err := eng.State()
+	if err != nil {
+		t.Fatalf("State: %v", err)
+	}
+	if state.StageStatus["validate"].Status != "completed" {
+		t.Errorf("validate status = %q, want completed", state.StageStatus["validate"].Status)
+	}
+}
+
+func TestEngine_UserErrors(t *testing.T) {
+	eng, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if _, err := eng.Init(InitParams{Description: "no name"}); !IsUserError(err) {
+		t.Errorf("missing name: err = %v, want a user error", err)
+	}
+	if _, err := eng.Init(InitParams{Name: "x", Description: "y", Mode: "turbo"}); !IsUserError(err) {
+		t.Errorf("bad mode: err = %v, want a user error", err)
+	}
+	if _, err := eng.Init(InitParams{Name: "x", Description: "y"}); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	_, err = eng.Design(DesignData{ArchitectureOverview: "a", TechStack: "b", Components: "c", DataModel: "d"})
+	if !IsUserError(err) || !strings.Contains(err.Error(), "design") {
+		t.Errorf("out-of-order stage: err = %v, want a user error", err)
+	}
+	if _, err := eng.Init(InitParams{Name: "x", Description: "y"}); !IsUserError(err) {
+		t.Errorf("re-init without force: err = %v, want a user error", err)
+	}
+}
+
+func TestEngine_Batch(t *testing.T) {
+	root := t.TempDir()
+	eng, err := New(root)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, err := eng.Init(InitParams{Name: "tracker", Description: "Time tracking", Mode: ModeExpert}); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	before, err := eng.State()
+	if err != nil {
+		t.Fatalf("State: %v", err)
+	}
+
+	err = eng.Batch(func() error {
+		if _, err := eng.Principles(PrinciplesData{Principles: "- Never lose a time entry"}); err != nil {
+			return err
+		}
+		_, err := eng.Propose(CharterData{
+			ProblemStatement: "Freelancers lose billable hours",
+			TargetUsers:      "Freelancers",
+			ProposedSolution: "A web time tracker",
+			SuccessCriteria:  "- 90% of hours logged",
+		})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+	after, err := eng.State()
+	if err != nil {
+		t.Fatalf("State: %v", err)
+	}
+	if after.CurrentStage != "specify" {
+		t.Errorf("stage after batch = %s, want specify", after.CurrentStage)
+	}
+	if after.Revision <= before.Revision {
+		t.Errorf("revision = %d, want it past %d", after.Revision, before.Revision)
+	}
+
+	// A failing batch leaves the saved state untouched.
+	err = eng.Batch(func() error {
+		if _, err := eng.Specify(RequirementsData{MustHave: "- **FR-001**: Users can log time"}); err != nil {
+			return err
+		}
+		_, err := eng.Design(DesignData{ArchitectureOverview: "a", TechStack: "b", Components: "c", DataModel: "d"})
+		return err
+	})
+	if !IsUserError(err) {
+		t.Fatalf("Batch with an out-of-order stage: err = %v, want a user error", err)
+	}
+	state, err := eng.State()
+	if err != nil {
+		t.Fatalf("State: %v", err)
+	}
+	if state.CurrentStage != "specify" || state.Revision != after.Revision {
+		t.Errorf("after failed batch: stage %s rev %d, want specify rev %d",
+			state.CurrentStage, state.Revision, after.Revision)
+	}
+}